@@ -16,23 +16,38 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"text/template"
 
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables/report/generated/acpitablesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens/report/generated/apcbsecanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume/report/generated/biosrtmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature/report/generated/pspsignanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl/report/generated/pspsplanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain/report/generated/bootchainanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance/report/generated/firmwareprovenanceanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff/report/generated/imagediffanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm/report/generated/intelacmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme/report/generated/intelmeanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms/report/generated/optionromsanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr/report/generated/reproducepcranalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors/report/generated/txterrorsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules/report/generated/vulnmodulesanalysis"
 	xregisters "github.com/immune-gmbh/attestation-sdk/pkg/registers"
 
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/analyze/format"
@@ -76,8 +91,11 @@ type dumpCommand = dump.Command
 type Command struct {
 	dumpCommand
 	analyzers         analyzersFlag
+	plugins           analyzersFlag
 	eventLog          *string
+	acpiTables        *string
 	expectPCR0        *string
+	psbFusedKeyHash   *string
 	afasEndpoint      *string
 	firmwareVersion   *string
 	registers         *string
@@ -85,6 +103,8 @@ type Command struct {
 	flow              *string
 	localhostRequest  *bool
 	showNotApplicable *bool
+	profile           *bool
+	dependencyGraphs  *string
 	dumpRequest       *string
 	useRequest        *string
 	outputJSON        *bool
@@ -137,6 +157,18 @@ func (cmd Command) EventLog() (*tpmeventlog.TPMEventLog, error) {
 	return helpers.ParseTPMEventlog(eventlogPath)
 }
 
+// ACPITables returns the ACPI tables from the directory defined by flag '-acpi-tables' and '-localhost'
+func (cmd Command) ACPITables() ([]acpi.Table, bool, error) {
+	if len(*cmd.acpiTables) > 0 {
+		tables, err := acpi.LoadTablesDir(*cmd.acpiTables)
+		return tables, true, err
+	} else if *cmd.localhostRequest {
+		tables, err := acpi.LoadTablesDir(acpi.DefaultTablesPath)
+		return tables, false, err
+	}
+	return nil, false, nil
+}
+
 // ExpectPCR0 returns a PCR0 defined by path flag '-expect-pcr0' and '-localhost'
 func (cmd Command) ExpectPCR0() ([]byte, bool, error) {
 	if len(*cmd.expectPCR0) > 0 {
@@ -158,6 +190,18 @@ func (cmd Command) ExpectPCR0() ([]byte, bool, error) {
 	return nil, false, nil
 }
 
+// PSBFusedKeyHash returns the hash of the OEM root key fused into the AMD CPU according to flag '-psb-fused-key-hash' and '-localhost'
+func (cmd Command) PSBFusedKeyHash() ([]byte, bool, error) {
+	if len(*cmd.psbFusedKeyHash) > 0 {
+		keyHash, err := hex.DecodeString(strings.TrimPrefix(*cmd.psbFusedKeyHash, "0x"))
+		return keyHash, true, err
+	} else if *cmd.localhostRequest {
+		keyHash, err := xregisters.LocalPSBFusedKeyHash()
+		return keyHash, false, err
+	}
+	return nil, false, nil
+}
+
 // FlagFlow returns the value of the flag "flow"
 func (cmd Command) FlagFlow() (pcr.Flow, error) {
 	return pcr.FlowFromString(*cmd.flow)
@@ -272,17 +316,22 @@ func (cmd Command) DumpRequestFormat() (DumpFormat, error) {
 func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
 	cmd.dumpCommand.SetupFlagSet(flag)
 
-	flag.Var(&cmd.analyzers, "analyzer", "List of analyzers to start, values: "+knownAnalyzersArg())
+	flag.Var(&cmd.analyzers, "analyzer", "List of analyzers to start (by default: "+analyzersArg(defaultAnalyzers)+"), values: "+knownAnalyzersArg())
+	flag.Var(&cmd.plugins, "plugin", "List of out-of-process analyzer plugins (configured on the server) to start")
 	cmd.afasEndpoint = flag.String("afas-endpoint", "http://localhost:17545", "")
 	cmd.firmwareVersion = flag.String("firmware-version", "", "the version of the firmware to compare with; empty value means to read SMBIOS values")
 	cmd.eventLog = flag.String("event-log", "", "path to the binary EventLog")
+	cmd.acpiTables = flag.String("acpi-tables", "", "path to the directory with dumped ACPI tables (like "+acpi.DefaultTablesPath+", which is used by default with -localhost)")
 	cmd.expectPCR0 = flag.String("expect-pcr0", "", "if you need information why PCR0 does not match the one you expect then pass the expected value here (allowed formats: binary, base64, hex); by default it reads the PCR0 value from TPM")
 	cmd.registers = flag.String("registers", "", "use status registers from JSON file (or dump them from TXT Public Space if empty value)")
+	cmd.psbFusedKeyHash = flag.String("psb-fused-key-hash", "", "SHA256 hash of the OEM root key fused into the AMD CPU in hex; by default it is read from SMBIOS with -localhost (if provided by BIOS)")
 	cmd.tpmDevice = flag.String("tpm-device", "", "optional tpm device type, values: "+pcr0tool_commands.TPMTypeCommandLineValues())
 	cmd.flow = flag.String("flow", pcr.FlowAuto.String(), "desired measurements flow, values: "+pcr0tool_commands.FlowCommandLineValues())
 	cmd.localhostRequest = flag.Bool("localhost", false, "specified whether request is made for localhost environment")
 	cmd.showNotApplicable = flag.Bool("show-not-applicable", false, "specifies whether to show not applicable analyzers result")
 	cmd.outputJSON = flag.Bool("json", false, "prints the result AnalyzeResult thrift structure in json format")
+	cmd.dependencyGraphs = flag.String("dependency-graph-dir", "", "requests the input dependency graphs of the analyzers and saves them into this directory as <index>-<analyzer>.{dot,json}")
+	cmd.profile = flag.Bool("profile", false, "requests and prints the execution profile of the analysis (timings, cache hits/misses and process allocations during each interval)")
 
 	// TODO: Consider splitting "afascli analyze" to "afascli scan" and "afascli analyze".
 	//       The "scan" should gather all the information, but do not send it anywhere,
@@ -319,8 +368,8 @@ func (cmd Command) buildAnalyzeRequest(
 		actualFirmwareFile = args[0]
 	}
 
-	if len(cmd.analyzers) == 0 {
-		cmd.analyzers = knownAnalyzers
+	if len(cmd.analyzers) == 0 && len(cmd.plugins) == 0 {
+		cmd.analyzers = defaultAnalyzers
 	}
 	for _, analyzer := range cmd.analyzers {
 		var found bool
@@ -408,6 +457,22 @@ func (cmd Command) buildAnalyzeRequest(
 		return nil, err
 	}
 
+	acpiTables, userInput, err := cmd.ACPITables()
+	if err != nil {
+		logger.FromCtx(ctx).Errorf("Failed to obtain ACPI tables: %v", err)
+		if userInput {
+			return nil, err
+		}
+	}
+
+	psbFusedKeyHash, userInput, err := cmd.PSBFusedKeyHash()
+	if err != nil {
+		logger.FromCtx(ctx).Errorf("Failed to obtain PSB fused key hash: %v", err)
+		if userInput {
+			return nil, err
+		}
+	}
+
 	expectPCR0, userInput, err := cmd.ExpectPCR0()
 	if err != nil {
 		logger.FromCtx(ctx).Errorf("Failed to obtain expected PCR0: %v", err)
@@ -505,11 +570,131 @@ func (cmd Command) buildAnalyzeRequest(
 			if err != nil {
 				color.New(color.FgRed).Printf("Failed to add BIOS RTM Volume input request: %v\n", err)
 			}
+		case intelifdanalysis.IntelFlashDescriptorAnalyzerID:
+			err = requestBuilder.AddIntelFlashDescriptorInput(
+				firmwareVersion,
+				nil,
+				actualImage,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add Intel flash descriptor input request: %v\n", err)
+			}
+		case intelmeanalysis.IntelMEAnalyzerID:
+			err = requestBuilder.AddIntelMEInput(
+				firmwareVersion,
+				nil,
+				actualImage,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add Intel ME input request: %v\n", err)
+			}
+		case pspsplanalysis.PSPSecurityPatchLevelAnalyzerID:
+			err = requestBuilder.AddPSPSecurityPatchLevelInput(
+				firmwareVersion,
+				nil,
+				actualImage,
+				registers,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add PSP security patch level input request: %v\n", err)
+			}
+		case psbfusesanalysis.PSBFusesAnalyzerID:
+			err = requestBuilder.AddPSBFusesInput(
+				actualImage,
+				registers,
+				psbFusedKeyHash,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add PSB fuses input request: %v\n", err)
+			}
+		case vulnmodulesanalysis.VulnerableModulesAnalyzerID:
+			err = requestBuilder.AddVulnerableModulesInput(
+				actualImage,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add vulnerable modules input request: %v\n", err)
+			}
+		case flashdegradationanalysis.FlashDegradationAnalyzerID:
+			err = requestBuilder.AddFlashDegradationInput(
+				firmwareVersion,
+				nil,
+				actualImage,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add flash degradation input request: %v\n", err)
+			}
+		case firmwareprovenanceanalysis.FirmwareProvenanceAnalyzerID:
+			err = requestBuilder.AddFirmwareProvenanceInput(
+				actualImage,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add firmware provenance input request: %v\n", err)
+			}
+		case optionromsanalysis.OptionROMsAnalyzerID:
+			err = requestBuilder.AddOptionROMsInput(
+				eventlog,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add option ROMs input request: %v\n", err)
+			}
+		case bootchainanalysis.BootChainAnalyzerID:
+			err = requestBuilder.AddBootChainInput(
+				eventlog,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add boot chain input request: %v\n", err)
+			}
+		case acpitablesanalysis.ACPITablesAnalyzerID:
+			err = requestBuilder.AddACPITablesInput(
+				firmwareVersion,
+				nil,
+				acpiTables,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add ACPI tables input request: %v\n", err)
+			}
+		case txterrorsanalysis.TXTErrorsAnalyzerID:
+			err = requestBuilder.AddTXTErrorsInput(
+				registers,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add TXT errors input request: %v\n", err)
+			}
+		case imagediffanalysis.ImageDiffAnalyzerID:
+			err = requestBuilder.AddImageDiffInput(
+				firmwareVersion,
+				nil,
+				actualImage,
+			)
+			if err != nil {
+				color.New(color.FgRed).Printf("Failed to add image diff input request: %v\n", err)
+			}
 		default:
 			return nil, fmt.Errorf("not supported analyzer: %s", analyzer)
 		}
 	}
 
+	for _, pluginID := range cmd.plugins {
+		var actualImagePtr *afas.FirmwareImage
+		if actualImage.CountSetFieldsFirmwareImage() == 1 {
+			actualImagePtr = &actualImage
+		}
+		err = requestBuilder.AddPluginInput(
+			string(pluginID),
+			firmwareVersion,
+			nil,
+			actualImagePtr,
+			registers,
+			tpmDevice,
+			eventlog,
+			expectPCR0,
+			acpiTables,
+		)
+		if err != nil {
+			color.New(color.FgRed).Printf("Failed to add input request of plugin %s: %v\n", pluginID, err)
+		}
+	}
+
 	return requestBuilder.GetThrift(), nil
 }
 
@@ -578,6 +763,13 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 		}
 	}
 
+	if *cmd.profile {
+		request.Profile = ptr(true)
+	}
+	if len(*cmd.dependencyGraphs) > 0 {
+		request.DependencyGraphs = ptr(true)
+	}
+
 	if dumpRequestFormat != DumpFormatNone {
 		switch dumpRequestFormat {
 		case DumpFormatJSON:
@@ -602,6 +794,11 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 		if err != nil {
 			return fmt.Errorf("analyze request failed: %w", err)
 		}
+		if len(*cmd.dependencyGraphs) > 0 {
+			if err := saveDependencyGraphs(*cmd.dependencyGraphs, result); err != nil {
+				return fmt.Errorf("unable to save the dependency graphs: %w", err)
+			}
+		}
 
 		switch {
 		case *cmd.outputJSON:
@@ -625,6 +822,36 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 			}
 		default:
 			format.HumanReadable(os.Stdout, *result, true, *cmd.showNotApplicable)
+			if result.IsSetProfile() {
+				format.Profile(os.Stdout, *result.Profile, true)
+			}
+		}
+	}
+	return nil
+}
+
+// saveDependencyGraphs saves AnalyzerResult.DependencyGraphJSON of each
+// analyzer to "<dir>/<index>-<analyzer>.{dot,json}".
+func saveDependencyGraphs(dir string, result *afas.AnalyzeResult_) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("unable to create directory '%s': %w", dir, err)
+	}
+	for idx, analyzerResult := range result.GetResults() {
+		if !analyzerResult.IsSetDependencyGraphJSON() {
+			continue
+		}
+		var graph analysis.DependencyGraph
+		if err := json.Unmarshal([]byte(analyzerResult.GetDependencyGraphJSON()), &graph); err != nil {
+			return fmt.Errorf("unable to parse the dependency graph of analyzer '%s': %w", analyzerResult.AnalyzerName, err)
+		}
+		for ext, content := range map[string]string{
+			".dot":  graph.DOT(),
+			".json": analyzerResult.GetDependencyGraphJSON(),
+		} {
+			path := filepath.Join(dir, fmt.Sprintf("%d-%s%s", idx, analyzerResult.AnalyzerName, ext))
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				return fmt.Errorf("unable to write '%s': %w", path, err)
+			}
 		}
 	}
 	return nil
@@ -666,11 +893,39 @@ var knownAnalyzers = []analysis.AnalyzerID{
 	pspsignanalysis.PSPSignatureAnalyzerID,
 	biosrtmanalysis.BIOSRTMVolumeAnalyzerID,
 	apcbsecanalysis.APCBSecurityTokensAnalyzerID,
+	intelifdanalysis.IntelFlashDescriptorAnalyzerID,
+	intelmeanalysis.IntelMEAnalyzerID,
+	pspsplanalysis.PSPSecurityPatchLevelAnalyzerID,
+	psbfusesanalysis.PSBFusesAnalyzerID,
+	vulnmodulesanalysis.VulnerableModulesAnalyzerID,
+	flashdegradationanalysis.FlashDegradationAnalyzerID,
+	firmwareprovenanceanalysis.FirmwareProvenanceAnalyzerID,
+	optionromsanalysis.OptionROMsAnalyzerID,
+	bootchainanalysis.BootChainAnalyzerID,
+	acpitablesanalysis.ACPITablesAnalyzerID,
+	txterrorsanalysis.TXTErrorsAnalyzerID,
+	imagediffanalysis.ImageDiffAnalyzerID,
+}
+
+// defaultAnalyzers are started if neither analyzers nor plugins are specified.
+// The rest of knownAnalyzers require inputs which are not always available
+// (or are not applicable to a host firmware image), so they are opt-in.
+var defaultAnalyzers = []analysis.AnalyzerID{
+	diffanalysis.DiffMeasuredBootAnalyzerID,
+	intelacmanalysis.IntelACMAnalyzerID,
+	reproducepcranalysis.ReproducePCRAnalyzerID,
+	pspsignanalysis.PSPSignatureAnalyzerID,
+	biosrtmanalysis.BIOSRTMVolumeAnalyzerID,
+	apcbsecanalysis.APCBSecurityTokensAnalyzerID,
 }
 
 func knownAnalyzersArg() string {
-	result := make([]string, 0, len(knownAnalyzers))
-	for _, analyzer := range knownAnalyzers {
+	return analyzersArg(knownAnalyzers)
+}
+
+func analyzersArg(analyzers []analysis.AnalyzerID) string {
+	result := make([]string, 0, len(analyzers))
+	for _, analyzer := range analyzers {
 		result = append(result, string(analyzer))
 	}
 	return strings.Join(result, "|")
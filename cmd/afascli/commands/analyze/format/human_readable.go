@@ -16,6 +16,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/9elements/converged-security-suite/v2/pkg/diff"
@@ -28,9 +29,22 @@ import (
 	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerreport"
 	"github.com/immune-gmbh/attestation-sdk/if/generated/measurements"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables/report/generated/acpitablesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume/report/generated/biosrtmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature/report/generated/pspsignanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl/report/generated/pspsplanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain/report/generated/bootchainanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance/report/generated/firmwareprovenanceanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff/report/generated/imagediffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme/report/generated/intelmeanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms/report/generated/optionromsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/report/generated/pluginanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors/report/generated/txterrorsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules/report/generated/vulnmodulesanalysis"
 	controllertypes "github.com/immune-gmbh/attestation-sdk/pkg/server/controller/types"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
 	"github.com/linuxboot/fiano/pkg/amd/apcb"
@@ -61,13 +75,47 @@ func HumanReadable(w io.Writer, result afas.AnalyzeResult_, enableColors bool, s
 				continue
 			}
 		}
-		fmt.Fprintf(w, "=== Results of '%s' ===\n", analyzerResult.AnalyzerName)
+		if analyzerResult.IsSetAnalyzerVersion() {
+			fmt.Fprintf(w, "=== Results of '%s' (version %s) ===\n", analyzerResult.AnalyzerName, analyzerResult.GetAnalyzerVersion())
+		} else {
+			fmt.Fprintf(w, "=== Results of '%s' ===\n", analyzerResult.AnalyzerName)
+		}
 		printAnalyzerResult(w, *analyzerResult.AnalyzerOutcome, enableColors)
 		fmt.Fprintf(w, "=== End of '%s' ===\n", analyzerResult.AnalyzerName)
 	}
+	if result.IsSetVerdict() {
+		printHostVerdict(w, *result.Verdict, enableColors)
+	}
 	printReferencesToImages(w, result)
 }
 
+func printHostVerdict(w io.Writer, verdict afas.HostVerdict, enableColors bool) {
+	fprintfWithColor(w, enableColors, verdictClassColor(verdict.VerdictClass), "=== Host verdict: %s (confidence: %.2f) ===\n", verdict.VerdictClass, verdict.Confidence)
+	for _, evidence := range verdict.Evidence {
+		if evidence == nil {
+			continue
+		}
+		fmt.Fprintf(w, "%s by rule '%s' (confidence: %.2f): '%s'", evidence.VerdictClass, evidence.Rule, evidence.Confidence, evidence.AnalyzerName)
+		if evidence.IsSetIssueCode() {
+			fmt.Fprintf(w, " [%s]", evidence.GetIssueCode())
+		}
+		if evidence.IsSetDescription() {
+			fmt.Fprintf(w, " %s", evidence.GetDescription())
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func verdictClassColor(class afas.HostVerdictClass) color.Attribute {
+	switch class {
+	case afas.HostVerdictClass_Healthy:
+		return color.FgGreen
+	case afas.HostVerdictClass_Inconclusive, afas.HostVerdictClass_DegradedHardware, afas.HostVerdictClass_Misconfigured:
+		return color.FgYellow
+	}
+	return color.FgRed
+}
+
 func printReferencesToImages(w io.Writer, result afas.AnalyzeResult_) {
 	var actualImageIDs, originalImageIDs []types.ImageID
 	actualImageIDIsSet, originalImageIDIsSet := map[types.ImageID]struct{}{}, map[types.ImageID]struct{}{}
@@ -167,6 +215,9 @@ func printAnalyzerResult(w io.Writer, result afas.AnalyzerOutcome, enableColors
 			case report.Custom.IsSetDiffMeasuredBoot():
 				diffMeasuredBoot := report.Custom.GetDiffMeasuredBoot()
 				fmt.Fprintf(w, "Diff diagnosis: %s\n", diffMeasuredBoot.GetDiagnosis())
+				if diffMeasuredBoot.IsSetDiagnosisExplanation() {
+					fmt.Fprintf(w, "Diagnosis explanation: %s\n", diffMeasuredBoot.GetDiagnosisExplanation())
+				}
 				for _, diffEntry := range diffMeasuredBoot.GetDiffEntries() {
 					var offset, length int64
 					if diffEntry.Range == nil || (diffEntry.Range.Length == 0 && diffEntry.OBSOLETE_Length != 0) {
@@ -185,6 +236,16 @@ func printAnalyzerResult(w io.Writer, result afas.AnalyzerOutcome, enableColors
 						fmt.Fprintf(w, "\tbitwise non 0x00/0xFF hamming distance is %7d\n", diffEntry.HammingDistanceNon00orFF)
 					}
 				}
+				for _, fileDiff := range diffMeasuredBoot.GetFileDiffs() {
+					fmt.Fprintf(w, "%s\n", fileDiffDescription(fileDiff))
+				}
+				for _, variableDiff := range diffMeasuredBoot.GetNVRAMDiffs() {
+					fmt.Fprintf(w, "NVRAM variable %s:%s %s (%s)\n",
+						variableDiff.GUID, variableDiff.Name,
+						strings.ToLower(variableDiff.Change.String()),
+						strings.ToLower(variableDiff.Class.String()),
+					)
+				}
 			case report.Custom.IsSetIntelACM():
 				intelACM := report.Custom.GetIntelACM()
 				if intelACM.Original != nil {
@@ -279,6 +340,119 @@ func printAnalyzerResult(w io.Writer, result afas.AnalyzerOutcome, enableColors
 						fmt.Fprintf(w, "Token.Value: %s\n", token.Value)
 					}
 				}
+			case report.Custom.IsSetIntelFlashDescriptor():
+				intelFlashDescriptor := report.Custom.IntelFlashDescriptor
+				for _, item := range []struct {
+					Name string
+					Info *intelifdanalysis.FlashDescriptorInfo
+				}{
+					{Name: "Original", Info: intelFlashDescriptor.Original},
+					{Name: "Actual", Info: intelFlashDescriptor.Actual},
+				} {
+					if item.Info == nil {
+						continue
+					}
+					fmt.Fprintf(w, "%s.Version: %s\n", item.Name, item.Info.Version)
+					for _, region := range item.Info.Regions {
+						fmt.Fprintf(w, "%s.Region.%s: 0x%08X--0x%08X\n", item.Name, region.Type, region.Offset, region.Offset+region.Length)
+					}
+					for _, master := range item.Info.Masters {
+						fmt.Fprintf(w, "%s.Master.%s: read: %v, write: %v\n", item.Name, master.Master, master.ReadableRegions, master.WritableRegions)
+					}
+					fmt.Fprintf(w, "%s.Locked: %t\n", item.Name, item.Info.Locked)
+					if item.Info.HAP != nil {
+						fmt.Fprintf(w, "%s.HAP: %t\n", item.Name, *item.Info.HAP)
+					}
+					if item.Info.AltMEDisable != nil {
+						fmt.Fprintf(w, "%s.AltMEDisable: %t\n", item.Name, *item.Info.AltMEDisable)
+					}
+				}
+				for _, difference := range intelFlashDescriptor.Differences {
+					fprintfWithColor(w, enableColors, color.FgRed, "%s differs: original: '%s', actual: '%s'\n", difference.Field, difference.Original, difference.Actual)
+				}
+			case report.Custom.IsSetIntelME():
+				intelME := report.Custom.IntelME
+				for _, item := range []struct {
+					Name string
+					Info *intelmeanalysis.MEInfo
+				}{
+					{Name: "Original", Info: intelME.Original},
+					{Name: "Actual", Info: intelME.Actual},
+				} {
+					if item.Info == nil {
+						continue
+					}
+					if item.Info.Version != nil {
+						fmt.Fprintf(w, "%s.Version: %d.%d.%d.%d\n", item.Name,
+							uint16(item.Info.Version.Major), uint16(item.Info.Version.Minor),
+							uint16(item.Info.Version.Hotfix), uint16(item.Info.Version.Build))
+					}
+					if item.Info.SVN != nil {
+						fmt.Fprintf(w, "%s.SVN: %d\n", item.Name, *item.Info.SVN)
+					}
+					for _, partition := range item.Info.Partitions {
+						colorAttr := color.FgGreen
+						switch partition.ManifestValidation {
+						case intelmeanalysis.ManifestValidation_HashMismatch, intelmeanalysis.ManifestValidation_InvalidFormat:
+							colorAttr = color.FgRed
+						}
+						fprintfWithColor(w, enableColors, colorAttr, "%s.Partition.%s: type: %s, SHA256: %X, manifest: %s\n",
+							item.Name, partition.Name, partition.Type, partition.SHA256, partition.ManifestValidation)
+					}
+				}
+				fmt.Fprintf(w, "Diagnosis: %s\n", intelME.Diagnosis)
+				if len(intelME.ChangedPartitions) > 0 {
+					fmt.Fprintf(w, "Changed partitions: %s\n", strings.Join(intelME.ChangedPartitions, ", "))
+				}
+			case report.Custom.IsSetPSPSecurityPatchLevel():
+				pspSPL := report.Custom.PSPSecurityPatchLevel
+				for _, item := range []struct {
+					Name string
+					Info *pspsplanalysis.FirmwareSPL
+				}{
+					{Name: "Original", Info: pspSPL.Original},
+					{Name: "Actual", Info: pspSPL.Actual},
+				} {
+					if item.Info == nil {
+						continue
+					}
+					for _, entry := range item.Info.Entries {
+						fmt.Fprintf(w, "%s.%s.%s: SPL: 0x%X, version: 0x%X\n", item.Name, entry.Directory, entry.Entry, entry.SecurityPatchLevel, entry.ImageVersion)
+					}
+					if item.Info.BIOSKeyRevisionID != nil {
+						fmt.Fprintf(w, "%s.BIOSKeyRevisionID: %d\n", item.Name, *item.Info.BIOSKeyRevisionID)
+					}
+				}
+				for _, downgrade := range pspSPL.Downgrades {
+					fprintfWithColor(w, enableColors, color.FgRed, "%s.%s downgraded: SPL: 0x%X -> 0x%X, version: 0x%X -> 0x%X\n",
+						downgrade.Actual.Directory, downgrade.Actual.Entry,
+						downgrade.Original.SecurityPatchLevel, downgrade.Actual.SecurityPatchLevel,
+						downgrade.Original.ImageVersion, downgrade.Actual.ImageVersion)
+				}
+				if pspSPL.Fuses != nil {
+					fmt.Fprintf(w, "Fuses: PSB enabled: %t, anti-rollback enabled: %t, BIOS key revision: %d\n",
+						pspSPL.Fuses.PlatformSecureBootEnabled, pspSPL.Fuses.AntiRollbackEnabled, pspSPL.Fuses.BIOSKeyRevisionID)
+				}
+			case report.Custom.IsSetPSBFuses():
+				PrintPSBFusesReport(w, enableColors, report.Custom.PSBFuses)
+			case report.Custom.IsSetVulnerableModules():
+				PrintVulnerableModulesReport(w, enableColors, report.Custom.VulnerableModules)
+			case report.Custom.IsSetFlashDegradation():
+				PrintFlashDegradationReport(w, report.Custom.FlashDegradation)
+			case report.Custom.IsSetFirmwareProvenance():
+				PrintFirmwareProvenanceReport(w, report.Custom.FirmwareProvenance)
+			case report.Custom.IsSetOptionROMs():
+				PrintOptionROMsReport(w, enableColors, report.Custom.OptionROMs)
+			case report.Custom.IsSetBootChain():
+				PrintBootChainReport(w, enableColors, report.Custom.BootChain)
+			case report.Custom.IsSetACPITables():
+				PrintACPITablesReport(w, enableColors, report.Custom.ACPITables)
+			case report.Custom.IsSetTXTErrors():
+				PrintTXTErrorsReport(w, enableColors, report.Custom.TXTErrors)
+			case report.Custom.IsSetImageDiff():
+				PrintImageDiffReport(w, enableColors, report.Custom.ImageDiff)
+			case report.Custom.IsSetPlugin():
+				PrintPluginReport(w, report.Custom.Plugin)
 			default:
 				fmt.Fprintln(w, "Not supported report.Custom type")
 				if resultJSON, err := json.MarshalIndent(report.Custom, "", " "); err == nil {
@@ -302,9 +476,18 @@ func printAnalyzerResult(w io.Writer, result afas.AnalyzerOutcome, enableColors
 					continue
 				}
 				fprintfWithColor(w, enableColors, severityColor(issue.Severity), "\tSeverity: %s\n", issue.Severity)
+				if issue.Code != nil {
+					fmt.Fprintf(w, "\tCode: %s\n", *issue.Code)
+				}
 				if issue.Description != nil {
 					fmt.Fprintf(w, "\tDescription: %s\n", *issue.Description)
 				}
+				if len(issue.Params) > 0 {
+					fmt.Fprintf(w, "\tParams: %s\n", formatIssueParams(issue.Params))
+				}
+				if issue.Remediation != nil {
+					fmt.Fprintf(w, "\tRemediation: %s\n", *issue.Remediation)
+				}
 				if issue.Custom != nil {
 					// TODO: add printing of custom issue information here
 					// else
@@ -415,6 +598,20 @@ func severityColor(severity analyzerreport.Severity) color.Attribute {
 	return color.FgRed
 }
 
+func formatIssueParams(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", key, params[key]))
+	}
+	return strings.Join(pairs, " ")
+}
+
 func maxSeverity(issues []*analyzerreport.Issue) analyzerreport.Severity {
 	result := analyzerreport.Severity_SeverityInfo
 	for _, issue := range issues {
@@ -428,3 +625,304 @@ func maxSeverity(issues []*analyzerreport.Issue) analyzerreport.Severity {
 
 	return result
 }
+
+// PrintPSBFusesReport prints the report of PSBFuses analyzer in a human-readable format
+func PrintPSBFusesReport(w io.Writer, enableColors bool, report *psbfusesanalysis.CustomReport) {
+	fuses := report.Fuses
+	fmt.Fprintf(w, "Fuses: PSB enabled: %t, vendor ID: 0x%02X, platform model ID: 0x%X, key revision ID: 0x%X, anti-rollback: %t, customer key lock: %t\n",
+		fuses.PlatformSecureBootEnabled, fuses.VendorID, fuses.PlatformModelID, fuses.KeyRevisionID, fuses.AntiRollbackEnabled, fuses.CustomerKeyLock)
+	if fuses.KeyHash != nil {
+		fmt.Fprintf(w, "Fuses.KeyHash: %X\n", fuses.KeyHash)
+	}
+	if fuses.TestStatus != nil {
+		fmt.Fprintf(w, "Fuses.TestStatus: %s\n", *fuses.TestStatus)
+	}
+	if report.Image != nil {
+		fmt.Fprintf(w, "Image: vendor ID: 0x%02X, platform model ID: 0x%X, key revision ID: 0x%X, key hash: %X\n",
+			report.Image.VendorID, report.Image.PlatformModelID, report.Image.KeyRevisionID, report.Image.KeyHash)
+	} else {
+		fmt.Fprintln(w, "Image: no OEM signing key")
+	}
+	for _, mismatch := range report.Mismatches {
+		fprintfWithColor(w, enableColors, color.FgRed, "%s differs: fused: '%s', image: '%s'\n", mismatch.Field, mismatch.Fused, mismatch.Image)
+	}
+	if report.WouldBrick {
+		fprintfWithColor(w, enableColors, color.FgRed, "The CPU would refuse to boot the image\n")
+	}
+}
+
+// PrintVulnerableModulesReport prints the report of VulnerableModules analyzer in a human-readable format
+func PrintVulnerableModulesReport(w io.Writer, enableColors bool, report *vulnmodulesanalysis.CustomReport) {
+	fmt.Fprintf(w, "Scanned modules: %d, known advisories: %d\n", report.ScannedModules, report.KnownAdvisories)
+	for _, match := range report.Matches {
+		module := match.Module
+		fprintfWithColor(w, enableColors, color.FgRed, "%s (%s): module %s", match.Advisory.CVE, match.Advisory.Severity, module.GUID)
+		if module.Name != nil {
+			fprintfWithColor(w, enableColors, color.FgRed, " '%s'", *module.Name)
+		}
+		if module.Version != nil {
+			fprintfWithColor(w, enableColors, color.FgRed, " version '%s'", *module.Version)
+		}
+		fmt.Fprintln(w)
+		for _, hash := range module.SHA256 {
+			fmt.Fprintf(w, "\tSHA256: %X\n", hash)
+		}
+		if match.Advisory.Description != "" {
+			fmt.Fprintf(w, "\t%s\n", match.Advisory.Description)
+		}
+	}
+}
+
+// PrintFlashDegradationReport prints the report of FlashDegradation analyzer in a human-readable format
+func PrintFlashDegradationReport(w io.Writer, report *flashdegradationanalysis.CustomReport) {
+	fmt.Fprintf(w, "Verdict: %s (degradation score %d/100)\n", report.Verdict, report.DegradationScore)
+	if report.Verdict == flashdegradationanalysis.Verdict_NoDifference {
+		return
+	}
+	fmt.Fprintf(w, "Changed bytes: %d in %d area(s); flipped bits 1->0: %d, 0->1: %d; bytes turned to 0x00: %d, to 0xFF: %d\n",
+		report.ChangedBytes, report.DiffRanges,
+		report.FlippedBits1To0, report.FlippedBits0To1,
+		report.StuckAtZeroBytes, report.StuckAtOneBytes,
+	)
+	fmt.Fprintf(w, "Areas aligned to erase blocks (%d bytes): %d, to pages (%d bytes): %d\n",
+		report.EraseBlockSize, report.EraseBlockAlignedRanges,
+		report.PageSize, report.PageAlignedRanges,
+	)
+	for _, sector := range report.Sectors {
+		fmt.Fprintf(w, "\tsector 0x%08X: %d byte(s) changed, flipped bits 1->0: %d, 0->1: %d",
+			sector.Offset, sector.ChangedBytes, sector.FlippedBits1To0, sector.FlippedBits0To1)
+		if sector.StuckAtValue != nil {
+			fmt.Fprintf(w, ", stuck at 0x%02X", *sector.StuckAtValue)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// PrintFirmwareProvenanceReport prints the report of FirmwareProvenance analyzer in a human-readable format
+func PrintFirmwareProvenanceReport(w io.Writer, report *firmwareprovenanceanalysis.CustomReport) {
+	fmt.Fprintf(w, "Running version: %s, status: %s\n", report.RunningVersion, report.Status)
+	if report.ModelID != nil {
+		fmt.Fprintf(w, "Model ID: %d, known versions: %d\n", *report.ModelID, report.KnownVersions)
+	} else {
+		fmt.Fprintf(w, "Known versions: %d\n", report.KnownVersions)
+	}
+	printVersionInfo := func(title string, info *firmwareprovenanceanalysis.VersionInfo) {
+		if info == nil {
+			return
+		}
+		fmt.Fprintf(w, "%s: %s", title, info.Version)
+		if info.ReleaseDate != nil {
+			fmt.Fprintf(w, ", released %s", *info.ReleaseDate)
+		}
+		if info.Revoked {
+			fmt.Fprintf(w, ", revoked")
+		}
+		if info.SupersededBy != nil {
+			fmt.Fprintf(w, ", superseded by %s", *info.SupersededBy)
+		}
+		fmt.Fprintln(w)
+	}
+	printVersionInfo("Running", report.Running)
+	printVersionInfo("Latest", report.Latest)
+	if report.ReleaseDateGapDays != nil {
+		fmt.Fprintf(w, "Release date gap: %d days\n", *report.ReleaseDateGapDays)
+	}
+}
+
+// PrintOptionROMsReport prints the report of OptionROMs analyzer in a human-readable format
+func PrintOptionROMsReport(w io.Writer, enableColors bool, report *optionromsanalysis.CustomReport) {
+	fmt.Fprintf(w, "Option ROMs measured to PCR2: %d (known to the catalog: %d)\n", len(report.OptionROMs), report.CatalogEntries)
+	for _, optionROM := range report.OptionROMs {
+		status := optionROM.Status.String()
+		if enableColors && optionROM.Status != optionromsanalysis.Status_Known {
+			status = color.New(color.FgRed).Sprint(status)
+		}
+		fmt.Fprintf(w, "\t%s: %s", optionROM.DevicePath, status)
+		if optionROM.Name != nil {
+			fmt.Fprintf(w, " '%s'", *optionROM.Name)
+			if optionROM.Version != nil {
+				fmt.Fprintf(w, " version %s", *optionROM.Version)
+			}
+		}
+		fmt.Fprintln(w)
+		for _, digest := range optionROM.Digests {
+			fmt.Fprintf(w, "\t\t%s: %X\n", digest.HashAlgo, digest.Value)
+		}
+	}
+}
+
+// PrintBootChainReport prints the report of BootChain analyzer in a human-readable format
+func PrintBootChainReport(w io.Writer, enableColors bool, report *bootchainanalysis.CustomReport) {
+	fmt.Fprintf(w, "Boot components measured to PCR4/PCR8/PCR9: %d (allowlist entries: %d)\n", len(report.Components), report.AllowlistEntries)
+	for _, component := range report.Components {
+		status := component.Status.String()
+		if enableColors && component.Status == bootchainanalysis.ComponentStatus_Unknown {
+			status = color.New(color.FgRed).Sprint(status)
+		}
+		fmt.Fprintf(w, "\tPCR%d %s '%s': %s", component.PCR, component.Type, component.Description, status)
+		if component.AllowlistName != nil {
+			fmt.Fprintf(w, " (%s)", *component.AllowlistName)
+		}
+		fmt.Fprintln(w)
+		if component.Status != bootchainanalysis.ComponentStatus_Unknown {
+			continue
+		}
+		for _, digest := range component.Digests {
+			fmt.Fprintf(w, "\t\t%s: %X\n", digest.HashAlgo, digest.Value)
+		}
+	}
+}
+
+// PrintACPITablesReport prints the report of ACPITables analyzer in a human-readable format
+func PrintACPITablesReport(w io.Writer, enableColors bool, report *acpitablesanalysis.CustomReport) {
+	if dma := report.DMAProtection; dma != nil {
+		optIn := fmt.Sprint(dma.PlatformOptIn)
+		if enableColors && !dma.PlatformOptIn {
+			optIn = color.New(color.FgRed).Sprint(optIn)
+		}
+		fmt.Fprintf(w, "%s: IOMMUs: %d, flags: 0x%X, platform opt-in: %s", dma.Signature, dma.IOMMUs, dma.Flags, optIn)
+		if dma.OriginalPlatformOptIn != nil {
+			fmt.Fprintf(w, " (original: %v)", *dma.OriginalPlatformOptIn)
+		}
+		fmt.Fprintln(w)
+		for _, region := range dma.ReservedRegions {
+			status := region.Status.String()
+			if enableColors && region.Status == acpitablesanalysis.RegionStatus_Unexpected {
+				status = color.New(color.FgRed).Sprint(status)
+			}
+			fmt.Fprintf(w, "\treserved region [0x%X-0x%X] for %s: %s\n", region.Base, region.Limit, strings.Join(region.Devices, ", "), status)
+		}
+	}
+	fmt.Fprintf(w, "ACPI tables: %d (found in the original firmware: %d)\n", len(report.Tables), report.OriginalTables)
+	for _, table := range report.Tables {
+		fmt.Fprintf(w, "\t%s (%s '%s'): checksum 0x%02X", table.Name, table.OEMID, table.OEMTableID, table.Checksum)
+		if !table.ChecksumValid {
+			fmt.Fprint(w, " (invalid)")
+		}
+		if table.OriginalChecksum != nil {
+			fmt.Fprintf(w, ", original 0x%02X", *table.OriginalChecksum)
+		}
+		fmt.Fprintf(w, ": %s\n", table.Status)
+	}
+}
+
+// PrintTXTErrorsReport prints the report of TXTErrors analyzer in a human-readable format
+func PrintTXTErrorsReport(w io.Writer, enableColors bool, report *txterrorsanalysis.CustomReport) {
+	if report.TXTReset {
+		reset := "TXT was reset because of an error (TXT_RESET.STS)"
+		if enableColors {
+			reset = color.New(color.FgRed).Sprint(reset)
+		}
+		fmt.Fprintln(w, reset)
+	}
+	if len(report.Errors) == 0 {
+		fmt.Fprintln(w, "No TXT/ACM errors reported")
+		return
+	}
+	for _, txtError := range report.Errors {
+		name := "unknown error"
+		if txtError.Name != nil {
+			name = *txtError.Name
+		}
+		if enableColors {
+			name = color.New(color.FgRed).Sprint(name)
+		}
+		fmt.Fprintf(w, "%s (0x%X): %s error %s: %s\n", txtError.Register, txtError.Raw, txtError.Source, txtError.Code, name)
+		if txtError.Source != "processor" {
+			fmt.Fprintf(w, "\tmodule type: %s, class: 0x%02X, major: 0x%02X, minor: 0x%04X\n", txtError.ModuleTypeName, txtError.Class, txtError.Major, txtError.Minor)
+		}
+		if txtError.Description != nil {
+			fmt.Fprintf(w, "\t%s\n", *txtError.Description)
+		}
+		if txtError.Remediation != nil {
+			fmt.Fprintf(w, "\tremediation: %s\n", *txtError.Remediation)
+		}
+	}
+}
+
+// PrintImageDiffReport prints the report of ImageDiff analyzer in a human-readable format
+func PrintImageDiffReport(w io.Writer, enableColors bool, report *imagediffanalysis.CustomReport) {
+	fmt.Fprintf(w, "Size: %d (original: %d)", report.ActualSize, report.OriginalSize)
+	if report.SizeChange != imagediffanalysis.SizeChange_None {
+		fmt.Fprintf(w, ", %s", report.SizeChange)
+		if report.TailSHA256 != nil {
+			fmt.Fprintf(w, " (SHA256 %X", report.TailSHA256)
+			if report.TailErased {
+				fmt.Fprint(w, ", erased")
+			}
+			fmt.Fprint(w, ")")
+		}
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Containers:")
+	for _, container := range report.ActualContainers {
+		fmt.Fprintf(w, "\t%s [0x%X-0x%X]", container.Format, container.Offset, container.Offset+container.Size)
+		if container.Name != "" {
+			fmt.Fprintf(w, " '%s'", container.Name)
+		}
+		fmt.Fprintf(w, ": %d entries", container.Entries)
+		if container.ParseError != nil {
+			fmt.Fprintf(w, " (%s)", *container.ParseError)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "Changed entries: %d (unchanged: %d)\n", len(report.ChangedEntries), report.UnchangedEntries)
+	for _, entry := range report.ChangedEntries {
+		status := entry.Status.String()
+		if enableColors {
+			status = color.New(color.FgRed).Sprint(status)
+		}
+		fmt.Fprintf(w, "\t%s: %s\n", entry.Path, status)
+		printSide := func(title string, size int64, hash []byte, attributes *string) {
+			if hash == nil {
+				return
+			}
+			fmt.Fprintf(w, "\t\t%s: %d bytes, SHA256 %X", title, size, hash)
+			if attributes != nil {
+				fmt.Fprintf(w, ", %s", *attributes)
+			}
+			fmt.Fprintln(w)
+		}
+		printSide("original", entry.GetOriginalSize(), entry.OriginalSHA256, entry.OriginalAttributes)
+		printSide("actual", entry.GetActualSize(), entry.ActualSHA256, entry.ActualAttributes)
+	}
+}
+
+// PrintPluginReport prints the report of an out-of-process analyzer plugin in a human-readable format
+func PrintPluginReport(w io.Writer, report *pluginanalysis.CustomReport) {
+	if report.Version != nil {
+		fmt.Fprintf(w, "Plugin version: %s\n", *report.Version)
+	}
+	if report.Data == nil {
+		return
+	}
+	var data any
+	if err := json.Unmarshal([]byte(*report.Data), &data); err != nil {
+		fmt.Fprintf(w, "Data: %s\n", *report.Data)
+		return
+	}
+	if dataJSON, err := json.MarshalIndent(data, "", " "); err == nil {
+		fmt.Fprintf(w, "Data:\n%s\n", dataJSON)
+	}
+}
+
+// fileDiffDescription returns a description like "EFI_FV_FILETYPE_DRIVER 'PcRtc' (GUID): .text modified, 37 bytes"
+func fileDiffDescription(fileDiff *diffanalysis.FileDiff) string {
+	var result strings.Builder
+	result.WriteString(fileDiff.FileType)
+	if fileDiff.Name != nil {
+		fmt.Fprintf(&result, " '%s'", *fileDiff.Name)
+	}
+	fmt.Fprintf(&result, " (%s): ", fileDiff.GUID)
+	if len(fileDiff.PESections) == 0 {
+		fmt.Fprintf(&result, "%s, %d bytes", strings.ToLower(fileDiff.Change.String()), fileDiff.ChangedBytes)
+		return result.String()
+	}
+	for idx, section := range fileDiff.PESections {
+		if idx > 0 {
+			result.WriteString("; ")
+		}
+		fmt.Fprintf(&result, "%s %s, %d bytes", section.Name, strings.ToLower(section.Change.String()), section.ChangedBytes)
+	}
+	return result.String()
+}
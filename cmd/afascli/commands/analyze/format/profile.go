@@ -0,0 +1,117 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package format
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
+)
+
+const profileBarWidth = 40
+
+// Profile writes the execution profile of an Analyze request to the io.Writer
+// as a flame-style tree: each entry is a bar placed on the timeline of the
+// whole analysis, and nested entries are the values calculated for their parents.
+func Profile(w io.Writer, profile afas.AnalyzeProfile, enableColors bool) {
+	var total int64
+	for _, entry := range profile.Entries {
+		if entry == nil {
+			continue
+		}
+		if end := entry.StartNanoseconds + entry.DurationNanoseconds; end > total {
+			total = end
+		}
+	}
+	fmt.Fprintf(w, "=== Profile (total: %s) ===\n", time.Duration(total))
+	fmt.Fprintf(w, "%-*s %10s %10s\n", profileBarWidth+2, "timeline", "duration", "allocated")
+	fmt.Fprintf(w, "(allocated: process allocations during the interval, including concurrently executed analyzers and calculations)\n")
+
+	// Entries are ordered depth-first, so the depth of a parent is always known already.
+	depths := make([]int, len(profile.Entries))
+	for idx, entry := range profile.Entries {
+		if entry == nil {
+			continue
+		}
+		if entry.IsSetParentIndex() {
+			if parentIdx := int(entry.GetParentIndex()); parentIdx >= 0 && parentIdx < idx {
+				depths[idx] = depths[parentIdx] + 1
+			}
+		}
+
+		description := fmt.Sprintf("%s %s", entry.Kind, entry.Name)
+		if entry.IsSetCache() {
+			description += fmt.Sprintf(" (%s)", entry.GetCache())
+		}
+		if entry.IsSetError() {
+			description += fmt.Sprintf(": %s", entry.GetError())
+		}
+		fmt.Fprintf(w, "%s %10s %10s %s",
+			profileBar(entry.StartNanoseconds, entry.DurationNanoseconds, total),
+			time.Duration(entry.DurationNanoseconds).Round(time.Microsecond),
+			formatBytes(entry.AllocatedBytes),
+			strings.Repeat("  ", depths[idx]),
+		)
+		fprintfWithColor(w, enableColors, profileEntryColor(*entry), "%s\n", description)
+	}
+}
+
+// profileBar returns the position of the [start, start+duration) interval on the
+// timeline of length total.
+func profileBar(start, duration, total int64) string {
+	if total <= 0 {
+		return "[" + strings.Repeat(" ", profileBarWidth) + "]"
+	}
+	offset := int(start * profileBarWidth / total)
+	if offset >= profileBarWidth {
+		offset = profileBarWidth - 1
+	}
+	width := int(duration * profileBarWidth / total)
+	if width < 1 {
+		width = 1
+	}
+	if offset+width > profileBarWidth {
+		width = profileBarWidth - offset
+	}
+	return "[" + strings.Repeat(" ", offset) + strings.Repeat("#", width) + strings.Repeat(" ", profileBarWidth-offset-width) + "]"
+}
+
+func profileEntryColor(entry afas.ProfileEntry) color.Attribute {
+	switch {
+	case entry.IsSetError():
+		return color.FgRed
+	case entry.Kind == afas.ProfileEntryKind_Analyzer:
+		return color.FgCyan
+	case entry.IsSetCache() && entry.GetCache() != afas.ProfileCacheResult__Miss:
+		return color.FgGreen
+	}
+	return color.FgYellow
+}
+
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package boot_chain
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/analyze/format"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain/report/generated/bootchainanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/commands"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	allowlistDir *string
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "-allowlist-dir=DIR <path to the TPM EventLog>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "checks the boot components measured to PCR4/PCR8/PCR9 against a directory of allowed binaries locally (analyzer BootChain without AFAS)"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.allowlistDir = flag.String("allowlist-dir", "", "path to the directory with allowed EFI binaries, kernels and initrds")
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) < 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: no path to the TPM EventLog was specified")}
+	}
+	if len(args) > 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
+	}
+	if *cmd.allowlistDir == "" {
+		return commands.ErrArgs{Err: fmt.Errorf("-allowlist-dir is not specified")}
+	}
+
+	eventLogFile, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to open EventLog '%s': %w", args[0], err)
+	}
+	defer eventLogFile.Close()
+	eventLog, err := tpmeventlog.Parse(eventLogFile)
+	if err != nil {
+		return fmt.Errorf("unable to parse EventLog '%s': %w", args[0], err)
+	}
+
+	allowlist, err := bootchain.LoadAllowlistDir(*cmd.allowlistDir)
+	if err != nil {
+		return err
+	}
+
+	allowlists := analysis.NewReferenceDataStore[bootchain.Allowlist](1)
+	allowlistHash, err := allowlists.Put(allowlist)
+	if err != nil {
+		return err
+	}
+	report, err := bootchain.New(allowlists).Analyze(ctx, bootchain.Input{
+		TPMEventLog:   eventLog,
+		AllowlistHash: bootchain.AllowlistHash(allowlistHash),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to analyze the boot chain: %w", err)
+	}
+	customReport := report.Custom.(bootchainanalysis.CustomReport)
+	format.PrintBootChainReport(os.Stdout, true, &customReport)
+
+	if len(report.Issues) > 0 {
+		return ErrUnknownComponents{Count: len(report.Issues)}
+	}
+	return nil
+}
+
+var _ commands.ExitCoder = ErrUnknownComponents{}
+
+// ErrUnknownComponents means some of the measured boot components are not in the allowlist.
+type ErrUnknownComponents struct {
+	Count int
+}
+
+// Error implements interface "error".
+func (err ErrUnknownComponents) Error() string {
+	return fmt.Sprintf("%d boot component(s) are not in the allowlist", err.Count)
+}
+
+// ExitCode implements commands.ExitCoder.
+func (ErrUnknownComponents) ExitCode() int {
+	return 3
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flash_health
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	verbhelpers "github.com/immune-gmbh/attestation-sdk/cmd/afascli/helpers"
+	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/commands"
+	"github.com/immune-gmbh/attestation-sdk/pkg/firmwarewand"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	afasEndpoint *string
+	assetID      *uint64
+	limit        *uint64
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "-asset-id=assetID"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "aggregates FlashDegradation reports of a host to spot a flash chip degrading over time"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.afasEndpoint = flag.String("afas-endpoint", "http://localhost:17545", "")
+	cmd.assetID = flag.Uint64("asset-id", 0, "AssetID of the host")
+	cmd.limit = flag.Uint64("limit", 100, "maximal amount of the latest reports to aggregate")
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) > 0 {
+		return commands.ErrArgs{Err: fmt.Errorf("an extra parameter found")}
+	}
+	if *cmd.assetID == 0 {
+		return commands.ErrArgs{Err: fmt.Errorf("-asset-id is not specified")}
+	}
+
+	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, verbhelpers.FirmwarewandOptions(*cmd.afasEndpoint)...)...)
+	if err != nil {
+		return fmt.Errorf("unable to initialize a firmwarewand: %w", err)
+	}
+
+	assetID := int64(*cmd.assetID)
+	result, err := fwWand.SearchReport(ctx, afas.SearchReportFilters{AssetID: &assetID}, *cmd.limit)
+	if err != nil {
+		return fmt.Errorf("unable to perform SearchReport request: %w", err)
+	}
+
+	// The reports are found in reversed-chronological order.
+	var reports []*flashdegradationanalysis.CustomReport
+	for idx := len(result.Found) - 1; idx >= 0; idx-- {
+		for _, analyzerResult := range result.Found[idx].GetResults() {
+			report := analyzerResult.GetAnalyzerOutcome().GetReport()
+			if report == nil || !report.GetCustom().IsSetFlashDegradation() {
+				continue
+			}
+			reports = append(reports, report.GetCustom().GetFlashDegradation())
+		}
+	}
+	if len(reports) == 0 {
+		fmt.Printf("Have not found any FlashDegradation reports of host %d\n", assetID)
+		return nil
+	}
+
+	trend := flashdegradation.AggregateReports(reports)
+	fmt.Printf("Reports: %d, with verdict %s: %d\n", trend.Reports, flashdegradationanalysis.Verdict_LikelyHardwareDegradation, trend.DegradationVerdicts)
+	fmt.Printf("Changed bytes (from the oldest to the newest report): %v\n", trend.ChangedBytes)
+	for _, offset := range trend.RecurringSectors {
+		fmt.Printf("\tsector 0x%08X is changed in multiple reports\n", offset)
+	}
+	if trend.Degrading {
+		return ErrDegrading{AssetID: assetID}
+	}
+	return nil
+}
+
+var _ commands.ExitCoder = ErrDegrading{}
+
+// ErrDegrading means the flash chip of the host is likely degrading over time.
+type ErrDegrading struct {
+	AssetID int64
+}
+
+// Error implements interface "error".
+func (err ErrDegrading) Error() string {
+	return fmt.Sprintf("the flash chip of host %d is likely degrading", err.AssetID)
+}
+
+// ExitCode implements commands.ExitCoder.
+func (ErrDegrading) ExitCode() int {
+	return 3
+}
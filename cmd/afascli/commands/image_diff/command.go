@@ -0,0 +1,93 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package image_diff
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/analyze/format"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff"
+	"github.com/immune-gmbh/attestation-sdk/pkg/commands"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct{}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "<path to the original image> <path to the actual image>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "compares a BMC/NIC/SSD firmware image with the original one file by file locally (analyzer ImageDiff without AFAS)"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) < 2 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: paths to the original and the actual images should be specified")}
+	}
+	if len(args) > 2 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
+	}
+
+	original, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to read the original image '%s': %w", args[0], err)
+	}
+	actual, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("unable to read the actual image '%s': %w", args[1], err)
+	}
+
+	report := imagediff.Compare(original, actual)
+	format.PrintImageDiffReport(os.Stdout, true, &report)
+
+	issues := imagediff.Issues(report)
+	for _, issue := range issues {
+		fmt.Printf("%s: %s\n", issue.Severity, issue.Description)
+	}
+	if len(issues) > 0 {
+		return ErrImagesDiffer{Issues: len(issues)}
+	}
+	return nil
+}
+
+var _ commands.ExitCoder = ErrImagesDiffer{}
+
+// ErrImagesDiffer means the actual image differs from the original one.
+type ErrImagesDiffer struct {
+	Issues int
+}
+
+// Error implements interface "error".
+func (err ErrImagesDiffer) Error() string {
+	return fmt.Sprintf("the images differ: %d issue(s)", err.Issues)
+}
+
+// ExitCode implements commands.ExitCoder.
+func (ErrImagesDiffer) ExitCode() int {
+	return 3
+}
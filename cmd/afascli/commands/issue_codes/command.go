@@ -0,0 +1,101 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package issue_codes
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/commands"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	analyzerID *string
+	outputJSON *bool
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "[issue code]"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "prints the catalog of issue codes reported by analyzers"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.analyzerID = flag.String("analyzer", "", "print only issue codes of the specified analyzer")
+	cmd.outputJSON = flag.Bool("json", false, "print the catalog in json format")
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) > 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
+	}
+
+	var codes []analysis.IssueCodeInfo
+	if len(args) == 1 {
+		info := analysis.LookupIssueCode(analysis.IssueCode(args[0]))
+		if info == nil {
+			return fmt.Errorf("unknown issue code '%s'", args[0])
+		}
+		codes = append(codes, *info)
+	} else {
+		for _, info := range analysis.IssueCodes() {
+			if *cmd.analyzerID != "" && string(info.AnalyzerID) != *cmd.analyzerID {
+				continue
+			}
+			codes = append(codes, info)
+		}
+	}
+
+	if *cmd.outputJSON {
+		b, err := json.MarshalIndent(codes, "", " ")
+		if err != nil {
+			return fmt.Errorf("unable to serialize the issue codes: %w", err)
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	for _, info := range codes {
+		printIssueCode(info)
+	}
+	return nil
+}
+
+func printIssueCode(info analysis.IssueCodeInfo) {
+	fmt.Printf("%s\n", info.Code)
+	if info.AnalyzerID != "" {
+		fmt.Printf("\tAnalyzer: %s\n", info.AnalyzerID)
+	} else {
+		fmt.Printf("\tAnalyzer: <any>\n")
+	}
+	if len(info.Params) > 0 {
+		fmt.Printf("\tParams: %s\n", strings.Join(info.Params, ", "))
+	}
+	fmt.Printf("\tDescription: %s\n", info.Description)
+	fmt.Printf("\tRemediation: %s\n", info.Remediation)
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package optionrom_catalog
+
+import (
+	"context"
+	"crypto"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms"
+	"github.com/immune-gmbh/attestation-sdk/pkg/commands"
+	"github.com/immune-gmbh/attestation-sdk/pkg/optionrom"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	name       *string
+	version    *string
+	devicePath *string
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "<path to the option ROM image>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "prints an entry of the option ROM catalog (for analyzer OptionROMs) for an option ROM image"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.name = flag.String("name", "", "the name of the option ROM in the catalog (default: the file name)")
+	cmd.version = flag.String("version", "", "the version of the option ROM")
+	cmd.devicePath = flag.String("device-path", "", "the device path pattern the option ROM is expected at (see analyzer OptionROMs)")
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) < 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: no path to the option ROM was specified")}
+	}
+	if len(args) > 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
+	}
+
+	rom, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to read the option ROM '%s': %w", args[0], err)
+	}
+	images, err := optionrom.Parse(rom)
+	if err != nil {
+		return fmt.Errorf("unable to parse the option ROM: %w", err)
+	}
+
+	entry := optionroms.CatalogEntry{
+		Name:       *cmd.name,
+		Version:    *cmd.version,
+		DevicePath: *cmd.devicePath,
+	}
+	if entry.Name == "" {
+		entry.Name = filepath.Base(args[0])
+	}
+	for _, img := range images {
+		if img.EFI == nil {
+			continue
+		}
+		for _, digest := range []struct {
+			hashFunc crypto.Hash
+			values   *[]string
+		}{
+			{crypto.SHA1, &entry.SHA1},
+			{crypto.SHA256, &entry.SHA256},
+			{crypto.SHA384, &entry.SHA384},
+		} {
+			h, err := img.EFI.AuthenticodeHash(digest.hashFunc)
+			if err != nil {
+				return fmt.Errorf("unable to hash the EFI image at offset 0x%X (vendor 0x%04X, device 0x%04X): %w", img.Offset, img.VendorID, img.DeviceID, err)
+			}
+			*digest.values = append(*digest.values, hex.EncodeToString(h))
+		}
+	}
+	if len(entry.SHA256) == 0 {
+		return fmt.Errorf("the option ROM contains no EFI images")
+	}
+
+	b, err := yaml.Marshal(optionroms.Catalog{OptionROMs: []optionroms.CatalogEntry{entry}})
+	if err != nil {
+		return fmt.Errorf("unable to serialize the catalog entry: %w", err)
+	}
+	fmt.Print(string(b))
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package psb_status
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/analyze/format"
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/helpers"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/commands"
+	xregisters "github.com/immune-gmbh/attestation-sdk/pkg/registers"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	registers       *string
+	psbFusedKeyHash *string
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "<path to the image>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "checks locally (without a server) if the PSB binding of the image matches the CPU fuses"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.registers = flag.String("registers", "", "use status registers from JSON file (or read them from the local machine if empty value)")
+	cmd.psbFusedKeyHash = flag.String("psb-fused-key-hash", "", "SHA256 hash of the OEM root key fused into the CPU in hex (or read it from SMBIOS of the local machine if empty value and -registers is not set)")
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) < 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: no path to the firmware was specified")}
+	}
+	if len(args) > 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
+	}
+
+	image, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to read the image '%s': %w", args[0], err)
+	}
+
+	var regs registers.Registers
+	if len(*cmd.registers) > 0 {
+		regs, err = helpers.ParseRegisters(*cmd.registers)
+	} else {
+		regs, err = xregisters.LocalRegisters()
+	}
+	if regs == nil {
+		return fmt.Errorf("unable to obtain status registers: %w", err)
+	}
+
+	var keyHash []byte
+	switch {
+	case len(*cmd.psbFusedKeyHash) > 0:
+		keyHash, err = hex.DecodeString(strings.TrimPrefix(*cmd.psbFusedKeyHash, "0x"))
+		if err != nil {
+			return commands.ErrArgs{Err: fmt.Errorf("unable to parse the PSB fused key hash: %w", err)}
+		}
+	case len(*cmd.registers) == 0:
+		keyHash, err = xregisters.LocalPSBFusedKeyHash()
+		if err != nil {
+			// the hash is optional, the rest of the fuses is still checked
+			logger.FromCtx(ctx).Warnf("unable to obtain the PSB fused key hash: %v", err)
+		}
+	}
+
+	input, err := psbfuses.NewExecutorInput(analysis.BytesBlob(image), regs, keyHash)
+	if err != nil {
+		return fmt.Errorf("unable to build the analyzer input: %w", err)
+	}
+
+	dataCalculator, err := analysis.NewDataCalculator(100)
+	if err != nil {
+		return fmt.Errorf("unable to initialize data calculator: %w", err)
+	}
+
+	report, err := analysis.ExecuteAnalyzer(ctx, dataCalculator, psbfuses.New(), input, nil)
+	if err != nil {
+		return fmt.Errorf("unable to analyze: %w", err)
+	}
+
+	customReport, ok := report.Custom.(psbfusesanalysis.CustomReport)
+	if !ok {
+		return fmt.Errorf("unexpected report type: %T", report.Custom)
+	}
+	format.PrintPSBFusesReport(os.Stdout, false, &customReport)
+
+	severity := analysis.SeverityInfo
+	for _, issue := range report.Issues {
+		fmt.Printf("[%s] %s\n", issue.Severity, issue.Description)
+		if issue.Severity > severity {
+			severity = issue.Severity
+		}
+	}
+
+	if customReport.WouldBrick {
+		return ErrWouldBrick{}
+	}
+	if severity == analysis.SeverityCritical {
+		return fmt.Errorf("found critical issues")
+	}
+	return nil
+}
+
+var _ commands.ExitCoder = ErrWouldBrick{}
+
+// ErrWouldBrick means the CPU would refuse to boot the image.
+type ErrWouldBrick struct{}
+
+// Error implements interface "error".
+func (ErrWouldBrick) Error() string {
+	return "the CPU would refuse to boot the image"
+}
+
+// ExitCode implements commands.ExitCoder.
+func (ErrWouldBrick) ExitCode() int {
+	return 3
+}
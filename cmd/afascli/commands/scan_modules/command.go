@@ -0,0 +1,117 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package scan_modules
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/analyze/format"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules/report/generated/vulnmodulesanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/commands"
+)
+
+// Command is the implementation of `commands.Command`.
+type Command struct {
+	advisories *string
+}
+
+// Usage prints the syntax of arguments for this command
+func (cmd Command) Usage() string {
+	return "<path to the image>"
+}
+
+// Description explains what this verb commands to do
+func (cmd Command) Description() string {
+	return "checks locally (without a server) the UEFI modules of the image against an advisory database"
+}
+
+// SetupFlagSet is called to allow the command implementation
+// to setup which option flags it has.
+func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
+	cmd.advisories = flag.String("advisories", "", "path to the JSON/YAML database of vulnerable UEFI modules")
+}
+
+// Execute is the main function here. It is responsible to
+// start the execution of the command.
+//
+// `args` are the arguments left unused by verb itself and options.
+func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []string) error {
+	if len(args) < 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: no path to the firmware was specified")}
+	}
+	if len(args) > 1 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: too many parameters")}
+	}
+	if len(*cmd.advisories) == 0 {
+		return commands.ErrArgs{Err: fmt.Errorf("error: no path to the advisory database was specified")}
+	}
+
+	advisoryDB, err := vulnerablemodules.LoadAdvisoryDB(*cmd.advisories)
+	if err != nil {
+		return err
+	}
+
+	image, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to read the image '%s': %w", args[0], err)
+	}
+
+	advisoryDBs := analysis.NewReferenceDataStore[vulnerablemodules.AdvisoryDB](1)
+	input, err := vulnerablemodules.NewExecutorInput(analysis.BytesBlob(image), advisoryDBs, advisoryDB)
+	if err != nil {
+		return fmt.Errorf("unable to build the analyzer input: %w", err)
+	}
+
+	dataCalculator, err := analysis.NewDataCalculator(100)
+	if err != nil {
+		return fmt.Errorf("unable to initialize data calculator: %w", err)
+	}
+
+	report, err := analysis.ExecuteAnalyzer(ctx, dataCalculator, vulnerablemodules.New(advisoryDBs), input, nil)
+	if err != nil {
+		return fmt.Errorf("unable to analyze: %w", err)
+	}
+
+	customReport, ok := report.Custom.(vulnmodulesanalysis.CustomReport)
+	if !ok {
+		return fmt.Errorf("unexpected report type: %T", report.Custom)
+	}
+	format.PrintVulnerableModulesReport(os.Stdout, false, &customReport)
+
+	if len(customReport.Matches) > 0 {
+		return ErrVulnerableModules{Count: len(customReport.Matches)}
+	}
+	return nil
+}
+
+var _ commands.ExitCoder = ErrVulnerableModules{}
+
+// ErrVulnerableModules means the image contains modules listed in the advisory database.
+type ErrVulnerableModules struct {
+	Count int
+}
+
+// Error implements interface "error".
+func (err ErrVulnerableModules) Error() string {
+	return fmt.Sprintf("found %d advisory matches", err.Count)
+}
+
+// ExitCode implements commands.ExitCoder.
+func (ErrVulnerableModules) ExitCode() int {
+	return 3
+}
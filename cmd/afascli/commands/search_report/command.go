@@ -33,12 +33,17 @@ type Command struct {
 	jobID             *string
 	assetID           *uint64
 	imageID           types.ImageID
+	analyzerID        *string
+	minVersion        *string
+	maxVersion        *string
+	verdictClass      *string
+	minConfidence     *float64
 	showNotApplicable *bool
 }
 
 // Usage prints the syntax of arguments for this command
 func (cmd Command) Usage() string {
-	return "<-image-id=imageID|-asset-id=assetID|-job-id=jobID>"
+	return "<-image-id=imageID|-asset-id=assetID|-job-id=jobID|-analyzer=analyzerID|-verdict=class>"
 }
 
 // Description explains what this verb commands to do
@@ -54,6 +59,11 @@ func (cmd *Command) SetupFlagSet(flag *flag.FlagSet) {
 	cmd.jobID = flag.String("job-id", "", "JobID to filter the reports by")
 	cmd.assetID = flag.Uint64("asset-id", 0, "AssetID to filter the reports by")
 	flag.Var(&cmd.imageID, "image-id", "ImageID to filter the reports by")
+	cmd.analyzerID = flag.String("analyzer", "", "analyzer ID to filter the reports by (the report should contain a report of this analyzer)")
+	cmd.minVersion = flag.String("min-analyzer-version", "", "inclusive lower bound of the version of the analyzer specified by -analyzer (MAJOR.MINOR.PATCH)")
+	cmd.maxVersion = flag.String("max-analyzer-version", "", "exclusive upper bound of the version of the analyzer specified by -analyzer (MAJOR.MINOR.PATCH)")
+	cmd.verdictClass = flag.String("verdict", "", "verdict class about the host to filter the reports by: Inconclusive, Healthy, DegradedHardware, Misconfigured, Suspicious or Compromised")
+	cmd.minConfidence = flag.Float64("min-verdict-confidence", 0, "inclusive lower bound of the confidence of the verdict about the host to filter the reports by")
 	cmd.showNotApplicable = flag.Bool("show-not-applicable", false, "specifies whether to show not applicable analyzers result")
 }
 
@@ -121,6 +131,32 @@ func (cmd Command) Execute(ctx context.Context, cfg commands.Config, args []stri
 		}
 	}
 
+	if *cmd.analyzerID != "" {
+		searchFilters.AnalyzerID = cmd.analyzerID
+	}
+	if *cmd.minVersion != "" || *cmd.maxVersion != "" {
+		if *cmd.analyzerID == "" {
+			return commands.ErrArgs{Err: fmt.Errorf("-min-analyzer-version and -max-analyzer-version require -analyzer")}
+		}
+		if *cmd.minVersion != "" {
+			searchFilters.MinAnalyzerVersion = cmd.minVersion
+		}
+		if *cmd.maxVersion != "" {
+			searchFilters.MaxAnalyzerVersion = cmd.maxVersion
+		}
+	}
+
+	if *cmd.verdictClass != "" {
+		verdictClass, err := afas.HostVerdictClassFromString(*cmd.verdictClass)
+		if err != nil {
+			return commands.ErrArgs{Err: fmt.Errorf("unable to parse -verdict: %w", err)}
+		}
+		searchFilters.VerdictClass = &verdictClass
+	}
+	if *cmd.minConfidence != 0 {
+		searchFilters.MinVerdictConfidence = cmd.minConfidence
+	}
+
 	fwWand, err := firmwarewand.New(ctx, append(cfg.FirmwareWandOptions, cmd.firmwarewandOptions()...)...)
 	if err != nil {
 		return fmt.Errorf("unable to initialize a firmwarewand: %w", err)
@@ -23,13 +23,20 @@ import (
 	"sort"
 
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/analyze"
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/boot_chain"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/display_eventlog"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/display_info"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/display_tpm"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/dump"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/dump_registers"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/fetch"
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/flash_health"
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/image_diff"
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/issue_codes"
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/optionrom_catalog"
 	pcr0sum "github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/pcr0_sum"
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/psb_status"
+	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/scan_modules"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/search"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/search_report"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/txt_status"
@@ -46,17 +53,24 @@ import (
 
 var (
 	knownCommands = map[string]commands.Command{
-		"analyze":          &analyze.Command{},
-		"display_eventlog": &display_eventlog.Command{},
-		"display_info":     &display_info.Command{},
-		"display_tpm":      &display_tpm.Command{},
-		"dump":             &dump.Command{},
-		"dump_registers":   &dump_registers.Command{},
-		"fetch":            &fetch.Command{},
-		"pcr0_sum":         &pcr0sum.Command{},
-		"search":           &search.Command{},
-		"search_report":    &search_report.Command{},
-		"txt_status":       &txt_status.Command{},
+		"analyze":           &analyze.Command{},
+		"boot_chain":        &boot_chain.Command{},
+		"display_eventlog":  &display_eventlog.Command{},
+		"display_info":      &display_info.Command{},
+		"display_tpm":       &display_tpm.Command{},
+		"dump":              &dump.Command{},
+		"dump_registers":    &dump_registers.Command{},
+		"fetch":             &fetch.Command{},
+		"flash_health":      &flash_health.Command{},
+		"image_diff":        &image_diff.Command{},
+		"issue_codes":       &issue_codes.Command{},
+		"optionrom_catalog": &optionrom_catalog.Command{},
+		"pcr0_sum":          &pcr0sum.Command{},
+		"psb_status":        &psb_status.Command{},
+		"scan_modules":      &scan_modules.Command{},
+		"search":            &search.Command{},
+		"search_report":     &search_report.Command{},
+		"txt_status":        &txt_status.Command{},
 	}
 	exitCode = 0
 )
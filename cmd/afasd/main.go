@@ -22,8 +22,15 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
 	"github.com/immune-gmbh/attestation-sdk/pkg/blobstorage"
 	"github.com/immune-gmbh/attestation-sdk/pkg/devicegetter"
+	"github.com/immune-gmbh/attestation-sdk/pkg/diskcache"
 	"github.com/immune-gmbh/attestation-sdk/pkg/firmwaredb/firmwaredbsql"
 	"github.com/immune-gmbh/attestation-sdk/pkg/firmwarerepo"
 	"github.com/immune-gmbh/attestation-sdk/pkg/objcache"
@@ -32,6 +39,7 @@ import (
 	controllertypes "github.com/immune-gmbh/attestation-sdk/pkg/server/controller/types"
 	"github.com/immune-gmbh/attestation-sdk/pkg/server/thrift"
 	"github.com/immune-gmbh/attestation-sdk/pkg/storage"
+	"github.com/immune-gmbh/attestation-sdk/pkg/verdict"
 
 	"github.com/facebookincubator/go-belt/beltctx"
 	"github.com/facebookincubator/go-belt/tool/logger"
@@ -47,6 +55,8 @@ const (
 	rtpfwCacheEvictionTimeoutDefault = 24 * time.Hour
 	apiCachePurgeTimeoutDefault      = time.Hour
 	dataCacheSizeDefault             = 1000
+	persistentDataCacheSizeDefault   = 16 << 30 // 16GiB
+	advisoriesReloadIntervalDefault  = time.Minute
 )
 
 func assertNoError(ctx context.Context, err error) {
@@ -94,6 +104,19 @@ func main() {
 	)
 	storageCacheSize := pflag.Uint64("image-storage-cache-size", storageCacheSizeDefault, "defines the memory limit for the storage used to save images, analyzed by AFAS")
 	dataCacheSize := pflag.Int("data-cache-size", dataCacheSizeDefault, "defines the size of the cache for internally calculated data objects like parsed firmware, measurements flow")
+	persistentDataCacheDir := pflag.String("persistent-data-cache-dir", "", "if non-empty then internally calculated data objects (like aligned images) are also cached in this directory and reused after restarts")
+	persistentDataCacheSize := pflag.Int64("persistent-data-cache-size", persistentDataCacheSizeDefault, "defines the disk space limit (in bytes) for the cache in --persistent-data-cache-dir")
+	advisoriesPath := pflag.String("uefi-advisories", "", "path to the JSON/YAML database of vulnerable UEFI modules (analyzer VulnerableModules is disabled if empty)")
+	nvramRulesPath := pflag.String("nvram-rules", "", "path to the JSON/YAML rules classifying NVRAM variable changes for analyzer DiffMeasuredBoot (built-in rules are used if empty)")
+	diagnosisRulesPath := pflag.String("diff-diagnosis-rules", "", "path to the JSON/YAML rules diagnosing firmware differences for analyzer DiffMeasuredBoot (built-in rules are used if empty)")
+	optionROMCatalogPath := pflag.String("option-rom-catalog", "", "path to the JSON/YAML catalog of known option ROMs for analyzer OptionROMs (in addition to OPTION_ROM entries of the firmware database)")
+	txtErrorCodesPath := pflag.String("txt-error-codes", "", "path to the JSON/YAML table of TXT/ACM error codes for analyzer TXTErrors, extending the built-in table")
+	bootAllowlistDir := pflag.String("boot-allowlist-dir", "", "path to the directory with allowed EFI binaries, kernels and initrds for analyzer BootChain (the analyzer is disabled if empty)")
+	analyzerLimitsPath := pflag.String("analyzer-limits", "", "path to the JSON/YAML file with per-analyzer timeouts and memory budgets (analyzers are not limited if empty)")
+	analyzerPluginsPath := pflag.String("analyzer-plugins", "", "path to the JSON/YAML file with the configuration of out-of-process analyzer plugins (no plugins if empty)")
+	verdictPolicyPath := pflag.String("verdict-policy", "", "path to the JSON/YAML policy combining the reports of all analyzers into a verdict about the host (the built-in policy is used if empty)")
+	analyzerReportCache := pflag.String("analyzer-report-cache", controller.AnalyzerReportCacheDisabled.String(), "defines if stored analyzer reports are reused for an already analyzed input: disabled, any-version or up-to-date (reports of older analyzer versions are misses)")
+	advisoriesReloadInterval := pflag.Duration("uefi-advisories-reload-interval", advisoriesReloadIntervalDefault, "defines how often the database of vulnerable UEFI modules is checked for modifications")
 	pflag.Parse()
 	if pflag.NArg() != 0 {
 		usageExit()
@@ -142,7 +165,75 @@ func main() {
 	if err != nil {
 		log.Panic(err)
 	}
-	controllertypes.OverrideValueCalculators(dataCalculator)
+	if err := controllertypes.OverrideValueCalculators(dataCalculator); err != nil {
+		log.Panic(err)
+	}
+	if *persistentDataCacheDir != "" {
+		persistentDataCache, err := diskcache.New(*persistentDataCacheDir, *persistentDataCacheSize)
+		assertNoError(ctx, err)
+		dataCalculator.SetPersistentCache(persistentDataCache)
+	}
+
+	var advisoryDB *vulnerablemodules.AdvisoryDBWatcher
+	if *advisoriesPath != "" {
+		advisoryDB, err = vulnerablemodules.NewAdvisoryDBWatcher(*advisoriesPath, *advisoriesReloadInterval)
+		assertNoError(ctx, err)
+	}
+
+	var nvramRules *diffmeasuredboot.NVRAMRules
+	if *nvramRulesPath != "" {
+		nvramRules, err = diffmeasuredboot.LoadNVRAMRules(*nvramRulesPath)
+		assertNoError(ctx, err)
+	}
+	var diagnosisRules *diffmeasuredboot.DiagnosisRules
+	if *diagnosisRulesPath != "" {
+		diagnosisRules, err = diffmeasuredboot.LoadDiagnosisRules(*diagnosisRulesPath)
+		assertNoError(ctx, err)
+	}
+
+	var optionROMCatalog *optionroms.Catalog
+	if *optionROMCatalogPath != "" {
+		optionROMCatalog, err = optionroms.LoadCatalog(*optionROMCatalogPath)
+		assertNoError(ctx, err)
+	}
+
+	var bootAllowlist *bootchain.Allowlist
+	if *bootAllowlistDir != "" {
+		bootAllowlist, err = bootchain.LoadAllowlistDir(*bootAllowlistDir)
+		assertNoError(ctx, err)
+	}
+
+	var txtErrorCodes *txterrors.ErrorCodes
+	if *txtErrorCodesPath != "" {
+		txtErrorCodes, err = txterrors.LoadErrorCodes(*txtErrorCodesPath)
+		assertNoError(ctx, err)
+	}
+
+	var analyzerLimits *controller.AnalyzerLimits
+	if *analyzerLimitsPath != "" {
+		analyzerLimits, err = controller.LoadAnalyzerLimits(*analyzerLimitsPath)
+		assertNoError(ctx, err)
+	}
+
+	var analyzerPlugins []*plugin.Plugin
+	if *analyzerPluginsPath != "" {
+		pluginsConfig, err := plugin.LoadConfig(*analyzerPluginsPath)
+		assertNoError(ctx, err)
+		analyzerPlugins, err = plugin.Load(ctx, pluginsConfig)
+		assertNoError(ctx, err)
+		for _, p := range analyzerPlugins {
+			log.Infof("loaded analyzer plugin '%s' from '%s'", p.ID(), p.Executable())
+		}
+	}
+
+	analyzerReportCachePolicy, err := controller.ParseAnalyzerReportCachePolicy(*analyzerReportCache)
+	assertNoError(ctx, err)
+
+	var verdictPolicy *verdict.Policy
+	if *verdictPolicyPath != "" {
+		verdictPolicy, err = verdict.LoadPolicy(*verdictPolicyPath)
+		assertNoError(ctx, err)
+	}
 
 	ctrl, err := controller.New(ctx,
 		storage,
@@ -151,6 +242,18 @@ func main() {
 		dataCalculator,
 		devicegetter.DummyDeviceGetter{},
 		*apiCachePurgeTimeout,
+		controller.Options{
+			AdvisoryDB:          advisoryDB,
+			NVRAMRules:          nvramRules,
+			DiagnosisRules:      diagnosisRules,
+			OptionROMCatalog:    optionROMCatalog,
+			BootAllowlist:       bootAllowlist,
+			TXTErrorCodes:       txtErrorCodes,
+			AnalyzerLimits:      analyzerLimits,
+			Plugins:             analyzerPlugins,
+			AnalyzerReportCache: analyzerReportCachePolicy,
+			VerdictPolicy:       verdictPolicy,
+		},
 	)
 	assertNoError(ctx, err)
 	log.Debugf("created a controller")
@@ -154,6 +154,19 @@ struct SearchReportFilters {
   1: optional binary JobID;
   2: optional i64 AssetID;
   3: SearchFirmwareFilters ActualFirmware;
+
+  // The report should contain a report of this analyzer. The analyzer
+  // version bounds below are applied to that report (and require AnalyzerID).
+  4: optional string AnalyzerID;
+  // Inclusive lower bound of the version of the analyzer (MAJOR.MINOR.PATCH).
+  5: optional string MinAnalyzerVersion;
+  // Exclusive upper bound of the version of the analyzer (MAJOR.MINOR.PATCH).
+  6: optional string MaxAnalyzerVersion;
+
+  // The verdict about the host should be of this class.
+  7: optional HostVerdictClass VerdictClass;
+  // Inclusive lower bound of the confidence of the verdict about the host.
+  8: optional double MinVerdictConfidence;
 }
 
 struct SearchReportResult {
@@ -181,6 +194,15 @@ struct PCR {
   2: i32 Index;
 }
 
+// ACPITable is a raw ACPI table as provided by the firmware to the OS.
+struct ACPITable {
+  // Name identifies the table on the host, for example "DSDT" or "SSDT3"
+  // (the file name in /sys/firmware/acpi/tables).
+  1: string Name;
+  // Data is the whole table including the header.
+  2: binary Data;
+}
+
 // Artifact represents large shared data objects that are desirable to be passed once
 union Artifact {
   1: FirmwareImage FwImage;
@@ -189,6 +211,7 @@ union Artifact {
   4: tpm.EventLog TPMEventLog;
   5: list<StatusRegister> StatusRegisters;
   6: measurements.Flow MeasurementsFlow;
+  7: list<ACPITable> ACPITables;
 }
 
 // DiffMeasuredBootInput is an input structure for DiffMeasuredBoot analyzer
@@ -228,6 +251,96 @@ struct APCBSecurityTokensInput {
   1: i32 ActualFirmwareImage;
 }
 
+struct IntelFlashDescriptorInput {
+  1: i32 ActualFirmwareImage;
+  2: optional i32 OriginalFirmwareImage;
+}
+
+struct IntelMEInput {
+  1: i32 ActualFirmwareImage;
+  2: optional i32 OriginalFirmwareImage;
+}
+
+struct PSPSecurityPatchLevelInput {
+  1: i32 ActualFirmwareImage;
+  2: optional i32 OriginalFirmwareImage;
+  3: optional i32 StatusRegisters;
+}
+
+struct PSBFusesInput {
+  1: i32 ActualFirmwareImage;
+  2: optional i32 StatusRegisters;
+  // FusedKeyHash is the SHA256 hash of the OEM root key fused into the CPU.
+  // It is not exposed through a status register, so it is provided separately.
+  3: optional binary FusedKeyHash;
+}
+
+// VulnerableModulesInput checks the UEFI modules of the image against
+// the advisory database configured on the server side.
+struct VulnerableModulesInput {
+  1: i32 ActualFirmwareImage;
+}
+
+// FlashDegradationInput characterizes the difference between the actual
+// and the original images to tell a flash chip degradation from an intentional write.
+struct FlashDegradationInput {
+  1: i32 ActualFirmwareImage;
+  2: optional i32 OriginalFirmwareImage;
+}
+
+// FirmwareProvenanceInput compares the running firmware version with
+// the newest version known to the firmware database for the same model.
+struct FirmwareProvenanceInput {
+  1: i32 ActualFirmwareImage;
+}
+
+// OptionROMsInput checks the option ROMs measured to PCR2 against
+// the catalog of known option ROMs configured on the server side.
+struct OptionROMsInput {
+  1: i32 TPMEventLog;
+}
+
+// BootChainInput checks the boot applications, commands and files measured
+// to PCR4, PCR8 and PCR9 against the allowlist configured on the server side.
+struct BootChainInput {
+  1: i32 TPMEventLog;
+}
+
+// ACPITablesInput checks the DMA protection policy (DMAR/IVRS) and compares
+// the ACPI tables of the host with the ones embedded into the original firmware.
+struct ACPITablesInput {
+  1: i32 ACPITables;
+  2: optional i32 OriginalFirmwareImage;
+}
+
+// TXTErrorsInput decodes the errors reported by the processor and
+// the ACMs in TXT.ERRORCODE, ACM_STATUS and TXT.ESTS.
+struct TXTErrorsInput {
+  1: optional i32 StatusRegisters;
+}
+
+// ImageDiffInput compares a firmware image which is not a UEFI BIOS
+// (BMC, NIC or SSD firmware) with the original one by splitting both
+// images into files and partitions of known container formats.
+struct ImageDiffInput {
+  1: i32 ActualFirmwareImage;
+  2: i32 OriginalFirmwareImage;
+}
+
+// PluginInput runs an out-of-process analyzer plugin configured on the server
+// (see analyzer_plugin.thrift). Only the artifacts declared by the plugin are
+// passed to it, the rest are ignored.
+struct PluginInput {
+  1: string AnalyzerID;
+  2: optional i32 ActualFirmwareImage;
+  3: optional i32 OriginalFirmwareImage;
+  4: optional i32 StatusRegisters;
+  5: optional i32 TPMDevice;
+  6: optional i32 TPMEventLog;
+  7: optional i32 ActualPCR0;
+  8: optional i32 ACPITables;
+}
+
 // AnalysisInput is analysis-specific input data.
 union AnalyzerInput {
   1: DiffMeasuredBootInput DiffMeasuredBoot;
@@ -236,6 +349,19 @@ union AnalyzerInput {
   4: PSPSignatureInput PSPSignature;
   5: BIOSRTMVolumeInput BIOSRTMVolume;
   6: APCBSecurityTokensInput APCBSecurityTokens;
+  7: IntelFlashDescriptorInput IntelFlashDescriptor;
+  8: IntelMEInput IntelME;
+  9: PSPSecurityPatchLevelInput PSPSecurityPatchLevel;
+  10: PSBFusesInput PSBFuses;
+  11: VulnerableModulesInput VulnerableModules;
+  12: FlashDegradationInput FlashDegradation;
+  13: FirmwareProvenanceInput FirmwareProvenance;
+  14: OptionROMsInput OptionROMs;
+  15: BootChainInput BootChain;
+  16: ACPITablesInput ACPITables;
+  17: TXTErrorsInput TXTErrors;
+  18: ImageDiffInput ImageDiff;
+  19: PluginInput Plugin;
 }
 
 struct AnalyzeRequest {
@@ -249,12 +375,19 @@ struct AnalyzeRequest {
 
   // Analyzers defines input structure for analyzers to be started
   3: list<AnalyzerInput> Analyzers;
+
+  // Profile requests to return AnalyzeResult.Profile.
+  4: optional bool Profile;
+
+  // DependencyGraphs requests to return AnalyzerResult.DependencyGraphJSON.
+  5: optional bool DependencyGraphs;
 }
 
 enum ErrorClass {
   InternalError = 1,
   InvalidInput = 2,
   NotSupported = 3,
+  BudgetExceeded = 4,
 }
 
 struct Error {
@@ -270,6 +403,16 @@ struct AnalyzerResult {
   // No essential functionality should depend on this.
   // May not be provided if the server decides so for any reason.
   3: optional string ProcessedInputJSON;
+
+  // AnalyzerVersion is the version of the analyzer which produced the outcome
+  // (MAJOR.MINOR.PATCH). Is not set for reports stored before the versioning
+  // was introduced.
+  4: optional string AnalyzerVersion;
+
+  // DependencyGraphJSON is the input dependency graph of the analyzer
+  // (see analysis.DependencyGraph). It shows which inputs were missing if
+  // the analyzer did not run. Is set only if requested, see AnalyzeRequest.DependencyGraphs.
+  5: optional string DependencyGraphJSON;
 }
 
 union AnalyzerOutcome {
@@ -277,12 +420,94 @@ union AnalyzerOutcome {
   2: Error Err;
 }
 
+// HostVerdictClass is the overall assessment of a host, in the ascending order of severity.
+enum HostVerdictClass {
+  Healthy = 1,
+  DegradedHardware = 2,
+  Misconfigured = 3,
+  Suspicious = 4,
+  Compromised = 5,
+  // Inconclusive means the host could not be assessed (too few analyzers succeeded).
+  Inconclusive = 6,
+}
+
+// HostVerdictEvidence is an issue reported by an analyzer which contributed to the verdict.
+struct HostVerdictEvidence {
+  1: string AnalyzerName;
+  2: optional string IssueCode;
+  3: analyzerreport.Severity Severity;
+  4: optional string Description;
+  // Rule is the name of the rule of the verdict policy which classified the issue.
+  5: string Rule;
+  6: HostVerdictClass VerdictClass;
+  7: double Confidence;
+}
+
+// HostVerdict combines the reports of all analyzers into a single assessment of the host.
+struct HostVerdict {
+  1: HostVerdictClass VerdictClass;
+  // Confidence is in range [0, 1].
+  2: double Confidence;
+  // Evidence is sorted by the severity of the class (the most severe first).
+  3: list<HostVerdictEvidence> Evidence;
+}
+
+enum ProfileEntryKind {
+  Analyzer = 1,
+  Calculator = 2,
+}
+
+// ProfileCacheResult defines where a calculated value was taken from.
+enum ProfileCacheResult {
+  // Miss means the value was actually calculated.
+  Miss = 1,
+  // DataCache is the cache shared by analyzers of the same Analyze request.
+  DataCache = 2,
+  // GlobalCache is the in-memory cache of the server.
+  GlobalCache = 3,
+  // PersistentCache is the cache which survives restarts of the server.
+  PersistentCache = 4,
+  // Concurrent means the result of the same calculation performed concurrently was awaited.
+  Concurrent = 5,
+}
+
+// ProfileEntry is a measurement of a single analyzer execution or of a single calculated value.
+//
+// Duration and AllocatedBytes include the ones of the child entries.
+struct ProfileEntry {
+  1: ProfileEntryKind Kind;
+  2: string Name;
+  // ParentIndex is the index of the parent entry in AnalyzeProfile.Entries. Is not set for root entries.
+  3: optional i32 ParentIndex;
+  // StartNanoseconds is relative to the beginning of the analysis.
+  4: i64 StartNanoseconds;
+  5: i64 DurationNanoseconds;
+  // Cache is set only for calculated values.
+  6: optional ProfileCacheResult Cache;
+  // AllocatedBytes are the process allocations during the interval of the entry: they are measured
+  // process-wide, so they also include allocations of concurrently executed analyzers and calculations.
+  7: i64 AllocatedBytes;
+  8: optional string Error;
+}
+
+struct AnalyzeProfile {
+  // Entries are ordered depth-first, so a parent always precedes its children.
+  1: list<ProfileEntry> Entries;
+}
+
 struct AnalyzeResult {
   // JobID is a unique identifier for completed analysis. Could be used to find logs
   1: binary JobID;
 
   // Results are analyzers reports or errors in the same order as in AnalyzeFirmwareRequest
   2: list<AnalyzerResult> Results;
+
+  // Verdict is the overall assessment of the host. Is not set for reports
+  // stored before the verdicts were introduced.
+  3: optional HostVerdict Verdict;
+
+  // Profile is set only if requested, see AnalyzeRequest.Profile.
+  4: optional AnalyzeProfile Profile;
 }
 
 struct CheckFirmwareVersionRequest {
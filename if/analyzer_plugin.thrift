@@ -0,0 +1,89 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+include "afas.thrift"
+include "analyzerreport.thrift"
+include "tpm.thrift"
+
+namespace go if.generated.analyzerplugin
+
+// ProtocolVersion is the version of the protocol between afasd and
+// out-of-process analyzer plugins.
+//
+// A plugin is an executable which reads a single Request from stdin and
+// writes a single Response to stdout, both are encoded with the Thrift binary
+// protocol. A new process is started for every request.
+const i32 ProtocolVersion = 1;
+
+// InputType is a kind of data a plugin may receive.
+enum InputType {
+  ActualFirmware = 1,
+  OriginalFirmware = 2,
+  StatusRegisters = 3,
+  TPMDevice = 4,
+  TPMEventLog = 5,
+  ActualPCR0 = 6,
+  ACPITables = 7,
+}
+
+// DescribeRequest is sent once when afasd loads the plugin.
+struct DescribeRequest {
+  1: i32 ProtocolVersion;
+}
+
+// Description tells afasd the ID of the analyzer and the inputs it needs.
+struct Description {
+  1: i32 ProtocolVersion;
+  // AnalyzerID should not collide with IDs of built-in analyzers.
+  2: string AnalyzerID;
+  // Version is the semantic version (MAJOR.MINOR.PATCH) of the analyzer
+  // logic, it is stored with every report of the plugin.
+  3: optional string Version;
+  4: list<InputType> RequiredInputs;
+  5: list<InputType> OptionalInputs;
+}
+
+// Inputs contains only the inputs declared in the Description.
+struct Inputs {
+  1: optional binary ActualFirmware;
+  2: optional binary OriginalFirmware;
+  3: optional list<afas.StatusRegister> StatusRegisters;
+  4: optional afas.TPMType TPMDevice;
+  5: optional tpm.EventLog TPMEventLog;
+  6: optional binary ActualPCR0;
+  7: optional list<afas.ACPITable> ACPITables;
+}
+
+struct AnalyzeRequest {
+  1: Inputs Inputs;
+}
+
+union Request {
+  1: DescribeRequest Describe;
+  2: AnalyzeRequest Analyze;
+}
+
+struct Report {
+  1: list<analyzerreport.Issue> Issues;
+  2: list<string> Comments;
+  // Data is plugin-specific data (JSON is recommended), it is stored in the report as is.
+  3: optional string Data;
+}
+
+union Response {
+  1: Description Description;
+  2: Report Report;
+  // NotApplicable tells that the analyzer is not applicable for the provided input.
+  3: string NotApplicable;
+  // Error tells that the analysis failed.
+  4: string Error;
+}
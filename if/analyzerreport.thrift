@@ -9,12 +9,25 @@
 // 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
 //
 // THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+include "../pkg/analyzers/acpitables/report/acpitablesanalysis.thrift"
 include "../pkg/analyzers/amd/apcbsectokens/report/apcbsecanalysis.thrift"
 include "../pkg/analyzers/amd/biosrtmvolume/report/biosrtmanalysis.thrift"
 include "../pkg/analyzers/amd/pspsignature/report/pspsignanalysis.thrift"
+include "../pkg/analyzers/amd/psbfuses/report/psbfusesanalysis.thrift"
+include "../pkg/analyzers/amd/pspspl/report/pspsplanalysis.thrift"
 include "../pkg/analyzers/diffmeasuredboot/report/diffanalysis.thrift"
+include "../pkg/analyzers/flashdegradation/report/flashdegradationanalysis.thrift"
+include "../pkg/analyzers/firmwareprovenance/report/firmwareprovenanceanalysis.thrift"
+include "../pkg/analyzers/imagediff/report/imagediffanalysis.thrift"
+include "../pkg/analyzers/optionroms/report/optionromsanalysis.thrift"
+include "../pkg/analyzers/plugin/report/pluginanalysis.thrift"
+include "../pkg/analyzers/bootchain/report/bootchainanalysis.thrift"
 include "../pkg/analyzers/intelacm/report/intelacmanalysis.thrift"
+include "../pkg/analyzers/intelifd/report/intelifdanalysis.thrift"
+include "../pkg/analyzers/intelme/report/intelmeanalysis.thrift"
 include "../pkg/analyzers/reproducepcr/report/reproducepcranalysis.thrift"
+include "../pkg/analyzers/txterrors/report/txterrorsanalysis.thrift"
+include "../pkg/analyzers/vulnerablemodules/report/vulnmodulesanalysis.thrift"
 
 namespace go if.generated.analyzerreport
 
@@ -38,6 +51,16 @@ struct Issue {
 
   // Description is a text description of a found problem
   3: optional string Description;
+
+  // Code is a stable machine-readable identifier of the kind of the problem,
+  // formatted as "<ANALYZER>.<KIND>" (see "afascli issue_codes")
+  4: optional string Code;
+
+  // Params are structured parameters of the problem (for example an expected and an actual value)
+  5: optional map<string, string> Params;
+
+  // Remediation is a hint on how to fix the problem
+  6: optional string Remediation;
 }
 
 // ReportInfo provides an ability to customise Report by analyzers
@@ -48,6 +71,19 @@ union ReportInfo {
   4: pspsignanalysis.CustomReport PSPSignature;
   5: biosrtmanalysis.CustomReport BIOSRTMVolume;
   6: apcbsecanalysis.CustomReport APCBSecurityTokens;
+  7: intelifdanalysis.CustomReport IntelFlashDescriptor;
+  8: intelmeanalysis.CustomReport IntelME;
+  9: pspsplanalysis.CustomReport PSPSecurityPatchLevel;
+  10: psbfusesanalysis.CustomReport PSBFuses;
+  11: vulnmodulesanalysis.CustomReport VulnerableModules;
+  12: flashdegradationanalysis.CustomReport FlashDegradation;
+  13: firmwareprovenanceanalysis.CustomReport FirmwareProvenance;
+  14: optionromsanalysis.CustomReport OptionROMs;
+  15: bootchainanalysis.CustomReport BootChain;
+  16: acpitablesanalysis.CustomReport ACPITables;
+  17: txterrorsanalysis.CustomReport TXTErrors;
+  18: imagediffanalysis.CustomReport ImageDiff;
+  19: pluginanalysis.CustomReport Plugin;
 }
 
 struct AnalyzerReport {
@@ -277,9 +277,10 @@ func (p *BuildMode) Value() (driver.Value, error) {
 type ErrorClass int64
 
 const (
-	ErrorClass_InternalError ErrorClass = 1
-	ErrorClass_InvalidInput  ErrorClass = 2
-	ErrorClass_NotSupported  ErrorClass = 3
+	ErrorClass_InternalError  ErrorClass = 1
+	ErrorClass_InvalidInput   ErrorClass = 2
+	ErrorClass_NotSupported   ErrorClass = 3
+	ErrorClass_BudgetExceeded ErrorClass = 4
 )
 
 func (p ErrorClass) String() string {
@@ -290,6 +291,8 @@ func (p ErrorClass) String() string {
 		return "InvalidInput"
 	case ErrorClass_NotSupported:
 		return "NotSupported"
+	case ErrorClass_BudgetExceeded:
+		return "BudgetExceeded"
 	}
 	return "<UNSET>"
 }
@@ -302,6 +305,8 @@ func ErrorClassFromString(s string) (ErrorClass, error) {
 		return ErrorClass_InvalidInput, nil
 	case "NotSupported":
 		return ErrorClass_NotSupported, nil
+	case "BudgetExceeded":
+		return ErrorClass_BudgetExceeded, nil
 	}
 	return ErrorClass(0), fmt.Errorf("not a valid ErrorClass string")
 }
@@ -337,6 +342,215 @@ func (p *ErrorClass) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type HostVerdictClass int64
+
+const (
+	HostVerdictClass_Healthy          HostVerdictClass = 1
+	HostVerdictClass_DegradedHardware HostVerdictClass = 2
+	HostVerdictClass_Misconfigured    HostVerdictClass = 3
+	HostVerdictClass_Suspicious       HostVerdictClass = 4
+	HostVerdictClass_Compromised      HostVerdictClass = 5
+	HostVerdictClass_Inconclusive     HostVerdictClass = 6
+)
+
+func (p HostVerdictClass) String() string {
+	switch p {
+	case HostVerdictClass_Healthy:
+		return "Healthy"
+	case HostVerdictClass_DegradedHardware:
+		return "DegradedHardware"
+	case HostVerdictClass_Misconfigured:
+		return "Misconfigured"
+	case HostVerdictClass_Suspicious:
+		return "Suspicious"
+	case HostVerdictClass_Compromised:
+		return "Compromised"
+	case HostVerdictClass_Inconclusive:
+		return "Inconclusive"
+	}
+	return "<UNSET>"
+}
+
+func HostVerdictClassFromString(s string) (HostVerdictClass, error) {
+	switch s {
+	case "Healthy":
+		return HostVerdictClass_Healthy, nil
+	case "DegradedHardware":
+		return HostVerdictClass_DegradedHardware, nil
+	case "Misconfigured":
+		return HostVerdictClass_Misconfigured, nil
+	case "Suspicious":
+		return HostVerdictClass_Suspicious, nil
+	case "Compromised":
+		return HostVerdictClass_Compromised, nil
+	case "Inconclusive":
+		return HostVerdictClass_Inconclusive, nil
+	}
+	return HostVerdictClass(0), fmt.Errorf("not a valid HostVerdictClass string")
+}
+
+func HostVerdictClassPtr(v HostVerdictClass) *HostVerdictClass { return &v }
+
+func (p HostVerdictClass) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *HostVerdictClass) UnmarshalText(text []byte) error {
+	q, err := HostVerdictClassFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *HostVerdictClass) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = HostVerdictClass(v)
+	return nil
+}
+
+func (p *HostVerdictClass) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ProfileEntryKind int64
+
+const (
+	ProfileEntryKind_Analyzer   ProfileEntryKind = 1
+	ProfileEntryKind_Calculator ProfileEntryKind = 2
+)
+
+func (p ProfileEntryKind) String() string {
+	switch p {
+	case ProfileEntryKind_Analyzer:
+		return "Analyzer"
+	case ProfileEntryKind_Calculator:
+		return "Calculator"
+	}
+	return "<UNSET>"
+}
+
+func ProfileEntryKindFromString(s string) (ProfileEntryKind, error) {
+	switch s {
+	case "Analyzer":
+		return ProfileEntryKind_Analyzer, nil
+	case "Calculator":
+		return ProfileEntryKind_Calculator, nil
+	}
+	return ProfileEntryKind(0), fmt.Errorf("not a valid ProfileEntryKind string")
+}
+
+func ProfileEntryKindPtr(v ProfileEntryKind) *ProfileEntryKind { return &v }
+
+func (p ProfileEntryKind) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ProfileEntryKind) UnmarshalText(text []byte) error {
+	q, err := ProfileEntryKindFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *ProfileEntryKind) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = ProfileEntryKind(v)
+	return nil
+}
+
+func (p *ProfileEntryKind) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ProfileCacheResult_ int64
+
+const (
+	ProfileCacheResult__Miss            ProfileCacheResult_ = 1
+	ProfileCacheResult__DataCache       ProfileCacheResult_ = 2
+	ProfileCacheResult__GlobalCache     ProfileCacheResult_ = 3
+	ProfileCacheResult__PersistentCache ProfileCacheResult_ = 4
+	ProfileCacheResult__Concurrent      ProfileCacheResult_ = 5
+)
+
+func (p ProfileCacheResult_) String() string {
+	switch p {
+	case ProfileCacheResult__Miss:
+		return "Miss"
+	case ProfileCacheResult__DataCache:
+		return "DataCache"
+	case ProfileCacheResult__GlobalCache:
+		return "GlobalCache"
+	case ProfileCacheResult__PersistentCache:
+		return "PersistentCache"
+	case ProfileCacheResult__Concurrent:
+		return "Concurrent"
+	}
+	return "<UNSET>"
+}
+
+func ProfileCacheResult_FromString(s string) (ProfileCacheResult_, error) {
+	switch s {
+	case "Miss":
+		return ProfileCacheResult__Miss, nil
+	case "DataCache":
+		return ProfileCacheResult__DataCache, nil
+	case "GlobalCache":
+		return ProfileCacheResult__GlobalCache, nil
+	case "PersistentCache":
+		return ProfileCacheResult__PersistentCache, nil
+	case "Concurrent":
+		return ProfileCacheResult__Concurrent, nil
+	}
+	return ProfileCacheResult_(0), fmt.Errorf("not a valid ProfileCacheResult_ string")
+}
+
+func ProfileCacheResult_Ptr(v ProfileCacheResult_) *ProfileCacheResult_ { return &v }
+
+func (p ProfileCacheResult_) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ProfileCacheResult_) UnmarshalText(text []byte) error {
+	q, err := ProfileCacheResult_FromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *ProfileCacheResult_) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = ProfileCacheResult_(v)
+	return nil
+}
+
+func (p *ProfileCacheResult_) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type NodeInfo *diffanalysis.NodeInfo
 
 func NodeInfoPtr(v NodeInfo) *NodeInfo { return &v }
@@ -3738,10 +3952,20 @@ func (p *SearchReportRequest) String() string {
 //   - JobID
 //   - AssetID
 //   - ActualFirmware
+//   - AnalyzerID
+//   - MinAnalyzerVersion
+//   - MaxAnalyzerVersion
+//   - VerdictClass
+//   - MinVerdictConfidence
 type SearchReportFilters struct {
-	JobID          []byte                 `thrift:"JobID,1" db:"JobID" json:"JobID,omitempty"`
-	AssetID        *int64                 `thrift:"AssetID,2" db:"AssetID" json:"AssetID,omitempty"`
-	ActualFirmware *SearchFirmwareFilters `thrift:"ActualFirmware,3" db:"ActualFirmware" json:"ActualFirmware"`
+	JobID                []byte                 `thrift:"JobID,1" db:"JobID" json:"JobID,omitempty"`
+	AssetID              *int64                 `thrift:"AssetID,2" db:"AssetID" json:"AssetID,omitempty"`
+	ActualFirmware       *SearchFirmwareFilters `thrift:"ActualFirmware,3" db:"ActualFirmware" json:"ActualFirmware"`
+	AnalyzerID           *string                `thrift:"AnalyzerID,4" db:"AnalyzerID" json:"AnalyzerID,omitempty"`
+	MinAnalyzerVersion   *string                `thrift:"MinAnalyzerVersion,5" db:"MinAnalyzerVersion" json:"MinAnalyzerVersion,omitempty"`
+	MaxAnalyzerVersion   *string                `thrift:"MaxAnalyzerVersion,6" db:"MaxAnalyzerVersion" json:"MaxAnalyzerVersion,omitempty"`
+	VerdictClass         *HostVerdictClass      `thrift:"VerdictClass,7" db:"VerdictClass" json:"VerdictClass,omitempty"`
+	MinVerdictConfidence *float64               `thrift:"MinVerdictConfidence,8" db:"MinVerdictConfidence" json:"MinVerdictConfidence,omitempty"`
 }
 
 func NewSearchReportFilters() *SearchReportFilters {
@@ -3771,6 +3995,51 @@ func (p *SearchReportFilters) GetActualFirmware() *SearchFirmwareFilters {
 	}
 	return p.ActualFirmware
 }
+
+var SearchReportFilters_AnalyzerID_DEFAULT string
+
+func (p *SearchReportFilters) GetAnalyzerID() string {
+	if !p.IsSetAnalyzerID() {
+		return SearchReportFilters_AnalyzerID_DEFAULT
+	}
+	return *p.AnalyzerID
+}
+
+var SearchReportFilters_MinAnalyzerVersion_DEFAULT string
+
+func (p *SearchReportFilters) GetMinAnalyzerVersion() string {
+	if !p.IsSetMinAnalyzerVersion() {
+		return SearchReportFilters_MinAnalyzerVersion_DEFAULT
+	}
+	return *p.MinAnalyzerVersion
+}
+
+var SearchReportFilters_MaxAnalyzerVersion_DEFAULT string
+
+func (p *SearchReportFilters) GetMaxAnalyzerVersion() string {
+	if !p.IsSetMaxAnalyzerVersion() {
+		return SearchReportFilters_MaxAnalyzerVersion_DEFAULT
+	}
+	return *p.MaxAnalyzerVersion
+}
+
+var SearchReportFilters_VerdictClass_DEFAULT HostVerdictClass
+
+func (p *SearchReportFilters) GetVerdictClass() HostVerdictClass {
+	if !p.IsSetVerdictClass() {
+		return SearchReportFilters_VerdictClass_DEFAULT
+	}
+	return *p.VerdictClass
+}
+
+var SearchReportFilters_MinVerdictConfidence_DEFAULT float64
+
+func (p *SearchReportFilters) GetMinVerdictConfidence() float64 {
+	if !p.IsSetMinVerdictConfidence() {
+		return SearchReportFilters_MinVerdictConfidence_DEFAULT
+	}
+	return *p.MinVerdictConfidence
+}
 func (p *SearchReportFilters) IsSetJobID() bool {
 	return p.JobID != nil
 }
@@ -3783,6 +4052,26 @@ func (p *SearchReportFilters) IsSetActualFirmware() bool {
 	return p.ActualFirmware != nil
 }
 
+func (p *SearchReportFilters) IsSetAnalyzerID() bool {
+	return p.AnalyzerID != nil
+}
+
+func (p *SearchReportFilters) IsSetMinAnalyzerVersion() bool {
+	return p.MinAnalyzerVersion != nil
+}
+
+func (p *SearchReportFilters) IsSetMaxAnalyzerVersion() bool {
+	return p.MaxAnalyzerVersion != nil
+}
+
+func (p *SearchReportFilters) IsSetVerdictClass() bool {
+	return p.VerdictClass != nil
+}
+
+func (p *SearchReportFilters) IsSetMinVerdictConfidence() bool {
+	return p.MinVerdictConfidence != nil
+}
+
 func (p *SearchReportFilters) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -3827,6 +4116,56 @@ func (p *SearchReportFilters) Read(ctx context.Context, iprot thrift.TProtocol)
 					return err
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.DOUBLE {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -3868,6 +4207,52 @@ func (p *SearchReportFilters) ReadField3(ctx context.Context, iprot thrift.TProt
 	return nil
 }
 
+func (p *SearchReportFilters) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.AnalyzerID = &v
+	}
+	return nil
+}
+
+func (p *SearchReportFilters) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.MinAnalyzerVersion = &v
+	}
+	return nil
+}
+
+func (p *SearchReportFilters) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.MaxAnalyzerVersion = &v
+	}
+	return nil
+}
+
+func (p *SearchReportFilters) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		temp := HostVerdictClass(v)
+		p.VerdictClass = &temp
+	}
+	return nil
+}
+
+func (p *SearchReportFilters) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.MinVerdictConfidence = &v
+	}
+	return nil
+}
+
 func (p *SearchReportFilters) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin(ctx, "SearchReportFilters"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -3882,6 +4267,21 @@ func (p *SearchReportFilters) Write(ctx context.Context, oprot thrift.TProtocol)
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -3935,26 +4335,141 @@ func (p *SearchReportFilters) writeField3(ctx context.Context, oprot thrift.TPro
 	return err
 }
 
-func (p *SearchReportFilters) Equals(other *SearchReportFilters) bool {
-	if p == other {
-		return true
-	} else if p == nil || other == nil {
-		return false
-	}
-	if bytes.Compare(p.JobID, other.JobID) != 0 {
-		return false
-	}
-	if p.AssetID != other.AssetID {
-		if p.AssetID == nil || other.AssetID == nil {
-			return false
+func (p *SearchReportFilters) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnalyzerID() {
+		if err := oprot.WriteFieldBegin(ctx, "AnalyzerID", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:AnalyzerID: ", p), err)
 		}
-		if (*p.AssetID) != (*other.AssetID) {
-			return false
+		if err := oprot.WriteString(ctx, string(*p.AnalyzerID)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.AnalyzerID (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:AnalyzerID: ", p), err)
 		}
 	}
-	if !p.ActualFirmware.Equals(other.ActualFirmware) {
+	return err
+}
+
+func (p *SearchReportFilters) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinAnalyzerVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "MinAnalyzerVersion", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:MinAnalyzerVersion: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.MinAnalyzerVersion)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.MinAnalyzerVersion (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:MinAnalyzerVersion: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SearchReportFilters) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxAnalyzerVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "MaxAnalyzerVersion", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:MaxAnalyzerVersion: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.MaxAnalyzerVersion)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.MaxAnalyzerVersion (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:MaxAnalyzerVersion: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SearchReportFilters) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerdictClass() {
+		if err := oprot.WriteFieldBegin(ctx, "VerdictClass", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:VerdictClass: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.VerdictClass)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.VerdictClass (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:VerdictClass: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SearchReportFilters) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinVerdictConfidence() {
+		if err := oprot.WriteFieldBegin(ctx, "MinVerdictConfidence", thrift.DOUBLE, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:MinVerdictConfidence: ", p), err)
+		}
+		if err := oprot.WriteDouble(ctx, float64(*p.MinVerdictConfidence)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.MinVerdictConfidence (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:MinVerdictConfidence: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SearchReportFilters) Equals(other *SearchReportFilters) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if bytes.Compare(p.JobID, other.JobID) != 0 {
+		return false
+	}
+	if p.AssetID != other.AssetID {
+		if p.AssetID == nil || other.AssetID == nil {
+			return false
+		}
+		if (*p.AssetID) != (*other.AssetID) {
+			return false
+		}
+	}
+	if !p.ActualFirmware.Equals(other.ActualFirmware) {
 		return false
 	}
+	if p.AnalyzerID != other.AnalyzerID {
+		if p.AnalyzerID == nil || other.AnalyzerID == nil {
+			return false
+		}
+		if (*p.AnalyzerID) != (*other.AnalyzerID) {
+			return false
+		}
+	}
+	if p.MinAnalyzerVersion != other.MinAnalyzerVersion {
+		if p.MinAnalyzerVersion == nil || other.MinAnalyzerVersion == nil {
+			return false
+		}
+		if (*p.MinAnalyzerVersion) != (*other.MinAnalyzerVersion) {
+			return false
+		}
+	}
+	if p.MaxAnalyzerVersion != other.MaxAnalyzerVersion {
+		if p.MaxAnalyzerVersion == nil || other.MaxAnalyzerVersion == nil {
+			return false
+		}
+		if (*p.MaxAnalyzerVersion) != (*other.MaxAnalyzerVersion) {
+			return false
+		}
+	}
+	if p.VerdictClass != other.VerdictClass {
+		if p.VerdictClass == nil || other.VerdictClass == nil {
+			return false
+		}
+		if (*p.VerdictClass) != (*other.VerdictClass) {
+			return false
+		}
+	}
+	if p.MinVerdictConfidence != other.MinVerdictConfidence {
+		if p.MinVerdictConfidence == nil || other.MinVerdictConfidence == nil {
+			return false
+		}
+		if (*p.MinVerdictConfidence) != (*other.MinVerdictConfidence) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -4776,6 +5291,161 @@ func (p *PCR) String() string {
 	return fmt.Sprintf("PCR(%+v)", *p)
 }
 
+// Attributes:
+//   - Name
+//   - Data
+type ACPITable struct {
+	Name string `thrift:"Name,1" db:"Name" json:"Name"`
+	Data []byte `thrift:"Data,2" db:"Data" json:"Data"`
+}
+
+func NewACPITable() *ACPITable {
+	return &ACPITable{}
+}
+
+func (p *ACPITable) GetName() string {
+	return p.Name
+}
+
+func (p *ACPITable) GetData() []byte {
+	return p.Data
+}
+func (p *ACPITable) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ACPITable) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *ACPITable) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Data = v
+	}
+	return nil
+}
+
+func (p *ACPITable) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ACPITable"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ACPITable) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Name: ", p), err)
+	}
+	return err
+}
+
+func (p *ACPITable) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Data", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Data: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.Data); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Data (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Data: ", p), err)
+	}
+	return err
+}
+
+func (p *ACPITable) Equals(other *ACPITable) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if bytes.Compare(p.Data, other.Data) != 0 {
+		return false
+	}
+	return true
+}
+
+func (p *ACPITable) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ACPITable(%+v)", *p)
+}
+
 // Attributes:
 //   - FwImage
 //   - Pcr
@@ -4783,6 +5453,7 @@ func (p *PCR) String() string {
 //   - TPMEventLog
 //   - StatusRegisters
 //   - MeasurementsFlow
+//   - ACPITables
 type Artifact struct {
 	FwImage          *FirmwareImage     `thrift:"FwImage,1" db:"FwImage" json:"FwImage,omitempty"`
 	Pcr              *PCR               `thrift:"Pcr,2" db:"Pcr" json:"Pcr,omitempty"`
@@ -4790,6 +5461,7 @@ type Artifact struct {
 	TPMEventLog      *tpm.EventLog      `thrift:"TPMEventLog,4" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
 	StatusRegisters  []*StatusRegister  `thrift:"StatusRegisters,5" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
 	MeasurementsFlow *measurements.Flow `thrift:"MeasurementsFlow,6" db:"MeasurementsFlow" json:"MeasurementsFlow,omitempty"`
+	ACPITables       []*ACPITable       `thrift:"ACPITables,7" db:"ACPITables" json:"ACPITables,omitempty"`
 }
 
 func NewArtifact() *Artifact {
@@ -4846,6 +5518,12 @@ func (p *Artifact) GetMeasurementsFlow() measurements.Flow {
 	}
 	return *p.MeasurementsFlow
 }
+
+var Artifact_ACPITables_DEFAULT []*ACPITable
+
+func (p *Artifact) GetACPITables() []*ACPITable {
+	return p.ACPITables
+}
 func (p *Artifact) CountSetFieldsArtifact() int {
 	count := 0
 	if p.IsSetFwImage() {
@@ -4866,6 +5544,9 @@ func (p *Artifact) CountSetFieldsArtifact() int {
 	if p.IsSetMeasurementsFlow() {
 		count++
 	}
+	if p.IsSetACPITables() {
+		count++
+	}
 	return count
 
 }
@@ -4894,6 +5575,10 @@ func (p *Artifact) IsSetMeasurementsFlow() bool {
 	return p.MeasurementsFlow != nil
 }
 
+func (p *Artifact) IsSetACPITables() bool {
+	return p.ACPITables != nil
+}
+
 func (p *Artifact) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -4968,6 +5653,16 @@ func (p *Artifact) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -5047,6 +5742,26 @@ func (p *Artifact) ReadField6(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
+func (p *Artifact) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*ACPITable, 0, size)
+	p.ACPITables = tSlice
+	for i := 0; i < size; i++ {
+		_elem9 := &ACPITable{}
+		if err := _elem9.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem9), err)
+		}
+		p.ACPITables = append(p.ACPITables, _elem9)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
 func (p *Artifact) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if c := p.CountSetFieldsArtifact(); c != 1 {
 		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
@@ -5073,6 +5788,9 @@ func (p *Artifact) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField6(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -5181,6 +5899,29 @@ func (p *Artifact) writeField6(ctx context.Context, oprot thrift.TProtocol) (err
 	return err
 }
 
+func (p *Artifact) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetACPITables() {
+		if err := oprot.WriteFieldBegin(ctx, "ACPITables", thrift.LIST, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:ACPITables: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.ACPITables)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.ACPITables {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:ACPITables: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *Artifact) Equals(other *Artifact) bool {
 	if p == other {
 		return true
@@ -5208,8 +5949,8 @@ func (p *Artifact) Equals(other *Artifact) bool {
 		return false
 	}
 	for i, _tgt := range p.StatusRegisters {
-		_src9 := other.StatusRegisters[i]
-		if !_tgt.Equals(_src9) {
+		_src10 := other.StatusRegisters[i]
+		if !_tgt.Equals(_src10) {
 			return false
 		}
 	}
@@ -5221,6 +5962,15 @@ func (p *Artifact) Equals(other *Artifact) bool {
 			return false
 		}
 	}
+	if len(p.ACPITables) != len(other.ACPITables) {
+		return false
+	}
+	for i, _tgt := range p.ACPITables {
+		_src11 := other.ACPITables[i]
+		if !_tgt.Equals(_src11) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -6602,127 +7352,4487 @@ func (p *APCBSecurityTokensInput) String() string {
 }
 
 // Attributes:
-//   - DiffMeasuredBoot
-//   - IntelACM
-//   - ReproducePCR
-//   - PSPSignature
-//   - BIOSRTMVolume
-//   - APCBSecurityTokens
-type AnalyzerInput struct {
-	DiffMeasuredBoot   *DiffMeasuredBootInput   `thrift:"DiffMeasuredBoot,1" db:"DiffMeasuredBoot" json:"DiffMeasuredBoot,omitempty"`
-	IntelACM           *IntelACMInput           `thrift:"IntelACM,2" db:"IntelACM" json:"IntelACM,omitempty"`
-	ReproducePCR       *ReproducePCRInput       `thrift:"ReproducePCR,3" db:"ReproducePCR" json:"ReproducePCR,omitempty"`
-	PSPSignature       *PSPSignatureInput       `thrift:"PSPSignature,4" db:"PSPSignature" json:"PSPSignature,omitempty"`
-	BIOSRTMVolume      *BIOSRTMVolumeInput      `thrift:"BIOSRTMVolume,5" db:"BIOSRTMVolume" json:"BIOSRTMVolume,omitempty"`
-	APCBSecurityTokens *APCBSecurityTokensInput `thrift:"APCBSecurityTokens,6" db:"APCBSecurityTokens" json:"APCBSecurityTokens,omitempty"`
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+type IntelFlashDescriptorInput struct {
+	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
 }
 
-func NewAnalyzerInput() *AnalyzerInput {
-	return &AnalyzerInput{}
+func NewIntelFlashDescriptorInput() *IntelFlashDescriptorInput {
+	return &IntelFlashDescriptorInput{}
 }
 
-var AnalyzerInput_DiffMeasuredBoot_DEFAULT *DiffMeasuredBootInput
+func (p *IntelFlashDescriptorInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
 
-func (p *AnalyzerInput) GetDiffMeasuredBoot() *DiffMeasuredBootInput {
-	if !p.IsSetDiffMeasuredBoot() {
-		return AnalyzerInput_DiffMeasuredBoot_DEFAULT
+var IntelFlashDescriptorInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *IntelFlashDescriptorInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return IntelFlashDescriptorInput_OriginalFirmwareImage_DEFAULT
 	}
-	return p.DiffMeasuredBoot
+	return *p.OriginalFirmwareImage
+}
+func (p *IntelFlashDescriptorInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
 }
 
-var AnalyzerInput_IntelACM_DEFAULT *IntelACMInput
+func (p *IntelFlashDescriptorInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-func (p *AnalyzerInput) GetIntelACM() *IntelACMInput {
-	if !p.IsSetIntelACM() {
-		return AnalyzerInput_IntelACM_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
-	return p.IntelACM
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-var AnalyzerInput_ReproducePCR_DEFAULT *ReproducePCRInput
+func (p *IntelFlashDescriptorInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
 
-func (p *AnalyzerInput) GetReproducePCR() *ReproducePCRInput {
-	if !p.IsSetReproducePCR() {
-		return AnalyzerInput_ReproducePCR_DEFAULT
+func (p *IntelFlashDescriptorInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
 	}
-	return p.ReproducePCR
+	return nil
 }
 
-var AnalyzerInput_PSPSignature_DEFAULT *PSPSignatureInput
+func (p *IntelFlashDescriptorInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "IntelFlashDescriptorInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
 
-func (p *AnalyzerInput) GetPSPSignature() *PSPSignatureInput {
-	if !p.IsSetPSPSignature() {
-		return AnalyzerInput_PSPSignature_DEFAULT
+func (p *IntelFlashDescriptorInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
 	}
-	return p.PSPSignature
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
 }
 
-var AnalyzerInput_BIOSRTMVolume_DEFAULT *BIOSRTMVolumeInput
+func (p *IntelFlashDescriptorInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
 
-func (p *AnalyzerInput) GetBIOSRTMVolume() *BIOSRTMVolumeInput {
-	if !p.IsSetBIOSRTMVolume() {
-		return AnalyzerInput_BIOSRTMVolume_DEFAULT
+func (p *IntelFlashDescriptorInput) Equals(other *IntelFlashDescriptorInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
 	}
-	return p.BIOSRTMVolume
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	return true
 }
 
-var AnalyzerInput_APCBSecurityTokens_DEFAULT *APCBSecurityTokensInput
+func (p *IntelFlashDescriptorInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IntelFlashDescriptorInput(%+v)", *p)
+}
 
-func (p *AnalyzerInput) GetAPCBSecurityTokens() *APCBSecurityTokensInput {
-	if !p.IsSetAPCBSecurityTokens() {
-		return AnalyzerInput_APCBSecurityTokens_DEFAULT
+// Attributes:
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+type IntelMEInput struct {
+	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+}
+
+func NewIntelMEInput() *IntelMEInput {
+	return &IntelMEInput{}
+}
+
+func (p *IntelMEInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+
+var IntelMEInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *IntelMEInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return IntelMEInput_OriginalFirmwareImage_DEFAULT
 	}
-	return p.APCBSecurityTokens
+	return *p.OriginalFirmwareImage
 }
-func (p *AnalyzerInput) CountSetFieldsAnalyzerInput() int {
-	count := 0
-	if p.IsSetDiffMeasuredBoot() {
-		count++
+func (p *IntelMEInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
+}
+
+func (p *IntelMEInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	if p.IsSetIntelACM() {
-		count++
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
 	}
-	if p.IsSetReproducePCR() {
-		count++
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if p.IsSetPSPSignature() {
-		count++
+	return nil
+}
+
+func (p *IntelMEInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
 	}
-	if p.IsSetBIOSRTMVolume() {
+	return nil
+}
+
+func (p *IntelMEInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *IntelMEInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "IntelMEInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *IntelMEInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *IntelMEInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *IntelMEInput) Equals(other *IntelMEInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *IntelMEInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IntelMEInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+//   - StatusRegisters
+type PSPSecurityPatchLevelInput struct {
+	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+	StatusRegisters       *int32 `thrift:"StatusRegisters,3" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
+}
+
+func NewPSPSecurityPatchLevelInput() *PSPSecurityPatchLevelInput {
+	return &PSPSecurityPatchLevelInput{}
+}
+
+func (p *PSPSecurityPatchLevelInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+
+var PSPSecurityPatchLevelInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *PSPSecurityPatchLevelInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return PSPSecurityPatchLevelInput_OriginalFirmwareImage_DEFAULT
+	}
+	return *p.OriginalFirmwareImage
+}
+
+var PSPSecurityPatchLevelInput_StatusRegisters_DEFAULT int32
+
+func (p *PSPSecurityPatchLevelInput) GetStatusRegisters() int32 {
+	if !p.IsSetStatusRegisters() {
+		return PSPSecurityPatchLevelInput_StatusRegisters_DEFAULT
+	}
+	return *p.StatusRegisters
+}
+func (p *PSPSecurityPatchLevelInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
+}
+
+func (p *PSPSecurityPatchLevelInput) IsSetStatusRegisters() bool {
+	return p.StatusRegisters != nil
+}
+
+func (p *PSPSecurityPatchLevelInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *PSPSecurityPatchLevelInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *PSPSecurityPatchLevelInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *PSPSecurityPatchLevelInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.StatusRegisters = &v
+	}
+	return nil
+}
+
+func (p *PSPSecurityPatchLevelInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PSPSecurityPatchLevelInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PSPSecurityPatchLevelInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *PSPSecurityPatchLevelInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PSPSecurityPatchLevelInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusRegisters() {
+		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:StatusRegisters: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.StatusRegisters)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.StatusRegisters (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:StatusRegisters: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PSPSecurityPatchLevelInput) Equals(other *PSPSecurityPatchLevelInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	if p.StatusRegisters != other.StatusRegisters {
+		if p.StatusRegisters == nil || other.StatusRegisters == nil {
+			return false
+		}
+		if (*p.StatusRegisters) != (*other.StatusRegisters) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *PSPSecurityPatchLevelInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PSPSecurityPatchLevelInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+//   - StatusRegisters
+//   - FusedKeyHash
+type PSBFusesInput struct {
+	ActualFirmwareImage int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	StatusRegisters     *int32 `thrift:"StatusRegisters,2" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
+	FusedKeyHash        []byte `thrift:"FusedKeyHash,3" db:"FusedKeyHash" json:"FusedKeyHash,omitempty"`
+}
+
+func NewPSBFusesInput() *PSBFusesInput {
+	return &PSBFusesInput{}
+}
+
+func (p *PSBFusesInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+
+var PSBFusesInput_StatusRegisters_DEFAULT int32
+
+func (p *PSBFusesInput) GetStatusRegisters() int32 {
+	if !p.IsSetStatusRegisters() {
+		return PSBFusesInput_StatusRegisters_DEFAULT
+	}
+	return *p.StatusRegisters
+}
+
+var PSBFusesInput_FusedKeyHash_DEFAULT []byte
+
+func (p *PSBFusesInput) GetFusedKeyHash() []byte {
+	return p.FusedKeyHash
+}
+func (p *PSBFusesInput) IsSetStatusRegisters() bool {
+	return p.StatusRegisters != nil
+}
+
+func (p *PSBFusesInput) IsSetFusedKeyHash() bool {
+	return p.FusedKeyHash != nil
+}
+
+func (p *PSBFusesInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *PSBFusesInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *PSBFusesInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.StatusRegisters = &v
+	}
+	return nil
+}
+
+func (p *PSBFusesInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.FusedKeyHash = v
+	}
+	return nil
+}
+
+func (p *PSBFusesInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PSBFusesInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PSBFusesInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *PSBFusesInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusRegisters() {
+		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:StatusRegisters: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.StatusRegisters)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.StatusRegisters (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:StatusRegisters: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PSBFusesInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFusedKeyHash() {
+		if err := oprot.WriteFieldBegin(ctx, "FusedKeyHash", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:FusedKeyHash: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.FusedKeyHash); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.FusedKeyHash (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:FusedKeyHash: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PSBFusesInput) Equals(other *PSBFusesInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.StatusRegisters != other.StatusRegisters {
+		if p.StatusRegisters == nil || other.StatusRegisters == nil {
+			return false
+		}
+		if (*p.StatusRegisters) != (*other.StatusRegisters) {
+			return false
+		}
+	}
+	if bytes.Compare(p.FusedKeyHash, other.FusedKeyHash) != 0 {
+		return false
+	}
+	return true
+}
+
+func (p *PSBFusesInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PSBFusesInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+type VulnerableModulesInput struct {
+	ActualFirmwareImage int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+}
+
+func NewVulnerableModulesInput() *VulnerableModulesInput {
+	return &VulnerableModulesInput{}
+}
+
+func (p *VulnerableModulesInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+func (p *VulnerableModulesInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *VulnerableModulesInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *VulnerableModulesInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "VulnerableModulesInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *VulnerableModulesInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *VulnerableModulesInput) Equals(other *VulnerableModulesInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	return true
+}
+
+func (p *VulnerableModulesInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VulnerableModulesInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+type FlashDegradationInput struct {
+	ActualFirmwareImage   int32  `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+}
+
+func NewFlashDegradationInput() *FlashDegradationInput {
+	return &FlashDegradationInput{}
+}
+
+func (p *FlashDegradationInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+
+var FlashDegradationInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *FlashDegradationInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return FlashDegradationInput_OriginalFirmwareImage_DEFAULT
+	}
+	return *p.OriginalFirmwareImage
+}
+func (p *FlashDegradationInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
+}
+
+func (p *FlashDegradationInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FlashDegradationInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *FlashDegradationInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *FlashDegradationInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FlashDegradationInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FlashDegradationInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *FlashDegradationInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FlashDegradationInput) Equals(other *FlashDegradationInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *FlashDegradationInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FlashDegradationInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+type FirmwareProvenanceInput struct {
+	ActualFirmwareImage int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+}
+
+func NewFirmwareProvenanceInput() *FirmwareProvenanceInput {
+	return &FirmwareProvenanceInput{}
+}
+
+func (p *FirmwareProvenanceInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+func (p *FirmwareProvenanceInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FirmwareProvenanceInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *FirmwareProvenanceInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FirmwareProvenanceInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FirmwareProvenanceInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *FirmwareProvenanceInput) Equals(other *FirmwareProvenanceInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	return true
+}
+
+func (p *FirmwareProvenanceInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FirmwareProvenanceInput(%+v)", *p)
+}
+
+// Attributes:
+//   - TPMEventLog
+type OptionROMsInput struct {
+	TPMEventLog int32 `thrift:"TPMEventLog,1" db:"TPMEventLog" json:"TPMEventLog"`
+}
+
+func NewOptionROMsInput() *OptionROMsInput {
+	return &OptionROMsInput{}
+}
+
+func (p *OptionROMsInput) GetTPMEventLog() int32 {
+	return p.TPMEventLog
+}
+func (p *OptionROMsInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *OptionROMsInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.TPMEventLog = v
+	}
+	return nil
+}
+
+func (p *OptionROMsInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "OptionROMsInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *OptionROMsInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:TPMEventLog: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.TPMEventLog)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.TPMEventLog (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:TPMEventLog: ", p), err)
+	}
+	return err
+}
+
+func (p *OptionROMsInput) Equals(other *OptionROMsInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.TPMEventLog != other.TPMEventLog {
+		return false
+	}
+	return true
+}
+
+func (p *OptionROMsInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OptionROMsInput(%+v)", *p)
+}
+
+// Attributes:
+//   - TPMEventLog
+type BootChainInput struct {
+	TPMEventLog int32 `thrift:"TPMEventLog,1" db:"TPMEventLog" json:"TPMEventLog"`
+}
+
+func NewBootChainInput() *BootChainInput {
+	return &BootChainInput{}
+}
+
+func (p *BootChainInput) GetTPMEventLog() int32 {
+	return p.TPMEventLog
+}
+func (p *BootChainInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *BootChainInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.TPMEventLog = v
+	}
+	return nil
+}
+
+func (p *BootChainInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "BootChainInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *BootChainInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:TPMEventLog: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.TPMEventLog)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.TPMEventLog (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:TPMEventLog: ", p), err)
+	}
+	return err
+}
+
+func (p *BootChainInput) Equals(other *BootChainInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.TPMEventLog != other.TPMEventLog {
+		return false
+	}
+	return true
+}
+
+func (p *BootChainInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BootChainInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ACPITables
+//   - OriginalFirmwareImage
+type ACPITablesInput struct {
+	ACPITables            int32  `thrift:"ACPITables,1" db:"ACPITables" json:"ACPITables"`
+	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+}
+
+func NewACPITablesInput() *ACPITablesInput {
+	return &ACPITablesInput{}
+}
+
+func (p *ACPITablesInput) GetACPITables() int32 {
+	return p.ACPITables
+}
+
+var ACPITablesInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *ACPITablesInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return ACPITablesInput_OriginalFirmwareImage_DEFAULT
+	}
+	return *p.OriginalFirmwareImage
+}
+func (p *ACPITablesInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
+}
+
+func (p *ACPITablesInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ACPITablesInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ACPITables = v
+	}
+	return nil
+}
+
+func (p *ACPITablesInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *ACPITablesInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ACPITablesInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ACPITablesInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ACPITables", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ACPITables: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ACPITables)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ACPITables (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ACPITables: ", p), err)
+	}
+	return err
+}
+
+func (p *ACPITablesInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ACPITablesInput) Equals(other *ACPITablesInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ACPITables != other.ACPITables {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *ACPITablesInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ACPITablesInput(%+v)", *p)
+}
+
+// Attributes:
+//   - StatusRegisters
+type TXTErrorsInput struct {
+	StatusRegisters *int32 `thrift:"StatusRegisters,1" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
+}
+
+func NewTXTErrorsInput() *TXTErrorsInput {
+	return &TXTErrorsInput{}
+}
+
+var TXTErrorsInput_StatusRegisters_DEFAULT int32
+
+func (p *TXTErrorsInput) GetStatusRegisters() int32 {
+	if !p.IsSetStatusRegisters() {
+		return TXTErrorsInput_StatusRegisters_DEFAULT
+	}
+	return *p.StatusRegisters
+}
+func (p *TXTErrorsInput) IsSetStatusRegisters() bool {
+	return p.StatusRegisters != nil
+}
+
+func (p *TXTErrorsInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *TXTErrorsInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.StatusRegisters = &v
+	}
+	return nil
+}
+
+func (p *TXTErrorsInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "TXTErrorsInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *TXTErrorsInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusRegisters() {
+		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.I32, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:StatusRegisters: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.StatusRegisters)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.StatusRegisters (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:StatusRegisters: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TXTErrorsInput) Equals(other *TXTErrorsInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.StatusRegisters != other.StatusRegisters {
+		if p.StatusRegisters == nil || other.StatusRegisters == nil {
+			return false
+		}
+		if (*p.StatusRegisters) != (*other.StatusRegisters) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *TXTErrorsInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TXTErrorsInput(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+type ImageDiffInput struct {
+	ActualFirmwareImage   int32 `thrift:"ActualFirmwareImage,1" db:"ActualFirmwareImage" json:"ActualFirmwareImage"`
+	OriginalFirmwareImage int32 `thrift:"OriginalFirmwareImage,2" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage"`
+}
+
+func NewImageDiffInput() *ImageDiffInput {
+	return &ImageDiffInput{}
+}
+
+func (p *ImageDiffInput) GetActualFirmwareImage() int32 {
+	return p.ActualFirmwareImage
+}
+
+func (p *ImageDiffInput) GetOriginalFirmwareImage() int32 {
+	return p.OriginalFirmwareImage
+}
+func (p *ImageDiffInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ImageDiffInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *ImageDiffInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmwareImage = v
+	}
+	return nil
+}
+
+func (p *ImageDiffInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ImageDiffInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ImageDiffInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ActualFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *ImageDiffInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmwareImage: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.OriginalFirmwareImage)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmwareImage: ", p), err)
+	}
+	return err
+}
+
+func (p *ImageDiffInput) Equals(other *ImageDiffInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		return false
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		return false
+	}
+	return true
+}
+
+func (p *ImageDiffInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImageDiffInput(%+v)", *p)
+}
+
+// Attributes:
+//   - AnalyzerID
+//   - ActualFirmwareImage
+//   - OriginalFirmwareImage
+//   - StatusRegisters
+//   - TPMDevice
+//   - TPMEventLog
+//   - ActualPCR0
+//   - ACPITables
+type PluginInput struct {
+	AnalyzerID            string `thrift:"AnalyzerID,1" db:"AnalyzerID" json:"AnalyzerID"`
+	ActualFirmwareImage   *int32 `thrift:"ActualFirmwareImage,2" db:"ActualFirmwareImage" json:"ActualFirmwareImage,omitempty"`
+	OriginalFirmwareImage *int32 `thrift:"OriginalFirmwareImage,3" db:"OriginalFirmwareImage" json:"OriginalFirmwareImage,omitempty"`
+	StatusRegisters       *int32 `thrift:"StatusRegisters,4" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
+	TPMDevice             *int32 `thrift:"TPMDevice,5" db:"TPMDevice" json:"TPMDevice,omitempty"`
+	TPMEventLog           *int32 `thrift:"TPMEventLog,6" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
+	ActualPCR0            *int32 `thrift:"ActualPCR0,7" db:"ActualPCR0" json:"ActualPCR0,omitempty"`
+	ACPITables            *int32 `thrift:"ACPITables,8" db:"ACPITables" json:"ACPITables,omitempty"`
+}
+
+func NewPluginInput() *PluginInput {
+	return &PluginInput{}
+}
+
+func (p *PluginInput) GetAnalyzerID() string {
+	return p.AnalyzerID
+}
+
+var PluginInput_ActualFirmwareImage_DEFAULT int32
+
+func (p *PluginInput) GetActualFirmwareImage() int32 {
+	if !p.IsSetActualFirmwareImage() {
+		return PluginInput_ActualFirmwareImage_DEFAULT
+	}
+	return *p.ActualFirmwareImage
+}
+
+var PluginInput_OriginalFirmwareImage_DEFAULT int32
+
+func (p *PluginInput) GetOriginalFirmwareImage() int32 {
+	if !p.IsSetOriginalFirmwareImage() {
+		return PluginInput_OriginalFirmwareImage_DEFAULT
+	}
+	return *p.OriginalFirmwareImage
+}
+
+var PluginInput_StatusRegisters_DEFAULT int32
+
+func (p *PluginInput) GetStatusRegisters() int32 {
+	if !p.IsSetStatusRegisters() {
+		return PluginInput_StatusRegisters_DEFAULT
+	}
+	return *p.StatusRegisters
+}
+
+var PluginInput_TPMDevice_DEFAULT int32
+
+func (p *PluginInput) GetTPMDevice() int32 {
+	if !p.IsSetTPMDevice() {
+		return PluginInput_TPMDevice_DEFAULT
+	}
+	return *p.TPMDevice
+}
+
+var PluginInput_TPMEventLog_DEFAULT int32
+
+func (p *PluginInput) GetTPMEventLog() int32 {
+	if !p.IsSetTPMEventLog() {
+		return PluginInput_TPMEventLog_DEFAULT
+	}
+	return *p.TPMEventLog
+}
+
+var PluginInput_ActualPCR0_DEFAULT int32
+
+func (p *PluginInput) GetActualPCR0() int32 {
+	if !p.IsSetActualPCR0() {
+		return PluginInput_ActualPCR0_DEFAULT
+	}
+	return *p.ActualPCR0
+}
+
+var PluginInput_ACPITables_DEFAULT int32
+
+func (p *PluginInput) GetACPITables() int32 {
+	if !p.IsSetACPITables() {
+		return PluginInput_ACPITables_DEFAULT
+	}
+	return *p.ACPITables
+}
+func (p *PluginInput) IsSetActualFirmwareImage() bool {
+	return p.ActualFirmwareImage != nil
+}
+
+func (p *PluginInput) IsSetOriginalFirmwareImage() bool {
+	return p.OriginalFirmwareImage != nil
+}
+
+func (p *PluginInput) IsSetStatusRegisters() bool {
+	return p.StatusRegisters != nil
+}
+
+func (p *PluginInput) IsSetTPMDevice() bool {
+	return p.TPMDevice != nil
+}
+
+func (p *PluginInput) IsSetTPMEventLog() bool {
+	return p.TPMEventLog != nil
+}
+
+func (p *PluginInput) IsSetActualPCR0() bool {
+	return p.ActualPCR0 != nil
+}
+
+func (p *PluginInput) IsSetACPITables() bool {
+	return p.ACPITables != nil
+}
+
+func (p *PluginInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *PluginInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.AnalyzerID = v
+	}
+	return nil
+}
+
+func (p *PluginInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.ActualFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *PluginInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.OriginalFirmwareImage = &v
+	}
+	return nil
+}
+
+func (p *PluginInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.StatusRegisters = &v
+	}
+	return nil
+}
+
+func (p *PluginInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.TPMDevice = &v
+	}
+	return nil
+}
+
+func (p *PluginInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.TPMEventLog = &v
+	}
+	return nil
+}
+
+func (p *PluginInput) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.ActualPCR0 = &v
+	}
+	return nil
+}
+
+func (p *PluginInput) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.ACPITables = &v
+	}
+	return nil
+}
+
+func (p *PluginInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PluginInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PluginInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerID", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AnalyzerID: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.AnalyzerID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AnalyzerID: ", p), err)
+	}
+	return err
+}
+
+func (p *PluginInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "ActualFirmwareImage", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ActualFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.ActualFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ActualFirmwareImage (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ActualFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PluginInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmwareImage() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmwareImage", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:OriginalFirmwareImage: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.OriginalFirmwareImage)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmwareImage (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:OriginalFirmwareImage: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PluginInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusRegisters() {
+		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:StatusRegisters: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.StatusRegisters)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.StatusRegisters (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:StatusRegisters: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PluginInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMDevice() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:TPMDevice: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:TPMDevice: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PluginInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMEventLog() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.I32, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:TPMEventLog: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMEventLog)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMEventLog (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:TPMEventLog: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PluginInput) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualPCR0() {
+		if err := oprot.WriteFieldBegin(ctx, "ActualPCR0", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:ActualPCR0: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.ActualPCR0)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ActualPCR0 (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:ActualPCR0: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PluginInput) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetACPITables() {
+		if err := oprot.WriteFieldBegin(ctx, "ACPITables", thrift.I32, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:ACPITables: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.ACPITables)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ACPITables (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:ACPITables: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PluginInput) Equals(other *PluginInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.AnalyzerID != other.AnalyzerID {
+		return false
+	}
+	if p.ActualFirmwareImage != other.ActualFirmwareImage {
+		if p.ActualFirmwareImage == nil || other.ActualFirmwareImage == nil {
+			return false
+		}
+		if (*p.ActualFirmwareImage) != (*other.ActualFirmwareImage) {
+			return false
+		}
+	}
+	if p.OriginalFirmwareImage != other.OriginalFirmwareImage {
+		if p.OriginalFirmwareImage == nil || other.OriginalFirmwareImage == nil {
+			return false
+		}
+		if (*p.OriginalFirmwareImage) != (*other.OriginalFirmwareImage) {
+			return false
+		}
+	}
+	if p.StatusRegisters != other.StatusRegisters {
+		if p.StatusRegisters == nil || other.StatusRegisters == nil {
+			return false
+		}
+		if (*p.StatusRegisters) != (*other.StatusRegisters) {
+			return false
+		}
+	}
+	if p.TPMDevice != other.TPMDevice {
+		if p.TPMDevice == nil || other.TPMDevice == nil {
+			return false
+		}
+		if (*p.TPMDevice) != (*other.TPMDevice) {
+			return false
+		}
+	}
+	if p.TPMEventLog != other.TPMEventLog {
+		if p.TPMEventLog == nil || other.TPMEventLog == nil {
+			return false
+		}
+		if (*p.TPMEventLog) != (*other.TPMEventLog) {
+			return false
+		}
+	}
+	if p.ActualPCR0 != other.ActualPCR0 {
+		if p.ActualPCR0 == nil || other.ActualPCR0 == nil {
+			return false
+		}
+		if (*p.ActualPCR0) != (*other.ActualPCR0) {
+			return false
+		}
+	}
+	if p.ACPITables != other.ACPITables {
+		if p.ACPITables == nil || other.ACPITables == nil {
+			return false
+		}
+		if (*p.ACPITables) != (*other.ACPITables) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *PluginInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PluginInput(%+v)", *p)
+}
+
+// Attributes:
+//   - DiffMeasuredBoot
+//   - IntelACM
+//   - ReproducePCR
+//   - PSPSignature
+//   - BIOSRTMVolume
+//   - APCBSecurityTokens
+//   - IntelFlashDescriptor
+//   - IntelME
+//   - PSPSecurityPatchLevel
+//   - PSBFuses
+//   - VulnerableModules
+//   - FlashDegradation
+//   - FirmwareProvenance
+//   - OptionROMs
+//   - BootChain
+//   - ACPITables
+//   - TXTErrors
+//   - ImageDiff
+//   - Plugin
+type AnalyzerInput struct {
+	DiffMeasuredBoot      *DiffMeasuredBootInput      `thrift:"DiffMeasuredBoot,1" db:"DiffMeasuredBoot" json:"DiffMeasuredBoot,omitempty"`
+	IntelACM              *IntelACMInput              `thrift:"IntelACM,2" db:"IntelACM" json:"IntelACM,omitempty"`
+	ReproducePCR          *ReproducePCRInput          `thrift:"ReproducePCR,3" db:"ReproducePCR" json:"ReproducePCR,omitempty"`
+	PSPSignature          *PSPSignatureInput          `thrift:"PSPSignature,4" db:"PSPSignature" json:"PSPSignature,omitempty"`
+	BIOSRTMVolume         *BIOSRTMVolumeInput         `thrift:"BIOSRTMVolume,5" db:"BIOSRTMVolume" json:"BIOSRTMVolume,omitempty"`
+	APCBSecurityTokens    *APCBSecurityTokensInput    `thrift:"APCBSecurityTokens,6" db:"APCBSecurityTokens" json:"APCBSecurityTokens,omitempty"`
+	IntelFlashDescriptor  *IntelFlashDescriptorInput  `thrift:"IntelFlashDescriptor,7" db:"IntelFlashDescriptor" json:"IntelFlashDescriptor,omitempty"`
+	IntelME               *IntelMEInput               `thrift:"IntelME,8" db:"IntelME" json:"IntelME,omitempty"`
+	PSPSecurityPatchLevel *PSPSecurityPatchLevelInput `thrift:"PSPSecurityPatchLevel,9" db:"PSPSecurityPatchLevel" json:"PSPSecurityPatchLevel,omitempty"`
+	PSBFuses              *PSBFusesInput              `thrift:"PSBFuses,10" db:"PSBFuses" json:"PSBFuses,omitempty"`
+	VulnerableModules     *VulnerableModulesInput     `thrift:"VulnerableModules,11" db:"VulnerableModules" json:"VulnerableModules,omitempty"`
+	FlashDegradation      *FlashDegradationInput      `thrift:"FlashDegradation,12" db:"FlashDegradation" json:"FlashDegradation,omitempty"`
+	FirmwareProvenance    *FirmwareProvenanceInput    `thrift:"FirmwareProvenance,13" db:"FirmwareProvenance" json:"FirmwareProvenance,omitempty"`
+	OptionROMs            *OptionROMsInput            `thrift:"OptionROMs,14" db:"OptionROMs" json:"OptionROMs,omitempty"`
+	BootChain             *BootChainInput             `thrift:"BootChain,15" db:"BootChain" json:"BootChain,omitempty"`
+	ACPITables            *ACPITablesInput            `thrift:"ACPITables,16" db:"ACPITables" json:"ACPITables,omitempty"`
+	TXTErrors             *TXTErrorsInput             `thrift:"TXTErrors,17" db:"TXTErrors" json:"TXTErrors,omitempty"`
+	ImageDiff             *ImageDiffInput             `thrift:"ImageDiff,18" db:"ImageDiff" json:"ImageDiff,omitempty"`
+	Plugin                *PluginInput                `thrift:"Plugin,19" db:"Plugin" json:"Plugin,omitempty"`
+}
+
+func NewAnalyzerInput() *AnalyzerInput {
+	return &AnalyzerInput{}
+}
+
+var AnalyzerInput_DiffMeasuredBoot_DEFAULT *DiffMeasuredBootInput
+
+func (p *AnalyzerInput) GetDiffMeasuredBoot() *DiffMeasuredBootInput {
+	if !p.IsSetDiffMeasuredBoot() {
+		return AnalyzerInput_DiffMeasuredBoot_DEFAULT
+	}
+	return p.DiffMeasuredBoot
+}
+
+var AnalyzerInput_IntelACM_DEFAULT *IntelACMInput
+
+func (p *AnalyzerInput) GetIntelACM() *IntelACMInput {
+	if !p.IsSetIntelACM() {
+		return AnalyzerInput_IntelACM_DEFAULT
+	}
+	return p.IntelACM
+}
+
+var AnalyzerInput_ReproducePCR_DEFAULT *ReproducePCRInput
+
+func (p *AnalyzerInput) GetReproducePCR() *ReproducePCRInput {
+	if !p.IsSetReproducePCR() {
+		return AnalyzerInput_ReproducePCR_DEFAULT
+	}
+	return p.ReproducePCR
+}
+
+var AnalyzerInput_PSPSignature_DEFAULT *PSPSignatureInput
+
+func (p *AnalyzerInput) GetPSPSignature() *PSPSignatureInput {
+	if !p.IsSetPSPSignature() {
+		return AnalyzerInput_PSPSignature_DEFAULT
+	}
+	return p.PSPSignature
+}
+
+var AnalyzerInput_BIOSRTMVolume_DEFAULT *BIOSRTMVolumeInput
+
+func (p *AnalyzerInput) GetBIOSRTMVolume() *BIOSRTMVolumeInput {
+	if !p.IsSetBIOSRTMVolume() {
+		return AnalyzerInput_BIOSRTMVolume_DEFAULT
+	}
+	return p.BIOSRTMVolume
+}
+
+var AnalyzerInput_APCBSecurityTokens_DEFAULT *APCBSecurityTokensInput
+
+func (p *AnalyzerInput) GetAPCBSecurityTokens() *APCBSecurityTokensInput {
+	if !p.IsSetAPCBSecurityTokens() {
+		return AnalyzerInput_APCBSecurityTokens_DEFAULT
+	}
+	return p.APCBSecurityTokens
+}
+
+var AnalyzerInput_IntelFlashDescriptor_DEFAULT *IntelFlashDescriptorInput
+
+func (p *AnalyzerInput) GetIntelFlashDescriptor() *IntelFlashDescriptorInput {
+	if !p.IsSetIntelFlashDescriptor() {
+		return AnalyzerInput_IntelFlashDescriptor_DEFAULT
+	}
+	return p.IntelFlashDescriptor
+}
+
+var AnalyzerInput_IntelME_DEFAULT *IntelMEInput
+
+func (p *AnalyzerInput) GetIntelME() *IntelMEInput {
+	if !p.IsSetIntelME() {
+		return AnalyzerInput_IntelME_DEFAULT
+	}
+	return p.IntelME
+}
+
+var AnalyzerInput_PSPSecurityPatchLevel_DEFAULT *PSPSecurityPatchLevelInput
+
+func (p *AnalyzerInput) GetPSPSecurityPatchLevel() *PSPSecurityPatchLevelInput {
+	if !p.IsSetPSPSecurityPatchLevel() {
+		return AnalyzerInput_PSPSecurityPatchLevel_DEFAULT
+	}
+	return p.PSPSecurityPatchLevel
+}
+
+var AnalyzerInput_PSBFuses_DEFAULT *PSBFusesInput
+
+func (p *AnalyzerInput) GetPSBFuses() *PSBFusesInput {
+	if !p.IsSetPSBFuses() {
+		return AnalyzerInput_PSBFuses_DEFAULT
+	}
+	return p.PSBFuses
+}
+
+var AnalyzerInput_VulnerableModules_DEFAULT *VulnerableModulesInput
+
+func (p *AnalyzerInput) GetVulnerableModules() *VulnerableModulesInput {
+	if !p.IsSetVulnerableModules() {
+		return AnalyzerInput_VulnerableModules_DEFAULT
+	}
+	return p.VulnerableModules
+}
+
+var AnalyzerInput_FlashDegradation_DEFAULT *FlashDegradationInput
+
+func (p *AnalyzerInput) GetFlashDegradation() *FlashDegradationInput {
+	if !p.IsSetFlashDegradation() {
+		return AnalyzerInput_FlashDegradation_DEFAULT
+	}
+	return p.FlashDegradation
+}
+
+var AnalyzerInput_FirmwareProvenance_DEFAULT *FirmwareProvenanceInput
+
+func (p *AnalyzerInput) GetFirmwareProvenance() *FirmwareProvenanceInput {
+	if !p.IsSetFirmwareProvenance() {
+		return AnalyzerInput_FirmwareProvenance_DEFAULT
+	}
+	return p.FirmwareProvenance
+}
+
+var AnalyzerInput_OptionROMs_DEFAULT *OptionROMsInput
+
+func (p *AnalyzerInput) GetOptionROMs() *OptionROMsInput {
+	if !p.IsSetOptionROMs() {
+		return AnalyzerInput_OptionROMs_DEFAULT
+	}
+	return p.OptionROMs
+}
+
+var AnalyzerInput_BootChain_DEFAULT *BootChainInput
+
+func (p *AnalyzerInput) GetBootChain() *BootChainInput {
+	if !p.IsSetBootChain() {
+		return AnalyzerInput_BootChain_DEFAULT
+	}
+	return p.BootChain
+}
+
+var AnalyzerInput_ACPITables_DEFAULT *ACPITablesInput
+
+func (p *AnalyzerInput) GetACPITables() *ACPITablesInput {
+	if !p.IsSetACPITables() {
+		return AnalyzerInput_ACPITables_DEFAULT
+	}
+	return p.ACPITables
+}
+
+var AnalyzerInput_TXTErrors_DEFAULT *TXTErrorsInput
+
+func (p *AnalyzerInput) GetTXTErrors() *TXTErrorsInput {
+	if !p.IsSetTXTErrors() {
+		return AnalyzerInput_TXTErrors_DEFAULT
+	}
+	return p.TXTErrors
+}
+
+var AnalyzerInput_ImageDiff_DEFAULT *ImageDiffInput
+
+func (p *AnalyzerInput) GetImageDiff() *ImageDiffInput {
+	if !p.IsSetImageDiff() {
+		return AnalyzerInput_ImageDiff_DEFAULT
+	}
+	return p.ImageDiff
+}
+
+var AnalyzerInput_Plugin_DEFAULT *PluginInput
+
+func (p *AnalyzerInput) GetPlugin() *PluginInput {
+	if !p.IsSetPlugin() {
+		return AnalyzerInput_Plugin_DEFAULT
+	}
+	return p.Plugin
+}
+func (p *AnalyzerInput) CountSetFieldsAnalyzerInput() int {
+	count := 0
+	if p.IsSetDiffMeasuredBoot() {
+		count++
+	}
+	if p.IsSetIntelACM() {
+		count++
+	}
+	if p.IsSetReproducePCR() {
+		count++
+	}
+	if p.IsSetPSPSignature() {
+		count++
+	}
+	if p.IsSetBIOSRTMVolume() {
+		count++
+	}
+	if p.IsSetAPCBSecurityTokens() {
+		count++
+	}
+	if p.IsSetIntelFlashDescriptor() {
+		count++
+	}
+	if p.IsSetIntelME() {
+		count++
+	}
+	if p.IsSetPSPSecurityPatchLevel() {
+		count++
+	}
+	if p.IsSetPSBFuses() {
+		count++
+	}
+	if p.IsSetVulnerableModules() {
+		count++
+	}
+	if p.IsSetFlashDegradation() {
+		count++
+	}
+	if p.IsSetFirmwareProvenance() {
+		count++
+	}
+	if p.IsSetOptionROMs() {
+		count++
+	}
+	if p.IsSetBootChain() {
+		count++
+	}
+	if p.IsSetACPITables() {
+		count++
+	}
+	if p.IsSetTXTErrors() {
+		count++
+	}
+	if p.IsSetImageDiff() {
+		count++
+	}
+	if p.IsSetPlugin() {
+		count++
+	}
+	return count
+
+}
+
+func (p *AnalyzerInput) IsSetDiffMeasuredBoot() bool {
+	return p.DiffMeasuredBoot != nil
+}
+
+func (p *AnalyzerInput) IsSetIntelACM() bool {
+	return p.IntelACM != nil
+}
+
+func (p *AnalyzerInput) IsSetReproducePCR() bool {
+	return p.ReproducePCR != nil
+}
+
+func (p *AnalyzerInput) IsSetPSPSignature() bool {
+	return p.PSPSignature != nil
+}
+
+func (p *AnalyzerInput) IsSetBIOSRTMVolume() bool {
+	return p.BIOSRTMVolume != nil
+}
+
+func (p *AnalyzerInput) IsSetAPCBSecurityTokens() bool {
+	return p.APCBSecurityTokens != nil
+}
+
+func (p *AnalyzerInput) IsSetIntelFlashDescriptor() bool {
+	return p.IntelFlashDescriptor != nil
+}
+
+func (p *AnalyzerInput) IsSetIntelME() bool {
+	return p.IntelME != nil
+}
+
+func (p *AnalyzerInput) IsSetPSPSecurityPatchLevel() bool {
+	return p.PSPSecurityPatchLevel != nil
+}
+
+func (p *AnalyzerInput) IsSetPSBFuses() bool {
+	return p.PSBFuses != nil
+}
+
+func (p *AnalyzerInput) IsSetVulnerableModules() bool {
+	return p.VulnerableModules != nil
+}
+
+func (p *AnalyzerInput) IsSetFlashDegradation() bool {
+	return p.FlashDegradation != nil
+}
+
+func (p *AnalyzerInput) IsSetFirmwareProvenance() bool {
+	return p.FirmwareProvenance != nil
+}
+
+func (p *AnalyzerInput) IsSetOptionROMs() bool {
+	return p.OptionROMs != nil
+}
+
+func (p *AnalyzerInput) IsSetBootChain() bool {
+	return p.BootChain != nil
+}
+
+func (p *AnalyzerInput) IsSetACPITables() bool {
+	return p.ACPITables != nil
+}
+
+func (p *AnalyzerInput) IsSetTXTErrors() bool {
+	return p.TXTErrors != nil
+}
+
+func (p *AnalyzerInput) IsSetImageDiff() bool {
+	return p.ImageDiff != nil
+}
+
+func (p *AnalyzerInput) IsSetPlugin() bool {
+	return p.Plugin != nil
+}
+
+func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField10(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField11(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField12(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField13(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 14:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField14(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 15:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField15(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 16:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField16(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 17:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField17(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 18:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField18(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 19:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField19(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.DiffMeasuredBoot = &DiffMeasuredBootInput{}
+	if err := p.DiffMeasuredBoot.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DiffMeasuredBoot), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.IntelACM = &IntelACMInput{}
+	if err := p.IntelACM.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IntelACM), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ReproducePCR = &ReproducePCRInput{}
+	if err := p.ReproducePCR.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ReproducePCR), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PSPSignature = &PSPSignatureInput{}
+	if err := p.PSPSignature.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PSPSignature), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	p.BIOSRTMVolume = &BIOSRTMVolumeInput{}
+	if err := p.BIOSRTMVolume.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BIOSRTMVolume), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	p.APCBSecurityTokens = &APCBSecurityTokensInput{}
+	if err := p.APCBSecurityTokens.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.APCBSecurityTokens), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	p.IntelFlashDescriptor = &IntelFlashDescriptorInput{}
+	if err := p.IntelFlashDescriptor.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IntelFlashDescriptor), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	p.IntelME = &IntelMEInput{}
+	if err := p.IntelME.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IntelME), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PSPSecurityPatchLevel = &PSPSecurityPatchLevelInput{}
+	if err := p.PSPSecurityPatchLevel.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PSPSecurityPatchLevel), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField10(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PSBFuses = &PSBFusesInput{}
+	if err := p.PSBFuses.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PSBFuses), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField11(ctx context.Context, iprot thrift.TProtocol) error {
+	p.VulnerableModules = &VulnerableModulesInput{}
+	if err := p.VulnerableModules.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.VulnerableModules), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField12(ctx context.Context, iprot thrift.TProtocol) error {
+	p.FlashDegradation = &FlashDegradationInput{}
+	if err := p.FlashDegradation.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FlashDegradation), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField13(ctx context.Context, iprot thrift.TProtocol) error {
+	p.FirmwareProvenance = &FirmwareProvenanceInput{}
+	if err := p.FirmwareProvenance.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FirmwareProvenance), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField14(ctx context.Context, iprot thrift.TProtocol) error {
+	p.OptionROMs = &OptionROMsInput{}
+	if err := p.OptionROMs.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.OptionROMs), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField15(ctx context.Context, iprot thrift.TProtocol) error {
+	p.BootChain = &BootChainInput{}
+	if err := p.BootChain.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BootChain), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField16(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ACPITables = &ACPITablesInput{}
+	if err := p.ACPITables.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ACPITables), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField17(ctx context.Context, iprot thrift.TProtocol) error {
+	p.TXTErrors = &TXTErrorsInput{}
+	if err := p.TXTErrors.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TXTErrors), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField18(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ImageDiff = &ImageDiffInput{}
+	if err := p.ImageDiff.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ImageDiff), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) ReadField19(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Plugin = &PluginInput{}
+	if err := p.Plugin.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Plugin), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsAnalyzerInput(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "AnalyzerInput"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField10(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField11(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField12(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField13(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField14(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField15(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField16(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField17(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField18(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField19(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzerInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiffMeasuredBoot() {
+		if err := oprot.WriteFieldBegin(ctx, "DiffMeasuredBoot", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:DiffMeasuredBoot: ", p), err)
+		}
+		if err := p.DiffMeasuredBoot.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DiffMeasuredBoot), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:DiffMeasuredBoot: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntelACM() {
+		if err := oprot.WriteFieldBegin(ctx, "IntelACM", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:IntelACM: ", p), err)
+		}
+		if err := p.IntelACM.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IntelACM), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:IntelACM: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetReproducePCR() {
+		if err := oprot.WriteFieldBegin(ctx, "ReproducePCR", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ReproducePCR: ", p), err)
+		}
+		if err := p.ReproducePCR.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ReproducePCR), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ReproducePCR: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPSPSignature() {
+		if err := oprot.WriteFieldBegin(ctx, "PSPSignature", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:PSPSignature: ", p), err)
+		}
+		if err := p.PSPSignature.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PSPSignature), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:PSPSignature: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBIOSRTMVolume() {
+		if err := oprot.WriteFieldBegin(ctx, "BIOSRTMVolume", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:BIOSRTMVolume: ", p), err)
+		}
+		if err := p.BIOSRTMVolume.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BIOSRTMVolume), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:BIOSRTMVolume: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAPCBSecurityTokens() {
+		if err := oprot.WriteFieldBegin(ctx, "APCBSecurityTokens", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:APCBSecurityTokens: ", p), err)
+		}
+		if err := p.APCBSecurityTokens.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.APCBSecurityTokens), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:APCBSecurityTokens: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntelFlashDescriptor() {
+		if err := oprot.WriteFieldBegin(ctx, "IntelFlashDescriptor", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:IntelFlashDescriptor: ", p), err)
+		}
+		if err := p.IntelFlashDescriptor.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IntelFlashDescriptor), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:IntelFlashDescriptor: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntelME() {
+		if err := oprot.WriteFieldBegin(ctx, "IntelME", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:IntelME: ", p), err)
+		}
+		if err := p.IntelME.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IntelME), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:IntelME: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPSPSecurityPatchLevel() {
+		if err := oprot.WriteFieldBegin(ctx, "PSPSecurityPatchLevel", thrift.STRUCT, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:PSPSecurityPatchLevel: ", p), err)
+		}
+		if err := p.PSPSecurityPatchLevel.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PSPSecurityPatchLevel), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:PSPSecurityPatchLevel: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField10(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPSBFuses() {
+		if err := oprot.WriteFieldBegin(ctx, "PSBFuses", thrift.STRUCT, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:PSBFuses: ", p), err)
+		}
+		if err := p.PSBFuses.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PSBFuses), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:PSBFuses: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField11(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVulnerableModules() {
+		if err := oprot.WriteFieldBegin(ctx, "VulnerableModules", thrift.STRUCT, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:VulnerableModules: ", p), err)
+		}
+		if err := p.VulnerableModules.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.VulnerableModules), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:VulnerableModules: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField12(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFlashDegradation() {
+		if err := oprot.WriteFieldBegin(ctx, "FlashDegradation", thrift.STRUCT, 12); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:FlashDegradation: ", p), err)
+		}
+		if err := p.FlashDegradation.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FlashDegradation), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:FlashDegradation: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField13(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFirmwareProvenance() {
+		if err := oprot.WriteFieldBegin(ctx, "FirmwareProvenance", thrift.STRUCT, 13); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:FirmwareProvenance: ", p), err)
+		}
+		if err := p.FirmwareProvenance.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FirmwareProvenance), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 13:FirmwareProvenance: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField14(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOptionROMs() {
+		if err := oprot.WriteFieldBegin(ctx, "OptionROMs", thrift.STRUCT, 14); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 14:OptionROMs: ", p), err)
+		}
+		if err := p.OptionROMs.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.OptionROMs), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 14:OptionROMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField15(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBootChain() {
+		if err := oprot.WriteFieldBegin(ctx, "BootChain", thrift.STRUCT, 15); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 15:BootChain: ", p), err)
+		}
+		if err := p.BootChain.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BootChain), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 15:BootChain: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField16(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetACPITables() {
+		if err := oprot.WriteFieldBegin(ctx, "ACPITables", thrift.STRUCT, 16); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 16:ACPITables: ", p), err)
+		}
+		if err := p.ACPITables.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ACPITables), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 16:ACPITables: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField17(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTXTErrors() {
+		if err := oprot.WriteFieldBegin(ctx, "TXTErrors", thrift.STRUCT, 17); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 17:TXTErrors: ", p), err)
+		}
+		if err := p.TXTErrors.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TXTErrors), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 17:TXTErrors: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField18(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetImageDiff() {
+		if err := oprot.WriteFieldBegin(ctx, "ImageDiff", thrift.STRUCT, 18); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 18:ImageDiff: ", p), err)
+		}
+		if err := p.ImageDiff.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ImageDiff), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 18:ImageDiff: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) writeField19(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlugin() {
+		if err := oprot.WriteFieldBegin(ctx, "Plugin", thrift.STRUCT, 19); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 19:Plugin: ", p), err)
+		}
+		if err := p.Plugin.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Plugin), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 19:Plugin: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerInput) Equals(other *AnalyzerInput) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.DiffMeasuredBoot.Equals(other.DiffMeasuredBoot) {
+		return false
+	}
+	if !p.IntelACM.Equals(other.IntelACM) {
+		return false
+	}
+	if !p.ReproducePCR.Equals(other.ReproducePCR) {
+		return false
+	}
+	if !p.PSPSignature.Equals(other.PSPSignature) {
+		return false
+	}
+	if !p.BIOSRTMVolume.Equals(other.BIOSRTMVolume) {
+		return false
+	}
+	if !p.APCBSecurityTokens.Equals(other.APCBSecurityTokens) {
+		return false
+	}
+	if !p.IntelFlashDescriptor.Equals(other.IntelFlashDescriptor) {
+		return false
+	}
+	if !p.IntelME.Equals(other.IntelME) {
+		return false
+	}
+	if !p.PSPSecurityPatchLevel.Equals(other.PSPSecurityPatchLevel) {
+		return false
+	}
+	if !p.PSBFuses.Equals(other.PSBFuses) {
+		return false
+	}
+	if !p.VulnerableModules.Equals(other.VulnerableModules) {
+		return false
+	}
+	if !p.FlashDegradation.Equals(other.FlashDegradation) {
+		return false
+	}
+	if !p.FirmwareProvenance.Equals(other.FirmwareProvenance) {
+		return false
+	}
+	if !p.OptionROMs.Equals(other.OptionROMs) {
+		return false
+	}
+	if !p.BootChain.Equals(other.BootChain) {
+		return false
+	}
+	if !p.ACPITables.Equals(other.ACPITables) {
+		return false
+	}
+	if !p.TXTErrors.Equals(other.TXTErrors) {
+		return false
+	}
+	if !p.ImageDiff.Equals(other.ImageDiff) {
+		return false
+	}
+	if !p.Plugin.Equals(other.Plugin) {
+		return false
+	}
+	return true
+}
+
+func (p *AnalyzerInput) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzerInput(%+v)", *p)
+}
+
+// Attributes:
+//   - HostInfo
+//   - Artifacts
+//   - Analyzers
+//   - Profile
+//   - DependencyGraphs
+type AnalyzeRequest struct {
+	HostInfo         *HostInfo        `thrift:"HostInfo,1" db:"HostInfo" json:"HostInfo,omitempty"`
+	Artifacts        []*Artifact      `thrift:"Artifacts,2" db:"Artifacts" json:"Artifacts"`
+	Analyzers        []*AnalyzerInput `thrift:"Analyzers,3" db:"Analyzers" json:"Analyzers"`
+	Profile          *bool            `thrift:"Profile,4" db:"Profile" json:"Profile,omitempty"`
+	DependencyGraphs *bool            `thrift:"DependencyGraphs,5" db:"DependencyGraphs" json:"DependencyGraphs,omitempty"`
+}
+
+func NewAnalyzeRequest() *AnalyzeRequest {
+	return &AnalyzeRequest{}
+}
+
+var AnalyzeRequest_HostInfo_DEFAULT *HostInfo
+
+func (p *AnalyzeRequest) GetHostInfo() *HostInfo {
+	if !p.IsSetHostInfo() {
+		return AnalyzeRequest_HostInfo_DEFAULT
+	}
+	return p.HostInfo
+}
+
+func (p *AnalyzeRequest) GetArtifacts() []*Artifact {
+	return p.Artifacts
+}
+
+func (p *AnalyzeRequest) GetAnalyzers() []*AnalyzerInput {
+	return p.Analyzers
+}
+
+var AnalyzeRequest_Profile_DEFAULT bool
+
+func (p *AnalyzeRequest) GetProfile() bool {
+	if !p.IsSetProfile() {
+		return AnalyzeRequest_Profile_DEFAULT
+	}
+	return *p.Profile
+}
+
+var AnalyzeRequest_DependencyGraphs_DEFAULT bool
+
+func (p *AnalyzeRequest) GetDependencyGraphs() bool {
+	if !p.IsSetDependencyGraphs() {
+		return AnalyzeRequest_DependencyGraphs_DEFAULT
+	}
+	return *p.DependencyGraphs
+}
+func (p *AnalyzeRequest) IsSetHostInfo() bool {
+	return p.HostInfo != nil
+}
+
+func (p *AnalyzeRequest) IsSetProfile() bool {
+	return p.Profile != nil
+}
+
+func (p *AnalyzeRequest) IsSetDependencyGraphs() bool {
+	return p.DependencyGraphs != nil
+}
+
+func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.HostInfo = &HostInfo{}
+	if err := p.HostInfo.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HostInfo), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Artifact, 0, size)
+	p.Artifacts = tSlice
+	for i := 0; i < size; i++ {
+		_elem12 := &Artifact{}
+		if err := _elem12.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem12), err)
+		}
+		p.Artifacts = append(p.Artifacts, _elem12)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*AnalyzerInput, 0, size)
+	p.Analyzers = tSlice
+	for i := 0; i < size; i++ {
+		_elem13 := &AnalyzerInput{}
+		if err := _elem13.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem13), err)
+		}
+		p.Analyzers = append(p.Analyzers, _elem13)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Profile = &v
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.DependencyGraphs = &v
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzeRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetHostInfo() {
+		if err := oprot.WriteFieldBegin(ctx, "HostInfo", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:HostInfo: ", p), err)
+		}
+		if err := p.HostInfo.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HostInfo), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:HostInfo: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Artifacts", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Artifacts: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Artifacts)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Artifacts {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Artifacts: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Analyzers", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Analyzers: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Analyzers)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Analyzers {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Analyzers: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetProfile() {
+		if err := oprot.WriteFieldBegin(ctx, "Profile", thrift.BOOL, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Profile: ", p), err)
+		}
+		if err := oprot.WriteBool(ctx, bool(*p.Profile)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Profile (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Profile: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDependencyGraphs() {
+		if err := oprot.WriteFieldBegin(ctx, "DependencyGraphs", thrift.BOOL, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:DependencyGraphs: ", p), err)
+		}
+		if err := oprot.WriteBool(ctx, bool(*p.DependencyGraphs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.DependencyGraphs (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:DependencyGraphs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) Equals(other *AnalyzeRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.HostInfo.Equals(other.HostInfo) {
+		return false
+	}
+	if len(p.Artifacts) != len(other.Artifacts) {
+		return false
+	}
+	for i, _tgt := range p.Artifacts {
+		_src14 := other.Artifacts[i]
+		if !_tgt.Equals(_src14) {
+			return false
+		}
+	}
+	if len(p.Analyzers) != len(other.Analyzers) {
+		return false
+	}
+	for i, _tgt := range p.Analyzers {
+		_src15 := other.Analyzers[i]
+		if !_tgt.Equals(_src15) {
+			return false
+		}
+	}
+	if p.Profile != other.Profile {
+		if p.Profile == nil || other.Profile == nil {
+			return false
+		}
+		if (*p.Profile) != (*other.Profile) {
+			return false
+		}
+	}
+	if p.DependencyGraphs != other.DependencyGraphs {
+		if p.DependencyGraphs == nil || other.DependencyGraphs == nil {
+			return false
+		}
+		if (*p.DependencyGraphs) != (*other.DependencyGraphs) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *AnalyzeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzeRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ErrorClass
+//   - Description
+type Error struct {
+	ErrorClass  ErrorClass `thrift:"ErrorClass,1" db:"ErrorClass" json:"ErrorClass"`
+	Description string     `thrift:"Description,2" db:"Description" json:"Description"`
+}
+
+func NewError() *Error {
+	return &Error{}
+}
+
+func (p *Error) GetErrorClass() ErrorClass {
+	return p.ErrorClass
+}
+
+func (p *Error) GetDescription() string {
+	return p.Description
+}
+func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Error) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := ErrorClass(v)
+		p.ErrorClass = temp
+	}
+	return nil
+}
+
+func (p *Error) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *Error) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Error"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Error) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ErrorClass", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ErrorClass: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ErrorClass)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ErrorClass (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ErrorClass: ", p), err)
+	}
+	return err
+}
+
+func (p *Error) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Description: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Description (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Description: ", p), err)
+	}
+	return err
+}
+
+func (p *Error) Equals(other *Error) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ErrorClass != other.ErrorClass {
+		return false
+	}
+	if p.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+func (p *Error) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Error(%+v)", *p)
+}
+
+// Attributes:
+//   - AnalyzerName
+//   - AnalyzerOutcome
+//   - ProcessedInputJSON
+//   - AnalyzerVersion
+//   - DependencyGraphJSON
+type AnalyzerResult_ struct {
+	AnalyzerName        string           `thrift:"AnalyzerName,1" db:"AnalyzerName" json:"AnalyzerName"`
+	AnalyzerOutcome     *AnalyzerOutcome `thrift:"AnalyzerOutcome,2" db:"AnalyzerOutcome" json:"AnalyzerOutcome"`
+	ProcessedInputJSON  *string          `thrift:"ProcessedInputJSON,3" db:"ProcessedInputJSON" json:"ProcessedInputJSON,omitempty"`
+	AnalyzerVersion     *string          `thrift:"AnalyzerVersion,4" db:"AnalyzerVersion" json:"AnalyzerVersion,omitempty"`
+	DependencyGraphJSON *string          `thrift:"DependencyGraphJSON,5" db:"DependencyGraphJSON" json:"DependencyGraphJSON,omitempty"`
+}
+
+func NewAnalyzerResult_() *AnalyzerResult_ {
+	return &AnalyzerResult_{}
+}
+
+func (p *AnalyzerResult_) GetAnalyzerName() string {
+	return p.AnalyzerName
+}
+
+var AnalyzerResult__AnalyzerOutcome_DEFAULT *AnalyzerOutcome
+
+func (p *AnalyzerResult_) GetAnalyzerOutcome() *AnalyzerOutcome {
+	if !p.IsSetAnalyzerOutcome() {
+		return AnalyzerResult__AnalyzerOutcome_DEFAULT
+	}
+	return p.AnalyzerOutcome
+}
+
+var AnalyzerResult__ProcessedInputJSON_DEFAULT string
+
+func (p *AnalyzerResult_) GetProcessedInputJSON() string {
+	if !p.IsSetProcessedInputJSON() {
+		return AnalyzerResult__ProcessedInputJSON_DEFAULT
+	}
+	return *p.ProcessedInputJSON
+}
+
+var AnalyzerResult__AnalyzerVersion_DEFAULT string
+
+func (p *AnalyzerResult_) GetAnalyzerVersion() string {
+	if !p.IsSetAnalyzerVersion() {
+		return AnalyzerResult__AnalyzerVersion_DEFAULT
+	}
+	return *p.AnalyzerVersion
+}
+
+var AnalyzerResult__DependencyGraphJSON_DEFAULT string
+
+func (p *AnalyzerResult_) GetDependencyGraphJSON() string {
+	if !p.IsSetDependencyGraphJSON() {
+		return AnalyzerResult__DependencyGraphJSON_DEFAULT
+	}
+	return *p.DependencyGraphJSON
+}
+func (p *AnalyzerResult_) IsSetAnalyzerOutcome() bool {
+	return p.AnalyzerOutcome != nil
+}
+
+func (p *AnalyzerResult_) IsSetProcessedInputJSON() bool {
+	return p.ProcessedInputJSON != nil
+}
+
+func (p *AnalyzerResult_) IsSetAnalyzerVersion() bool {
+	return p.AnalyzerVersion != nil
+}
+
+func (p *AnalyzerResult_) IsSetDependencyGraphJSON() bool {
+	return p.DependencyGraphJSON != nil
+}
+
+func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.AnalyzerName = v
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.AnalyzerOutcome = &AnalyzerOutcome{}
+	if err := p.AnalyzerOutcome.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AnalyzerOutcome), err)
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.ProcessedInputJSON = &v
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.AnalyzerVersion = &v
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.DependencyGraphJSON = &v
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzerResult"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzerResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AnalyzerName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.AnalyzerName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AnalyzerName: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerOutcome", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AnalyzerOutcome: ", p), err)
+	}
+	if err := p.AnalyzerOutcome.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AnalyzerOutcome), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AnalyzerOutcome: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetProcessedInputJSON() {
+		if err := oprot.WriteFieldBegin(ctx, "ProcessedInputJSON", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ProcessedInputJSON: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.ProcessedInputJSON)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ProcessedInputJSON (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ProcessedInputJSON: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnalyzerVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "AnalyzerVersion", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:AnalyzerVersion: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.AnalyzerVersion)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.AnalyzerVersion (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:AnalyzerVersion: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDependencyGraphJSON() {
+		if err := oprot.WriteFieldBegin(ctx, "DependencyGraphJSON", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:DependencyGraphJSON: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.DependencyGraphJSON)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.DependencyGraphJSON (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:DependencyGraphJSON: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzerResult_) Equals(other *AnalyzerResult_) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.AnalyzerName != other.AnalyzerName {
+		return false
+	}
+	if !p.AnalyzerOutcome.Equals(other.AnalyzerOutcome) {
+		return false
+	}
+	if p.ProcessedInputJSON != other.ProcessedInputJSON {
+		if p.ProcessedInputJSON == nil || other.ProcessedInputJSON == nil {
+			return false
+		}
+		if (*p.ProcessedInputJSON) != (*other.ProcessedInputJSON) {
+			return false
+		}
+	}
+	if p.AnalyzerVersion != other.AnalyzerVersion {
+		if p.AnalyzerVersion == nil || other.AnalyzerVersion == nil {
+			return false
+		}
+		if (*p.AnalyzerVersion) != (*other.AnalyzerVersion) {
+			return false
+		}
+	}
+	if p.DependencyGraphJSON != other.DependencyGraphJSON {
+		if p.DependencyGraphJSON == nil || other.DependencyGraphJSON == nil {
+			return false
+		}
+		if (*p.DependencyGraphJSON) != (*other.DependencyGraphJSON) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *AnalyzerResult_) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzerResult_(%+v)", *p)
+}
+
+// Attributes:
+//   - Report
+//   - Err
+type AnalyzerOutcome struct {
+	Report *analyzerreport.AnalyzerReport `thrift:"Report,1" db:"Report" json:"Report,omitempty"`
+	Err    *Error                         `thrift:"Err,2" db:"Err" json:"Err,omitempty"`
+}
+
+func NewAnalyzerOutcome() *AnalyzerOutcome {
+	return &AnalyzerOutcome{}
+}
+
+var AnalyzerOutcome_Report_DEFAULT *analyzerreport.AnalyzerReport
+
+func (p *AnalyzerOutcome) GetReport() *analyzerreport.AnalyzerReport {
+	if !p.IsSetReport() {
+		return AnalyzerOutcome_Report_DEFAULT
+	}
+	return p.Report
+}
+
+var AnalyzerOutcome_Err_DEFAULT *Error
+
+func (p *AnalyzerOutcome) GetErr() *Error {
+	if !p.IsSetErr() {
+		return AnalyzerOutcome_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *AnalyzerOutcome) CountSetFieldsAnalyzerOutcome() int {
+	count := 0
+	if p.IsSetReport() {
 		count++
 	}
-	if p.IsSetAPCBSecurityTokens() {
+	if p.IsSetErr() {
 		count++
 	}
 	return count
 
 }
 
-func (p *AnalyzerInput) IsSetDiffMeasuredBoot() bool {
-	return p.DiffMeasuredBoot != nil
-}
-
-func (p *AnalyzerInput) IsSetIntelACM() bool {
-	return p.IntelACM != nil
-}
-
-func (p *AnalyzerInput) IsSetReproducePCR() bool {
-	return p.ReproducePCR != nil
-}
-
-func (p *AnalyzerInput) IsSetPSPSignature() bool {
-	return p.PSPSignature != nil
-}
-
-func (p *AnalyzerInput) IsSetBIOSRTMVolume() bool {
-	return p.BIOSRTMVolume != nil
+func (p *AnalyzerOutcome) IsSetReport() bool {
+	return p.Report != nil
 }
 
-func (p *AnalyzerInput) IsSetAPCBSecurityTokens() bool {
-	return p.APCBSecurityTokens != nil
+func (p *AnalyzerOutcome) IsSetErr() bool {
+	return p.Err != nil
 }
 
-func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -6756,46 +11866,6 @@ func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 					return err
 				}
 			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField3(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 4:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField4(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 5:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField5(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
-		case 6:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField6(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -6811,59 +11881,27 @@ func (p *AnalyzerInput) Read(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AnalyzerInput) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.DiffMeasuredBoot = &DiffMeasuredBootInput{}
-	if err := p.DiffMeasuredBoot.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DiffMeasuredBoot), err)
-	}
-	return nil
-}
-
-func (p *AnalyzerInput) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	p.IntelACM = &IntelACMInput{}
-	if err := p.IntelACM.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IntelACM), err)
-	}
-	return nil
-}
-
-func (p *AnalyzerInput) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	p.ReproducePCR = &ReproducePCRInput{}
-	if err := p.ReproducePCR.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ReproducePCR), err)
-	}
-	return nil
-}
-
-func (p *AnalyzerInput) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-	p.PSPSignature = &PSPSignatureInput{}
-	if err := p.PSPSignature.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PSPSignature), err)
-	}
-	return nil
-}
-
-func (p *AnalyzerInput) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
-	p.BIOSRTMVolume = &BIOSRTMVolumeInput{}
-	if err := p.BIOSRTMVolume.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BIOSRTMVolume), err)
+func (p *AnalyzerOutcome) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Report = &analyzerreport.AnalyzerReport{}
+	if err := p.Report.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Report), err)
 	}
 	return nil
 }
 
-func (p *AnalyzerInput) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
-	p.APCBSecurityTokens = &APCBSecurityTokensInput{}
-	if err := p.APCBSecurityTokens.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.APCBSecurityTokens), err)
+func (p *AnalyzerOutcome) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Err = &Error{}
+	if err := p.Err.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
 	}
 	return nil
 }
 
-func (p *AnalyzerInput) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if c := p.CountSetFieldsAnalyzerInput(); c != 1 {
+func (p *AnalyzerOutcome) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsAnalyzerOutcome(); c != 1 {
 		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
 	}
-	if err := oprot.WriteStructBegin(ctx, "AnalyzerInput"); err != nil {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzerOutcome"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -6873,18 +11911,6 @@ func (p *AnalyzerInput) Write(ctx context.Context, oprot thrift.TProtocol) error
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField3(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField4(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField5(ctx, oprot); err != nil {
-			return err
-		}
-		if err := p.writeField6(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -6895,165 +11921,126 @@ func (p *AnalyzerInput) Write(ctx context.Context, oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AnalyzerInput) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetDiffMeasuredBoot() {
-		if err := oprot.WriteFieldBegin(ctx, "DiffMeasuredBoot", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:DiffMeasuredBoot: ", p), err)
-		}
-		if err := p.DiffMeasuredBoot.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DiffMeasuredBoot), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:DiffMeasuredBoot: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *AnalyzerInput) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetIntelACM() {
-		if err := oprot.WriteFieldBegin(ctx, "IntelACM", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:IntelACM: ", p), err)
-		}
-		if err := p.IntelACM.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IntelACM), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:IntelACM: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *AnalyzerInput) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetReproducePCR() {
-		if err := oprot.WriteFieldBegin(ctx, "ReproducePCR", thrift.STRUCT, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ReproducePCR: ", p), err)
-		}
-		if err := p.ReproducePCR.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ReproducePCR), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ReproducePCR: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *AnalyzerInput) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetPSPSignature() {
-		if err := oprot.WriteFieldBegin(ctx, "PSPSignature", thrift.STRUCT, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:PSPSignature: ", p), err)
-		}
-		if err := p.PSPSignature.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PSPSignature), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:PSPSignature: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *AnalyzerInput) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetBIOSRTMVolume() {
-		if err := oprot.WriteFieldBegin(ctx, "BIOSRTMVolume", thrift.STRUCT, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:BIOSRTMVolume: ", p), err)
+func (p *AnalyzerOutcome) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetReport() {
+		if err := oprot.WriteFieldBegin(ctx, "Report", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Report: ", p), err)
 		}
-		if err := p.BIOSRTMVolume.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BIOSRTMVolume), err)
+		if err := p.Report.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Report), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:BIOSRTMVolume: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Report: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *AnalyzerInput) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetAPCBSecurityTokens() {
-		if err := oprot.WriteFieldBegin(ctx, "APCBSecurityTokens", thrift.STRUCT, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:APCBSecurityTokens: ", p), err)
+func (p *AnalyzerOutcome) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin(ctx, "Err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Err: ", p), err)
 		}
-		if err := p.APCBSecurityTokens.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.APCBSecurityTokens), err)
+		if err := p.Err.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:APCBSecurityTokens: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Err: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *AnalyzerInput) Equals(other *AnalyzerInput) bool {
+func (p *AnalyzerOutcome) Equals(other *AnalyzerOutcome) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.DiffMeasuredBoot.Equals(other.DiffMeasuredBoot) {
-		return false
-	}
-	if !p.IntelACM.Equals(other.IntelACM) {
-		return false
-	}
-	if !p.ReproducePCR.Equals(other.ReproducePCR) {
-		return false
-	}
-	if !p.PSPSignature.Equals(other.PSPSignature) {
-		return false
-	}
-	if !p.BIOSRTMVolume.Equals(other.BIOSRTMVolume) {
+	if !p.Report.Equals(other.Report) {
 		return false
 	}
-	if !p.APCBSecurityTokens.Equals(other.APCBSecurityTokens) {
+	if !p.Err.Equals(other.Err) {
 		return false
 	}
 	return true
 }
 
-func (p *AnalyzerInput) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("AnalyzerInput(%+v)", *p)
+func (p *AnalyzerOutcome) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzerOutcome(%+v)", *p)
+}
+
+// Attributes:
+//   - AnalyzerName
+//   - IssueCode
+//   - Severity
+//   - Description
+//   - Rule
+//   - VerdictClass
+//   - Confidence
+type HostVerdictEvidence struct {
+	AnalyzerName string                  `thrift:"AnalyzerName,1" db:"AnalyzerName" json:"AnalyzerName"`
+	IssueCode    *string                 `thrift:"IssueCode,2" db:"IssueCode" json:"IssueCode,omitempty"`
+	Severity     analyzerreport.Severity `thrift:"Severity,3" db:"Severity" json:"Severity"`
+	Description  *string                 `thrift:"Description,4" db:"Description" json:"Description,omitempty"`
+	Rule         string                  `thrift:"Rule,5" db:"Rule" json:"Rule"`
+	VerdictClass HostVerdictClass        `thrift:"VerdictClass,6" db:"VerdictClass" json:"VerdictClass"`
+	Confidence   float64                 `thrift:"Confidence,7" db:"Confidence" json:"Confidence"`
+}
+
+func NewHostVerdictEvidence() *HostVerdictEvidence {
+	return &HostVerdictEvidence{}
 }
 
-// Attributes:
-//   - HostInfo
-//   - Artifacts
-//   - Analyzers
-type AnalyzeRequest struct {
-	HostInfo  *HostInfo        `thrift:"HostInfo,1" db:"HostInfo" json:"HostInfo,omitempty"`
-	Artifacts []*Artifact      `thrift:"Artifacts,2" db:"Artifacts" json:"Artifacts"`
-	Analyzers []*AnalyzerInput `thrift:"Analyzers,3" db:"Analyzers" json:"Analyzers"`
+func (p *HostVerdictEvidence) GetAnalyzerName() string {
+	return p.AnalyzerName
 }
 
-func NewAnalyzeRequest() *AnalyzeRequest {
-	return &AnalyzeRequest{}
+var HostVerdictEvidence_IssueCode_DEFAULT string
+
+func (p *HostVerdictEvidence) GetIssueCode() string {
+	if !p.IsSetIssueCode() {
+		return HostVerdictEvidence_IssueCode_DEFAULT
+	}
+	return *p.IssueCode
 }
 
-var AnalyzeRequest_HostInfo_DEFAULT *HostInfo
+func (p *HostVerdictEvidence) GetSeverity() analyzerreport.Severity {
+	return p.Severity
+}
 
-func (p *AnalyzeRequest) GetHostInfo() *HostInfo {
-	if !p.IsSetHostInfo() {
-		return AnalyzeRequest_HostInfo_DEFAULT
+var HostVerdictEvidence_Description_DEFAULT string
+
+func (p *HostVerdictEvidence) GetDescription() string {
+	if !p.IsSetDescription() {
+		return HostVerdictEvidence_Description_DEFAULT
 	}
-	return p.HostInfo
+	return *p.Description
 }
 
-func (p *AnalyzeRequest) GetArtifacts() []*Artifact {
-	return p.Artifacts
+func (p *HostVerdictEvidence) GetRule() string {
+	return p.Rule
 }
 
-func (p *AnalyzeRequest) GetAnalyzers() []*AnalyzerInput {
-	return p.Analyzers
+func (p *HostVerdictEvidence) GetVerdictClass() HostVerdictClass {
+	return p.VerdictClass
 }
-func (p *AnalyzeRequest) IsSetHostInfo() bool {
-	return p.HostInfo != nil
+
+func (p *HostVerdictEvidence) GetConfidence() float64 {
+	return p.Confidence
+}
+func (p *HostVerdictEvidence) IsSetIssueCode() bool {
+	return p.IssueCode != nil
 }
 
-func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *HostVerdictEvidence) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *HostVerdictEvidence) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7068,7 +12055,7 @@ func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -7078,7 +12065,7 @@ func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -7088,7 +12075,7 @@ func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
@@ -7097,6 +12084,46 @@ func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error
 					return err
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.DOUBLE {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -7112,56 +12139,73 @@ func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AnalyzeRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.HostInfo = &HostInfo{}
-	if err := p.HostInfo.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HostInfo), err)
+func (p *HostVerdictEvidence) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.AnalyzerName = v
 	}
 	return nil
 }
 
-func (p *AnalyzeRequest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *HostVerdictEvidence) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.IssueCode = &v
 	}
-	tSlice := make([]*Artifact, 0, size)
-	p.Artifacts = tSlice
-	for i := 0; i < size; i++ {
-		_elem10 := &Artifact{}
-		if err := _elem10.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
-		}
-		p.Artifacts = append(p.Artifacts, _elem10)
+	return nil
+}
+
+func (p *HostVerdictEvidence) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := analyzerreport.Severity(v)
+		p.Severity = temp
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	return nil
+}
+
+func (p *HostVerdictEvidence) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Description = &v
 	}
 	return nil
 }
 
-func (p *AnalyzeRequest) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *HostVerdictEvidence) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Rule = v
 	}
-	tSlice := make([]*AnalyzerInput, 0, size)
-	p.Analyzers = tSlice
-	for i := 0; i < size; i++ {
-		_elem11 := &AnalyzerInput{}
-		if err := _elem11.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem11), err)
-		}
-		p.Analyzers = append(p.Analyzers, _elem11)
+	return nil
+}
+
+func (p *HostVerdictEvidence) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		temp := HostVerdictClass(v)
+		p.VerdictClass = temp
 	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	return nil
+}
+
+func (p *HostVerdictEvidence) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.Confidence = v
 	}
 	return nil
 }
 
-func (p *AnalyzeRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "AnalyzeRequest"); err != nil {
+func (p *HostVerdictEvidence) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "HostVerdictEvidence"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7174,6 +12218,18 @@ func (p *AnalyzeRequest) Write(ctx context.Context, oprot thrift.TProtocol) erro
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7184,120 +12240,174 @@ func (p *AnalyzeRequest) Write(ctx context.Context, oprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AnalyzeRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetHostInfo() {
-		if err := oprot.WriteFieldBegin(ctx, "HostInfo", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:HostInfo: ", p), err)
+func (p *HostVerdictEvidence) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerName", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AnalyzerName: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.AnalyzerName)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerName (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AnalyzerName: ", p), err)
+	}
+	return err
+}
+
+func (p *HostVerdictEvidence) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIssueCode() {
+		if err := oprot.WriteFieldBegin(ctx, "IssueCode", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:IssueCode: ", p), err)
 		}
-		if err := p.HostInfo.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HostInfo), err)
+		if err := oprot.WriteString(ctx, string(*p.IssueCode)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.IssueCode (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:HostInfo: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:IssueCode: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *AnalyzeRequest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Artifacts", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Artifacts: ", p), err)
+func (p *HostVerdictEvidence) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Severity", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Severity: ", p), err)
 	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Artifacts)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+	if err := oprot.WriteI32(ctx, int32(p.Severity)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Severity (3) field write error: ", p), err)
 	}
-	for _, v := range p.Artifacts {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Severity: ", p), err)
+	}
+	return err
+}
+
+func (p *HostVerdictEvidence) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Description: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Description)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Description (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Description: ", p), err)
 		}
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	return err
+}
+
+func (p *HostVerdictEvidence) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Rule", thrift.STRING, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Rule: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Rule)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Rule (5) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Artifacts: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Rule: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzeRequest) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Analyzers", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Analyzers: ", p), err)
+func (p *HostVerdictEvidence) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "VerdictClass", thrift.I32, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:VerdictClass: ", p), err)
 	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Analyzers)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+	if err := oprot.WriteI32(ctx, int32(p.VerdictClass)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.VerdictClass (6) field write error: ", p), err)
 	}
-	for _, v := range p.Analyzers {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:VerdictClass: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	return err
+}
+
+func (p *HostVerdictEvidence) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Confidence", thrift.DOUBLE, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:Confidence: ", p), err)
+	}
+	if err := oprot.WriteDouble(ctx, float64(p.Confidence)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Confidence (7) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Analyzers: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:Confidence: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzeRequest) Equals(other *AnalyzeRequest) bool {
+func (p *HostVerdictEvidence) Equals(other *HostVerdictEvidence) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.HostInfo.Equals(other.HostInfo) {
-		return false
-	}
-	if len(p.Artifacts) != len(other.Artifacts) {
+	if p.AnalyzerName != other.AnalyzerName {
 		return false
 	}
-	for i, _tgt := range p.Artifacts {
-		_src12 := other.Artifacts[i]
-		if !_tgt.Equals(_src12) {
+	if p.IssueCode != other.IssueCode {
+		if p.IssueCode == nil || other.IssueCode == nil {
+			return false
+		}
+		if (*p.IssueCode) != (*other.IssueCode) {
 			return false
 		}
 	}
-	if len(p.Analyzers) != len(other.Analyzers) {
+	if p.Severity != other.Severity {
 		return false
 	}
-	for i, _tgt := range p.Analyzers {
-		_src13 := other.Analyzers[i]
-		if !_tgt.Equals(_src13) {
+	if p.Description != other.Description {
+		if p.Description == nil || other.Description == nil {
+			return false
+		}
+		if (*p.Description) != (*other.Description) {
 			return false
 		}
 	}
+	if p.Rule != other.Rule {
+		return false
+	}
+	if p.VerdictClass != other.VerdictClass {
+		return false
+	}
+	if p.Confidence != other.Confidence {
+		return false
+	}
 	return true
 }
 
-func (p *AnalyzeRequest) String() string {
+func (p *HostVerdictEvidence) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnalyzeRequest(%+v)", *p)
+	return fmt.Sprintf("HostVerdictEvidence(%+v)", *p)
 }
 
 // Attributes:
-//   - ErrorClass
-//   - Description
-type Error struct {
-	ErrorClass  ErrorClass `thrift:"ErrorClass,1" db:"ErrorClass" json:"ErrorClass"`
-	Description string     `thrift:"Description,2" db:"Description" json:"Description"`
+//   - VerdictClass
+//   - Confidence
+//   - Evidence
+type HostVerdict struct {
+	VerdictClass HostVerdictClass       `thrift:"VerdictClass,1" db:"VerdictClass" json:"VerdictClass"`
+	Confidence   float64                `thrift:"Confidence,2" db:"Confidence" json:"Confidence"`
+	Evidence     []*HostVerdictEvidence `thrift:"Evidence,3" db:"Evidence" json:"Evidence"`
 }
 
-func NewError() *Error {
-	return &Error{}
+func NewHostVerdict() *HostVerdict {
+	return &HostVerdict{}
 }
 
-func (p *Error) GetErrorClass() ErrorClass {
-	return p.ErrorClass
+func (p *HostVerdict) GetVerdictClass() HostVerdictClass {
+	return p.VerdictClass
 }
 
-func (p *Error) GetDescription() string {
-	return p.Description
+func (p *HostVerdict) GetConfidence() float64 {
+	return p.Confidence
 }
-func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *HostVerdict) GetEvidence() []*HostVerdictEvidence {
+	return p.Evidence
+}
+func (p *HostVerdict) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7322,7 +12432,7 @@ func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.DOUBLE {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -7331,6 +12441,16 @@ func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -7346,27 +12466,47 @@ func (p *Error) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Error) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *HostVerdict) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		temp := ErrorClass(v)
-		p.ErrorClass = temp
+		temp := HostVerdictClass(v)
+		p.VerdictClass = temp
 	}
 	return nil
 }
 
-func (p *Error) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
+func (p *HostVerdict) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(ctx); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.Description = v
+		p.Confidence = v
 	}
 	return nil
 }
 
-func (p *Error) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "Error"); err != nil {
+func (p *HostVerdict) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*HostVerdictEvidence, 0, size)
+	p.Evidence = tSlice
+	for i := 0; i < size; i++ {
+		_elem16 := &HostVerdictEvidence{}
+		if err := _elem16.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem16), err)
+		}
+		p.Evidence = append(p.Evidence, _elem16)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *HostVerdict) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "HostVerdict"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7376,6 +12516,9 @@ func (p *Error) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7386,98 +12529,167 @@ func (p *Error) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *Error) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ErrorClass", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ErrorClass: ", p), err)
+func (p *HostVerdict) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "VerdictClass", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:VerdictClass: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.ErrorClass)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ErrorClass (1) field write error: ", p), err)
+	if err := oprot.WriteI32(ctx, int32(p.VerdictClass)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.VerdictClass (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ErrorClass: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:VerdictClass: ", p), err)
 	}
 	return err
 }
 
-func (p *Error) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Description: ", p), err)
+func (p *HostVerdict) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Confidence", thrift.DOUBLE, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Confidence: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Description (2) field write error: ", p), err)
+	if err := oprot.WriteDouble(ctx, float64(p.Confidence)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Confidence (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Description: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Confidence: ", p), err)
 	}
 	return err
 }
 
-func (p *Error) Equals(other *Error) bool {
+func (p *HostVerdict) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Evidence", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Evidence: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Evidence)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Evidence {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Evidence: ", p), err)
+	}
+	return err
+}
+
+func (p *HostVerdict) Equals(other *HostVerdict) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.ErrorClass != other.ErrorClass {
+	if p.VerdictClass != other.VerdictClass {
 		return false
 	}
-	if p.Description != other.Description {
+	if p.Confidence != other.Confidence {
 		return false
 	}
+	if len(p.Evidence) != len(other.Evidence) {
+		return false
+	}
+	for i, _tgt := range p.Evidence {
+		_src17 := other.Evidence[i]
+		if !_tgt.Equals(_src17) {
+			return false
+		}
+	}
 	return true
 }
 
-func (p *Error) String() string {
+func (p *HostVerdict) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Error(%+v)", *p)
+	return fmt.Sprintf("HostVerdict(%+v)", *p)
 }
 
 // Attributes:
-//   - AnalyzerName
-//   - AnalyzerOutcome
-//   - ProcessedInputJSON
-type AnalyzerResult_ struct {
-	AnalyzerName       string           `thrift:"AnalyzerName,1" db:"AnalyzerName" json:"AnalyzerName"`
-	AnalyzerOutcome    *AnalyzerOutcome `thrift:"AnalyzerOutcome,2" db:"AnalyzerOutcome" json:"AnalyzerOutcome"`
-	ProcessedInputJSON *string          `thrift:"ProcessedInputJSON,3" db:"ProcessedInputJSON" json:"ProcessedInputJSON,omitempty"`
+//   - Kind
+//   - Name
+//   - ParentIndex
+//   - StartNanoseconds
+//   - DurationNanoseconds
+//   - Cache
+//   - AllocatedBytes
+//   - Error
+type ProfileEntry struct {
+	Kind                ProfileEntryKind     `thrift:"Kind,1" db:"Kind" json:"Kind"`
+	Name                string               `thrift:"Name,2" db:"Name" json:"Name"`
+	ParentIndex         *int32               `thrift:"ParentIndex,3" db:"ParentIndex" json:"ParentIndex,omitempty"`
+	StartNanoseconds    int64                `thrift:"StartNanoseconds,4" db:"StartNanoseconds" json:"StartNanoseconds"`
+	DurationNanoseconds int64                `thrift:"DurationNanoseconds,5" db:"DurationNanoseconds" json:"DurationNanoseconds"`
+	Cache               *ProfileCacheResult_ `thrift:"Cache,6" db:"Cache" json:"Cache,omitempty"`
+	AllocatedBytes      int64                `thrift:"AllocatedBytes,7" db:"AllocatedBytes" json:"AllocatedBytes"`
+	Error               *string              `thrift:"Error,8" db:"Error" json:"Error,omitempty"`
 }
 
-func NewAnalyzerResult_() *AnalyzerResult_ {
-	return &AnalyzerResult_{}
+func NewProfileEntry() *ProfileEntry {
+	return &ProfileEntry{}
 }
 
-func (p *AnalyzerResult_) GetAnalyzerName() string {
-	return p.AnalyzerName
+func (p *ProfileEntry) GetKind() ProfileEntryKind {
+	return p.Kind
 }
 
-var AnalyzerResult__AnalyzerOutcome_DEFAULT *AnalyzerOutcome
+func (p *ProfileEntry) GetName() string {
+	return p.Name
+}
 
-func (p *AnalyzerResult_) GetAnalyzerOutcome() *AnalyzerOutcome {
-	if !p.IsSetAnalyzerOutcome() {
-		return AnalyzerResult__AnalyzerOutcome_DEFAULT
+var ProfileEntry_ParentIndex_DEFAULT int32
+
+func (p *ProfileEntry) GetParentIndex() int32 {
+	if !p.IsSetParentIndex() {
+		return ProfileEntry_ParentIndex_DEFAULT
 	}
-	return p.AnalyzerOutcome
+	return *p.ParentIndex
 }
 
-var AnalyzerResult__ProcessedInputJSON_DEFAULT string
+func (p *ProfileEntry) GetStartNanoseconds() int64 {
+	return p.StartNanoseconds
+}
 
-func (p *AnalyzerResult_) GetProcessedInputJSON() string {
-	if !p.IsSetProcessedInputJSON() {
-		return AnalyzerResult__ProcessedInputJSON_DEFAULT
+func (p *ProfileEntry) GetDurationNanoseconds() int64 {
+	return p.DurationNanoseconds
+}
+
+var ProfileEntry_Cache_DEFAULT ProfileCacheResult_
+
+func (p *ProfileEntry) GetCache() ProfileCacheResult_ {
+	if !p.IsSetCache() {
+		return ProfileEntry_Cache_DEFAULT
 	}
-	return *p.ProcessedInputJSON
+	return *p.Cache
 }
-func (p *AnalyzerResult_) IsSetAnalyzerOutcome() bool {
-	return p.AnalyzerOutcome != nil
+
+func (p *ProfileEntry) GetAllocatedBytes() int64 {
+	return p.AllocatedBytes
 }
 
-func (p *AnalyzerResult_) IsSetProcessedInputJSON() bool {
-	return p.ProcessedInputJSON != nil
+var ProfileEntry_Error_DEFAULT string
+
+func (p *ProfileEntry) GetError() string {
+	if !p.IsSetError() {
+		return ProfileEntry_Error_DEFAULT
+	}
+	return *p.Error
+}
+func (p *ProfileEntry) IsSetParentIndex() bool {
+	return p.ParentIndex != nil
 }
 
-func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *ProfileEntry) IsSetCache() bool {
+	return p.Cache != nil
+}
+
+func (p *ProfileEntry) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *ProfileEntry) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7492,7 +12704,7 @@ func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) erro
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -7502,7 +12714,7 @@ func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) erro
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -7512,7 +12724,7 @@ func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) erro
 				}
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField3(ctx, iprot); err != nil {
 					return err
 				}
@@ -7521,6 +12733,56 @@ func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) erro
 					return err
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -7536,34 +12798,82 @@ func (p *AnalyzerResult_) Read(ctx context.Context, iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AnalyzerResult_) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
+func (p *ProfileEntry) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.AnalyzerName = v
+		temp := ProfileEntryKind(v)
+		p.Kind = temp
 	}
 	return nil
 }
 
-func (p *AnalyzerResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	p.AnalyzerOutcome = &AnalyzerOutcome{}
-	if err := p.AnalyzerOutcome.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AnalyzerOutcome), err)
+func (p *ProfileEntry) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Name = v
 	}
 	return nil
 }
 
-func (p *AnalyzerResult_) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(ctx); err != nil {
+func (p *ProfileEntry) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.ProcessedInputJSON = &v
+		p.ParentIndex = &v
 	}
 	return nil
 }
 
-func (p *AnalyzerResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "AnalyzerResult"); err != nil {
+func (p *ProfileEntry) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.StartNanoseconds = v
+	}
+	return nil
+}
+
+func (p *ProfileEntry) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.DurationNanoseconds = v
+	}
+	return nil
+}
+
+func (p *ProfileEntry) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		temp := ProfileCacheResult_(v)
+		p.Cache = &temp
+	}
+	return nil
+}
+
+func (p *ProfileEntry) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.AllocatedBytes = v
+	}
+	return nil
+}
+
+func (p *ProfileEntry) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.Error = &v
+	}
+	return nil
+}
+
+func (p *ProfileEntry) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ProfileEntry"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -7576,137 +12886,210 @@ func (p *AnalyzerResult_) Write(ctx context.Context, oprot thrift.TProtocol) err
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ProfileEntry) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Kind", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Kind: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Kind)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Kind (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Kind: ", p), err)
+	}
+	return err
+}
+
+func (p *ProfileEntry) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Name", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Name (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Name: ", p), err)
+	}
+	return err
+}
+
+func (p *ProfileEntry) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetParentIndex() {
+		if err := oprot.WriteFieldBegin(ctx, "ParentIndex", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ParentIndex: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.ParentIndex)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ParentIndex (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ParentIndex: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ProfileEntry) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "StartNanoseconds", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:StartNanoseconds: ", p), err)
 	}
-	if err := oprot.WriteFieldStop(ctx); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
+	if err := oprot.WriteI64(ctx, int64(p.StartNanoseconds)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.StartNanoseconds (4) field write error: ", p), err)
 	}
-	if err := oprot.WriteStructEnd(ctx); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:StartNanoseconds: ", p), err)
 	}
-	return nil
+	return err
 }
 
-func (p *AnalyzerResult_) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "AnalyzerName", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AnalyzerName: ", p), err)
+func (p *ProfileEntry) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "DurationNanoseconds", thrift.I64, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:DurationNanoseconds: ", p), err)
 	}
-	if err := oprot.WriteString(ctx, string(p.AnalyzerName)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerName (1) field write error: ", p), err)
+	if err := oprot.WriteI64(ctx, int64(p.DurationNanoseconds)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.DurationNanoseconds (5) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AnalyzerName: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:DurationNanoseconds: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzerResult_) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "AnalyzerOutcome", thrift.STRUCT, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AnalyzerOutcome: ", p), err)
+func (p *ProfileEntry) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetCache() {
+		if err := oprot.WriteFieldBegin(ctx, "Cache", thrift.I32, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Cache: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.Cache)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Cache (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Cache: ", p), err)
+		}
 	}
-	if err := p.AnalyzerOutcome.Write(ctx, oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AnalyzerOutcome), err)
+	return err
+}
+
+func (p *ProfileEntry) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AllocatedBytes", thrift.I64, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:AllocatedBytes: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.AllocatedBytes)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AllocatedBytes (7) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AnalyzerOutcome: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:AllocatedBytes: ", p), err)
 	}
 	return err
 }
 
-func (p *AnalyzerResult_) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetProcessedInputJSON() {
-		if err := oprot.WriteFieldBegin(ctx, "ProcessedInputJSON", thrift.STRING, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ProcessedInputJSON: ", p), err)
+func (p *ProfileEntry) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err := oprot.WriteFieldBegin(ctx, "Error", thrift.STRING, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:Error: ", p), err)
 		}
-		if err := oprot.WriteString(ctx, string(*p.ProcessedInputJSON)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.ProcessedInputJSON (3) field write error: ", p), err)
+		if err := oprot.WriteString(ctx, string(*p.Error)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Error (8) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ProcessedInputJSON: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:Error: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *AnalyzerResult_) Equals(other *AnalyzerResult_) bool {
+func (p *ProfileEntry) Equals(other *ProfileEntry) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.AnalyzerName != other.AnalyzerName {
+	if p.Kind != other.Kind {
 		return false
 	}
-	if !p.AnalyzerOutcome.Equals(other.AnalyzerOutcome) {
+	if p.Name != other.Name {
 		return false
 	}
-	if p.ProcessedInputJSON != other.ProcessedInputJSON {
-		if p.ProcessedInputJSON == nil || other.ProcessedInputJSON == nil {
+	if p.ParentIndex != other.ParentIndex {
+		if p.ParentIndex == nil || other.ParentIndex == nil {
 			return false
 		}
-		if (*p.ProcessedInputJSON) != (*other.ProcessedInputJSON) {
+		if (*p.ParentIndex) != (*other.ParentIndex) {
+			return false
+		}
+	}
+	if p.StartNanoseconds != other.StartNanoseconds {
+		return false
+	}
+	if p.DurationNanoseconds != other.DurationNanoseconds {
+		return false
+	}
+	if p.Cache != other.Cache {
+		if p.Cache == nil || other.Cache == nil {
+			return false
+		}
+		if (*p.Cache) != (*other.Cache) {
+			return false
+		}
+	}
+	if p.AllocatedBytes != other.AllocatedBytes {
+		return false
+	}
+	if p.Error != other.Error {
+		if p.Error == nil || other.Error == nil {
+			return false
+		}
+		if (*p.Error) != (*other.Error) {
 			return false
 		}
 	}
 	return true
 }
 
-func (p *AnalyzerResult_) String() string {
+func (p *ProfileEntry) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnalyzerResult_(%+v)", *p)
+	return fmt.Sprintf("ProfileEntry(%+v)", *p)
 }
 
 // Attributes:
-//   - Report
-//   - Err
-type AnalyzerOutcome struct {
-	Report *analyzerreport.AnalyzerReport `thrift:"Report,1" db:"Report" json:"Report,omitempty"`
-	Err    *Error                         `thrift:"Err,2" db:"Err" json:"Err,omitempty"`
-}
-
-func NewAnalyzerOutcome() *AnalyzerOutcome {
-	return &AnalyzerOutcome{}
-}
-
-var AnalyzerOutcome_Report_DEFAULT *analyzerreport.AnalyzerReport
-
-func (p *AnalyzerOutcome) GetReport() *analyzerreport.AnalyzerReport {
-	if !p.IsSetReport() {
-		return AnalyzerOutcome_Report_DEFAULT
-	}
-	return p.Report
-}
-
-var AnalyzerOutcome_Err_DEFAULT *Error
-
-func (p *AnalyzerOutcome) GetErr() *Error {
-	if !p.IsSetErr() {
-		return AnalyzerOutcome_Err_DEFAULT
-	}
-	return p.Err
-}
-func (p *AnalyzerOutcome) CountSetFieldsAnalyzerOutcome() int {
-	count := 0
-	if p.IsSetReport() {
-		count++
-	}
-	if p.IsSetErr() {
-		count++
-	}
-	return count
-
+//   - Entries
+type AnalyzeProfile struct {
+	Entries []*ProfileEntry `thrift:"Entries,1" db:"Entries" json:"Entries"`
 }
 
-func (p *AnalyzerOutcome) IsSetReport() bool {
-	return p.Report != nil
+func NewAnalyzeProfile() *AnalyzeProfile {
+	return &AnalyzeProfile{}
 }
 
-func (p *AnalyzerOutcome) IsSetErr() bool {
-	return p.Err != nil
+func (p *AnalyzeProfile) GetEntries() []*ProfileEntry {
+	return p.Entries
 }
-
-func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *AnalyzeProfile) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -7721,7 +13104,7 @@ func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) erro
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -7730,16 +13113,6 @@ func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) erro
 					return err
 				}
 			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err := p.ReadField2(ctx, iprot); err != nil {
-					return err
-				}
-			} else {
-				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-					return err
-				}
-			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -7755,36 +13128,34 @@ func (p *AnalyzerOutcome) Read(ctx context.Context, iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AnalyzerOutcome) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Report = &analyzerreport.AnalyzerReport{}
-	if err := p.Report.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Report), err)
+func (p *AnalyzeProfile) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	return nil
-}
-
-func (p *AnalyzerOutcome) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	p.Err = &Error{}
-	if err := p.Err.Read(ctx, iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	tSlice := make([]*ProfileEntry, 0, size)
+	p.Entries = tSlice
+	for i := 0; i < size; i++ {
+		_elem18 := &ProfileEntry{}
+		if err := _elem18.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem18), err)
+		}
+		p.Entries = append(p.Entries, _elem18)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *AnalyzerOutcome) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if c := p.CountSetFieldsAnalyzerOutcome(); c != 1 {
-		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
-	}
-	if err := oprot.WriteStructBegin(ctx, "AnalyzerOutcome"); err != nil {
+func (p *AnalyzeProfile) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzeProfile"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
 		if err := p.writeField1(ctx, oprot); err != nil {
 			return err
 		}
-		if err := p.writeField2(ctx, oprot); err != nil {
-			return err
-		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7795,64 +13166,62 @@ func (p *AnalyzerOutcome) Write(ctx context.Context, oprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *AnalyzerOutcome) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetReport() {
-		if err := oprot.WriteFieldBegin(ctx, "Report", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Report: ", p), err)
-		}
-		if err := p.Report.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Report), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Report: ", p), err)
-		}
+func (p *AnalyzeProfile) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entries", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Entries: ", p), err)
 	}
-	return err
-}
-
-func (p *AnalyzerOutcome) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if p.IsSetErr() {
-		if err := oprot.WriteFieldBegin(ctx, "Err", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Err: ", p), err)
-		}
-		if err := p.Err.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
-		}
-		if err := oprot.WriteFieldEnd(ctx); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Err: ", p), err)
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Entries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Entries {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
 	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Entries: ", p), err)
+	}
 	return err
 }
 
-func (p *AnalyzerOutcome) Equals(other *AnalyzerOutcome) bool {
+func (p *AnalyzeProfile) Equals(other *AnalyzeProfile) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if !p.Report.Equals(other.Report) {
+	if len(p.Entries) != len(other.Entries) {
 		return false
 	}
-	if !p.Err.Equals(other.Err) {
-		return false
+	for i, _tgt := range p.Entries {
+		_src19 := other.Entries[i]
+		if !_tgt.Equals(_src19) {
+			return false
+		}
 	}
 	return true
 }
 
-func (p *AnalyzerOutcome) String() string {
+func (p *AnalyzeProfile) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AnalyzerOutcome(%+v)", *p)
+	return fmt.Sprintf("AnalyzeProfile(%+v)", *p)
 }
 
 // Attributes:
 //   - JobID
 //   - Results
+//   - Verdict
+//   - Profile
 type AnalyzeResult_ struct {
 	JobID   []byte             `thrift:"JobID,1" db:"JobID" json:"JobID"`
 	Results []*AnalyzerResult_ `thrift:"Results,2" db:"Results" json:"Results"`
+	Verdict *HostVerdict       `thrift:"Verdict,3" db:"Verdict" json:"Verdict,omitempty"`
+	Profile *AnalyzeProfile    `thrift:"Profile,4" db:"Profile" json:"Profile,omitempty"`
 }
 
 func NewAnalyzeResult_() *AnalyzeResult_ {
@@ -7866,6 +13235,32 @@ func (p *AnalyzeResult_) GetJobID() []byte {
 func (p *AnalyzeResult_) GetResults() []*AnalyzerResult_ {
 	return p.Results
 }
+
+var AnalyzeResult__Verdict_DEFAULT *HostVerdict
+
+func (p *AnalyzeResult_) GetVerdict() *HostVerdict {
+	if !p.IsSetVerdict() {
+		return AnalyzeResult__Verdict_DEFAULT
+	}
+	return p.Verdict
+}
+
+var AnalyzeResult__Profile_DEFAULT *AnalyzeProfile
+
+func (p *AnalyzeResult_) GetProfile() *AnalyzeProfile {
+	if !p.IsSetProfile() {
+		return AnalyzeResult__Profile_DEFAULT
+	}
+	return p.Profile
+}
+func (p *AnalyzeResult_) IsSetVerdict() bool {
+	return p.Verdict != nil
+}
+
+func (p *AnalyzeResult_) IsSetProfile() bool {
+	return p.Profile != nil
+}
+
 func (p *AnalyzeResult_) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -7900,6 +13295,26 @@ func (p *AnalyzeResult_) Read(ctx context.Context, iprot thrift.TProtocol) error
 					return err
 				}
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -7932,11 +13347,11 @@ func (p *AnalyzeResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol)
 	tSlice := make([]*AnalyzerResult_, 0, size)
 	p.Results = tSlice
 	for i := 0; i < size; i++ {
-		_elem14 := &AnalyzerResult_{}
-		if err := _elem14.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem14), err)
+		_elem20 := &AnalyzerResult_{}
+		if err := _elem20.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem20), err)
 		}
-		p.Results = append(p.Results, _elem14)
+		p.Results = append(p.Results, _elem20)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -7944,6 +13359,22 @@ func (p *AnalyzeResult_) ReadField2(ctx context.Context, iprot thrift.TProtocol)
 	return nil
 }
 
+func (p *AnalyzeResult_) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Verdict = &HostVerdict{}
+	if err := p.Verdict.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Verdict), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeResult_) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Profile = &AnalyzeProfile{}
+	if err := p.Profile.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Profile), err)
+	}
+	return nil
+}
+
 func (p *AnalyzeResult_) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin(ctx, "AnalyzeResult"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -7955,6 +13386,12 @@ func (p *AnalyzeResult_) Write(ctx context.Context, oprot thrift.TProtocol) erro
 		if err := p.writeField2(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -7999,6 +13436,36 @@ func (p *AnalyzeResult_) writeField2(ctx context.Context, oprot thrift.TProtocol
 	return err
 }
 
+func (p *AnalyzeResult_) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVerdict() {
+		if err := oprot.WriteFieldBegin(ctx, "Verdict", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Verdict: ", p), err)
+		}
+		if err := p.Verdict.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Verdict), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Verdict: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *AnalyzeResult_) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetProfile() {
+		if err := oprot.WriteFieldBegin(ctx, "Profile", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Profile: ", p), err)
+		}
+		if err := p.Profile.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Profile), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Profile: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *AnalyzeResult_) Equals(other *AnalyzeResult_) bool {
 	if p == other {
 		return true
@@ -8012,11 +13479,17 @@ func (p *AnalyzeResult_) Equals(other *AnalyzeResult_) bool {
 		return false
 	}
 	for i, _tgt := range p.Results {
-		_src15 := other.Results[i]
-		if !_tgt.Equals(_src15) {
+		_src21 := other.Results[i]
+		if !_tgt.Equals(_src21) {
 			return false
 		}
 	}
+	if !p.Verdict.Equals(other.Verdict) {
+		return false
+	}
+	if !p.Profile.Equals(other.Profile) {
+		return false
+	}
 	return true
 }
 
@@ -8087,11 +13560,11 @@ func (p *CheckFirmwareVersionRequest) ReadField1(ctx context.Context, iprot thri
 	tSlice := make([]*FirmwareVersion, 0, size)
 	p.Firmwares = tSlice
 	for i := 0; i < size; i++ {
-		_elem16 := &FirmwareVersion{}
-		if err := _elem16.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem16), err)
+		_elem22 := &FirmwareVersion{}
+		if err := _elem22.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem22), err)
 		}
-		p.Firmwares = append(p.Firmwares, _elem16)
+		p.Firmwares = append(p.Firmwares, _elem22)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -8148,8 +13621,8 @@ func (p *CheckFirmwareVersionRequest) Equals(other *CheckFirmwareVersionRequest)
 		return false
 	}
 	for i, _tgt := range p.Firmwares {
-		_src17 := other.Firmwares[i]
-		if !_tgt.Equals(_src17) {
+		_src23 := other.Firmwares[i]
+		if !_tgt.Equals(_src23) {
 			return false
 		}
 	}
@@ -8223,13 +13696,13 @@ func (p *CheckFirmwareVersionResult_) ReadField1(ctx context.Context, iprot thri
 	tSlice := make([]bool, 0, size)
 	p.ExistStatus = tSlice
 	for i := 0; i < size; i++ {
-		var _elem18 bool
+		var _elem24 bool
 		if v, err := iprot.ReadBool(ctx); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_elem18 = v
+			_elem24 = v
 		}
-		p.ExistStatus = append(p.ExistStatus, _elem18)
+		p.ExistStatus = append(p.ExistStatus, _elem24)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -8286,8 +13759,8 @@ func (p *CheckFirmwareVersionResult_) Equals(other *CheckFirmwareVersionResult_)
 		return false
 	}
 	for i, _tgt := range p.ExistStatus {
-		_src19 := other.ExistStatus[i]
-		if _tgt != _src19 {
+		_src25 := other.ExistStatus[i]
+		if _tgt != _src25 {
 			return false
 		}
 	}
@@ -8354,61 +13827,61 @@ func (p *AttestationFailureAnalyzerServiceClient) SetLastResponseMeta_(meta thri
 // Parameters:
 //   - Request
 func (p *AttestationFailureAnalyzerServiceClient) SearchFirmware(ctx context.Context, request *SearchFirmwareRequest) (r *SearchFirmwareResult_, err error) {
-	var _args20 AttestationFailureAnalyzerServiceSearchFirmwareArgs
-	_args20.Request = request
-	var _result21 AttestationFailureAnalyzerServiceSearchFirmwareResult
+	var _args26 AttestationFailureAnalyzerServiceSearchFirmwareArgs
+	_args26.Request = request
+	var _result27 AttestationFailureAnalyzerServiceSearchFirmwareResult
 	var meta thrift.ResponseMeta
-	meta, err = p.Client_().Call(ctx, "SearchFirmware", &_args20, &_result21)
+	meta, err = p.Client_().Call(ctx, "SearchFirmware", &_args26, &_result27)
 	p.SetLastResponseMeta_(meta)
 	if err != nil {
 		return
 	}
-	return _result21.GetSuccess(), nil
+	return _result27.GetSuccess(), nil
 }
 
 // Parameters:
 //   - Request
 func (p *AttestationFailureAnalyzerServiceClient) SearchReport(ctx context.Context, request *SearchReportRequest) (r *SearchReportResult_, err error) {
-	var _args22 AttestationFailureAnalyzerServiceSearchReportArgs
-	_args22.Request = request
-	var _result23 AttestationFailureAnalyzerServiceSearchReportResult
+	var _args28 AttestationFailureAnalyzerServiceSearchReportArgs
+	_args28.Request = request
+	var _result29 AttestationFailureAnalyzerServiceSearchReportResult
 	var meta thrift.ResponseMeta
-	meta, err = p.Client_().Call(ctx, "SearchReport", &_args22, &_result23)
+	meta, err = p.Client_().Call(ctx, "SearchReport", &_args28, &_result29)
 	p.SetLastResponseMeta_(meta)
 	if err != nil {
 		return
 	}
-	return _result23.GetSuccess(), nil
+	return _result29.GetSuccess(), nil
 }
 
 // Parameters:
 //   - Request
 func (p *AttestationFailureAnalyzerServiceClient) Analyze(ctx context.Context, request *AnalyzeRequest) (r *AnalyzeResult_, err error) {
-	var _args24 AttestationFailureAnalyzerServiceAnalyzeArgs
-	_args24.Request = request
-	var _result25 AttestationFailureAnalyzerServiceAnalyzeResult
+	var _args30 AttestationFailureAnalyzerServiceAnalyzeArgs
+	_args30.Request = request
+	var _result31 AttestationFailureAnalyzerServiceAnalyzeResult
 	var meta thrift.ResponseMeta
-	meta, err = p.Client_().Call(ctx, "Analyze", &_args24, &_result25)
+	meta, err = p.Client_().Call(ctx, "Analyze", &_args30, &_result31)
 	p.SetLastResponseMeta_(meta)
 	if err != nil {
 		return
 	}
-	return _result25.GetSuccess(), nil
+	return _result31.GetSuccess(), nil
 }
 
 // Parameters:
 //   - Request
 func (p *AttestationFailureAnalyzerServiceClient) CheckFirmwareVersion(ctx context.Context, request *CheckFirmwareVersionRequest) (r *CheckFirmwareVersionResult_, err error) {
-	var _args26 AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs
-	_args26.Request = request
-	var _result27 AttestationFailureAnalyzerServiceCheckFirmwareVersionResult
+	var _args32 AttestationFailureAnalyzerServiceCheckFirmwareVersionArgs
+	_args32.Request = request
+	var _result33 AttestationFailureAnalyzerServiceCheckFirmwareVersionResult
 	var meta thrift.ResponseMeta
-	meta, err = p.Client_().Call(ctx, "CheckFirmwareVersion", &_args26, &_result27)
+	meta, err = p.Client_().Call(ctx, "CheckFirmwareVersion", &_args32, &_result33)
 	p.SetLastResponseMeta_(meta)
 	if err != nil {
 		return
 	}
-	return _result27.GetSuccess(), nil
+	return _result33.GetSuccess(), nil
 }
 
 type AttestationFailureAnalyzerServiceProcessor struct {
@@ -8431,12 +13904,12 @@ func (p *AttestationFailureAnalyzerServiceProcessor) ProcessorMap() map[string]t
 
 func NewAttestationFailureAnalyzerServiceProcessor(handler AttestationFailureAnalyzerService) *AttestationFailureAnalyzerServiceProcessor {
 
-	self28 := &AttestationFailureAnalyzerServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self28.processorMap["SearchFirmware"] = &attestationFailureAnalyzerServiceProcessorSearchFirmware{handler: handler}
-	self28.processorMap["SearchReport"] = &attestationFailureAnalyzerServiceProcessorSearchReport{handler: handler}
-	self28.processorMap["Analyze"] = &attestationFailureAnalyzerServiceProcessorAnalyze{handler: handler}
-	self28.processorMap["CheckFirmwareVersion"] = &attestationFailureAnalyzerServiceProcessorCheckFirmwareVersion{handler: handler}
-	return self28
+	self34 := &AttestationFailureAnalyzerServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self34.processorMap["SearchFirmware"] = &attestationFailureAnalyzerServiceProcessorSearchFirmware{handler: handler}
+	self34.processorMap["SearchReport"] = &attestationFailureAnalyzerServiceProcessorSearchReport{handler: handler}
+	self34.processorMap["Analyze"] = &attestationFailureAnalyzerServiceProcessorAnalyze{handler: handler}
+	self34.processorMap["CheckFirmwareVersion"] = &attestationFailureAnalyzerServiceProcessorCheckFirmwareVersion{handler: handler}
+	return self34
 }
 
 func (p *AttestationFailureAnalyzerServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -8449,12 +13922,12 @@ func (p *AttestationFailureAnalyzerServiceProcessor) Process(ctx context.Context
 	}
 	iprot.Skip(ctx, thrift.STRUCT)
 	iprot.ReadMessageEnd(ctx)
-	x29 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	x35 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
 	oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-	x29.Write(ctx, oprot)
+	x35.Write(ctx, oprot)
 	oprot.WriteMessageEnd(ctx)
 	oprot.Flush(ctx)
-	return false, x29
+	return false, x35
 
 }
 
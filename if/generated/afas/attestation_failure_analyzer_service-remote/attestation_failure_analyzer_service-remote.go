@@ -164,31 +164,6 @@ func main() {
 			fmt.Fprintln(os.Stderr, "SearchFirmware requires 1 args")
 			flag.Usage()
 		}
-		arg30 := flag.Arg(1)
-		mbTrans31 := thrift.NewTMemoryBufferLen(len(arg30))
-		defer mbTrans31.Close()
-		_, err32 := mbTrans31.WriteString(arg30)
-		if err32 != nil {
-			Usage()
-			return
-		}
-		factory33 := thrift.NewTJSONProtocolFactory()
-		jsProt34 := factory33.GetProtocol(mbTrans31)
-		argvalue0 := afas.NewSearchFirmwareRequest()
-		err35 := argvalue0.Read(context.Background(), jsProt34)
-		if err35 != nil {
-			Usage()
-			return
-		}
-		value0 := argvalue0
-		fmt.Print(client.SearchFirmware(context.Background(), value0))
-		fmt.Print("\n")
-		break
-	case "SearchReport":
-		if flag.NArg()-1 != 1 {
-			fmt.Fprintln(os.Stderr, "SearchReport requires 1 args")
-			flag.Usage()
-		}
 		arg36 := flag.Arg(1)
 		mbTrans37 := thrift.NewTMemoryBufferLen(len(arg36))
 		defer mbTrans37.Close()
@@ -199,19 +174,19 @@ func main() {
 		}
 		factory39 := thrift.NewTJSONProtocolFactory()
 		jsProt40 := factory39.GetProtocol(mbTrans37)
-		argvalue0 := afas.NewSearchReportRequest()
+		argvalue0 := afas.NewSearchFirmwareRequest()
 		err41 := argvalue0.Read(context.Background(), jsProt40)
 		if err41 != nil {
 			Usage()
 			return
 		}
 		value0 := argvalue0
-		fmt.Print(client.SearchReport(context.Background(), value0))
+		fmt.Print(client.SearchFirmware(context.Background(), value0))
 		fmt.Print("\n")
 		break
-	case "Analyze":
+	case "SearchReport":
 		if flag.NArg()-1 != 1 {
-			fmt.Fprintln(os.Stderr, "Analyze requires 1 args")
+			fmt.Fprintln(os.Stderr, "SearchReport requires 1 args")
 			flag.Usage()
 		}
 		arg42 := flag.Arg(1)
@@ -224,19 +199,19 @@ func main() {
 		}
 		factory45 := thrift.NewTJSONProtocolFactory()
 		jsProt46 := factory45.GetProtocol(mbTrans43)
-		argvalue0 := afas.NewAnalyzeRequest()
+		argvalue0 := afas.NewSearchReportRequest()
 		err47 := argvalue0.Read(context.Background(), jsProt46)
 		if err47 != nil {
 			Usage()
 			return
 		}
 		value0 := argvalue0
-		fmt.Print(client.Analyze(context.Background(), value0))
+		fmt.Print(client.SearchReport(context.Background(), value0))
 		fmt.Print("\n")
 		break
-	case "CheckFirmwareVersion":
+	case "Analyze":
 		if flag.NArg()-1 != 1 {
-			fmt.Fprintln(os.Stderr, "CheckFirmwareVersion requires 1 args")
+			fmt.Fprintln(os.Stderr, "Analyze requires 1 args")
 			flag.Usage()
 		}
 		arg48 := flag.Arg(1)
@@ -249,13 +224,38 @@ func main() {
 		}
 		factory51 := thrift.NewTJSONProtocolFactory()
 		jsProt52 := factory51.GetProtocol(mbTrans49)
-		argvalue0 := afas.NewCheckFirmwareVersionRequest()
+		argvalue0 := afas.NewAnalyzeRequest()
 		err53 := argvalue0.Read(context.Background(), jsProt52)
 		if err53 != nil {
 			Usage()
 			return
 		}
 		value0 := argvalue0
+		fmt.Print(client.Analyze(context.Background(), value0))
+		fmt.Print("\n")
+		break
+	case "CheckFirmwareVersion":
+		if flag.NArg()-1 != 1 {
+			fmt.Fprintln(os.Stderr, "CheckFirmwareVersion requires 1 args")
+			flag.Usage()
+		}
+		arg54 := flag.Arg(1)
+		mbTrans55 := thrift.NewTMemoryBufferLen(len(arg54))
+		defer mbTrans55.Close()
+		_, err56 := mbTrans55.WriteString(arg54)
+		if err56 != nil {
+			Usage()
+			return
+		}
+		factory57 := thrift.NewTJSONProtocolFactory()
+		jsProt58 := factory57.GetProtocol(mbTrans55)
+		argvalue0 := afas.NewCheckFirmwareVersionRequest()
+		err59 := argvalue0.Read(context.Background(), jsProt58)
+		if err59 != nil {
+			Usage()
+			return
+		}
+		value0 := argvalue0
 		fmt.Print(client.CheckFirmwareVersion(context.Background(), value0))
 		fmt.Print("\n")
 		break
@@ -0,0 +1,1964 @@
+// Code generated by Thrift Compiler (0.14.0). DO NOT EDIT.
+
+package analyzerplugin
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerreport"
+	"github.com/immune-gmbh/attestation-sdk/if/generated/tpm"
+	"time"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+var _ = time.Now
+var _ = bytes.Equal
+
+var _ = afas.GoUnusedProtection__
+var _ = analyzerreport.GoUnusedProtection__
+var _ = tpm.GoUnusedProtection__
+
+type InputType int64
+
+const (
+	InputType_ActualFirmware   InputType = 1
+	InputType_OriginalFirmware InputType = 2
+	InputType_StatusRegisters  InputType = 3
+	InputType_TPMDevice        InputType = 4
+	InputType_TPMEventLog      InputType = 5
+	InputType_ActualPCR0       InputType = 6
+	InputType_ACPITables       InputType = 7
+)
+
+func (p InputType) String() string {
+	switch p {
+	case InputType_ActualFirmware:
+		return "ActualFirmware"
+	case InputType_OriginalFirmware:
+		return "OriginalFirmware"
+	case InputType_StatusRegisters:
+		return "StatusRegisters"
+	case InputType_TPMDevice:
+		return "TPMDevice"
+	case InputType_TPMEventLog:
+		return "TPMEventLog"
+	case InputType_ActualPCR0:
+		return "ActualPCR0"
+	case InputType_ACPITables:
+		return "ACPITables"
+	}
+	return "<UNSET>"
+}
+
+func InputTypeFromString(s string) (InputType, error) {
+	switch s {
+	case "ActualFirmware":
+		return InputType_ActualFirmware, nil
+	case "OriginalFirmware":
+		return InputType_OriginalFirmware, nil
+	case "StatusRegisters":
+		return InputType_StatusRegisters, nil
+	case "TPMDevice":
+		return InputType_TPMDevice, nil
+	case "TPMEventLog":
+		return InputType_TPMEventLog, nil
+	case "ActualPCR0":
+		return InputType_ActualPCR0, nil
+	case "ACPITables":
+		return InputType_ACPITables, nil
+	}
+	return InputType(0), fmt.Errorf("not a valid InputType string")
+}
+
+func InputTypePtr(v InputType) *InputType { return &v }
+
+func (p InputType) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *InputType) UnmarshalText(text []byte) error {
+	q, err := InputTypeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *InputType) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = InputType(v)
+	return nil
+}
+
+func (p *InputType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// Attributes:
+//   - ProtocolVersion
+type DescribeRequest struct {
+	ProtocolVersion int32 `thrift:"ProtocolVersion,1" db:"ProtocolVersion" json:"ProtocolVersion"`
+}
+
+func NewDescribeRequest() *DescribeRequest {
+	return &DescribeRequest{}
+}
+
+func (p *DescribeRequest) GetProtocolVersion() int32 {
+	return p.ProtocolVersion
+}
+func (p *DescribeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DescribeRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ProtocolVersion = v
+	}
+	return nil
+}
+
+func (p *DescribeRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DescribeRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DescribeRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ProtocolVersion", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ProtocolVersion: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ProtocolVersion)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ProtocolVersion (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ProtocolVersion: ", p), err)
+	}
+	return err
+}
+
+func (p *DescribeRequest) Equals(other *DescribeRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ProtocolVersion != other.ProtocolVersion {
+		return false
+	}
+	return true
+}
+
+func (p *DescribeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DescribeRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - ProtocolVersion
+//   - AnalyzerID
+//   - Version
+//   - RequiredInputs
+//   - OptionalInputs
+type Description struct {
+	ProtocolVersion int32       `thrift:"ProtocolVersion,1" db:"ProtocolVersion" json:"ProtocolVersion"`
+	AnalyzerID      string      `thrift:"AnalyzerID,2" db:"AnalyzerID" json:"AnalyzerID"`
+	Version         *string     `thrift:"Version,3" db:"Version" json:"Version,omitempty"`
+	RequiredInputs  []InputType `thrift:"RequiredInputs,4" db:"RequiredInputs" json:"RequiredInputs"`
+	OptionalInputs  []InputType `thrift:"OptionalInputs,5" db:"OptionalInputs" json:"OptionalInputs"`
+}
+
+func NewDescription() *Description {
+	return &Description{}
+}
+
+func (p *Description) GetProtocolVersion() int32 {
+	return p.ProtocolVersion
+}
+
+func (p *Description) GetAnalyzerID() string {
+	return p.AnalyzerID
+}
+
+var Description_Version_DEFAULT string
+
+func (p *Description) GetVersion() string {
+	if !p.IsSetVersion() {
+		return Description_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+func (p *Description) GetRequiredInputs() []InputType {
+	return p.RequiredInputs
+}
+
+func (p *Description) GetOptionalInputs() []InputType {
+	return p.OptionalInputs
+}
+func (p *Description) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *Description) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Description) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ProtocolVersion = v
+	}
+	return nil
+}
+
+func (p *Description) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.AnalyzerID = v
+	}
+	return nil
+}
+
+func (p *Description) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Version = &v
+	}
+	return nil
+}
+
+func (p *Description) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]InputType, 0, size)
+	p.RequiredInputs = tSlice
+	for i := 0; i < size; i++ {
+		var _elem0 InputType
+		if v, err := iprot.ReadI32(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := InputType(v)
+			_elem0 = temp
+		}
+		p.RequiredInputs = append(p.RequiredInputs, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Description) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]InputType, 0, size)
+	p.OptionalInputs = tSlice
+	for i := 0; i < size; i++ {
+		var _elem1 InputType
+		if v, err := iprot.ReadI32(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := InputType(v)
+			_elem1 = temp
+		}
+		p.OptionalInputs = append(p.OptionalInputs, _elem1)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Description) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Description"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Description) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ProtocolVersion", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ProtocolVersion: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ProtocolVersion)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ProtocolVersion (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ProtocolVersion: ", p), err)
+	}
+	return err
+}
+
+func (p *Description) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AnalyzerID", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AnalyzerID: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.AnalyzerID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AnalyzerID (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AnalyzerID: ", p), err)
+	}
+	return err
+}
+
+func (p *Description) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Version: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Version)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Version (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Version: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Description) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "RequiredInputs", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:RequiredInputs: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.I32, len(p.RequiredInputs)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.RequiredInputs {
+		if err := oprot.WriteI32(ctx, int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:RequiredInputs: ", p), err)
+	}
+	return err
+}
+
+func (p *Description) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "OptionalInputs", thrift.LIST, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:OptionalInputs: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.I32, len(p.OptionalInputs)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.OptionalInputs {
+		if err := oprot.WriteI32(ctx, int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:OptionalInputs: ", p), err)
+	}
+	return err
+}
+
+func (p *Description) Equals(other *Description) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.ProtocolVersion != other.ProtocolVersion {
+		return false
+	}
+	if p.AnalyzerID != other.AnalyzerID {
+		return false
+	}
+	if p.Version != other.Version {
+		if p.Version == nil || other.Version == nil {
+			return false
+		}
+		if (*p.Version) != (*other.Version) {
+			return false
+		}
+	}
+	if len(p.RequiredInputs) != len(other.RequiredInputs) {
+		return false
+	}
+	for i, _tgt := range p.RequiredInputs {
+		_src2 := other.RequiredInputs[i]
+		if _tgt != _src2 {
+			return false
+		}
+	}
+	if len(p.OptionalInputs) != len(other.OptionalInputs) {
+		return false
+	}
+	for i, _tgt := range p.OptionalInputs {
+		_src3 := other.OptionalInputs[i]
+		if _tgt != _src3 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Description) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Description(%+v)", *p)
+}
+
+// Attributes:
+//   - ActualFirmware
+//   - OriginalFirmware
+//   - StatusRegisters
+//   - TPMDevice
+//   - TPMEventLog
+//   - ActualPCR0
+//   - ACPITables
+type Inputs struct {
+	ActualFirmware   []byte                 `thrift:"ActualFirmware,1" db:"ActualFirmware" json:"ActualFirmware,omitempty"`
+	OriginalFirmware []byte                 `thrift:"OriginalFirmware,2" db:"OriginalFirmware" json:"OriginalFirmware,omitempty"`
+	StatusRegisters  []*afas.StatusRegister `thrift:"StatusRegisters,3" db:"StatusRegisters" json:"StatusRegisters,omitempty"`
+	TPMDevice        *afas.TPMType          `thrift:"TPMDevice,4" db:"TPMDevice" json:"TPMDevice,omitempty"`
+	TPMEventLog      *tpm.EventLog          `thrift:"TPMEventLog,5" db:"TPMEventLog" json:"TPMEventLog,omitempty"`
+	ActualPCR0       []byte                 `thrift:"ActualPCR0,6" db:"ActualPCR0" json:"ActualPCR0,omitempty"`
+	ACPITables       []*afas.ACPITable      `thrift:"ACPITables,7" db:"ACPITables" json:"ACPITables,omitempty"`
+}
+
+func NewInputs() *Inputs {
+	return &Inputs{}
+}
+
+var Inputs_ActualFirmware_DEFAULT []byte
+
+func (p *Inputs) GetActualFirmware() []byte {
+	return p.ActualFirmware
+}
+
+var Inputs_OriginalFirmware_DEFAULT []byte
+
+func (p *Inputs) GetOriginalFirmware() []byte {
+	return p.OriginalFirmware
+}
+
+var Inputs_StatusRegisters_DEFAULT []*afas.StatusRegister
+
+func (p *Inputs) GetStatusRegisters() []*afas.StatusRegister {
+	return p.StatusRegisters
+}
+
+var Inputs_TPMDevice_DEFAULT afas.TPMType
+
+func (p *Inputs) GetTPMDevice() afas.TPMType {
+	if !p.IsSetTPMDevice() {
+		return Inputs_TPMDevice_DEFAULT
+	}
+	return *p.TPMDevice
+}
+
+var Inputs_TPMEventLog_DEFAULT *tpm.EventLog
+
+func (p *Inputs) GetTPMEventLog() *tpm.EventLog {
+	if !p.IsSetTPMEventLog() {
+		return Inputs_TPMEventLog_DEFAULT
+	}
+	return p.TPMEventLog
+}
+
+var Inputs_ActualPCR0_DEFAULT []byte
+
+func (p *Inputs) GetActualPCR0() []byte {
+	return p.ActualPCR0
+}
+
+var Inputs_ACPITables_DEFAULT []*afas.ACPITable
+
+func (p *Inputs) GetACPITables() []*afas.ACPITable {
+	return p.ACPITables
+}
+func (p *Inputs) IsSetActualFirmware() bool {
+	return p.ActualFirmware != nil
+}
+
+func (p *Inputs) IsSetOriginalFirmware() bool {
+	return p.OriginalFirmware != nil
+}
+
+func (p *Inputs) IsSetStatusRegisters() bool {
+	return p.StatusRegisters != nil
+}
+
+func (p *Inputs) IsSetTPMDevice() bool {
+	return p.TPMDevice != nil
+}
+
+func (p *Inputs) IsSetTPMEventLog() bool {
+	return p.TPMEventLog != nil
+}
+
+func (p *Inputs) IsSetActualPCR0() bool {
+	return p.ActualPCR0 != nil
+}
+
+func (p *Inputs) IsSetACPITables() bool {
+	return p.ACPITables != nil
+}
+
+func (p *Inputs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Inputs) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ActualFirmware = v
+	}
+	return nil
+}
+
+func (p *Inputs) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.OriginalFirmware = v
+	}
+	return nil
+}
+
+func (p *Inputs) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*afas.StatusRegister, 0, size)
+	p.StatusRegisters = tSlice
+	for i := 0; i < size; i++ {
+		_elem4 := &afas.StatusRegister{}
+		if err := _elem4.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		}
+		p.StatusRegisters = append(p.StatusRegisters, _elem4)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Inputs) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := afas.TPMType(v)
+		p.TPMDevice = &temp
+	}
+	return nil
+}
+
+func (p *Inputs) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	p.TPMEventLog = &tpm.EventLog{}
+	if err := p.TPMEventLog.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TPMEventLog), err)
+	}
+	return nil
+}
+
+func (p *Inputs) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ActualPCR0 = v
+	}
+	return nil
+}
+
+func (p *Inputs) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*afas.ACPITable, 0, size)
+	p.ACPITables = tSlice
+	for i := 0; i < size; i++ {
+		_elem5 := &afas.ACPITable{}
+		if err := _elem5.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem5), err)
+		}
+		p.ACPITables = append(p.ACPITables, _elem5)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Inputs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Inputs"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Inputs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualFirmware() {
+		if err := oprot.WriteFieldBegin(ctx, "ActualFirmware", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ActualFirmware: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.ActualFirmware); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ActualFirmware (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ActualFirmware: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Inputs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalFirmware() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalFirmware", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:OriginalFirmware: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.OriginalFirmware); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalFirmware (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:OriginalFirmware: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Inputs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatusRegisters() {
+		if err := oprot.WriteFieldBegin(ctx, "StatusRegisters", thrift.LIST, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:StatusRegisters: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.StatusRegisters)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.StatusRegisters {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:StatusRegisters: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Inputs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMDevice() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMDevice", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:TPMDevice: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TPMDevice)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TPMDevice (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:TPMDevice: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Inputs) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTPMEventLog() {
+		if err := oprot.WriteFieldBegin(ctx, "TPMEventLog", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:TPMEventLog: ", p), err)
+		}
+		if err := p.TPMEventLog.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TPMEventLog), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:TPMEventLog: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Inputs) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActualPCR0() {
+		if err := oprot.WriteFieldBegin(ctx, "ActualPCR0", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:ActualPCR0: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.ActualPCR0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ActualPCR0 (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:ActualPCR0: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Inputs) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetACPITables() {
+		if err := oprot.WriteFieldBegin(ctx, "ACPITables", thrift.LIST, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:ACPITables: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.ACPITables)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.ACPITables {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:ACPITables: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Inputs) Equals(other *Inputs) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if bytes.Compare(p.ActualFirmware, other.ActualFirmware) != 0 {
+		return false
+	}
+	if bytes.Compare(p.OriginalFirmware, other.OriginalFirmware) != 0 {
+		return false
+	}
+	if len(p.StatusRegisters) != len(other.StatusRegisters) {
+		return false
+	}
+	for i, _tgt := range p.StatusRegisters {
+		_src6 := other.StatusRegisters[i]
+		if !_tgt.Equals(_src6) {
+			return false
+		}
+	}
+	if p.TPMDevice != other.TPMDevice {
+		if p.TPMDevice == nil || other.TPMDevice == nil {
+			return false
+		}
+		if (*p.TPMDevice) != (*other.TPMDevice) {
+			return false
+		}
+	}
+	if !p.TPMEventLog.Equals(other.TPMEventLog) {
+		return false
+	}
+	if bytes.Compare(p.ActualPCR0, other.ActualPCR0) != 0 {
+		return false
+	}
+	if len(p.ACPITables) != len(other.ACPITables) {
+		return false
+	}
+	for i, _tgt := range p.ACPITables {
+		_src7 := other.ACPITables[i]
+		if !_tgt.Equals(_src7) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Inputs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Inputs(%+v)", *p)
+}
+
+// Attributes:
+//   - Inputs
+type AnalyzeRequest struct {
+	Inputs *Inputs `thrift:"Inputs,1" db:"Inputs" json:"Inputs"`
+}
+
+func NewAnalyzeRequest() *AnalyzeRequest {
+	return &AnalyzeRequest{}
+}
+
+var AnalyzeRequest_Inputs_DEFAULT *Inputs
+
+func (p *AnalyzeRequest) GetInputs() *Inputs {
+	if !p.IsSetInputs() {
+		return AnalyzeRequest_Inputs_DEFAULT
+	}
+	return p.Inputs
+}
+func (p *AnalyzeRequest) IsSetInputs() bool {
+	return p.Inputs != nil
+}
+
+func (p *AnalyzeRequest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Inputs = &Inputs{}
+	if err := p.Inputs.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Inputs), err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "AnalyzeRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *AnalyzeRequest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Inputs", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Inputs: ", p), err)
+	}
+	if err := p.Inputs.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Inputs), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Inputs: ", p), err)
+	}
+	return err
+}
+
+func (p *AnalyzeRequest) Equals(other *AnalyzeRequest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Inputs.Equals(other.Inputs) {
+		return false
+	}
+	return true
+}
+
+func (p *AnalyzeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AnalyzeRequest(%+v)", *p)
+}
+
+// Attributes:
+//   - Describe
+//   - Analyze
+type Request struct {
+	Describe *DescribeRequest `thrift:"Describe,1" db:"Describe" json:"Describe,omitempty"`
+	Analyze  *AnalyzeRequest  `thrift:"Analyze,2" db:"Analyze" json:"Analyze,omitempty"`
+}
+
+func NewRequest() *Request {
+	return &Request{}
+}
+
+var Request_Describe_DEFAULT *DescribeRequest
+
+func (p *Request) GetDescribe() *DescribeRequest {
+	if !p.IsSetDescribe() {
+		return Request_Describe_DEFAULT
+	}
+	return p.Describe
+}
+
+var Request_Analyze_DEFAULT *AnalyzeRequest
+
+func (p *Request) GetAnalyze() *AnalyzeRequest {
+	if !p.IsSetAnalyze() {
+		return Request_Analyze_DEFAULT
+	}
+	return p.Analyze
+}
+func (p *Request) CountSetFieldsRequest() int {
+	count := 0
+	if p.IsSetDescribe() {
+		count++
+	}
+	if p.IsSetAnalyze() {
+		count++
+	}
+	return count
+
+}
+
+func (p *Request) IsSetDescribe() bool {
+	return p.Describe != nil
+}
+
+func (p *Request) IsSetAnalyze() bool {
+	return p.Analyze != nil
+}
+
+func (p *Request) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Request) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Describe = &DescribeRequest{}
+	if err := p.Describe.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Describe), err)
+	}
+	return nil
+}
+
+func (p *Request) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Analyze = &AnalyzeRequest{}
+	if err := p.Analyze.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Analyze), err)
+	}
+	return nil
+}
+
+func (p *Request) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsRequest(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "Request"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Request) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescribe() {
+		if err := oprot.WriteFieldBegin(ctx, "Describe", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Describe: ", p), err)
+		}
+		if err := p.Describe.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Describe), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Describe: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Request) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAnalyze() {
+		if err := oprot.WriteFieldBegin(ctx, "Analyze", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Analyze: ", p), err)
+		}
+		if err := p.Analyze.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Analyze), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Analyze: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Request) Equals(other *Request) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Describe.Equals(other.Describe) {
+		return false
+	}
+	if !p.Analyze.Equals(other.Analyze) {
+		return false
+	}
+	return true
+}
+
+func (p *Request) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Request(%+v)", *p)
+}
+
+// Attributes:
+//   - Issues
+//   - Comments
+//   - Data
+type Report struct {
+	Issues   []*analyzerreport.Issue `thrift:"Issues,1" db:"Issues" json:"Issues"`
+	Comments []string                `thrift:"Comments,2" db:"Comments" json:"Comments"`
+	Data     *string                 `thrift:"Data,3" db:"Data" json:"Data,omitempty"`
+}
+
+func NewReport() *Report {
+	return &Report{}
+}
+
+func (p *Report) GetIssues() []*analyzerreport.Issue {
+	return p.Issues
+}
+
+func (p *Report) GetComments() []string {
+	return p.Comments
+}
+
+var Report_Data_DEFAULT string
+
+func (p *Report) GetData() string {
+	if !p.IsSetData() {
+		return Report_Data_DEFAULT
+	}
+	return *p.Data
+}
+func (p *Report) IsSetData() bool {
+	return p.Data != nil
+}
+
+func (p *Report) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Report) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*analyzerreport.Issue, 0, size)
+	p.Issues = tSlice
+	for i := 0; i < size; i++ {
+		_elem8 := &analyzerreport.Issue{}
+		if err := _elem8.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
+		}
+		p.Issues = append(p.Issues, _elem8)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Report) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Comments = tSlice
+	for i := 0; i < size; i++ {
+		var _elem9 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem9 = v
+		}
+		p.Comments = append(p.Comments, _elem9)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Report) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Data = &v
+	}
+	return nil
+}
+
+func (p *Report) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Report"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Report) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Issues", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Issues: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Issues)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Issues {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Issues: ", p), err)
+	}
+	return err
+}
+
+func (p *Report) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Comments", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Comments: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Comments)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Comments {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Comments: ", p), err)
+	}
+	return err
+}
+
+func (p *Report) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetData() {
+		if err := oprot.WriteFieldBegin(ctx, "Data", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Data: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Data)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Data (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Data: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Report) Equals(other *Report) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.Issues) != len(other.Issues) {
+		return false
+	}
+	for i, _tgt := range p.Issues {
+		_src10 := other.Issues[i]
+		if !_tgt.Equals(_src10) {
+			return false
+		}
+	}
+	if len(p.Comments) != len(other.Comments) {
+		return false
+	}
+	for i, _tgt := range p.Comments {
+		_src11 := other.Comments[i]
+		if _tgt != _src11 {
+			return false
+		}
+	}
+	if p.Data != other.Data {
+		if p.Data == nil || other.Data == nil {
+			return false
+		}
+		if (*p.Data) != (*other.Data) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Report) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Report(%+v)", *p)
+}
+
+// Attributes:
+//   - Description
+//   - Report
+//   - NotApplicable
+//   - Error
+type Response struct {
+	Description   *Description `thrift:"Description,1" db:"Description" json:"Description,omitempty"`
+	Report        *Report      `thrift:"Report,2" db:"Report" json:"Report,omitempty"`
+	NotApplicable *string      `thrift:"NotApplicable,3" db:"NotApplicable" json:"NotApplicable,omitempty"`
+	Error         *string      `thrift:"Error,4" db:"Error" json:"Error,omitempty"`
+}
+
+func NewResponse() *Response {
+	return &Response{}
+}
+
+var Response_Description_DEFAULT *Description
+
+func (p *Response) GetDescription() *Description {
+	if !p.IsSetDescription() {
+		return Response_Description_DEFAULT
+	}
+	return p.Description
+}
+
+var Response_Report_DEFAULT *Report
+
+func (p *Response) GetReport() *Report {
+	if !p.IsSetReport() {
+		return Response_Report_DEFAULT
+	}
+	return p.Report
+}
+
+var Response_NotApplicable_DEFAULT string
+
+func (p *Response) GetNotApplicable() string {
+	if !p.IsSetNotApplicable() {
+		return Response_NotApplicable_DEFAULT
+	}
+	return *p.NotApplicable
+}
+
+var Response_Error_DEFAULT string
+
+func (p *Response) GetError() string {
+	if !p.IsSetError() {
+		return Response_Error_DEFAULT
+	}
+	return *p.Error
+}
+func (p *Response) CountSetFieldsResponse() int {
+	count := 0
+	if p.IsSetDescription() {
+		count++
+	}
+	if p.IsSetReport() {
+		count++
+	}
+	if p.IsSetNotApplicable() {
+		count++
+	}
+	if p.IsSetError() {
+		count++
+	}
+	return count
+
+}
+
+func (p *Response) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *Response) IsSetReport() bool {
+	return p.Report != nil
+}
+
+func (p *Response) IsSetNotApplicable() bool {
+	return p.NotApplicable != nil
+}
+
+func (p *Response) IsSetError() bool {
+	return p.Error != nil
+}
+
+func (p *Response) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Response) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Description = &Description{}
+	if err := p.Description.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Description), err)
+	}
+	return nil
+}
+
+func (p *Response) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Report = &Report{}
+	if err := p.Report.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Report), err)
+	}
+	return nil
+}
+
+func (p *Response) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NotApplicable = &v
+	}
+	return nil
+}
+
+func (p *Response) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Error = &v
+	}
+	return nil
+}
+
+func (p *Response) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsResponse(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin(ctx, "Response"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Response) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Description: ", p), err)
+		}
+		if err := p.Description.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Description), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Description: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Response) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetReport() {
+		if err := oprot.WriteFieldBegin(ctx, "Report", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Report: ", p), err)
+		}
+		if err := p.Report.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Report), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Report: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Response) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetNotApplicable() {
+		if err := oprot.WriteFieldBegin(ctx, "NotApplicable", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:NotApplicable: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.NotApplicable)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.NotApplicable (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:NotApplicable: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Response) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetError() {
+		if err := oprot.WriteFieldBegin(ctx, "Error", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Error: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Error)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Error (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Error: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Response) Equals(other *Response) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Description.Equals(other.Description) {
+		return false
+	}
+	if !p.Report.Equals(other.Report) {
+		return false
+	}
+	if p.NotApplicable != other.NotApplicable {
+		if p.NotApplicable == nil || other.NotApplicable == nil {
+			return false
+		}
+		if (*p.NotApplicable) != (*other.NotApplicable) {
+			return false
+		}
+	}
+	if p.Error != other.Error {
+		if p.Error == nil || other.Error == nil {
+			return false
+		}
+		if (*p.Error) != (*other.Error) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Response) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Response(%+v)", *p)
+}
@@ -7,12 +7,25 @@ import (
 	"context"
 	"fmt"
 	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables/report/generated/acpitablesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens/report/generated/apcbsecanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume/report/generated/biosrtmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature/report/generated/pspsignanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl/report/generated/pspsplanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain/report/generated/bootchainanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance/report/generated/firmwareprovenanceanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff/report/generated/imagediffanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm/report/generated/intelacmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme/report/generated/intelmeanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms/report/generated/optionromsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/report/generated/pluginanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr/report/generated/reproducepcranalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors/report/generated/txterrorsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules/report/generated/vulnmodulesanalysis"
 	"time"
 )
 
@@ -23,12 +36,25 @@ var _ = context.Background
 var _ = time.Now
 var _ = bytes.Equal
 
+var _ = acpitablesanalysis.GoUnusedProtection__
 var _ = apcbsecanalysis.GoUnusedProtection__
 var _ = biosrtmanalysis.GoUnusedProtection__
 var _ = pspsignanalysis.GoUnusedProtection__
+var _ = psbfusesanalysis.GoUnusedProtection__
+var _ = pspsplanalysis.GoUnusedProtection__
 var _ = diffanalysis.GoUnusedProtection__
+var _ = flashdegradationanalysis.GoUnusedProtection__
+var _ = firmwareprovenanceanalysis.GoUnusedProtection__
+var _ = imagediffanalysis.GoUnusedProtection__
+var _ = optionromsanalysis.GoUnusedProtection__
+var _ = pluginanalysis.GoUnusedProtection__
+var _ = bootchainanalysis.GoUnusedProtection__
 var _ = intelacmanalysis.GoUnusedProtection__
+var _ = intelifdanalysis.GoUnusedProtection__
+var _ = intelmeanalysis.GoUnusedProtection__
 var _ = reproducepcranalysis.GoUnusedProtection__
+var _ = txterrorsanalysis.GoUnusedProtection__
+var _ = vulnmodulesanalysis.GoUnusedProtection__
 
 func init() {
 }
@@ -9,12 +9,25 @@ import (
 	"errors"
 	"fmt"
 	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables/report/generated/acpitablesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens/report/generated/apcbsecanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume/report/generated/biosrtmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature/report/generated/pspsignanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl/report/generated/pspsplanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain/report/generated/bootchainanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance/report/generated/firmwareprovenanceanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff/report/generated/imagediffanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm/report/generated/intelacmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme/report/generated/intelmeanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms/report/generated/optionromsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/report/generated/pluginanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr/report/generated/reproducepcranalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors/report/generated/txterrorsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules/report/generated/vulnmodulesanalysis"
 	"time"
 )
 
@@ -25,12 +38,25 @@ var _ = context.Background
 var _ = time.Now
 var _ = bytes.Equal
 
+var _ = acpitablesanalysis.GoUnusedProtection__
 var _ = apcbsecanalysis.GoUnusedProtection__
 var _ = biosrtmanalysis.GoUnusedProtection__
 var _ = pspsignanalysis.GoUnusedProtection__
+var _ = psbfusesanalysis.GoUnusedProtection__
+var _ = pspsplanalysis.GoUnusedProtection__
 var _ = diffanalysis.GoUnusedProtection__
+var _ = flashdegradationanalysis.GoUnusedProtection__
+var _ = firmwareprovenanceanalysis.GoUnusedProtection__
+var _ = imagediffanalysis.GoUnusedProtection__
+var _ = optionromsanalysis.GoUnusedProtection__
+var _ = pluginanalysis.GoUnusedProtection__
+var _ = bootchainanalysis.GoUnusedProtection__
 var _ = intelacmanalysis.GoUnusedProtection__
+var _ = intelifdanalysis.GoUnusedProtection__
+var _ = intelmeanalysis.GoUnusedProtection__
 var _ = reproducepcranalysis.GoUnusedProtection__
+var _ = txterrorsanalysis.GoUnusedProtection__
+var _ = vulnmodulesanalysis.GoUnusedProtection__
 
 type Severity int64
 
@@ -168,10 +194,16 @@ func (p *IssueInfo) String() string {
 //   - Custom
 //   - Severity
 //   - Description
+//   - Code
+//   - Params
+//   - Remediation
 type Issue struct {
-	Custom      *IssueInfo `thrift:"Custom,1" db:"Custom" json:"Custom,omitempty"`
-	Severity    Severity   `thrift:"Severity,2" db:"Severity" json:"Severity"`
-	Description *string    `thrift:"Description,3" db:"Description" json:"Description,omitempty"`
+	Custom      *IssueInfo        `thrift:"Custom,1" db:"Custom" json:"Custom,omitempty"`
+	Severity    Severity          `thrift:"Severity,2" db:"Severity" json:"Severity"`
+	Description *string           `thrift:"Description,3" db:"Description" json:"Description,omitempty"`
+	Code        *string           `thrift:"Code,4" db:"Code" json:"Code,omitempty"`
+	Params      map[string]string `thrift:"Params,5" db:"Params" json:"Params,omitempty"`
+	Remediation *string           `thrift:"Remediation,6" db:"Remediation" json:"Remediation,omitempty"`
 }
 
 func NewIssue() *Issue {
@@ -199,6 +231,30 @@ func (p *Issue) GetDescription() string {
 	}
 	return *p.Description
 }
+
+var Issue_Code_DEFAULT string
+
+func (p *Issue) GetCode() string {
+	if !p.IsSetCode() {
+		return Issue_Code_DEFAULT
+	}
+	return *p.Code
+}
+
+var Issue_Params_DEFAULT map[string]string
+
+func (p *Issue) GetParams() map[string]string {
+	return p.Params
+}
+
+var Issue_Remediation_DEFAULT string
+
+func (p *Issue) GetRemediation() string {
+	if !p.IsSetRemediation() {
+		return Issue_Remediation_DEFAULT
+	}
+	return *p.Remediation
+}
 func (p *Issue) IsSetCustom() bool {
 	return p.Custom != nil
 }
@@ -207,6 +263,18 @@ func (p *Issue) IsSetDescription() bool {
 	return p.Description != nil
 }
 
+func (p *Issue) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *Issue) IsSetParams() bool {
+	return p.Params != nil
+}
+
+func (p *Issue) IsSetRemediation() bool {
+	return p.Remediation != nil
+}
+
 func (p *Issue) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -251,6 +319,36 @@ func (p *Issue) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
 		default:
 			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
 				return err
@@ -293,6 +391,52 @@ func (p *Issue) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *Issue) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Code = &v
+	}
+	return nil
+}
+
+func (p *Issue) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.Params = tMap
+	for i := 0; i < size; i++ {
+		var _key0 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key0 = v
+		}
+		var _val1 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val1 = v
+		}
+		p.Params[_key0] = _val1
+	}
+	if err := iprot.ReadMapEnd(ctx); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *Issue) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.Remediation = &v
+	}
+	return nil
+}
+
 func (p *Issue) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin(ctx, "Issue"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -307,6 +451,15 @@ func (p *Issue) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField3(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -360,6 +513,62 @@ func (p *Issue) writeField3(ctx context.Context, oprot thrift.TProtocol) (err er
 	return err
 }
 
+func (p *Issue) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetCode() {
+		if err := oprot.WriteFieldBegin(ctx, "Code", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Code: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Code)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Code (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Code: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Issue) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetParams() {
+		if err := oprot.WriteFieldBegin(ctx, "Params", thrift.MAP, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Params: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(ctx, thrift.STRING, thrift.STRING, len(p.Params)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.Params {
+			if err := oprot.WriteString(ctx, string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteString(ctx, string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(ctx); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Params: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Issue) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemediation() {
+		if err := oprot.WriteFieldBegin(ctx, "Remediation", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Remediation: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Remediation)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Remediation (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Remediation: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *Issue) Equals(other *Issue) bool {
 	if p == other {
 		return true
@@ -380,6 +589,31 @@ func (p *Issue) Equals(other *Issue) bool {
 			return false
 		}
 	}
+	if p.Code != other.Code {
+		if p.Code == nil || other.Code == nil {
+			return false
+		}
+		if (*p.Code) != (*other.Code) {
+			return false
+		}
+	}
+	if len(p.Params) != len(other.Params) {
+		return false
+	}
+	for k, _tgt := range p.Params {
+		_src2 := other.Params[k]
+		if _tgt != _src2 {
+			return false
+		}
+	}
+	if p.Remediation != other.Remediation {
+		if p.Remediation == nil || other.Remediation == nil {
+			return false
+		}
+		if (*p.Remediation) != (*other.Remediation) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -397,13 +631,39 @@ func (p *Issue) String() string {
 //   - PSPSignature
 //   - BIOSRTMVolume
 //   - APCBSecurityTokens
+//   - IntelFlashDescriptor
+//   - IntelME
+//   - PSPSecurityPatchLevel
+//   - PSBFuses
+//   - VulnerableModules
+//   - FlashDegradation
+//   - FirmwareProvenance
+//   - OptionROMs
+//   - BootChain
+//   - ACPITables
+//   - TXTErrors
+//   - ImageDiff
+//   - Plugin
 type ReportInfo struct {
-	DiffMeasuredBoot   *diffanalysis.CustomReport         `thrift:"DiffMeasuredBoot,1" db:"DiffMeasuredBoot" json:"DiffMeasuredBoot,omitempty"`
-	IntelACM           *intelacmanalysis.IntelACMDiagInfo `thrift:"IntelACM,2" db:"IntelACM" json:"IntelACM,omitempty"`
-	ReproducePCR       *reproducepcranalysis.CustomReport `thrift:"ReproducePCR,3" db:"ReproducePCR" json:"ReproducePCR,omitempty"`
-	PSPSignature       *pspsignanalysis.CustomReport      `thrift:"PSPSignature,4" db:"PSPSignature" json:"PSPSignature,omitempty"`
-	BIOSRTMVolume      *biosrtmanalysis.CustomReport      `thrift:"BIOSRTMVolume,5" db:"BIOSRTMVolume" json:"BIOSRTMVolume,omitempty"`
-	APCBSecurityTokens *apcbsecanalysis.CustomReport      `thrift:"APCBSecurityTokens,6" db:"APCBSecurityTokens" json:"APCBSecurityTokens,omitempty"`
+	DiffMeasuredBoot      *diffanalysis.CustomReport               `thrift:"DiffMeasuredBoot,1" db:"DiffMeasuredBoot" json:"DiffMeasuredBoot,omitempty"`
+	IntelACM              *intelacmanalysis.IntelACMDiagInfo       `thrift:"IntelACM,2" db:"IntelACM" json:"IntelACM,omitempty"`
+	ReproducePCR          *reproducepcranalysis.CustomReport       `thrift:"ReproducePCR,3" db:"ReproducePCR" json:"ReproducePCR,omitempty"`
+	PSPSignature          *pspsignanalysis.CustomReport            `thrift:"PSPSignature,4" db:"PSPSignature" json:"PSPSignature,omitempty"`
+	BIOSRTMVolume         *biosrtmanalysis.CustomReport            `thrift:"BIOSRTMVolume,5" db:"BIOSRTMVolume" json:"BIOSRTMVolume,omitempty"`
+	APCBSecurityTokens    *apcbsecanalysis.CustomReport            `thrift:"APCBSecurityTokens,6" db:"APCBSecurityTokens" json:"APCBSecurityTokens,omitempty"`
+	IntelFlashDescriptor  *intelifdanalysis.CustomReport           `thrift:"IntelFlashDescriptor,7" db:"IntelFlashDescriptor" json:"IntelFlashDescriptor,omitempty"`
+	IntelME               *intelmeanalysis.CustomReport            `thrift:"IntelME,8" db:"IntelME" json:"IntelME,omitempty"`
+	PSPSecurityPatchLevel *pspsplanalysis.CustomReport             `thrift:"PSPSecurityPatchLevel,9" db:"PSPSecurityPatchLevel" json:"PSPSecurityPatchLevel,omitempty"`
+	PSBFuses              *psbfusesanalysis.CustomReport           `thrift:"PSBFuses,10" db:"PSBFuses" json:"PSBFuses,omitempty"`
+	VulnerableModules     *vulnmodulesanalysis.CustomReport        `thrift:"VulnerableModules,11" db:"VulnerableModules" json:"VulnerableModules,omitempty"`
+	FlashDegradation      *flashdegradationanalysis.CustomReport   `thrift:"FlashDegradation,12" db:"FlashDegradation" json:"FlashDegradation,omitempty"`
+	FirmwareProvenance    *firmwareprovenanceanalysis.CustomReport `thrift:"FirmwareProvenance,13" db:"FirmwareProvenance" json:"FirmwareProvenance,omitempty"`
+	OptionROMs            *optionromsanalysis.CustomReport         `thrift:"OptionROMs,14" db:"OptionROMs" json:"OptionROMs,omitempty"`
+	BootChain             *bootchainanalysis.CustomReport          `thrift:"BootChain,15" db:"BootChain" json:"BootChain,omitempty"`
+	ACPITables            *acpitablesanalysis.CustomReport         `thrift:"ACPITables,16" db:"ACPITables" json:"ACPITables,omitempty"`
+	TXTErrors             *txterrorsanalysis.CustomReport          `thrift:"TXTErrors,17" db:"TXTErrors" json:"TXTErrors,omitempty"`
+	ImageDiff             *imagediffanalysis.CustomReport          `thrift:"ImageDiff,18" db:"ImageDiff" json:"ImageDiff,omitempty"`
+	Plugin                *pluginanalysis.CustomReport             `thrift:"Plugin,19" db:"Plugin" json:"Plugin,omitempty"`
 }
 
 func NewReportInfo() *ReportInfo {
@@ -463,6 +723,123 @@ func (p *ReportInfo) GetAPCBSecurityTokens() *apcbsecanalysis.CustomReport {
 	}
 	return p.APCBSecurityTokens
 }
+
+var ReportInfo_IntelFlashDescriptor_DEFAULT *intelifdanalysis.CustomReport
+
+func (p *ReportInfo) GetIntelFlashDescriptor() *intelifdanalysis.CustomReport {
+	if !p.IsSetIntelFlashDescriptor() {
+		return ReportInfo_IntelFlashDescriptor_DEFAULT
+	}
+	return p.IntelFlashDescriptor
+}
+
+var ReportInfo_IntelME_DEFAULT *intelmeanalysis.CustomReport
+
+func (p *ReportInfo) GetIntelME() *intelmeanalysis.CustomReport {
+	if !p.IsSetIntelME() {
+		return ReportInfo_IntelME_DEFAULT
+	}
+	return p.IntelME
+}
+
+var ReportInfo_PSPSecurityPatchLevel_DEFAULT *pspsplanalysis.CustomReport
+
+func (p *ReportInfo) GetPSPSecurityPatchLevel() *pspsplanalysis.CustomReport {
+	if !p.IsSetPSPSecurityPatchLevel() {
+		return ReportInfo_PSPSecurityPatchLevel_DEFAULT
+	}
+	return p.PSPSecurityPatchLevel
+}
+
+var ReportInfo_PSBFuses_DEFAULT *psbfusesanalysis.CustomReport
+
+func (p *ReportInfo) GetPSBFuses() *psbfusesanalysis.CustomReport {
+	if !p.IsSetPSBFuses() {
+		return ReportInfo_PSBFuses_DEFAULT
+	}
+	return p.PSBFuses
+}
+
+var ReportInfo_VulnerableModules_DEFAULT *vulnmodulesanalysis.CustomReport
+
+func (p *ReportInfo) GetVulnerableModules() *vulnmodulesanalysis.CustomReport {
+	if !p.IsSetVulnerableModules() {
+		return ReportInfo_VulnerableModules_DEFAULT
+	}
+	return p.VulnerableModules
+}
+
+var ReportInfo_FlashDegradation_DEFAULT *flashdegradationanalysis.CustomReport
+
+func (p *ReportInfo) GetFlashDegradation() *flashdegradationanalysis.CustomReport {
+	if !p.IsSetFlashDegradation() {
+		return ReportInfo_FlashDegradation_DEFAULT
+	}
+	return p.FlashDegradation
+}
+
+var ReportInfo_FirmwareProvenance_DEFAULT *firmwareprovenanceanalysis.CustomReport
+
+func (p *ReportInfo) GetFirmwareProvenance() *firmwareprovenanceanalysis.CustomReport {
+	if !p.IsSetFirmwareProvenance() {
+		return ReportInfo_FirmwareProvenance_DEFAULT
+	}
+	return p.FirmwareProvenance
+}
+
+var ReportInfo_OptionROMs_DEFAULT *optionromsanalysis.CustomReport
+
+func (p *ReportInfo) GetOptionROMs() *optionromsanalysis.CustomReport {
+	if !p.IsSetOptionROMs() {
+		return ReportInfo_OptionROMs_DEFAULT
+	}
+	return p.OptionROMs
+}
+
+var ReportInfo_BootChain_DEFAULT *bootchainanalysis.CustomReport
+
+func (p *ReportInfo) GetBootChain() *bootchainanalysis.CustomReport {
+	if !p.IsSetBootChain() {
+		return ReportInfo_BootChain_DEFAULT
+	}
+	return p.BootChain
+}
+
+var ReportInfo_ACPITables_DEFAULT *acpitablesanalysis.CustomReport
+
+func (p *ReportInfo) GetACPITables() *acpitablesanalysis.CustomReport {
+	if !p.IsSetACPITables() {
+		return ReportInfo_ACPITables_DEFAULT
+	}
+	return p.ACPITables
+}
+
+var ReportInfo_TXTErrors_DEFAULT *txterrorsanalysis.CustomReport
+
+func (p *ReportInfo) GetTXTErrors() *txterrorsanalysis.CustomReport {
+	if !p.IsSetTXTErrors() {
+		return ReportInfo_TXTErrors_DEFAULT
+	}
+	return p.TXTErrors
+}
+
+var ReportInfo_ImageDiff_DEFAULT *imagediffanalysis.CustomReport
+
+func (p *ReportInfo) GetImageDiff() *imagediffanalysis.CustomReport {
+	if !p.IsSetImageDiff() {
+		return ReportInfo_ImageDiff_DEFAULT
+	}
+	return p.ImageDiff
+}
+
+var ReportInfo_Plugin_DEFAULT *pluginanalysis.CustomReport
+
+func (p *ReportInfo) GetPlugin() *pluginanalysis.CustomReport {
+	if !p.IsSetPlugin() {
+		return ReportInfo_Plugin_DEFAULT
+	}
+	return p.Plugin
+}
 func (p *ReportInfo) CountSetFieldsReportInfo() int {
 	count := 0
 	if p.IsSetDiffMeasuredBoot() {
@@ -483,6 +860,45 @@ func (p *ReportInfo) CountSetFieldsReportInfo() int {
 	if p.IsSetAPCBSecurityTokens() {
 		count++
 	}
+	if p.IsSetIntelFlashDescriptor() {
+		count++
+	}
+	if p.IsSetIntelME() {
+		count++
+	}
+	if p.IsSetPSPSecurityPatchLevel() {
+		count++
+	}
+	if p.IsSetPSBFuses() {
+		count++
+	}
+	if p.IsSetVulnerableModules() {
+		count++
+	}
+	if p.IsSetFlashDegradation() {
+		count++
+	}
+	if p.IsSetFirmwareProvenance() {
+		count++
+	}
+	if p.IsSetOptionROMs() {
+		count++
+	}
+	if p.IsSetBootChain() {
+		count++
+	}
+	if p.IsSetACPITables() {
+		count++
+	}
+	if p.IsSetTXTErrors() {
+		count++
+	}
+	if p.IsSetImageDiff() {
+		count++
+	}
+	if p.IsSetPlugin() {
+		count++
+	}
 	return count
 
 }
@@ -511,6 +927,58 @@ func (p *ReportInfo) IsSetAPCBSecurityTokens() bool {
 	return p.APCBSecurityTokens != nil
 }
 
+func (p *ReportInfo) IsSetIntelFlashDescriptor() bool {
+	return p.IntelFlashDescriptor != nil
+}
+
+func (p *ReportInfo) IsSetIntelME() bool {
+	return p.IntelME != nil
+}
+
+func (p *ReportInfo) IsSetPSPSecurityPatchLevel() bool {
+	return p.PSPSecurityPatchLevel != nil
+}
+
+func (p *ReportInfo) IsSetPSBFuses() bool {
+	return p.PSBFuses != nil
+}
+
+func (p *ReportInfo) IsSetVulnerableModules() bool {
+	return p.VulnerableModules != nil
+}
+
+func (p *ReportInfo) IsSetFlashDegradation() bool {
+	return p.FlashDegradation != nil
+}
+
+func (p *ReportInfo) IsSetFirmwareProvenance() bool {
+	return p.FirmwareProvenance != nil
+}
+
+func (p *ReportInfo) IsSetOptionROMs() bool {
+	return p.OptionROMs != nil
+}
+
+func (p *ReportInfo) IsSetBootChain() bool {
+	return p.BootChain != nil
+}
+
+func (p *ReportInfo) IsSetACPITables() bool {
+	return p.ACPITables != nil
+}
+
+func (p *ReportInfo) IsSetTXTErrors() bool {
+	return p.TXTErrors != nil
+}
+
+func (p *ReportInfo) IsSetImageDiff() bool {
+	return p.ImageDiff != nil
+}
+
+func (p *ReportInfo) IsSetPlugin() bool {
+	return p.Plugin != nil
+}
+
 func (p *ReportInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -585,23 +1053,153 @@ func (p *ReportInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
 					return err
 				}
 			}
-		default:
-			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-				return err
+		case 7:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
 			}
-		}
-		if err := iprot.ReadFieldEnd(ctx); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
-}
-
-func (p *ReportInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	p.DiffMeasuredBoot = &diffanalysis.CustomReport{}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField10(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField11(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 12:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField12(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 13:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField13(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 14:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField14(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 15:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField15(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 16:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField16(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 17:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField17(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 18:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField18(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 19:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField19(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.DiffMeasuredBoot = &diffanalysis.CustomReport{}
 	if err := p.DiffMeasuredBoot.Read(ctx, iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DiffMeasuredBoot), err)
 	}
@@ -648,6 +1246,110 @@ func (p *ReportInfo) ReadField6(ctx context.Context, iprot thrift.TProtocol) err
 	return nil
 }
 
+func (p *ReportInfo) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	p.IntelFlashDescriptor = &intelifdanalysis.CustomReport{}
+	if err := p.IntelFlashDescriptor.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IntelFlashDescriptor), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	p.IntelME = &intelmeanalysis.CustomReport{}
+	if err := p.IntelME.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IntelME), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PSPSecurityPatchLevel = &pspsplanalysis.CustomReport{}
+	if err := p.PSPSecurityPatchLevel.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PSPSecurityPatchLevel), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField10(ctx context.Context, iprot thrift.TProtocol) error {
+	p.PSBFuses = &psbfusesanalysis.CustomReport{}
+	if err := p.PSBFuses.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PSBFuses), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField11(ctx context.Context, iprot thrift.TProtocol) error {
+	p.VulnerableModules = &vulnmodulesanalysis.CustomReport{}
+	if err := p.VulnerableModules.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.VulnerableModules), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField12(ctx context.Context, iprot thrift.TProtocol) error {
+	p.FlashDegradation = &flashdegradationanalysis.CustomReport{}
+	if err := p.FlashDegradation.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FlashDegradation), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField13(ctx context.Context, iprot thrift.TProtocol) error {
+	p.FirmwareProvenance = &firmwareprovenanceanalysis.CustomReport{}
+	if err := p.FirmwareProvenance.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FirmwareProvenance), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField14(ctx context.Context, iprot thrift.TProtocol) error {
+	p.OptionROMs = &optionromsanalysis.CustomReport{}
+	if err := p.OptionROMs.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.OptionROMs), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField15(ctx context.Context, iprot thrift.TProtocol) error {
+	p.BootChain = &bootchainanalysis.CustomReport{}
+	if err := p.BootChain.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BootChain), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField16(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ACPITables = &acpitablesanalysis.CustomReport{}
+	if err := p.ACPITables.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ACPITables), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField17(ctx context.Context, iprot thrift.TProtocol) error {
+	p.TXTErrors = &txterrorsanalysis.CustomReport{}
+	if err := p.TXTErrors.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TXTErrors), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField18(ctx context.Context, iprot thrift.TProtocol) error {
+	p.ImageDiff = &imagediffanalysis.CustomReport{}
+	if err := p.ImageDiff.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ImageDiff), err)
+	}
+	return nil
+}
+
+func (p *ReportInfo) ReadField19(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Plugin = &pluginanalysis.CustomReport{}
+	if err := p.Plugin.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Plugin), err)
+	}
+	return nil
+}
+
 func (p *ReportInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
 	if c := p.CountSetFieldsReportInfo(); c != 1 {
 		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
@@ -674,6 +1376,45 @@ func (p *ReportInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
 		if err := p.writeField6(ctx, oprot); err != nil {
 			return err
 		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField10(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField11(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField12(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField13(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField14(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField15(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField16(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField17(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField18(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField19(ctx, oprot); err != nil {
+			return err
+		}
 	}
 	if err := oprot.WriteFieldStop(ctx); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -774,6 +1515,201 @@ func (p *ReportInfo) writeField6(ctx context.Context, oprot thrift.TProtocol) (e
 	return err
 }
 
+func (p *ReportInfo) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntelFlashDescriptor() {
+		if err := oprot.WriteFieldBegin(ctx, "IntelFlashDescriptor", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:IntelFlashDescriptor: ", p), err)
+		}
+		if err := p.IntelFlashDescriptor.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IntelFlashDescriptor), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:IntelFlashDescriptor: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntelME() {
+		if err := oprot.WriteFieldBegin(ctx, "IntelME", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:IntelME: ", p), err)
+		}
+		if err := p.IntelME.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IntelME), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:IntelME: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPSPSecurityPatchLevel() {
+		if err := oprot.WriteFieldBegin(ctx, "PSPSecurityPatchLevel", thrift.STRUCT, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:PSPSecurityPatchLevel: ", p), err)
+		}
+		if err := p.PSPSecurityPatchLevel.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PSPSecurityPatchLevel), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:PSPSecurityPatchLevel: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField10(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPSBFuses() {
+		if err := oprot.WriteFieldBegin(ctx, "PSBFuses", thrift.STRUCT, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:PSBFuses: ", p), err)
+		}
+		if err := p.PSBFuses.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PSBFuses), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:PSBFuses: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField11(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVulnerableModules() {
+		if err := oprot.WriteFieldBegin(ctx, "VulnerableModules", thrift.STRUCT, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:VulnerableModules: ", p), err)
+		}
+		if err := p.VulnerableModules.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.VulnerableModules), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:VulnerableModules: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField12(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFlashDegradation() {
+		if err := oprot.WriteFieldBegin(ctx, "FlashDegradation", thrift.STRUCT, 12); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:FlashDegradation: ", p), err)
+		}
+		if err := p.FlashDegradation.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FlashDegradation), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:FlashDegradation: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField13(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFirmwareProvenance() {
+		if err := oprot.WriteFieldBegin(ctx, "FirmwareProvenance", thrift.STRUCT, 13); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:FirmwareProvenance: ", p), err)
+		}
+		if err := p.FirmwareProvenance.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FirmwareProvenance), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 13:FirmwareProvenance: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField14(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOptionROMs() {
+		if err := oprot.WriteFieldBegin(ctx, "OptionROMs", thrift.STRUCT, 14); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 14:OptionROMs: ", p), err)
+		}
+		if err := p.OptionROMs.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.OptionROMs), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 14:OptionROMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField15(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBootChain() {
+		if err := oprot.WriteFieldBegin(ctx, "BootChain", thrift.STRUCT, 15); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 15:BootChain: ", p), err)
+		}
+		if err := p.BootChain.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BootChain), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 15:BootChain: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField16(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetACPITables() {
+		if err := oprot.WriteFieldBegin(ctx, "ACPITables", thrift.STRUCT, 16); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 16:ACPITables: ", p), err)
+		}
+		if err := p.ACPITables.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ACPITables), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 16:ACPITables: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField17(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTXTErrors() {
+		if err := oprot.WriteFieldBegin(ctx, "TXTErrors", thrift.STRUCT, 17); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 17:TXTErrors: ", p), err)
+		}
+		if err := p.TXTErrors.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TXTErrors), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 17:TXTErrors: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField18(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetImageDiff() {
+		if err := oprot.WriteFieldBegin(ctx, "ImageDiff", thrift.STRUCT, 18); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 18:ImageDiff: ", p), err)
+		}
+		if err := p.ImageDiff.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ImageDiff), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 18:ImageDiff: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReportInfo) writeField19(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetPlugin() {
+		if err := oprot.WriteFieldBegin(ctx, "Plugin", thrift.STRUCT, 19); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 19:Plugin: ", p), err)
+		}
+		if err := p.Plugin.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Plugin), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 19:Plugin: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *ReportInfo) Equals(other *ReportInfo) bool {
 	if p == other {
 		return true
@@ -798,6 +1734,45 @@ func (p *ReportInfo) Equals(other *ReportInfo) bool {
 	if !p.APCBSecurityTokens.Equals(other.APCBSecurityTokens) {
 		return false
 	}
+	if !p.IntelFlashDescriptor.Equals(other.IntelFlashDescriptor) {
+		return false
+	}
+	if !p.IntelME.Equals(other.IntelME) {
+		return false
+	}
+	if !p.PSPSecurityPatchLevel.Equals(other.PSPSecurityPatchLevel) {
+		return false
+	}
+	if !p.PSBFuses.Equals(other.PSBFuses) {
+		return false
+	}
+	if !p.VulnerableModules.Equals(other.VulnerableModules) {
+		return false
+	}
+	if !p.FlashDegradation.Equals(other.FlashDegradation) {
+		return false
+	}
+	if !p.FirmwareProvenance.Equals(other.FirmwareProvenance) {
+		return false
+	}
+	if !p.OptionROMs.Equals(other.OptionROMs) {
+		return false
+	}
+	if !p.BootChain.Equals(other.BootChain) {
+		return false
+	}
+	if !p.ACPITables.Equals(other.ACPITables) {
+		return false
+	}
+	if !p.TXTErrors.Equals(other.TXTErrors) {
+		return false
+	}
+	if !p.ImageDiff.Equals(other.ImageDiff) {
+		return false
+	}
+	if !p.Plugin.Equals(other.Plugin) {
+		return false
+	}
 	return true
 }
 
@@ -929,11 +1904,11 @@ func (p *AnalyzerReport) ReadField2(ctx context.Context, iprot thrift.TProtocol)
 	tSlice := make([]*Issue, 0, size)
 	p.Issues = tSlice
 	for i := 0; i < size; i++ {
-		_elem0 := &Issue{}
-		if err := _elem0.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+		_elem3 := &Issue{}
+		if err := _elem3.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
 		}
-		p.Issues = append(p.Issues, _elem0)
+		p.Issues = append(p.Issues, _elem3)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -949,13 +1924,13 @@ func (p *AnalyzerReport) ReadField3(ctx context.Context, iprot thrift.TProtocol)
 	tSlice := make([]string, 0, size)
 	p.Comments = tSlice
 	for i := 0; i < size; i++ {
-		var _elem1 string
+		var _elem4 string
 		if v, err := iprot.ReadString(ctx); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_elem1 = v
+			_elem4 = v
 		}
-		p.Comments = append(p.Comments, _elem1)
+		p.Comments = append(p.Comments, _elem4)
 	}
 	if err := iprot.ReadListEnd(ctx); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -1061,8 +2036,8 @@ func (p *AnalyzerReport) Equals(other *AnalyzerReport) bool {
 		return false
 	}
 	for i, _tgt := range p.Issues {
-		_src2 := other.Issues[i]
-		if !_tgt.Equals(_src2) {
+		_src5 := other.Issues[i]
+		if !_tgt.Equals(_src5) {
 			return false
 		}
 	}
@@ -1070,8 +2045,8 @@ func (p *AnalyzerReport) Equals(other *AnalyzerReport) bool {
 		return false
 	}
 	for i, _tgt := range p.Comments {
-		_src3 := other.Comments[i]
-		if _tgt != _src3 {
+		_src6 := other.Comments[i]
+		if _tgt != _src6 {
 			return false
 		}
 	}
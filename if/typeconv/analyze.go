@@ -18,14 +18,28 @@ import (
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerreport"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables/report/generated/acpitablesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens/report/generated/apcbsecanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume/report/generated/biosrtmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature/report/generated/pspsignanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl/report/generated/pspsplanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain/report/generated/bootchainanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance/report/generated/firmwareprovenanceanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff/report/generated/imagediffanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm/report/generated/intelacmanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme/report/generated/intelmeanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms/report/generated/optionromsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/report/generated/pluginanalysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr/report/generated/reproducepcranalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors/report/generated/txterrorsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules/report/generated/vulnmodulesanalysis"
 	controllererrors "github.com/immune-gmbh/attestation-sdk/pkg/server/controller/errors"
 	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
+	"github.com/immune-gmbh/attestation-sdk/pkg/verdict"
 )
 
 const (
@@ -41,9 +55,89 @@ func ToThriftAnalyzeReport(report *models.AnalyzeReport) *afas.AnalyzeResult_ {
 	for _, report := range report.AnalyzerReports {
 		result.Results = append(result.Results, ToThriftAnalyzerReport(report))
 	}
+	if report.Verdict != nil {
+		result.Verdict = ToThriftHostVerdict(*report.Verdict)
+	}
+	return result
+}
+
+// ToThriftHostVerdict converts verdict.Verdict to the Thrift representation of it.
+func ToThriftHostVerdict(v verdict.Verdict) *afas.HostVerdict {
+	// The class is validated by verdict.Policy, so an error here means
+	// a corrupted stored verdict and there is no better value to report.
+	class, _ := ToThriftHostVerdictClass(v.Class)
+	result := &afas.HostVerdict{
+		VerdictClass: class,
+		Confidence:   v.Confidence,
+		Evidence:     make([]*afas.HostVerdictEvidence, 0, len(v.Evidence)),
+	}
+	for _, evidence := range v.Evidence {
+		class, _ := ToThriftHostVerdictClass(evidence.Class)
+		severity, _ := ToThriftAnalysisSeverity(evidence.Severity)
+		item := &afas.HostVerdictEvidence{
+			AnalyzerName: string(evidence.AnalyzerID),
+			Severity:     severity,
+			Rule:         evidence.Rule,
+			VerdictClass: class,
+			Confidence:   evidence.Confidence,
+		}
+		if len(evidence.IssueCode) > 0 {
+			item.IssueCode = &[]string{string(evidence.IssueCode)}[0]
+		}
+		if len(evidence.Description) > 0 {
+			item.Description = &[]string{evidence.Description}[0]
+		}
+		result.Evidence = append(result.Evidence, item)
+	}
+	return result
+}
+
+// ToThriftAnalyzeProfile converts analysis.Profile to the Thrift representation of it.
+//
+// The tree of entries is flattened depth-first. A snapshot of the profile
+// is converted, so the profile may still be modified concurrently.
+func ToThriftAnalyzeProfile(profile *analysis.Profile) *afas.AnalyzeProfile {
+	profile = profile.Snapshot()
+	result := &afas.AnalyzeProfile{}
+	var addEntries func(entries []*analysis.ProfileEntry, parentIndex *int32)
+	addEntries = func(entries []*analysis.ProfileEntry, parentIndex *int32) {
+		for _, entry := range entries {
+			kind, _ := afas.ProfileEntryKindFromString(string(entry.Kind))
+			item := &afas.ProfileEntry{
+				Kind:                kind,
+				Name:                entry.Name,
+				ParentIndex:         parentIndex,
+				StartNanoseconds:    entry.Start.Nanoseconds(),
+				DurationNanoseconds: entry.Duration.Nanoseconds(),
+				AllocatedBytes:      int64(entry.AllocatedBytes),
+			}
+			if entry.Cache != "" {
+				if cache, err := afas.ProfileCacheResult_FromString(string(entry.Cache)); err == nil {
+					item.Cache = &cache
+				}
+			}
+			if entry.Error != "" {
+				item.Error = &[]string{entry.Error}[0]
+			}
+			index := int32(len(result.Entries))
+			result.Entries = append(result.Entries, item)
+			addEntries(entry.Children, &index)
+		}
+	}
+	addEntries(profile.Entries, nil)
 	return result
 }
 
+// ToThriftHostVerdictClass converts verdict.Class to the Thrift representation of it.
+func ToThriftHostVerdictClass(class verdict.Class) (afas.HostVerdictClass, error) {
+	return afas.HostVerdictClassFromString(string(class))
+}
+
+// FromThriftHostVerdictClass converts the Thrift representation of a verdict class to verdict.Class.
+func FromThriftHostVerdictClass(class afas.HostVerdictClass) (verdict.Class, error) {
+	return verdict.ParseClass(class.String())
+}
+
 // ToThriftAnalysisIssue converts internal analysis.Issue structure to the Thrift representation of it.
 func ToThriftAnalysisIssue(issue analysis.Issue) (*analyzerreport.Issue, error) {
 	var result analyzerreport.Issue
@@ -54,12 +148,42 @@ func ToThriftAnalysisIssue(issue analysis.Issue) (*analyzerreport.Issue, error)
 	if len(issue.Description) > 0 {
 		result.Description = &issue.Description
 	}
+	if len(issue.Code) > 0 {
+		result.Code = &[]string{string(issue.Code)}[0]
+	}
+	if len(issue.Params) > 0 {
+		result.Params = issue.Params
+	}
+	if remediation := issue.RemediationHint(); len(remediation) > 0 {
+		result.Remediation = &remediation
+	}
 
 	severity, err := ToThriftAnalysisSeverity(issue.Severity)
 	result.Severity = severity
 	return &result, err
 }
 
+// FromThriftAnalysisIssue converts the Thrift representation of an issue to analysis.Issue.
+func FromThriftAnalysisIssue(issue *analyzerreport.Issue) (analysis.Issue, error) {
+	severity, err := FromThriftAnalysisSeverity(issue.GetSeverity())
+	if err != nil {
+		return analysis.Issue{}, err
+	}
+	code := analysis.IssueCode(issue.GetCode())
+	if len(code) > 0 {
+		if err := code.Validate(); err != nil {
+			return analysis.Issue{}, err
+		}
+	}
+	return analysis.Issue{
+		Severity:    severity,
+		Description: issue.GetDescription(),
+		Code:        code,
+		Params:      issue.GetParams(),
+		Remediation: issue.GetRemediation(),
+	}, nil
+}
+
 // ToThriftAnalyzerReport converts internal storage.AnalyzerResult structure to the Thrift representation of it.
 func ToThriftAnalyzerReport(report models.AnalyzerReport) *afas.AnalyzerResult_ {
 	// note: inputJSON is not mandatory to fill in the result
@@ -76,6 +200,9 @@ func ToThriftAnalyzerReport(report models.AnalyzerReport) *afas.AnalyzerResult_
 		AnalyzerOutcome:    &afas.AnalyzerOutcome{},
 		ProcessedInputJSON: &[]string{string(inputJSON)}[0],
 	}
+	if report.AnalyzerVersion != "" {
+		result.AnalyzerVersion = &[]string{string(report.AnalyzerVersion)}[0]
+	}
 	outcome := result.AnalyzerOutcome
 	if err := report.ExecError.Err; err != nil {
 		outcome.Err = &afas.Error{
@@ -107,6 +234,32 @@ func ToThriftAnalyzerReport(report models.AnalyzerReport) *afas.AnalyzerResult_
 			reportInfo.BIOSRTMVolume = &v
 		case apcbsecanalysis.CustomReport:
 			reportInfo.APCBSecurityTokens = &v
+		case intelifdanalysis.CustomReport:
+			reportInfo.IntelFlashDescriptor = &v
+		case intelmeanalysis.CustomReport:
+			reportInfo.IntelME = &v
+		case pspsplanalysis.CustomReport:
+			reportInfo.PSPSecurityPatchLevel = &v
+		case psbfusesanalysis.CustomReport:
+			reportInfo.PSBFuses = &v
+		case vulnmodulesanalysis.CustomReport:
+			reportInfo.VulnerableModules = &v
+		case flashdegradationanalysis.CustomReport:
+			reportInfo.FlashDegradation = &v
+		case firmwareprovenanceanalysis.CustomReport:
+			reportInfo.FirmwareProvenance = &v
+		case optionromsanalysis.CustomReport:
+			reportInfo.OptionROMs = &v
+		case bootchainanalysis.CustomReport:
+			reportInfo.BootChain = &v
+		case acpitablesanalysis.CustomReport:
+			reportInfo.ACPITables = &v
+		case txterrorsanalysis.CustomReport:
+			reportInfo.TXTErrors = &v
+		case imagediffanalysis.CustomReport:
+			reportInfo.ImageDiff = &v
+		case pluginanalysis.CustomReport:
+			reportInfo.Plugin = &v
 		default:
 			outcome.Report = nil
 			outcome.Err = &afas.Error{
@@ -148,6 +301,19 @@ func ToThriftAnalysisSeverity(severity analysis.Severity) (analyzerreport.Severi
 	return analyzerreport.Severity_SeverityCritical, fmt.Errorf("unknown severity %d", severity)
 }
 
+// FromThriftAnalysisSeverity converts the Thrift representation of a severity to analysis.Severity.
+func FromThriftAnalysisSeverity(severity analyzerreport.Severity) (analysis.Severity, error) {
+	switch severity {
+	case analyzerreport.Severity_SeverityCritical:
+		return analysis.SeverityCritical, nil
+	case analyzerreport.Severity_SeverityWarning:
+		return analysis.SeverityWarning, nil
+	case analyzerreport.Severity_SeverityInfo:
+		return analysis.SeverityInfo, nil
+	}
+	return analysis.SeverityCritical, fmt.Errorf("unknown severity %d", severity)
+}
+
 func analyzeExecErrorToClass(err error) afas.ErrorClass {
 	switch {
 	case errors.As(err, &controllererrors.ErrUnknownAnalyzer{}) ||
@@ -156,6 +322,8 @@ func analyzeExecErrorToClass(err error) afas.ErrorClass {
 		return afas.ErrorClass_InvalidInput
 	case errors.As(err, &analysis.ErrNotApplicable{}):
 		return afas.ErrorClass_NotSupported
+	case errors.As(err, &analysis.ErrBudgetExceeded{}):
+		return afas.ErrorClass_BudgetExceeded
 	}
 	return afas.ErrorClass_InternalError
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 	"github.com/immune-gmbh/attestation-sdk/if/generated/measurements"
 	thrift_tpm "github.com/immune-gmbh/attestation-sdk/if/generated/tpm"
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
 
 	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/types"
 	"github.com/9elements/converged-security-suite/v2/pkg/errors"
@@ -210,3 +211,35 @@ func FromThriftTPMEventLog(in *thrift_tpm.EventLog) *tpmeventlog.TPMEventLog {
 
 	return out
 }
+
+// ToThriftACPITables converts ACPI tables to the firmware analysis service format.
+func ToThriftACPITables(tables []acpi.Table) []*afas.ACPITable {
+	if tables == nil {
+		return nil
+	}
+
+	out := make([]*afas.ACPITable, 0, len(tables))
+	for _, table := range tables {
+		out = append(out, &afas.ACPITable{
+			Name: table.Name,
+			Data: table.Data,
+		})
+	}
+	return out
+}
+
+// FromThriftACPITables converts firmware analysis service ACPI tables to the internal format.
+func FromThriftACPITables(tables []*afas.ACPITable) []acpi.Table {
+	if tables == nil {
+		return nil
+	}
+
+	out := make([]acpi.Table, 0, len(tables))
+	for _, table := range tables {
+		out = append(out, acpi.Table{
+			Name: table.Name,
+			Data: table.Data,
+		})
+	}
+	return out
+}
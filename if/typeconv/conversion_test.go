@@ -13,8 +13,13 @@ package typeconv
 
 import (
 	"testing"
+	"time"
 
+	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 	"github.com/immune-gmbh/attestation-sdk/if/generated/measurements"
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/verdict"
 
 	"github.com/9elements/converged-security-suite/v2/pkg/registers"
 	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
@@ -52,6 +57,79 @@ func TestTPMTypeConversion(t *testing.T) {
 	}
 }
 
+func TestHostVerdictClassConversion(t *testing.T) {
+	for _, class := range verdict.Classes() {
+		thriftClass, err := ToThriftHostVerdictClass(class)
+		require.NoError(t, err)
+
+		resultClass, err := FromThriftHostVerdictClass(thriftClass)
+		require.NoError(t, err)
+
+		require.Equal(t, class, resultClass)
+	}
+}
+
+func TestAnalyzeProfileConversion(t *testing.T) {
+	profile := &analysis.Profile{
+		Entries: []*analysis.ProfileEntry{{
+			Kind:     analysis.ProfileEntryKindAnalyzer,
+			Name:     "DiffMeasuredBoot",
+			Duration: 3 * time.Second,
+			Children: []*analysis.ProfileEntry{{
+				Kind:     analysis.ProfileEntryKindCalculator,
+				Name:     "analysis.ActualFirmware",
+				Duration: time.Second,
+				Cache:    analysis.CacheResultMiss,
+				Children: []*analysis.ProfileEntry{{
+					Kind:  analysis.ProfileEntryKindCalculator,
+					Name:  "analysis.ActualFirmwareBlob",
+					Cache: analysis.CacheResultGlobalCache,
+				}},
+			}, {
+				Kind:           analysis.ProfileEntryKindCalculator,
+				Name:           "analysis.AlignedOriginalFirmware",
+				Start:          time.Second,
+				Duration:       time.Second,
+				Cache:          analysis.CacheResultPersistentCache,
+				AllocatedBytes: 1 << 20,
+			}},
+		}, {
+			Kind:  analysis.ProfileEntryKindAnalyzer,
+			Name:  "ReproducePCR",
+			Error: "not applicable",
+		}},
+	}
+
+	result := ToThriftAnalyzeProfile(profile)
+	require.Len(t, result.Entries, 5)
+
+	var names []string
+	var parents []*int32
+	for _, entry := range result.Entries {
+		names = append(names, entry.Name)
+		parents = append(parents, entry.ParentIndex)
+	}
+	require.Equal(t, []string{
+		"DiffMeasuredBoot",
+		"analysis.ActualFirmware",
+		"analysis.ActualFirmwareBlob",
+		"analysis.AlignedOriginalFirmware",
+		"ReproducePCR",
+	}, names)
+	require.Equal(t, []*int32{nil, ptr[int32](0), ptr[int32](1), ptr[int32](0), nil}, parents)
+
+	require.Equal(t, afas.ProfileEntryKind_Calculator, result.Entries[3].Kind)
+	require.Equal(t, afas.ProfileCacheResult__PersistentCache, result.Entries[3].GetCache())
+	require.Equal(t, int64(time.Second), result.Entries[3].StartNanoseconds)
+	require.Equal(t, int64(1<<20), result.Entries[3].AllocatedBytes)
+	require.False(t, result.Entries[0].IsSetCache())
+	require.Equal(t, "not applicable", result.Entries[4].GetError())
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func TestRegistersConversion(t *testing.T) {
 	txtRegister := registers.ParseACMPolicyStatusRegister(12345)
 	acmRegisters := registers.ParseBootGuardPBEC(54321)
@@ -88,3 +166,11 @@ func TestConvertToAFASTPMEventLog(t *testing.T) {
 	resultEventLog := FromThriftTPMEventLog(fasEventLog)
 	require.Equal(t, initial, resultEventLog)
 }
+
+func TestACPITablesConversion(t *testing.T) {
+	initial := []acpi.Table{
+		{Name: "DMAR", Data: []byte("DMAR")},
+		{Name: "SSDT2", Data: []byte("SSDT")},
+	}
+	require.Equal(t, initial, FromThriftACPITables(ToThriftACPITables(initial)))
+}
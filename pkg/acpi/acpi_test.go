@@ -0,0 +1,137 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package acpi
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testTable builds an ACPI table with a valid checksum
+func testTable(signature string, body []byte) []byte {
+	data := make([]byte, HeaderSize)
+	copy(data, signature)
+	binary.LittleEndian.PutUint32(data[4:], uint32(HeaderSize+len(body)))
+	data[8] = 1
+	copy(data[10:], "OEMID ")
+	copy(data[16:], "TABLEID ")
+	data = append(data, body...)
+	var sum uint8
+	for _, b := range data {
+		sum += b
+	}
+	data[9] = -sum
+	return data
+}
+
+func testDMAR() []byte {
+	body := make([]byte, 12)
+	body[0] = 38
+	body[1] = uint8(DMARFlagIntrRemap | DMARFlagDMACtrlPlatformOptIn)
+
+	drhd := make([]byte, 16)
+	binary.LittleEndian.PutUint16(drhd[0:], 0)
+	binary.LittleEndian.PutUint16(drhd[2:], uint16(len(drhd)))
+	body = append(body, drhd...)
+
+	rmrr := make([]byte, 24)
+	binary.LittleEndian.PutUint16(rmrr[0:], 1)
+	binary.LittleEndian.PutUint64(rmrr[8:], 0xE0000)
+	binary.LittleEndian.PutUint64(rmrr[16:], 0xEFFFF)
+	rmrr = append(rmrr, 1, 8, 0, 0, 0, 0, 0x14, 0)
+	rmrr = append(rmrr, 2, 10, 0, 0, 0, 0, 0x1C, 0, 0, 0)
+	binary.LittleEndian.PutUint16(rmrr[2:], uint16(len(rmrr)))
+	body = append(body, rmrr...)
+	return testTable(SignatureDMAR, body)
+}
+
+func TestParseHeader(t *testing.T) {
+	table := Table{Name: "DMAR", Data: testDMAR()}
+	header, err := table.Header()
+	require.NoError(t, err)
+	require.Equal(t, "DMAR", header.Signature)
+	require.Equal(t, uint32(len(table.Data)), header.Length)
+	require.Equal(t, "OEMID", header.OEMID)
+	require.Equal(t, "TABLEID", header.OEMTableID)
+	require.True(t, table.ChecksumValid())
+
+	table.Data[HeaderSize] ^= 0xFF
+	require.False(t, table.ChecksumValid())
+
+	_, err = ParseHeader(make([]byte, HeaderSize-1))
+	require.Error(t, err)
+}
+
+func TestParseDMAR(t *testing.T) {
+	dmar, err := ParseDMAR(testDMAR())
+	require.NoError(t, err)
+	require.Equal(t, uint8(38), dmar.HostAddressWidth)
+	require.NotZero(t, dmar.Flags&DMARFlagDMACtrlPlatformOptIn)
+	require.Equal(t, 1, dmar.DRHDs)
+	require.Len(t, dmar.RMRRs, 1)
+	require.Equal(t, uint64(0xE0000), dmar.RMRRs[0].BaseAddress)
+	require.Equal(t, uint64(0xEFFFF), dmar.RMRRs[0].LimitAddress)
+	require.Len(t, dmar.RMRRs[0].Devices, 2)
+	require.Equal(t, "00:14.0", dmar.RMRRs[0].Devices[0].String())
+	require.Equal(t, "00:1c.0/00.0", dmar.RMRRs[0].Devices[1].String())
+
+	_, err = ParseDMAR(testTable(SignatureIVRS, make([]byte, 12)))
+	require.Error(t, err)
+	truncated := testDMAR()
+	binary.LittleEndian.PutUint16(truncated[dmarHeaderSize+2:], 0xFFF)
+	_, err = ParseDMAR(truncated)
+	require.Error(t, err)
+}
+
+func TestParseIVRS(t *testing.T) {
+	body := make([]byte, 12)
+	binary.LittleEndian.PutUint32(body, uint32(IVRSInfoDMARemapSup))
+
+	ivhd := make([]byte, 24)
+	ivhd[0] = 0x11
+	binary.LittleEndian.PutUint16(ivhd[2:], uint16(len(ivhd)))
+	body = append(body, ivhd...)
+
+	ivmd := make([]byte, 32)
+	ivmd[0] = IVMDTypeRange
+	binary.LittleEndian.PutUint16(ivmd[2:], uint16(len(ivmd)))
+	binary.LittleEndian.PutUint16(ivmd[4:], 0x0008)
+	binary.LittleEndian.PutUint16(ivmd[6:], 0x00FF)
+	binary.LittleEndian.PutUint64(ivmd[16:], 0x9F000000)
+	binary.LittleEndian.PutUint64(ivmd[24:], 0x100000)
+	body = append(body, ivmd...)
+
+	ivrs, err := ParseIVRS(testTable(SignatureIVRS, body))
+	require.NoError(t, err)
+	require.NotZero(t, ivrs.Info&IVRSInfoDMARemapSup)
+	require.Equal(t, 1, ivrs.IVHDs)
+	require.Len(t, ivrs.IVMDs, 1)
+	require.Equal(t, uint64(0x9F000000), ivrs.IVMDs[0].StartAddress)
+	require.Equal(t, "00:01.0-00:1f.7", ivrs.IVMDs[0].Devices())
+}
+
+func TestLoadTablesDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "DMAR"), testDMAR(), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "dynamic"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dynamic", "SSDT14"), testTable("SSDT", nil), 0644))
+
+	tables, err := LoadTablesDir(dir)
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+	require.Equal(t, "DMAR", tables[0].Name)
+}
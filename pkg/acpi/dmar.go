@@ -0,0 +1,167 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package acpi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// SignatureDMAR is the signature of the DMA Remapping table (Intel VT-d).
+const SignatureDMAR = "DMAR"
+
+// DMARFlags are the flags of the DMAR table (Intel VT-d spec, "DMA Remapping Reporting Structure").
+type DMARFlags uint8
+
+const (
+	DMARFlagIntrRemap            = DMARFlags(1 << 0)
+	DMARFlagX2APICOptOut         = DMARFlags(1 << 1)
+	DMARFlagDMACtrlPlatformOptIn = DMARFlags(1 << 2)
+)
+
+// dmarHeaderSize is the size of the DMAR table before the remapping structures.
+const dmarHeaderSize = HeaderSize + 12
+
+// DMAR remapping structure types
+const (
+	dmarStructureDRHD = 0
+	dmarStructureRMRR = 1
+)
+
+// DMAR is the parsed DMA Remapping table.
+type DMAR struct {
+	HostAddressWidth uint8
+	Flags            DMARFlags
+
+	// DRHDs is the amount of DMA remapping hardware units.
+	DRHDs int
+
+	RMRRs []RMRR
+}
+
+// RMRR is a Reserved Memory Region Reporting structure: a memory region
+// the specified devices may access via DMA (bypassing the restrictions
+// of DMA remapping).
+type RMRR struct {
+	Segment      uint16
+	BaseAddress  uint64
+	LimitAddress uint64
+	Devices      []DeviceScope
+}
+
+// DeviceScope is a device scope entry of a DMAR remapping structure.
+type DeviceScope struct {
+	Type          uint8
+	EnumerationID uint8
+	StartBus      uint8
+	Path          []PCIPath
+}
+
+// PCIPath is an element of the hierarchical path of a device scope.
+type PCIPath struct {
+	Device   uint8
+	Function uint8
+}
+
+// String implements fmt.Stringer.
+//
+// The format is "bus:device.function" of the first element of the path
+// followed by "/device.function" of the downstream elements.
+func (scope DeviceScope) String() string {
+	var result strings.Builder
+	for idx, path := range scope.Path {
+		if idx == 0 {
+			fmt.Fprintf(&result, "%02x:%02x.%x", scope.StartBus, path.Device, path.Function)
+			continue
+		}
+		fmt.Fprintf(&result, "/%02x.%x", path.Device, path.Function)
+	}
+	return result.String()
+}
+
+// ParseDMAR parses a DMAR table (including the common header).
+func ParseDMAR(data []byte) (*DMAR, error) {
+	header, err := ParseHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if header.Signature != SignatureDMAR {
+		return nil, fmt.Errorf("invalid signature '%s', expected '%s'", header.Signature, SignatureDMAR)
+	}
+	if int(header.Length) > len(data) || header.Length < dmarHeaderSize {
+		return nil, fmt.Errorf("invalid table length %d (have %d bytes)", header.Length, len(data))
+	}
+	data = data[:header.Length]
+
+	result := &DMAR{
+		HostAddressWidth: data[HeaderSize],
+		Flags:            DMARFlags(data[HeaderSize+1]),
+	}
+	for offset := dmarHeaderSize; offset < len(data); {
+		if offset+4 > len(data) {
+			return nil, fmt.Errorf("truncated remapping structure at offset 0x%X", offset)
+		}
+		structureType := binary.LittleEndian.Uint16(data[offset:])
+		length := int(binary.LittleEndian.Uint16(data[offset+2:]))
+		if length < 4 || offset+length > len(data) {
+			return nil, fmt.Errorf("invalid length %d of remapping structure at offset 0x%X", length, offset)
+		}
+		structure := data[offset : offset+length]
+		offset += length
+
+		switch structureType {
+		case dmarStructureDRHD:
+			result.DRHDs++
+		case dmarStructureRMRR:
+			if len(structure) < 24 {
+				return nil, fmt.Errorf("RMRR structure is too short: %d", len(structure))
+			}
+			devices, err := parseDeviceScopes(structure[24:])
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse the device scopes of RMRR: %w", err)
+			}
+			result.RMRRs = append(result.RMRRs, RMRR{
+				Segment:      binary.LittleEndian.Uint16(structure[6:]),
+				BaseAddress:  binary.LittleEndian.Uint64(structure[8:]),
+				LimitAddress: binary.LittleEndian.Uint64(structure[16:]),
+				Devices:      devices,
+			})
+		}
+	}
+	return result, nil
+}
+
+func parseDeviceScopes(data []byte) ([]DeviceScope, error) {
+	var result []DeviceScope
+	for len(data) > 0 {
+		if len(data) < 6 {
+			return nil, fmt.Errorf("truncated device scope")
+		}
+		length := int(data[1])
+		if length < 6 || length > len(data) || (length-6)%2 != 0 {
+			return nil, fmt.Errorf("invalid device scope length %d", length)
+		}
+		scope := DeviceScope{
+			Type:          data[0],
+			EnumerationID: data[4],
+			StartBus:      data[5],
+		}
+		for idx := 6; idx < length; idx += 2 {
+			scope.Path = append(scope.Path, PCIPath{Device: data[idx], Function: data[idx+1]})
+		}
+		result = append(result, scope)
+		data = data[length:]
+	}
+	return result, nil
+}
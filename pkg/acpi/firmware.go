@@ -0,0 +1,95 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package acpi
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/uefi"
+)
+
+// FindTablesInFirmware returns the ACPI tables embedded into a UEFI firmware
+// image as RAW sections (like the "AcpiTables" file of EDK2-based firmware).
+//
+// Such tables are templates: the firmware may patch them (and recalculate
+// checksums) before installing, and some tables (like DMAR on many
+// platforms) are generated at runtime and are not found in the image at all.
+//
+// Tables are named by signature; repeated signatures get a number suffix
+// starting from 2 (like "SSDT", "SSDT2", ...).
+func FindTablesInFirmware(image []byte) ([]Table, error) {
+	fw, err := uefi.Parse(image, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the firmware image: %w", err)
+	}
+
+	collector := &tableCollector{
+		signatureCount: map[string]int{},
+	}
+	if err := collector.Run(fw.Firmware); err != nil {
+		return nil, err
+	}
+	return collector.Tables, nil
+}
+
+type tableCollector struct {
+	Tables         []Table
+	signatureCount map[string]int
+}
+
+// Run implements fianoUEFI.Visitor
+func (v *tableCollector) Run(f fianoUEFI.Firmware) error {
+	return f.Apply(v)
+}
+
+// Visit implements fianoUEFI.Visitor
+func (v *tableCollector) Visit(f fianoUEFI.Firmware) error {
+	section, ok := f.(*fianoUEFI.Section)
+	if !ok || section.Header.Type != fianoUEFI.SectionTypeRaw {
+		return f.ApplyChildren(v)
+	}
+
+	body := uefi.SectionBody(section)
+	if !looksLikeTable(body) {
+		return nil
+	}
+	length := binary.LittleEndian.Uint32(body[4:])
+	signature := string(body[:4])
+	v.signatureCount[signature]++
+	name := signature
+	if count := v.signatureCount[signature]; count > 1 {
+		name = fmt.Sprintf("%s%d", signature, count)
+	}
+	v.Tables = append(v.Tables, Table{
+		Name: name,
+		Data: append([]byte{}, body[:length]...),
+	})
+	return nil
+}
+
+// looksLikeTable returns true if the data starts with a plausible ACPI table header
+func looksLikeTable(data []byte) bool {
+	if len(data) < HeaderSize {
+		return false
+	}
+	for _, c := range data[:4] {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	length := binary.LittleEndian.Uint32(data[4:])
+	return length >= HeaderSize && int(length) <= len(data)
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package acpi
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// SignatureIVRS is the signature of the I/O Virtualization Reporting Structure (AMD-Vi).
+const SignatureIVRS = "IVRS"
+
+// IVRSInfo is the IVinfo field of the IVRS table (AMD IOMMU spec, "IVRS Header").
+type IVRSInfo uint32
+
+const (
+	IVRSInfoEFRSup = IVRSInfo(1 << 0)
+
+	// IVRSInfoDMARemapSup is the platform opt-in for DMA protection
+	// (the AMD analogue of DMAR DMA_CTRL_PLATFORM_OPT_IN).
+	IVRSInfoDMARemapSup = IVRSInfo(1 << 1)
+)
+
+// ivrsHeaderSize is the size of the IVRS table before the IVHD/IVMD blocks.
+const ivrsHeaderSize = HeaderSize + 12
+
+// IVMD block types
+const (
+	IVMDTypeAll   = 0x20
+	IVMDTypeOne   = 0x21
+	IVMDTypeRange = 0x22
+)
+
+// IVRS is the parsed I/O Virtualization Reporting Structure.
+type IVRS struct {
+	Info IVRSInfo
+
+	// IVHDs is the amount of IOMMU hardware definition blocks.
+	IVHDs int
+
+	IVMDs []IVMD
+}
+
+// IVMD is an I/O Virtualization Memory Definition block: a memory region
+// with special DMA access requirements (the AMD analogue of RMRR).
+type IVMD struct {
+	Type     uint8
+	Flags    uint8
+	DeviceID uint16
+
+	// AuxData is the last device ID of the range for IVMDTypeRange.
+	AuxData uint16
+
+	StartAddress uint64
+	Length       uint64
+}
+
+// Devices returns a text representation of the devices the block applies to.
+func (ivmd IVMD) Devices() string {
+	switch ivmd.Type {
+	case IVMDTypeAll:
+		return "all"
+	case IVMDTypeRange:
+		return formatDeviceID(ivmd.DeviceID) + "-" + formatDeviceID(ivmd.AuxData)
+	}
+	return formatDeviceID(ivmd.DeviceID)
+}
+
+// formatDeviceID formats a PCI requester ID as "bus:device.function"
+func formatDeviceID(id uint16) string {
+	return fmt.Sprintf("%02x:%02x.%x", id>>8, (id>>3)&0x1F, id&0x7)
+}
+
+// ParseIVRS parses an IVRS table (including the common header).
+func ParseIVRS(data []byte) (*IVRS, error) {
+	header, err := ParseHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	if header.Signature != SignatureIVRS {
+		return nil, fmt.Errorf("invalid signature '%s', expected '%s'", header.Signature, SignatureIVRS)
+	}
+	if int(header.Length) > len(data) || header.Length < ivrsHeaderSize {
+		return nil, fmt.Errorf("invalid table length %d (have %d bytes)", header.Length, len(data))
+	}
+	data = data[:header.Length]
+
+	result := &IVRS{
+		Info: IVRSInfo(binary.LittleEndian.Uint32(data[HeaderSize:])),
+	}
+	for offset := ivrsHeaderSize; offset < len(data); {
+		if offset+4 > len(data) {
+			return nil, fmt.Errorf("truncated block at offset 0x%X", offset)
+		}
+		blockType := data[offset]
+		length := int(binary.LittleEndian.Uint16(data[offset+2:]))
+		if length < 4 || offset+length > len(data) {
+			return nil, fmt.Errorf("invalid length %d of block at offset 0x%X", length, offset)
+		}
+		block := data[offset : offset+length]
+		offset += length
+
+		switch blockType {
+		case 0x10, 0x11, 0x40:
+			result.IVHDs++
+		case IVMDTypeAll, IVMDTypeOne, IVMDTypeRange:
+			if len(block) < 32 {
+				return nil, fmt.Errorf("IVMD block is too short: %d", len(block))
+			}
+			result.IVMDs = append(result.IVMDs, IVMD{
+				Type:         blockType,
+				Flags:        block[1],
+				DeviceID:     binary.LittleEndian.Uint16(block[4:]),
+				AuxData:      binary.LittleEndian.Uint16(block[6:]),
+				StartAddress: binary.LittleEndian.Uint64(block[16:]),
+				Length:       binary.LittleEndian.Uint64(block[24:]),
+			})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package acpi parses ACPI tables relevant for the firmware security
+// analysis: the common table header, DMAR (Intel VT-d) and IVRS (AMD-Vi).
+package acpi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultTablesPath is the directory where Linux exposes the ACPI tables
+// provided by the firmware.
+const DefaultTablesPath = "/sys/firmware/acpi/tables"
+
+// HeaderSize is the size of the common ACPI table header
+// (ACPI spec, "System Description Table Header").
+const HeaderSize = 36
+
+// Table is a raw ACPI table.
+type Table struct {
+	// Name identifies the table among the tables of the same host,
+	// for example "DSDT" or "SSDT3" (the file name in sysfs).
+	Name string
+
+	// Data is the whole table including the header.
+	Data []byte
+}
+
+// Header is the common header of ACPI system description tables.
+type Header struct {
+	Signature       string
+	Length          uint32
+	Revision        uint8
+	Checksum        uint8
+	OEMID           string
+	OEMTableID      string
+	OEMRevision     uint32
+	CreatorID       string
+	CreatorRevision uint32
+}
+
+// ParseHeader parses the common header of an ACPI table.
+func ParseHeader(data []byte) (*Header, error) {
+	if len(data) < HeaderSize {
+		return nil, fmt.Errorf("the table is too short for the header: %d < %d", len(data), HeaderSize)
+	}
+	return &Header{
+		Signature:       string(data[0:4]),
+		Length:          binary.LittleEndian.Uint32(data[4:]),
+		Revision:        data[8],
+		Checksum:        data[9],
+		OEMID:           strings.TrimRight(string(data[10:16]), " \x00"),
+		OEMTableID:      strings.TrimRight(string(data[16:24]), " \x00"),
+		OEMRevision:     binary.LittleEndian.Uint32(data[24:]),
+		CreatorID:       strings.TrimRight(string(data[28:32]), " \x00"),
+		CreatorRevision: binary.LittleEndian.Uint32(data[32:]),
+	}, nil
+}
+
+// Header parses the header of the table.
+func (t Table) Header() (*Header, error) {
+	return ParseHeader(t.Data)
+}
+
+// ChecksumValid returns true if all bytes of the table sum to zero
+// (as required by the ACPI spec).
+func (t Table) ChecksumValid() bool {
+	var sum uint8
+	for _, b := range t.Data {
+		sum += b
+	}
+	return sum == 0
+}
+
+// LoadTablesDir reads the ACPI tables from a directory of table dumps
+// (like DefaultTablesPath). Subdirectories (like "dynamic" and "data")
+// are ignored.
+func LoadTablesDir(dir string) ([]Table, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the directory '%s': %w", dir, err)
+	}
+
+	var result []Table
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ACPI table '%s': %w", entry.Name(), err)
+		}
+		result = append(result, Table{
+			Name: entry.Name(),
+			Data: data,
+		})
+	}
+	return result, nil
+}
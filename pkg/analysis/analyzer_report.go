@@ -0,0 +1,175 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// AnalyzerReport is the report of the analyzer with input type analyzerInputType
+// consumed by another analyzer. A field of this type in an analyzer input structure
+// declares the analyzer as a prerequisite, for example:
+//
+//	type Input struct {
+//		ReproducePCRReport *analysis.AnalyzerReport[reproducepcr.Input] `exec:"optional"`
+//	}
+//
+// The report is not calculated by DataCalculator, it should be provided in the Input
+// by the caller, who executes the prerequisites first (see AnalyzerReportProvider).
+// If a required report is not available, then the consuming analyzer is not applicable
+// if the prerequisite is not applicable, and fails otherwise (see ErrPrerequisiteFailed).
+type AnalyzerReport[analyzerInputType any] struct {
+	AnalyzerID      AnalyzerID
+	AnalyzerVersion AnalyzerVersion
+	Report          *Report
+
+	// NotApplicable is set instead of Report if the prerequisite is not applicable.
+	NotApplicable *ErrNotApplicable `json:",omitempty"`
+}
+
+func (r AnalyzerReport[analyzerInputType]) notApplicable() error {
+	if r.NotApplicable == nil {
+		return nil
+	}
+	return *r.NotApplicable
+}
+
+type analyzerReportInterface interface {
+	notApplicable() error
+}
+
+var analyzerReportInterfaceType = reflect.TypeOf((*analyzerReportInterface)(nil)).Elem()
+
+func isAnalyzerReportType(t reflect.Type) bool {
+	return t.Implements(analyzerReportInterfaceType)
+}
+
+// AnalyzerReportProvider is implemented by data calculators which know the analyzers
+// whose reports are consumed by other analyzers (see AnalyzerReport).
+type AnalyzerReportProvider interface {
+	// AnalyzerPrerequisites returns the analyzers whose reports are (transitively)
+	// consumed by the analyzer.
+	AnalyzerPrerequisites(analyzerID AnalyzerID) []AnalyzerID
+
+	// NewAnalyzerReportValue wraps the result of the analyzer into an AnalyzerReport
+	// value, which could be added to the Input of dependent analyzers through Input.AddCustomValue.
+	//
+	// execErr is the error returned by the analyzer. Only ErrNotApplicable is passed
+	// to dependent analyzers, false is returned for other errors.
+	NewAnalyzerReportValue(analyzerID AnalyzerID, version AnalyzerVersion, report *Report, execErr error) (any, bool)
+}
+
+var _ AnalyzerReportProvider = (*DataCalculator)(nil)
+
+type analyzerReportType struct {
+	inputType  reflect.Type
+	reportType reflect.Type
+}
+
+// RegisterAnalyzerReport allows other analyzers to consume the reports of the analyzer
+// through input values of type AnalyzerReport[inputType].
+//
+// Reports of different analyzers with the same input type cannot be distinguished,
+// so it is not allowed to register them both.
+func RegisterAnalyzerReport[inputType any](dc *DataCalculator, analyzer Analyzer[inputType]) error {
+	analyzerID := analyzer.ID()
+	reportType := reflect.TypeOf(AnalyzerReport[inputType]{})
+	if prevID, ok := dc.analyzerReportTypes[reportType]; ok && prevID != analyzerID {
+		return fmt.Errorf("reports of analyzers with input type %s are already provided by analyzer '%s'", reportType, prevID)
+	}
+
+	dc.analyzerReportTypes[reportType] = analyzerID
+	dc.analyzerReports[analyzerID] = analyzerReportType{
+		inputType:  reflect.TypeOf((*inputType)(nil)).Elem(),
+		reportType: reportType,
+	}
+	if err := dc.checkCycles(reportType); err != nil {
+		delete(dc.analyzerReportTypes, reportType)
+		delete(dc.analyzerReports, analyzerID)
+		return fmt.Errorf("unable to register the report of analyzer '%s': %w", analyzerID, err)
+	}
+
+	RegisterType((*AnalyzerReport[inputType])(nil))
+	return nil
+}
+
+// AnalyzerPrerequisites implements AnalyzerReportProvider.
+//
+// Only analyzers registered through RegisterAnalyzerReport are known.
+func (dc *DataCalculator) AnalyzerPrerequisites(analyzerID AnalyzerID) []AnalyzerID {
+	analyzerReport, ok := dc.analyzerReports[analyzerID]
+	if !ok {
+		return nil
+	}
+
+	prerequisites := map[AnalyzerID]struct{}{}
+	visited := map[reflect.Type]bool{}
+	var visit func(deps []dependency)
+	visit = func(deps []dependency) {
+		for _, dep := range deps {
+			if visited[dep.Type] {
+				continue
+			}
+			visited[dep.Type] = true
+			if id, ok := dc.analyzerReportTypes[dep.Type]; ok {
+				prerequisites[id] = struct{}{}
+			}
+			visit(dc.dependencies(dep.Type))
+		}
+	}
+	visit(structDependencies(analyzerReport.inputType))
+	delete(prerequisites, analyzerID)
+
+	result := make([]AnalyzerID, 0, len(prerequisites))
+	for id := range prerequisites {
+		result = append(result, id)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// NewAnalyzerReportValue implements AnalyzerReportProvider.
+func (dc *DataCalculator) NewAnalyzerReportValue(analyzerID AnalyzerID, version AnalyzerVersion, report *Report, execErr error) (any, bool) {
+	analyzerReport, ok := dc.analyzerReports[analyzerID]
+	if !ok {
+		return nil, false
+	}
+	result := reflect.New(analyzerReport.reportType).Elem()
+	result.FieldByName("AnalyzerID").Set(reflect.ValueOf(analyzerID))
+	result.FieldByName("AnalyzerVersion").Set(reflect.ValueOf(version))
+	var errNotApplicable ErrNotApplicable
+	switch {
+	case execErr == nil && report != nil:
+		result.FieldByName("Report").Set(reflect.ValueOf(report))
+	case errors.As(execErr, &errNotApplicable):
+		result.FieldByName("NotApplicable").Set(reflect.ValueOf(&errNotApplicable))
+	default:
+		return nil, false
+	}
+	return result.Interface(), true
+}
+
+// prerequisiteName returns a human-readable name of the prerequisite, which provides values of type t.
+func prerequisiteName(dc DataCalculatorInterface, t reflect.Type) string {
+	if dc, ok := dc.(*DataCalculator); ok {
+		if id, ok := dc.analyzerReportTypes[t]; ok {
+			return string(id)
+		}
+	}
+	return t.String()
+}
@@ -0,0 +1,202 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	RegisterType((*prerequisiteValue)(nil))
+}
+
+type prerequisiteValue struct {
+	N int
+}
+
+type prerequisiteInput struct {
+	Value prerequisiteValue
+}
+
+type dependentInput struct {
+	PrerequisiteReport AnalyzerReport[prerequisiteInput]
+}
+
+type optionalDependentInput struct {
+	PrerequisiteReport *AnalyzerReport[prerequisiteInput] `exec:"optional"`
+}
+
+type transitiveDependentInput struct {
+	DependentReport AnalyzerReport[dependentInput]
+}
+
+type testAnalyzer[inputType any] struct {
+	id      AnalyzerID
+	analyze func(ctx context.Context, in inputType) (*Report, error)
+}
+
+func (a testAnalyzer[inputType]) ID() AnalyzerID {
+	return a.id
+}
+
+func (a testAnalyzer[inputType]) Version() AnalyzerVersion {
+	return "1.0.0"
+}
+
+func (a testAnalyzer[inputType]) Analyze(ctx context.Context, in inputType) (*Report, error) {
+	return a.analyze(ctx, in)
+}
+
+func newPrerequisiteAnalyzer(calledCount *int) Analyzer[prerequisiteInput] {
+	return testAnalyzer[prerequisiteInput]{
+		id: "Prerequisite",
+		analyze: func(ctx context.Context, in prerequisiteInput) (*Report, error) {
+			*calledCount++
+			if in.Value.N < 0 {
+				return nil, fmt.Errorf("negative value")
+			}
+			return &Report{Comments: []string{fmt.Sprint(in.Value.N)}}, nil
+		},
+	}
+}
+
+func newDependentAnalyzer() Analyzer[dependentInput] {
+	return testAnalyzer[dependentInput]{
+		id: "Dependent",
+		analyze: func(ctx context.Context, in dependentInput) (*Report, error) {
+			return &Report{Comments: in.PrerequisiteReport.Report.Comments}, nil
+		},
+	}
+}
+
+func TestAnalyzerReportNotCalculated(t *testing.T) {
+	dataCalc, err := NewDataCalculator(10)
+	require.NoError(t, err)
+	var calledCount int
+	require.NoError(t, RegisterAnalyzerReport(dataCalc, newPrerequisiteAnalyzer(&calledCount)))
+
+	// prerequisites are executed by the caller, see AnalyzerReportProvider
+	in := NewInput().AddCustomValue(prerequisiteValue{N: 1})
+	_, err = ExecuteAnalyzer(context.Background(), dataCalc, newDependentAnalyzer(), in, NewDataCache())
+	require.ErrorAs(t, err, &ErrResolveInput{})
+	require.ErrorAs(t, err, &ErrPrerequisiteFailed{})
+	require.Zero(t, calledCount)
+}
+
+func TestAnalyzerReportProvided(t *testing.T) {
+	dataCalc, err := NewDataCalculator(10)
+	require.NoError(t, err)
+	var calledCount int
+	require.NoError(t, RegisterAnalyzerReport(dataCalc, newPrerequisiteAnalyzer(&calledCount)))
+
+	value, ok := dataCalc.NewAnalyzerReportValue("Prerequisite", "1.0.0", &Report{Comments: []string{"provided"}}, nil)
+	require.True(t, ok)
+	require.Equal(t, AnalyzerReport[prerequisiteInput]{
+		AnalyzerID:      "Prerequisite",
+		AnalyzerVersion: "1.0.0",
+		Report:          &Report{Comments: []string{"provided"}},
+	}, value)
+
+	_, ok = dataCalc.NewAnalyzerReportValue("Unknown", "1.0.0", &Report{}, nil)
+	require.False(t, ok)
+	_, ok = dataCalc.NewAnalyzerReportValue("Prerequisite", "1.0.0", nil, ErrAnalyze{Err: fmt.Errorf("failed")})
+	require.False(t, ok)
+
+	in := NewInput().AddCustomValue(value)
+	report, err := ExecuteAnalyzer(context.Background(), dataCalc, newDependentAnalyzer(), in, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"provided"}, report.Comments)
+	require.Zero(t, calledCount)
+}
+
+func TestAnalyzerReportPrerequisiteFailed(t *testing.T) {
+	dataCalc, err := NewDataCalculator(10)
+	require.NoError(t, err)
+	var calledCount int
+	require.NoError(t, RegisterAnalyzerReport(dataCalc, newPrerequisiteAnalyzer(&calledCount)))
+
+	notApplicable, ok := dataCalc.NewAnalyzerReportValue("Prerequisite", "1.0.0", nil, ErrAnalyze{Err: NewErrNotApplicable("zero value")})
+	require.True(t, ok)
+	require.Equal(t, AnalyzerReport[prerequisiteInput]{
+		AnalyzerID:      "Prerequisite",
+		AnalyzerVersion: "1.0.0",
+		NotApplicable:   &ErrNotApplicable{Description: "zero value"},
+	}, notApplicable)
+
+	t.Run("not_applicable", func(t *testing.T) {
+		in := NewInput().AddCustomValue(notApplicable)
+		_, err := ExecuteAnalyzer(context.Background(), dataCalc, newDependentAnalyzer(), in, nil)
+		require.ErrorAs(t, err, &ErrNotApplicable{})
+	})
+
+	t.Run("missing_report", func(t *testing.T) {
+		_, err := ExecuteAnalyzer(context.Background(), dataCalc, newDependentAnalyzer(), NewInput(), nil)
+		require.ErrorAs(t, err, &ErrResolveInput{})
+		require.ErrorAs(t, err, &ErrPrerequisiteFailed{})
+		require.False(t, errors.As(err, &ErrNotApplicable{}))
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		analyzer := testAnalyzer[optionalDependentInput]{
+			id: "OptionalDependent",
+			analyze: func(ctx context.Context, in optionalDependentInput) (*Report, error) {
+				require.Nil(t, in.PrerequisiteReport)
+				return &Report{}, nil
+			},
+		}
+		in := NewInput().AddCustomValue(notApplicable)
+		_, err := ExecuteAnalyzer[optionalDependentInput](context.Background(), dataCalc, analyzer, in, nil)
+		require.NoError(t, err)
+	})
+	require.Zero(t, calledCount)
+}
+
+func TestAnalyzerPrerequisites(t *testing.T) {
+	dataCalc, err := NewDataCalculator(10)
+	require.NoError(t, err)
+	var calledCount int
+	require.NoError(t, RegisterAnalyzerReport(dataCalc, newPrerequisiteAnalyzer(&calledCount)))
+	require.NoError(t, RegisterAnalyzerReport(dataCalc, newDependentAnalyzer()))
+	require.NoError(t, RegisterAnalyzerReport[transitiveDependentInput](dataCalc, testAnalyzer[transitiveDependentInput]{id: "TransitiveDependent"}))
+
+	require.Empty(t, dataCalc.AnalyzerPrerequisites("Prerequisite"))
+	require.Equal(t, []AnalyzerID{"Prerequisite"}, dataCalc.AnalyzerPrerequisites("Dependent"))
+	require.Equal(t, []AnalyzerID{"Dependent", "Prerequisite"}, dataCalc.AnalyzerPrerequisites("TransitiveDependent"))
+	require.Empty(t, dataCalc.AnalyzerPrerequisites("Unknown"))
+
+	// Reports of another analyzer with the same input type could not be distinguished.
+	err = RegisterAnalyzerReport[prerequisiteInput](dataCalc, testAnalyzer[prerequisiteInput]{id: "AnotherPrerequisite"})
+	require.Error(t, err)
+
+	t.Run("cycle", func(t *testing.T) {
+		dataCalc, err := NewDataCalculator(10)
+		require.NoError(t, err)
+		require.NoError(t, RegisterAnalyzerReport[cyclicInputA](dataCalc, testAnalyzer[cyclicInputA]{id: "A"}))
+		err = RegisterAnalyzerReport[cyclicInputB](dataCalc, testAnalyzer[cyclicInputB]{id: "B"})
+		require.ErrorAs(t, err, &ErrCyclicDependency{})
+		require.Empty(t, dataCalc.AnalyzerPrerequisites("B"))
+	})
+}
+
+type cyclicInputA struct {
+	Report *AnalyzerReport[cyclicInputB] `exec:"optional"`
+}
+
+type cyclicInputB struct {
+	Report AnalyzerReport[cyclicInputA]
+}
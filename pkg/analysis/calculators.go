@@ -129,6 +129,7 @@ func getFixedRegisters(ctx context.Context, in fixedRegistersInput) (FixedRegist
 		}
 		return res, []Issue{
 			{
+				Code:        IssueCodeAnalysisRegistersNotVerified,
 				Severity:    SeverityInfo,
 				Description: "Not enough data to check registers correctness",
 			},
@@ -147,6 +148,7 @@ func getFixedRegisters(ctx context.Context, in fixedRegistersInput) (FixedRegist
 	var issues []Issue
 	for _, mIssue := range mIssues {
 		issues = append(issues, Issue{
+			Code:        IssueCodeAnalysisHostConfigurationIssue,
 			Severity:    SeverityInfo,
 			Description: fmt.Sprintf("an issue of getting fixed host configuration: %s", mIssue.Error()),
 		})
@@ -158,6 +160,7 @@ func getFixedRegisters(ctx context.Context, in fixedRegistersInput) (FixedRegist
 			return FixedRegisters{}, issues, err
 		}
 		issues = append(issues, Issue{
+			Code:        IssueCodeAnalysisRegistersCheckFailed,
 			Severity:    SeverityInfo,
 			Description: fmt.Sprintf("Failed to check registers: %v", fixErr),
 		})
@@ -168,8 +171,10 @@ func getFixedRegisters(ctx context.Context, in fixedRegistersInput) (FixedRegist
 		fixedReg := fixedRegs.Find(reg.ID())
 		if fixedReg == nil {
 			issues = append(issues, Issue{
+				Code:        IssueCodeAnalysisUnexpectedRegister,
 				Severity:    SeverityInfo,
 				Description: fmt.Sprintf("register '%s' is not expected", reg.ID()),
+				Params:      map[string]string{"register": string(reg.ID())},
 			})
 			continue
 		}
@@ -183,8 +188,10 @@ func getFixedRegisters(ctx context.Context, in fixedRegistersInput) (FixedRegist
 		}
 		if !bytes.Equal(oldValue, newValue) {
 			issues = append(issues, Issue{
+				Code:        IssueCodeAnalysisRegisterCorrected,
 				Severity:    SeverityInfo,
 				Description: fmt.Sprintf("register's '%s' value was changed from '%X' to '%X'", reg.ID(), oldValue, newValue),
+				Params:      map[string]string{"register": string(reg.ID()), "actual": fmt.Sprintf("%X", oldValue), "corrected": fmt.Sprintf("%X", newValue)},
 			})
 		}
 	}
@@ -226,6 +233,7 @@ func getReferenceFirmware(ctx context.Context, in getReferenceFirmwareInput) (Re
 	var issues []Issue
 	if err != nil {
 		issues = []Issue{{
+			Code:        IssueCodeAnalysisReferenceFirmwarePartial,
 			Custom:      err,
 			Severity:    SeverityWarning,
 			Description: err.Error(),
@@ -30,6 +30,12 @@ import (
 type DataCalculator struct {
 	// valueCalculators is a container for executable functions that can calculate values for certain types
 	valueCalculators map[reflect.Type]any // calculator[any, any]
+	// calculatorVersions contains versions of calculators whose results may be stored in persistentCache
+	calculatorVersions map[reflect.Type]string
+	// analyzerReportTypes maps AnalyzerReport types to the analyzers providing them, see RegisterAnalyzerReport
+	analyzerReportTypes map[reflect.Type]AnalyzerID
+	// analyzerReports contains the analyzers which provide their reports to other analyzers
+	analyzerReports map[AnalyzerID]analyzerReportType
 
 	mu       sync.Mutex
 	singleOp *lockmap.LockMap
@@ -38,6 +44,8 @@ type DataCalculator struct {
 	runtime map[objhash.ObjHash]*calculatorFuture
 	// cache contains an objhash.ObjHash -> globalCacheItem of calculated values
 	cache cacheInterface
+	// persistentCache is an optional cache tier which survives restarts
+	persistentCache PersistentCache
 }
 
 type cacheInterface interface {
@@ -74,13 +82,22 @@ func NewDataCalculator(cacheSize int) (*DataCalculator, error) {
 	}
 
 	dc := &DataCalculator{
-		valueCalculators: make(map[reflect.Type]any),
-		runtime:          make(map[objhash.ObjHash]*calculatorFuture),
-		cache:            cache,
-		singleOp:         lockmap.NewLockMap(),
-	}
-
-	// valueCalculators should have a check for cyclic dependencies. Currently rely on code review
+		valueCalculators:    make(map[reflect.Type]any),
+		calculatorVersions:  make(map[reflect.Type]string),
+		analyzerReportTypes: make(map[reflect.Type]AnalyzerID),
+		analyzerReports:     make(map[AnalyzerID]analyzerReportType),
+		runtime:             make(map[objhash.ObjHash]*calculatorFuture),
+		cache:               cache,
+		singleOp:            lockmap.NewLockMap(),
+	}
+
+	// Only the results of versioned calculators are stored in the persistent cache.
+	// The parsed UEFI trees (OriginalFirmware, ActualFirmware, ReferenceFirmware,
+	// ActualPSPFirmware) are not: their nodes keep unexported slices of the image,
+	// which are lost by any serialization except the image itself, and restoring
+	// a tree from the image is the same parsing the calculator does. The boot
+	// flows are constant values (steps are Go code), the simulation of a flow
+	// is cached as its outcome: FixedRegisters.
 	if err := SetValueCalculator(dc, getOriginalFirmware); err != nil {
 		return nil, err
 	}
@@ -93,16 +110,16 @@ func NewDataCalculator(cacheSize int) (*DataCalculator, error) {
 	if err := SetValueCalculator(dc, getActualPSPFirmware); err != nil {
 		return nil, err
 	}
-	if err := SetValueCalculator(dc, getFixedRegisters); err != nil {
+	if err := SetVersionedValueCalculator(dc, getFixedRegisters, "1"); err != nil {
 		return nil, err
 	}
-	if err := SetValueCalculator(dc, getAlignedOriginalImage); err != nil {
+	if err := SetVersionedValueCalculator(dc, getAlignedOriginalImage, "1"); err != nil {
 		return nil, err
 	}
-	if err := SetValueCalculator(dc, getActualBIOSInfo); err != nil {
+	if err := SetVersionedValueCalculator(dc, getActualBIOSInfo, "1"); err != nil {
 		return nil, err
 	}
-	if err := SetValueCalculator(dc, getOriginalBIOSInfo); err != nil {
+	if err := SetVersionedValueCalculator(dc, getOriginalBIOSInfo, "1"); err != nil {
 		return nil, err
 	}
 	if err := SetValueCalculator(dc, bootFlowUpstreamToDownstream); err != nil {
@@ -147,7 +164,22 @@ type calculator[inputType, outputType any] interface {
 //
 // For example: firmware parsing, fixing register's values.
 // Input argument should be a function of format func(ctx context.Context, in InputStruct) (Output1, Output2, ..., OutputN, []Issue, error)
+//
+// Returns ErrCyclicDependency (and keeps the previous state) if the calculator
+// would make a value depend on itself.
 func SetValueCalculator[inputType, outputType any, T calculator[inputType, outputType]](dc *DataCalculator, calc T) error {
+	return SetVersionedValueCalculator[inputType, outputType](dc, calc, "")
+}
+
+// SetVersionedValueCalculator is the same as SetValueCalculator, but also
+// allows the results of the calculator to be stored in the persistent cache
+// (see DataCalculator.SetPersistentCache) if version is not empty.
+//
+// The version should be changed every time the calculator starts to produce different
+// results for the same input. The output type should be serializable: either implement
+// encoding.BinaryMarshaler and encoding.BinaryUnmarshaler, or be serializable by xjson
+// without losing data (values with unexported fields will not be restored correctly).
+func SetVersionedValueCalculator[inputType, outputType any, T calculator[inputType, outputType]](dc *DataCalculator, calc T, version string) error {
 	calcType := reflect.TypeOf(calc)
 
 	// first check, modify internal state later
@@ -155,7 +187,21 @@ func SetValueCalculator[inputType, outputType any, T calculator[inputType, outpu
 	if outType.Kind() == reflect.Pointer {
 		outType = outType.Elem()
 	}
+	prevCalc, hadPrevCalc := dc.valueCalculators[outType]
 	dc.valueCalculators[outType] = calc
+	if err := dc.checkCycles(outType); err != nil {
+		if hadPrevCalc {
+			dc.valueCalculators[outType] = prevCalc
+		} else {
+			delete(dc.valueCalculators, outType)
+		}
+		return err
+	}
+	if version != "" {
+		dc.calculatorVersions[outType] = version
+	} else {
+		delete(dc.calculatorVersions, outType)
+	}
 	return nil
 }
 
@@ -180,6 +226,14 @@ func (dc *DataCalculator) resolveType(
 		return reflect.Value{}, nil, ErrMissingInput{missingType: t.String()}
 	}
 
+	// dependencies are resolved within the measurement, so they are reported as its children
+	measurement, ctx := startProfileMeasurement(ctx, ProfileEntryKindCalculator, t.String())
+	cacheResult := CacheResultMiss
+	defer func() {
+		measurement.SetCache(cacheResult)
+		measurement.Finish(retErr)
+	}()
+
 	// try to calculate the value, but first we should resolve all its dependencies
 	calculatorVal := reflect.ValueOf(calculator)
 	calculatorType := calculatorVal.Type()
@@ -204,6 +258,7 @@ func (dc *DataCalculator) resolveType(
 	unlocker := dc.singleOp.Lock(opHash)
 	defer unlocker.Unlock()
 	if cachedValue, ok := unlocker.UserData.(*CachedValue); ok {
+		cacheResult = CacheResultConcurrent
 		return cachedValue.Val, cachedValue.Issues, cachedValue.Err
 	}
 	defer func() {
@@ -216,6 +271,7 @@ func (dc *DataCalculator) resolveType(
 
 	if cache != nil {
 		if res := cache.Get(t, &opHash); res != nil {
+			cacheResult = CacheResultDataCache
 			return res.Val, res.Issues, res.Err
 		}
 	}
@@ -225,6 +281,7 @@ func (dc *DataCalculator) resolveType(
 		item := cached.(*globalCacheItem)
 		if v, found := item.values[t]; found {
 			log.Debugf("Found result type '%s' and key 0x'%X' in global cache", t, opHash)
+			cacheResult = CacheResultGlobalCache
 			return v, item.issues, nil
 		}
 	}
@@ -273,6 +330,7 @@ func (dc *DataCalculator) resolveType(
 	}()
 	if found {
 		log.Debugf("Found result type '%s' and key '0x%X' in runtime cache", t, opHash)
+		cacheResult = CacheResultConcurrent
 		calcResult, err := calcFuture.Get()
 		if err != nil {
 			return reflect.Value{}, nil, err
@@ -281,14 +339,27 @@ func (dc *DataCalculator) resolveType(
 	}
 
 	// We are the first (and probably the only) who requested a value under this circumstances
-	inputArgs := prepareInputArgs(ctx, inputValue, calculatorType.In(1).Kind() == reflect.Ptr)
-	calcResult, err := newCalculatorResult(calculatorVal.Call(inputArgs))
-	if err != nil {
-		log.Errorf("failed to process calculator '%s' results: '%v'", calculatorType.Name(), err)
-		calcFuture.SetError(err)
-		return reflect.Value{}, nil, err
+	persistentKey, isPersistent := dc.persistentCacheKey(t, calculatorVal, inputValue)
+	var calcResult *calculatorResult
+	if isPersistent {
+		calcResult = dc.loadPersistent(ctx, calculatorType.Out(0), persistentKey, inputValue)
+		if calcResult != nil {
+			cacheResult = CacheResultPersistentCache
+		}
+	}
+	if calcResult == nil {
+		inputArgs := prepareInputArgs(ctx, inputValue, calculatorType.In(1).Kind() == reflect.Ptr)
+		calcResult, err = newCalculatorResult(calculatorVal.Call(inputArgs))
+		if err != nil {
+			log.Errorf("failed to process calculator '%s' results: '%v'", calculatorType.Name(), err)
+			calcFuture.SetError(err)
+			return reflect.Value{}, nil, err
+		}
+		log.Debugf("Calculated result for type '%s' and key 0x'%X'", t, opHash)
+		if isPersistent && calcResult.err == nil {
+			dc.storePersistent(ctx, persistentKey, *calcResult)
+		}
 	}
-	log.Debugf("Calculated result for type '%s' and key 0x'%X'", t, opHash)
 
 	// do not cache errors in a global cache, as they may disappear (for example someone will fix the orig firmware table)
 	if dc.cache != nil && calcResult.err == nil {
@@ -327,6 +398,14 @@ func newGlobalCacheItem(value reflect.Value, issues []Issue) *globalCacheItem {
 
 	// should not save in cache big chunks of data, like parsed firmware.
 	// Or very specific/flaky objects
+	if isAnalyzerReportType(value.Type()) {
+		// analyzer reports are shared only within a DataCache, an analyzer
+		// should not be skipped just because it was executed before
+		return &globalCacheItem{
+			values: valuesMap,
+			issues: issues,
+		}
+	}
 	switch value.Type() {
 	case reflect.TypeOf((*BytesBlob)(nil)).Elem():
 		break
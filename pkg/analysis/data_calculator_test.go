@@ -13,6 +13,7 @@
 package analysis
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"reflect"
@@ -20,7 +21,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
 	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/dmidecode"
+	"github.com/immune-gmbh/attestation-sdk/pkg/objhash"
+	"github.com/immune-gmbh/attestation-sdk/pkg/uefi"
 )
 
 func TestDataCalculatorCreation(t *testing.T) {
@@ -214,3 +220,139 @@ func TestRuntimeCache(t *testing.T) {
 type dummyInput struct{}
 
 type dummyOutput struct{}
+
+type mapPersistentCache map[objhash.ObjHash][]byte
+
+func (c mapPersistentCache) Get(key objhash.ObjHash) ([]byte, bool) {
+	v, ok := c[key]
+	return v, ok
+}
+
+func (c mapPersistentCache) Set(key objhash.ObjHash, value []byte) error {
+	c[key] = value
+	return nil
+}
+
+type persistentInput struct {
+	Blob ActualFirmwareBlob
+}
+
+func TestPersistentCacheUsed(t *testing.T) {
+	persistentCache := mapPersistentCache{}
+
+	var calcCalledCount int
+	calc := func(ctx context.Context, in persistentInput) (*ActualBIOSInfo, []Issue, error) {
+		calcCalledCount++
+		return NewActualBIOSInfo(dmidecode.BIOSInfo{Vendor: string(in.Blob.Bytes())}), []Issue{{
+			Severity:    SeverityWarning,
+			Description: "dummy issue",
+		}}, nil
+	}
+
+	calculate := func(version string, blob string) (reflect.Value, []Issue) {
+		// re-create datacalc to emulate a restart
+		dataCalc, err := NewDataCalculator(0)
+		require.NoError(t, err)
+		dataCalc.SetPersistentCache(persistentCache)
+		require.NoError(t, SetVersionedValueCalculator(dataCalc, calc, version))
+
+		v, issues, err := dataCalc.Calculate(context.Background(), reflect.TypeOf(ActualBIOSInfo{}), NewInput().AddActualFirmware(BytesBlob(blob)), nil)
+		require.NoError(t, err)
+		return v, issues
+	}
+
+	v, issues := calculate("1", "vendor")
+	require.Equal(t, 1, calcCalledCount)
+	require.Len(t, persistentCache, 1)
+
+	v2, issues2 := calculate("1", "vendor")
+	require.Equal(t, 1, calcCalledCount)
+	require.Equal(t, v.Interface(), v2.Interface())
+	require.Equal(t, issues, issues2)
+	require.Equal(t, "vendor", v2.Interface().(*ActualBIOSInfo).Vendor)
+
+	// different input
+	calculate("1", "another vendor")
+	require.Equal(t, 2, calcCalledCount)
+
+	// different version
+	calculate("2", "vendor")
+	require.Equal(t, 3, calcCalledCount)
+
+	// not versioned
+	calculate("", "vendor")
+	require.Equal(t, 4, calcCalledCount)
+	require.Len(t, persistentCache, 3)
+}
+
+func TestPersistentCacheAlignedOriginalFirmware(t *testing.T) {
+	persistentCache := mapPersistentCache{}
+	originalImage := append(bytes.Repeat([]byte{0x00}, 0x1000), bytes.Repeat([]byte{0xff}, 0x2000)...)
+
+	var calcCalledCount int
+	calc := func(ctx context.Context, in getAlignedOriginalImageInput) (AlignedOriginalFirmware, []Issue, error) {
+		calcCalledCount++
+		alignedImage, err := uefi.Parse(in.OriginalFirmware.Blob.Bytes()[0x1000:], false)
+		require.NoError(t, err)
+		return NewAlignedOriginalFirmware(alignedImage, 0x1000, in.OriginalFirmware.Blob), nil, nil
+	}
+
+	calculate := func() AlignedOriginalFirmware {
+		dataCalc, err := NewDataCalculator(0)
+		require.NoError(t, err)
+		dataCalc.SetPersistentCache(persistentCache)
+		require.NoError(t, SetVersionedValueCalculator(dataCalc, calc, "1"))
+
+		input := NewInput().
+			AddOriginalFirmware(BytesBlob(originalImage)).
+			AddActualFirmware(BytesBlob(originalImage[0x1000:]))
+		v, _, err := dataCalc.Calculate(context.Background(), reflect.TypeOf(AlignedOriginalFirmware{}), input, nil)
+		require.NoError(t, err)
+		return v.Interface().(AlignedOriginalFirmware)
+	}
+
+	cold := calculate()
+	require.Equal(t, 1, calcCalledCount)
+	require.Len(t, persistentCache, 1)
+
+	warm := calculate()
+	require.Equal(t, 1, calcCalledCount)
+	require.Equal(t, cold.Blob.Bytes(), warm.Blob.Bytes())
+	require.Equal(t, cold.ImageOffset, warm.ImageOffset)
+	require.Equal(t, cold.UEFI().Buf(), warm.UEFI().Buf())
+}
+
+func TestPersistentCacheFixedRegisters(t *testing.T) {
+	persistentCache := mapPersistentCache{}
+	regs, err := NewActualRegisters(registers.Registers{
+		registers.ParseACMPolicyStatusRegister(0x0000000200108681),
+		registers.ParseBTGSACMInfo(0x0000000400000011),
+	})
+	require.NoError(t, err)
+
+	var calcCalledCount int
+	calc := func(ctx context.Context, in struct{ Regs ActualRegisters }) (FixedRegisters, []Issue, error) {
+		calcCalledCount++
+		fixedRegs, err := NewFixedRegisters(in.Regs.GetRegisters())
+		return fixedRegs, nil, err
+	}
+
+	calculate := func() FixedRegisters {
+		dataCalc, err := NewDataCalculator(0)
+		require.NoError(t, err)
+		dataCalc.SetPersistentCache(persistentCache)
+		require.NoError(t, SetVersionedValueCalculator(dataCalc, calc, "1"))
+
+		v, _, err := dataCalc.Calculate(context.Background(), reflect.TypeOf(FixedRegisters{}), NewInput().AddActualRegisters(regs), nil)
+		require.NoError(t, err)
+		return v.Interface().(FixedRegisters)
+	}
+
+	cold := calculate()
+	require.Equal(t, 1, calcCalledCount)
+	require.Len(t, persistentCache, 1)
+
+	warm := calculate()
+	require.Equal(t, 1, calcCalledCount)
+	require.Equal(t, cold, warm)
+}
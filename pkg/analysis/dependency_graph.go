@@ -0,0 +1,371 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DependencyNodeKind defines what a node of a DependencyGraph represents.
+type DependencyNodeKind string
+
+const (
+	// DependencyNodeKindAnalyzer is a node representing an analyzer (the root of a graph).
+	DependencyNodeKindAnalyzer = DependencyNodeKind("analyzer")
+
+	// DependencyNodeKindValue is a node representing a value type required by an analyzer or a calculator.
+	DependencyNodeKindValue = DependencyNodeKind("value")
+)
+
+// DependencyNodeStatus defines if the value of a node could be obtained for the given Input.
+type DependencyNodeStatus string
+
+const (
+	// DependencyNodeStatusProvided means the value is provided directly in the Input.
+	DependencyNodeStatusProvided = DependencyNodeStatus("provided")
+
+	// DependencyNodeStatusCalculable means the value is not provided, but there is
+	// a value calculator and all its required inputs are obtainable.
+	DependencyNodeStatusCalculable = DependencyNodeStatus("calculable")
+
+	// DependencyNodeStatusReady means all required inputs of the analyzer are obtainable.
+	DependencyNodeStatusReady = DependencyNodeStatus("ready")
+
+	// DependencyNodeStatusMissing means the value cannot be obtained: it is not
+	// provided and either there is no calculator for it or some of the
+	// calculator's required inputs are missing.
+	DependencyNodeStatusMissing = DependencyNodeStatus("missing")
+)
+
+// DependencyNode is a single node of a DependencyGraph.
+type DependencyNode struct {
+	ID     string               `json:"id"`
+	Kind   DependencyNodeKind   `json:"kind"`
+	Status DependencyNodeStatus `json:"status"`
+}
+
+// DependencyEdge is a directed edge of a DependencyGraph: node "To" consumes the value of node "From".
+type DependencyEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Field    string `json:"field"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// DependencyGraph describes how the input of an analyzer is resolved for a specific Input:
+// which values are provided, which are calculated (and from what) and which are missing.
+type DependencyGraph struct {
+	AnalyzerID AnalyzerID       `json:"analyzer_id"`
+	Nodes      []DependencyNode `json:"nodes"`
+	Edges      []DependencyEdge `json:"edges"`
+}
+
+// Node returns the node with the given ID, or nil if there is no such node.
+func (g *DependencyGraph) Node(id string) *DependencyNode {
+	for idx := range g.Nodes {
+		if g.Nodes[idx].ID == id {
+			return &g.Nodes[idx]
+		}
+	}
+	return nil
+}
+
+// MissingInputs returns the values which are the root causes of the analyzer not being
+// able to run: missing values which could not be calculated from anything
+// (there is no calculator for them). Missing optional values are not included.
+func (g *DependencyGraph) MissingInputs() []string {
+	hasDependencies := map[string]bool{}
+	for _, edge := range g.Edges {
+		hasDependencies[edge.To] = true
+	}
+	requiredBy := map[string][]DependencyEdge{}
+	for _, edge := range g.Edges {
+		requiredBy[edge.From] = append(requiredBy[edge.From], edge)
+	}
+
+	// a value is relevant only if it is required through a chain of non-optional edges
+	// from a missing node up to the analyzer.
+	var isBlocking func(id string, visited map[string]bool) bool
+	isBlocking = func(id string, visited map[string]bool) bool {
+		if visited[id] {
+			return false
+		}
+		visited[id] = true
+		node := g.Node(id)
+		if node == nil || node.Status != DependencyNodeStatusMissing {
+			return false
+		}
+		if node.Kind == DependencyNodeKindAnalyzer {
+			return true
+		}
+		for _, edge := range requiredBy[id] {
+			if edge.Optional {
+				continue
+			}
+			if isBlocking(edge.To, visited) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var result []string
+	for _, node := range g.Nodes {
+		if node.Kind != DependencyNodeKindValue || node.Status != DependencyNodeStatusMissing || hasDependencies[node.ID] {
+			continue
+		}
+		if isBlocking(node.ID, map[string]bool{}) {
+			result = append(result, node.ID)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// JSON returns the graph serialized into JSON.
+func (g *DependencyGraph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// DOT returns the graph in the Graphviz DOT format.
+func (g *DependencyGraph) DOT() string {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "digraph %q {\n", string(g.AnalyzerID))
+	buf.WriteString("\trankdir=LR;\n")
+	for _, node := range g.Nodes {
+		shape := "box"
+		if node.Kind == DependencyNodeKindAnalyzer {
+			shape = "doubleoctagon"
+		}
+		color := "black"
+		switch node.Status {
+		case DependencyNodeStatusProvided:
+			color = "blue"
+		case DependencyNodeStatusCalculable, DependencyNodeStatusReady:
+			color = "darkgreen"
+		case DependencyNodeStatusMissing:
+			color = "red"
+		}
+		fmt.Fprintf(&buf, "\t%q [shape=%s, color=%s, label=%q];\n", node.ID, shape, color, fmt.Sprintf("%s\n(%s)", node.ID, node.Status))
+	}
+	for _, edge := range g.Edges {
+		style := "solid"
+		if edge.Optional {
+			style = "dashed"
+		}
+		fmt.Fprintf(&buf, "\t%q -> %q [label=%q, style=%s];\n", edge.From, edge.To, edge.Field, style)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// DependencyGraphBuilder is implemented by data calculators which are able to
+// describe how they are going to resolve the input of an analyzer.
+type DependencyGraphBuilder interface {
+	DependencyGraph(analyzerID AnalyzerID, inputType reflect.Type, in Input) (*DependencyGraph, error)
+}
+
+var _ DependencyGraphBuilder = (*DataCalculator)(nil)
+
+// DependencyGraph builds the graph of values required to fill an analyzer input
+// structure of type inputType given the Input.
+//
+// Returns ErrCyclicDependency if the value calculators depend on each other in a loop.
+func (dc *DataCalculator) DependencyGraph(analyzerID AnalyzerID, inputType reflect.Type, in Input) (*DependencyGraph, error) {
+	if inputType.Kind() == reflect.Pointer {
+		inputType = inputType.Elem()
+	}
+	if inputType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("input type is not a structure, but '%v'", inputType.Kind())
+	}
+
+	b := &dependencyGraphBuilder{
+		dc:       dc,
+		in:       in,
+		graph:    &DependencyGraph{AnalyzerID: analyzerID},
+		statuses: map[reflect.Type]DependencyNodeStatus{},
+	}
+	rootID := "analyzer:" + string(analyzerID)
+	status := DependencyNodeStatusReady
+	for _, dep := range structDependencies(inputType) {
+		depStatus, err := b.visit(dep.Type)
+		if err != nil {
+			return nil, err
+		}
+		b.graph.Edges = append(b.graph.Edges, DependencyEdge{
+			From:     dep.Type.String(),
+			To:       rootID,
+			Field:    dep.Field,
+			Optional: dep.Optional,
+		})
+		if depStatus == DependencyNodeStatusMissing && !dep.Optional {
+			status = DependencyNodeStatusMissing
+		}
+	}
+	b.graph.Nodes = append(b.graph.Nodes, DependencyNode{
+		ID:     rootID,
+		Kind:   DependencyNodeKindAnalyzer,
+		Status: status,
+	})
+	sort.Slice(b.graph.Nodes, func(i, j int) bool {
+		return b.graph.Nodes[i].ID < b.graph.Nodes[j].ID
+	})
+	return b.graph, nil
+}
+
+type dependencyGraphBuilder struct {
+	dc       *DataCalculator
+	in       Input
+	graph    *DependencyGraph
+	statuses map[reflect.Type]DependencyNodeStatus
+	stack    []reflect.Type
+}
+
+func (b *dependencyGraphBuilder) visit(t reflect.Type) (DependencyNodeStatus, error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if status, ok := b.statuses[t]; ok {
+		return status, nil
+	}
+	for idx, inProgress := range b.stack {
+		if inProgress == t {
+			return "", newErrCyclicDependency(append(b.stack[idx:], t))
+		}
+	}
+
+	status := b.resolveStatus(t)
+	if status == "" {
+		b.stack = append(b.stack, t)
+		status = DependencyNodeStatusCalculable
+		for _, dep := range calculatorDependencies(b.dc.valueCalculators[t]) {
+			depStatus, err := b.visit(dep.Type)
+			if err != nil {
+				return "", err
+			}
+			b.graph.Edges = append(b.graph.Edges, DependencyEdge{
+				From:     dep.Type.String(),
+				To:       t.String(),
+				Field:    dep.Field,
+				Optional: dep.Optional,
+			})
+			if depStatus == DependencyNodeStatusMissing && !dep.Optional {
+				status = DependencyNodeStatusMissing
+			}
+		}
+		b.stack = b.stack[:len(b.stack)-1]
+	}
+
+	b.statuses[t] = status
+	b.graph.Nodes = append(b.graph.Nodes, DependencyNode{
+		ID:     t.String(),
+		Kind:   DependencyNodeKindValue,
+		Status: status,
+	})
+	return status, nil
+}
+
+// resolveStatus returns the status of a value which does not depend on other values,
+// or an empty string if the value should be calculated.
+func (b *dependencyGraphBuilder) resolveStatus(t reflect.Type) DependencyNodeStatus {
+	if _, found := b.in[typeToID(t)]; found {
+		return DependencyNodeStatusProvided
+	}
+	if _, found := b.dc.valueCalculators[t]; !found {
+		return DependencyNodeStatusMissing
+	}
+	return ""
+}
+
+// checkCycles returns ErrCyclicDependency if the value of type t
+// (transitively) depends on itself through the registered value calculators.
+func (dc *DataCalculator) checkCycles(t reflect.Type) error {
+	done := map[reflect.Type]bool{}
+	var stack []reflect.Type
+	var visit func(t reflect.Type) error
+	visit = func(t reflect.Type) error {
+		if done[t] {
+			return nil
+		}
+		for idx, inProgress := range stack {
+			if inProgress == t {
+				return newErrCyclicDependency(append(stack[idx:], t))
+			}
+		}
+		stack = append(stack, t)
+		for _, dep := range dc.dependencies(t) {
+			if err := visit(dep.Type); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		done[t] = true
+		return nil
+	}
+	return visit(t)
+}
+
+type dependency struct {
+	Field    string
+	Type     reflect.Type
+	Optional bool
+}
+
+// dependencies returns the values required to obtain a value of type t: the input
+// of its calculator, or the input of the analyzer if t is an AnalyzerReport.
+func (dc *DataCalculator) dependencies(t reflect.Type) []dependency {
+	if analyzerID, ok := dc.analyzerReportTypes[t]; ok {
+		return structDependencies(dc.analyzerReports[analyzerID].inputType)
+	}
+	return calculatorDependencies(dc.valueCalculators[t])
+}
+
+// calculatorDependencies returns the values required by a value calculator
+// (the fields of its input structure).
+func calculatorDependencies(calculator any) []dependency {
+	if calculator == nil {
+		return nil
+	}
+	calculatorType := reflect.TypeOf(calculator)
+	if calculatorType.Kind() != reflect.Func || calculatorType.NumIn() < 2 {
+		return nil
+	}
+	return structDependencies(calculatorType.In(1))
+}
+
+func structDependencies(t reflect.Type) []dependency {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	result := make([]dependency, 0, t.NumField())
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		result = append(result, dependency{
+			Field:    field.Name,
+			Type:     fieldType,
+			Optional: isOptional(strings.Split(field.Tag.Get("exec"), ",")),
+		})
+	}
+	return result
+}
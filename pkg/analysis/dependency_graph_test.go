@@ -0,0 +1,139 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type cycleA struct{}
+
+type cycleB struct{}
+
+type cycleC struct{}
+
+type cycleAInput struct {
+	B cycleB
+}
+
+type cycleBInput struct {
+	C cycleC
+}
+
+type cycleCInput struct {
+	A *cycleA
+}
+
+func TestSetValueCalculatorCycle(t *testing.T) {
+	dataCalc, err := NewDataCalculator(0)
+	require.NoError(t, err)
+
+	require.NoError(t, SetValueCalculator(dataCalc, func(ctx context.Context, in cycleAInput) (cycleA, []Issue, error) {
+		return cycleA{}, nil, nil
+	}))
+	require.NoError(t, SetValueCalculator(dataCalc, func(ctx context.Context, in cycleBInput) (cycleB, []Issue, error) {
+		return cycleB{}, nil, nil
+	}))
+	err = SetValueCalculator(dataCalc, func(ctx context.Context, in cycleCInput) (*cycleC, []Issue, error) {
+		return &cycleC{}, nil, nil
+	})
+	var errCycle ErrCyclicDependency
+	require.ErrorAs(t, err, &errCycle)
+	require.Equal(t, []string{"analysis.cycleC", "analysis.cycleA", "analysis.cycleB", "analysis.cycleC"}, errCycle.Types)
+
+	// the calculator was not set
+	_, found := dataCalc.valueCalculators[reflect.TypeOf(cycleC{})]
+	require.False(t, found)
+}
+
+type graphAnalyzerInput struct {
+	Output   dummyOutput
+	Firmware ActualFirmware `exec:"optional"`
+	Blob     ActualFirmwareBlob
+}
+
+type graphAnalyzer struct{}
+
+func (graphAnalyzer) ID() AnalyzerID {
+	return "GraphAnalyzer"
+}
+
+func (graphAnalyzer) Version() AnalyzerVersion {
+	return "1.0.0"
+}
+
+func (graphAnalyzer) Analyze(ctx context.Context, in graphAnalyzerInput) (*Report, error) {
+	return &Report{}, nil
+}
+
+func TestDependencyGraph(t *testing.T) {
+	dataCalc, err := NewDataCalculator(0)
+	require.NoError(t, err)
+	require.NoError(t, SetValueCalculator(dataCalc, func(ctx context.Context, in dummyInput) (dummyOutput, []Issue, error) {
+		return dummyOutput{}, nil, nil
+	}))
+
+	t.Run("missing", func(t *testing.T) {
+		graph, err := dataCalc.DependencyGraph(graphAnalyzer{}.ID(), reflect.TypeOf(graphAnalyzerInput{}), NewInput())
+		require.NoError(t, err)
+
+		require.Equal(t, DependencyNodeStatusMissing, graph.Node("analyzer:GraphAnalyzer").Status)
+		require.Equal(t, DependencyNodeStatusCalculable, graph.Node("analysis.dummyOutput").Status)
+		require.Equal(t, DependencyNodeStatusMissing, graph.Node("analysis.ActualFirmware").Status)
+		require.Equal(t, []string{"analysis.ActualFirmwareBlob"}, graph.MissingInputs())
+
+		dot := graph.DOT()
+		require.Contains(t, dot, `"analysis.ActualFirmwareBlob" -> "analysis.ActualFirmware" [label="FirmwareImage", style=solid];`)
+		require.Contains(t, dot, `"analysis.ActualFirmware" -> "analyzer:GraphAnalyzer" [label="Firmware", style=dashed];`)
+
+		b, err := graph.JSON()
+		require.NoError(t, err)
+		var parsed DependencyGraph
+		require.NoError(t, json.Unmarshal(b, &parsed))
+		require.Equal(t, *graph, parsed)
+	})
+
+	t.Run("provided", func(t *testing.T) {
+		in := NewInput()
+		in.AddActualFirmware(BytesBlob{1, 2, 3})
+		graph, err := dataCalc.DependencyGraph(graphAnalyzer{}.ID(), reflect.TypeOf(graphAnalyzerInput{}), in)
+		require.NoError(t, err)
+
+		require.Equal(t, DependencyNodeStatusReady, graph.Node("analyzer:GraphAnalyzer").Status)
+		require.Equal(t, DependencyNodeStatusProvided, graph.Node("analysis.ActualFirmwareBlob").Status)
+		require.Equal(t, DependencyNodeStatusCalculable, graph.Node("analysis.ActualFirmware").Status)
+		require.Empty(t, graph.MissingInputs())
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		cyclicCalc := func(ctx context.Context, in struct{ Firmware ActualFirmware }) (ActualFirmwareBlob, []Issue, error) {
+			return ActualFirmwareBlob{}, nil, nil
+		}
+		// a calculator waiting for itself would deadlock ExecuteAnalyzer, so it is rejected
+		err := SetValueCalculator(dataCalc, cyclicCalc)
+		require.ErrorAs(t, err, &ErrCyclicDependency{})
+
+		// bypass the check in SetValueCalculator to emulate a misconfigured calculator
+		blobType := reflect.TypeOf((*ActualFirmwareBlob)(nil)).Elem()
+		dataCalc.valueCalculators[blobType] = cyclicCalc
+		defer delete(dataCalc.valueCalculators, blobType)
+
+		_, err = dataCalc.DependencyGraph(graphAnalyzer{}.ID(), reflect.TypeOf(graphAnalyzerInput{}), NewInput())
+		require.ErrorAs(t, err, &ErrCyclicDependency{})
+	})
+}
@@ -14,6 +14,7 @@ package analysis
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 )
 
@@ -23,6 +24,8 @@ func init() {
 	RegisterType((*ErrFailedCalcInput)(nil))
 	RegisterType((*ErrResolveInput)(nil))
 	RegisterType((*ErrResolveValue)(nil))
+	RegisterType((*ErrBudgetExceeded)(nil))
+	RegisterType((*ErrPrerequisiteFailed)(nil))
 }
 
 // ErrNotApplicable should be returned by analyzer to tell that it is not applicable for given input
@@ -128,6 +131,24 @@ func (e ErrAnalyze) Unwrap() error {
 	return e.Err
 }
 
+// ErrPrerequisiteFailed means that the report of a prerequisite analyzer
+// (see AnalyzerReport) is not available: the prerequisite failed or is
+// not applicable itself.
+type ErrPrerequisiteFailed struct {
+	Prerequisite string
+	Err          error
+}
+
+// Error implements interface "error".
+func (e ErrPrerequisiteFailed) Error() string {
+	return fmt.Sprintf("prerequisite '%s' is not available: %v", e.Prerequisite, e.Err)
+}
+
+// Unwrap is used by errors.Is and errors.As.
+func (e ErrPrerequisiteFailed) Unwrap() error {
+	return e.Err
+}
+
 // ErrTypeIDNotRegistered means there was an attempt to serialize/deserialize a value
 // of a type, not registered in the type register (see also function `RegisterType`).
 type ErrTypeIDNotRegistered struct {
@@ -138,3 +159,49 @@ type ErrTypeIDNotRegistered struct {
 func (e ErrTypeIDNotRegistered) Error() string {
 	return fmt.Sprintf("type with TypeID '%s' is not registered", e.TypeID)
 }
+
+// ErrCyclicDependency means value calculators depend on each other in a loop,
+// so the value could never be calculated.
+type ErrCyclicDependency struct {
+	// Types is the loop of value types, the first and the last items are the same type.
+	Types []string
+}
+
+func newErrCyclicDependency(types []reflect.Type) ErrCyclicDependency {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, t.String())
+	}
+	return ErrCyclicDependency{Types: names}
+}
+
+// Error implements interface "error".
+func (e ErrCyclicDependency) Error() string {
+	return fmt.Sprintf("cyclic dependency between value calculators: %s", strings.Join(e.Types, " -> "))
+}
+
+// ErrBudgetExceeded means the analyzer was cancelled, because it exceeded
+// its execution budget (see the analyzer limits of afasd).
+type ErrBudgetExceeded struct {
+	// Resource is the exceeded resource: "time" or "memory".
+	Resource string
+	// Limit is the human-readable configured limit.
+	Limit string
+}
+
+// Error implements interface "error".
+func (e ErrBudgetExceeded) Error() string {
+	return fmt.Sprintf("the analyzer exceeded its %s budget of %s and was cancelled", e.Resource, e.Limit)
+}
+
+// ErrReferenceDataNotFound means the reference data of an analyzer with the
+// content hash from the Input is not available (not configured or already evicted),
+// see ReferenceDataStore.
+type ErrReferenceDataNotFound struct {
+	Hash ReferenceDataHash
+}
+
+// Error implements interface "error".
+func (e ErrReferenceDataNotFound) Error() string {
+	return fmt.Sprintf("reference data with hash '%s' is not available", e.Hash)
+}
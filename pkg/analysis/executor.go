@@ -37,22 +37,28 @@ func ExecuteAnalyzer[analyzerInputType any](
 	in Input,
 	cache DataCache,
 ) (retReport *Report, retErr error) {
+	measurement, ctx := startProfileMeasurement(ctx, ProfileEntryKindAnalyzer, string(analyzer.ID()))
+	defer func() {
+		measurement.Finish(retErr)
+	}()
 	defer func() {
 		if newErr := errmon.ObserveRecoverCtx(ctx, recover()).AsError(); newErr != nil {
 			retErr = newErr
 		}
 	}()
 
-	// TODO: replace reflection with generics:
-	analyzeMethod := reflect.ValueOf(analyzer.Analyze)
-
-	var argIssues []Issue
-	intPtr, argIssues, err := resolveInputStruct(ctx, analyzeMethod.Type().In(1), in, newUniqueTypeDataCache(cache), dataCalculator)
+	analyzerInput, argIssues, err := ResolveInput[analyzerInputType](ctx, dataCalculator, in, newUniqueTypeDataCache(cache))
 	if err != nil {
+		// An analyzer is not applicable if its prerequisite is not applicable; but if
+		// the prerequisite failed, then the analyzer failed as well.
+		var errPrerequisite ErrPrerequisiteFailed
+		if errors.As(err, &errPrerequisite) && errors.As(errPrerequisite.Err, &ErrNotApplicable{}) {
+			return nil, NewErrNotApplicable(errPrerequisite.Error())
+		}
 		return nil, ErrResolveInput{Err: err}
 	}
 
-	report, err := analyzer.Analyze(ctx, intPtr.Elem().Interface().(analyzerInputType))
+	report, err := analyzer.Analyze(ctx, analyzerInput)
 	if err != nil {
 		return nil, ErrAnalyze{Err: err}
 	}
@@ -109,8 +115,25 @@ func resolveType(
 	in Input,
 	cache DataCache,
 	dc DataCalculatorInterface,
-) (reflect.Value, []Issue, error) {
+) (retValue reflect.Value, retIssues []Issue, retErr error) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if isAnalyzerReportType(t) {
+		defer func() {
+			if retErr != nil {
+				retErr = ErrPrerequisiteFailed{Prerequisite: prerequisiteName(dc, t), Err: retErr}
+			}
+		}()
+	}
 	if res := findType(ctx, t, in, cache); res != nil {
+		if v := reflect.Indirect(res.Val); res.Err == nil && v.IsValid() {
+			if report, ok := v.Interface().(analyzerReportInterface); ok {
+				if err := report.notApplicable(); err != nil {
+					return reflect.Value{}, nil, err
+				}
+			}
+		}
 		return res.Val, res.Issues, res.Err
 	}
 	v, issues, err := dc.Calculate(ctx, t, in, cache)
@@ -148,22 +171,32 @@ func resolveInputStruct(
 			continue
 		}
 		argIssues = append(argIssues, issues...)
-		switch {
-		case v.Kind() == valueField.Kind():
-		case v.Kind() == reflect.Pointer:
-			v = v.Elem()
-		case valueField.Kind() == reflect.Pointer:
-			if v.CanAddr() {
-				v = v.Addr()
-			} else {
-				newV := reflect.New(v.Type())
-				newV.Elem().Set(v)
-				v = newV
-			}
-		default:
-			return reflect.Value{}, nil, fmt.Errorf("do not know how to assign %T to %T", v.Interface(), valueField.Interface())
+		v, err = convertResolvedValue(v, valueField.Type())
+		if err != nil {
+			return reflect.Value{}, nil, err
 		}
 		valueField.Set(v)
 	}
 	return intPtr, uniqueIssues(argIssues), nil
 }
+
+// convertResolvedValue converts a resolved value to be assignable to a field of type fieldType
+// (a value might be provided/calculated as a pointer, while the field is not, and vice versa).
+func convertResolvedValue(v reflect.Value, fieldType reflect.Type) (reflect.Value, error) {
+	switch {
+	case v.Kind() == fieldType.Kind():
+	case v.Kind() == reflect.Pointer:
+		v = v.Elem()
+	case fieldType.Kind() == reflect.Pointer:
+		if v.CanAddr() {
+			v = v.Addr()
+		} else {
+			newV := reflect.New(v.Type())
+			newV.Elem().Set(v)
+			v = newV
+		}
+	default:
+		return reflect.Value{}, fmt.Errorf("do not know how to assign %T to %s", v.Interface(), fieldType)
+	}
+	return v, nil
+}
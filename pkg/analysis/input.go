@@ -13,6 +13,7 @@
 package analysis
 
 import (
+	"crypto/sha256"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
@@ -23,6 +24,7 @@ import (
 	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
 	"github.com/immune-gmbh/attestation-sdk/pkg/xjson"
 )
@@ -118,6 +120,17 @@ func (in Input) Value() (driver.Value, error) {
 	return string(b), err
 }
 
+// Hash returns a SHA256 hash of the serialized input. Equal inputs have equal hashes,
+// so it could be used to find reports produced for the same input.
+func (in Input) Hash() ([]byte, error) {
+	b, err := in.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(b)
+	return h[:], nil
+}
+
 // AddOriginalFirmware adds the original firmware image
 func (in Input) AddOriginalFirmware(image Blob) Input {
 	return in.AddCustomValue(NewOriginalFirmwareBlob(image))
@@ -148,11 +161,26 @@ func (in Input) AddActualPCR0(pcr []byte) Input {
 	return in.AddCustomValue(ActualPCR0(pcr))
 }
 
+// AddActualACPITables adds the ACPI tables of a host
+func (in Input) AddActualACPITables(tables []acpi.Table) Input {
+	return in.AddCustomValue(ActualACPITables(tables))
+}
+
+// AddPSBFusedKeyHash adds the hash of the OEM root key fused into the AMD CPU of a host
+func (in Input) AddPSBFusedKeyHash(keyHash []byte) Input {
+	return in.AddCustomValue(PSBFusedKeyHash(keyHash))
+}
+
 // AddAssetID adds information about asset id of a host
 func (in Input) AddAssetID(assetID int64) Input {
 	return in.AddCustomValue(AssetID(assetID))
 }
 
+// AddModelID adds information about the model id of a host
+func (in Input) AddModelID(modelID int64) Input {
+	return in.AddCustomValue(ModelID(modelID))
+}
+
 // AddActualBIOSInfo adds SMBIOS info about the actual BIOS firmware.
 func (in Input) AddActualBIOSInfo(biosInfo ActualBIOSInfo) Input {
 	return in.AddCustomValue(biosInfo)
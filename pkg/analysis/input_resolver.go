@@ -0,0 +1,190 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// InputField describes a single field of an analyzer input structure.
+type InputField struct {
+	Name     string
+	Type     reflect.Type
+	Optional bool
+}
+
+// InputDescription describes the required and optional inputs of an analyzer.
+type InputDescription struct {
+	Fields []InputField
+}
+
+// Required returns the fields which should be resolved for the analyzer to run.
+func (d InputDescription) Required() []InputField {
+	var result []InputField
+	for _, field := range d.Fields {
+		if !field.Optional {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+// Optional returns the fields which are allowed to be missing.
+func (d InputDescription) Optional() []InputField {
+	var result []InputField
+	for _, field := range d.Fields {
+		if field.Optional {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+// InputResolver fills the input structure of an analyzer with values
+// which are either provided in Input or calculated by DataCalculatorInterface.
+//
+// Strongly-typed resolvers are generated by tools/analyzerinputgen (see `go generate`).
+type InputResolver[inputType any] func(ctx context.Context, dc DataCalculatorInterface, in Input, cache DataCache) (inputType, []Issue, error)
+
+type generatedInputResolver struct {
+	description InputDescription
+	resolver    any // InputResolver[inputType]
+}
+
+var (
+	generatedInputResolversLocker sync.RWMutex
+	generatedInputResolvers       = map[reflect.Type]generatedInputResolver{}
+)
+
+// RegisterInputResolver registers a generated resolver of an analyzer input structure.
+// It is supposed to be called only from generated code.
+func RegisterInputResolver[inputType any](description InputDescription, resolver InputResolver[inputType]) {
+	generatedInputResolversLocker.Lock()
+	defer generatedInputResolversLocker.Unlock()
+	generatedInputResolvers[reflect.TypeOf((*inputType)(nil)).Elem()] = generatedInputResolver{
+		description: description,
+		resolver:    resolver,
+	}
+}
+
+func getGeneratedInputResolver[inputType any]() (generatedInputResolver, bool) {
+	generatedInputResolversLocker.RLock()
+	defer generatedInputResolversLocker.RUnlock()
+	r, ok := generatedInputResolvers[reflect.TypeOf((*inputType)(nil)).Elem()]
+	return r, ok
+}
+
+// HasGeneratedInputResolver returns true if a generated resolver is registered for the input structure.
+func HasGeneratedInputResolver[inputType any]() bool {
+	_, ok := getGeneratedInputResolver[inputType]()
+	return ok
+}
+
+// DescribeInput returns the description of an analyzer input structure.
+// It is taken from generated code if available, otherwise it is obtained through reflection.
+func DescribeInput[inputType any]() InputDescription {
+	if r, ok := getGeneratedInputResolver[inputType](); ok {
+		return r.description
+	}
+	return DescribeInputByReflection(reflect.TypeOf((*inputType)(nil)).Elem())
+}
+
+// DescribeInputByReflection returns the description of an analyzer input structure
+// by inspecting its fields and `exec` tags.
+func DescribeInputByReflection(t reflect.Type) InputDescription {
+	var result InputDescription
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+		result.Fields = append(result.Fields, InputField{
+			Name:     field.Name,
+			Type:     field.Type,
+			Optional: isOptional(strings.Split(field.Tag.Get("exec"), ",")),
+		})
+	}
+	return result
+}
+
+// ResolveInput fills an analyzer input structure. The generated resolver is used
+// if available, otherwise the structure is filled through reflection.
+func ResolveInput[inputType any](
+	ctx context.Context,
+	dc DataCalculatorInterface,
+	in Input,
+	cache DataCache,
+) (inputType, []Issue, error) {
+	r, ok := getGeneratedInputResolver[inputType]()
+	if !ok {
+		return ResolveInputByReflection[inputType](ctx, dc, in, cache)
+	}
+	result, issues, err := r.resolver.(InputResolver[inputType])(ctx, dc, in, cache)
+	if err != nil {
+		var zeroValue inputType
+		return zeroValue, nil, err
+	}
+	return result, uniqueIssues(issues), nil
+}
+
+// ResolveInputByReflection fills an analyzer input structure through reflection
+// (the fallback if there is no generated resolver).
+func ResolveInputByReflection[inputType any](
+	ctx context.Context,
+	dc DataCalculatorInterface,
+	in Input,
+	cache DataCache,
+) (inputType, []Issue, error) {
+	var zeroValue inputType
+	intPtr, issues, err := resolveInputStruct(ctx, reflect.TypeOf((*inputType)(nil)).Elem(), in, cache, dc)
+	if err != nil {
+		return zeroValue, nil, err
+	}
+	return intPtr.Elem().Interface().(inputType), issues, nil
+}
+
+// ResolveInputField resolves a single field of an analyzer input structure into dst,
+// the same way as it is done through reflection. It is supposed to be called only
+// from generated code.
+//
+// If an optional field could not be resolved, then dst is left untouched and no error is returned.
+func ResolveInputField[fieldType any](
+	ctx context.Context,
+	dc DataCalculatorInterface,
+	in Input,
+	cache DataCache,
+	fieldName string,
+	optional bool,
+	dst *fieldType,
+) ([]Issue, error) {
+	t := reflect.TypeOf((*fieldType)(nil)).Elem()
+	v, issues, err := resolveType(ctx, t, in, cache, dc)
+	if err != nil {
+		// it is ok to get an error for an optional field
+		if optional {
+			return nil, nil
+		}
+		return nil, ErrResolveValue{FieldName: fieldName, TypeName: t.Name(), Err: err}
+	}
+	v, err = convertResolvedValue(v, t)
+	if err != nil {
+		return nil, err
+	}
+	result, ok := v.Interface().(fieldType)
+	if !ok {
+		return nil, fmt.Errorf("do not know how to assign %T to %s", v.Interface(), t)
+	}
+	*dst = result
+	return issues, nil
+}
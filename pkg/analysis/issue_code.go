@@ -0,0 +1,87 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// IssueCode is a stable machine-readable identifier of a kind of issues,
+// formatted as "<ANALYZER>.<KIND>", for example "REPRO_PCR.MISMATCH_LOCALITY".
+//
+// Dashboards and runbooks rely on issue codes, so a code should never be
+// renamed or reused for another kind of issues. Every code of built-in
+// analyzers is listed in the catalog, see IssueCodes.
+type IssueCode string
+
+var issueCodeRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9_]*\.[A-Z][A-Z0-9_]*$`)
+
+// Validate returns an error if the code is not formatted as "<ANALYZER>.<KIND>".
+func (code IssueCode) Validate() error {
+	if !issueCodeRegexp.MatchString(string(code)) {
+		return fmt.Errorf("invalid issue code '%s': expected format <ANALYZER>.<KIND> (uppercase letters, digits and underscores)", string(code))
+	}
+	return nil
+}
+
+// IssueCodeInfo is an entry of the issue code catalog.
+type IssueCodeInfo struct {
+	Code IssueCode
+
+	// AnalyzerID is the analyzer which reports the issue. It is empty
+	// for issues reported by value calculators shared by analyzers.
+	AnalyzerID AnalyzerID
+
+	// Params are the names of the structured parameters of the issue, see Issue.Params.
+	Params []string
+
+	// Description explains what the issue means.
+	Description string
+
+	// Remediation is a hint on how to fix the issue.
+	Remediation string
+}
+
+var issueCodeInfos = func() map[IssueCode]*IssueCodeInfo {
+	m := make(map[IssueCode]*IssueCodeInfo, len(issueCodeCatalog))
+	for idx := range issueCodeCatalog {
+		info := &issueCodeCatalog[idx]
+		if _, ok := m[info.Code]; ok {
+			panic(fmt.Errorf("issue code '%s' is defined twice", info.Code))
+		}
+		m[info.Code] = info
+	}
+	return m
+}()
+
+// IssueCodes returns the catalog of issue codes of built-in analyzers sorted by code.
+func IssueCodes() []IssueCodeInfo {
+	result := make([]IssueCodeInfo, len(issueCodeCatalog))
+	copy(result, issueCodeCatalog)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Code < result[j].Code
+	})
+	return result
+}
+
+// LookupIssueCode returns the catalog entry of the issue code, or nil if the code is not in the catalog.
+func LookupIssueCode(code IssueCode) *IssueCodeInfo {
+	info := issueCodeInfos[code]
+	if info == nil {
+		return nil
+	}
+	infoCopy := *info
+	return &infoCopy
+}
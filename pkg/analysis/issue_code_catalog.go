@@ -0,0 +1,778 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+// Issue codes of all built-in analyzers, see IssueCode. The description and
+// the remediation hint of each code are in issueCodeCatalog.
+const (
+	// Issues reported by common value calculators.
+	IssueCodeAnalysisRegistersNotVerified     IssueCode = "ANALYSIS.REGISTERS_NOT_VERIFIED"
+	IssueCodeAnalysisHostConfigurationIssue   IssueCode = "ANALYSIS.HOST_CONFIGURATION_ISSUE"
+	IssueCodeAnalysisRegistersCheckFailed     IssueCode = "ANALYSIS.REGISTERS_CHECK_FAILED"
+	IssueCodeAnalysisUnexpectedRegister       IssueCode = "ANALYSIS.UNEXPECTED_REGISTER"
+	IssueCodeAnalysisRegisterCorrected        IssueCode = "ANALYSIS.REGISTER_CORRECTED"
+	IssueCodeAnalysisReferenceFirmwarePartial IssueCode = "ANALYSIS.REFERENCE_FIRMWARE_PARTIAL"
+
+	// Issues of analyzer ReproducePCR.
+	IssueCodeReproducePCRACMPolicyStatusCorrected   IssueCode = "REPRO_PCR.ACM_POLICY_STATUS_CORRECTED"
+	IssueCodeReproducePCRMatchedFlow                IssueCode = "REPRO_PCR.MATCHED_FLOW"
+	IssueCodeReproducePCRReproductionFailed         IssueCode = "REPRO_PCR.REPRODUCTION_FAILED"
+	IssueCodeReproducePCRMismatch                   IssueCode = "REPRO_PCR.MISMATCH"
+	IssueCodeReproducePCRMismatchLocality           IssueCode = "REPRO_PCR.MISMATCH_LOCALITY"
+	IssueCodeReproducePCRDisabledMeasurements       IssueCode = "REPRO_PCR.DISABLED_MEASUREMENTS"
+	IssueCodeReproducePCRACMPolicyStatusRecorrected IssueCode = "REPRO_PCR.ACM_POLICY_STATUS_RECORRECTED"
+	IssueCodeReproducePCRNoEventLog                 IssueCode = "REPRO_PCR.NO_EVENTLOG"
+	IssueCodeReproducePCREventLogACMPolicyStatus    IssueCode = "REPRO_PCR.EVENTLOG_ACM_POLICY_STATUS"
+	IssueCodeReproducePCREventLogReproductionError  IssueCode = "REPRO_PCR.EVENTLOG_REPRODUCTION_ERROR"
+	IssueCodeReproducePCREventLogReproductionIssue  IssueCode = "REPRO_PCR.EVENTLOG_REPRODUCTION_ISSUE"
+	IssueCodeReproducePCREventLogReplayMatch        IssueCode = "REPRO_PCR.EVENTLOG_REPLAY_MATCH"
+	IssueCodeReproducePCREventLogReplayMismatch     IssueCode = "REPRO_PCR.EVENTLOG_REPLAY_MISMATCH"
+	IssueCodeReproducePCREventLogReplayFailed       IssueCode = "REPRO_PCR.EVENTLOG_REPLAY_FAILED"
+
+	// Issues of analyzer DiffMeasuredBoot.
+	IssueCodeDiffMeasuredBootSignificantNVRAMChange IssueCode = "DIFF_MB.SIGNIFICANT_NVRAM_CHANGE"
+	IssueCodeDiffMeasuredBootNotSuspiciousDamage    IssueCode = "DIFF_MB.NOT_SUSPICIOUS_DAMAGE"
+	IssueCodeDiffMeasuredBootSuspiciousDamage       IssueCode = "DIFF_MB.SUSPICIOUS_DAMAGE"
+	IssueCodeDiffMeasuredBootBenignNVRAMChange      IssueCode = "DIFF_MB.BENIGN_NVRAM_CHANGE"
+	IssueCodeDiffMeasuredBootDiagnosis              IssueCode = "DIFF_MB.DIAGNOSIS"
+
+	// Issues of analyzer IntelACM.
+	IssueCodeIntelACMInfoUnavailable IssueCode = "INTEL_ACM.INFO_UNAVAILABLE"
+	IssueCodeIntelACMMismatch        IssueCode = "INTEL_ACM.MISMATCH"
+
+	// Issues of analyzer IntelFlashDescriptor.
+	IssueCodeIntelFlashDescriptorOriginalUnparsable IssueCode = "INTEL_IFD.ORIGINAL_UNPARSABLE"
+	IssueCodeIntelFlashDescriptorChanged            IssueCode = "INTEL_IFD.DESCRIPTOR_CHANGED"
+	IssueCodeIntelFlashDescriptorBIOSRegionWritable IssueCode = "INTEL_IFD.BIOS_REGION_WRITABLE"
+	IssueCodeIntelFlashDescriptorUnlocked           IssueCode = "INTEL_IFD.DESCRIPTOR_UNLOCKED"
+
+	// Issues of analyzer IntelME.
+	IssueCodeIntelMEPartitionMismatch                     IssueCode = "INTEL_ME.PARTITION_MISMATCH"
+	IssueCodeIntelMEPartitionInvalidFormat                IssueCode = "INTEL_ME.PARTITION_INVALID_FORMAT"
+	IssueCodeIntelMEOriginalUnparsable                    IssueCode = "INTEL_ME.ORIGINAL_UNPARSABLE"
+	IssueCodeIntelMEPartitionsChangedWithoutVersionChange IssueCode = "INTEL_ME.PARTITIONS_CHANGED_WITHOUT_VERSION_CHANGE"
+	IssueCodeIntelMEVersionDowngrade                      IssueCode = "INTEL_ME.VERSION_DOWNGRADE"
+	IssueCodeIntelMESVNDowngrade                          IssueCode = "INTEL_ME.SVN_DOWNGRADE"
+
+	// Issues of analyzer TXTErrors.
+	IssueCodeTXTErrorsUnknownError IssueCode = "TXT_ERRORS.UNKNOWN_ERROR"
+	IssueCodeTXTErrorsError        IssueCode = "TXT_ERRORS.ERROR"
+	IssueCodeTXTErrorsReset        IssueCode = "TXT_ERRORS.TXT_RESET"
+
+	// Issues of analyzer PSPSignature.
+	IssueCodePSPSignatureInvalidFormat           IssueCode = "PSP_SIGNATURE.INVALID_FORMAT"
+	IssueCodePSPSignatureNotFound                IssueCode = "PSP_SIGNATURE.NOT_FOUND"
+	IssueCodePSPSignatureIncorrectSignature      IssueCode = "PSP_SIGNATURE.INCORRECT_SIGNATURE"
+	IssueCodePSPSignatureKeyNotFound             IssueCode = "PSP_SIGNATURE.KEY_NOT_FOUND"
+	IssueCodePSPSignatureUnknownValidationResult IssueCode = "PSP_SIGNATURE.UNKNOWN_VALIDATION_RESULT"
+
+	// Issues of analyzer BIOSRTMVolume.
+	IssueCodeBIOSRTMVolumeUnknownProblem              IssueCode = "BIOS_RTM.UNKNOWN_PROBLEM"
+	IssueCodeBIOSRTMVolumeNotFound                    IssueCode = "BIOS_RTM.VOLUME_NOT_FOUND"
+	IssueCodeBIOSRTMVolumeSignatureNotFound           IssueCode = "BIOS_RTM.SIGNATURE_NOT_FOUND"
+	IssueCodeBIOSRTMVolumeInvalidFormat               IssueCode = "BIOS_RTM.INVALID_FORMAT"
+	IssueCodeBIOSRTMVolumeIncorrectSignature          IssueCode = "BIOS_RTM.INCORRECT_SIGNATURE"
+	IssueCodeBIOSRTMVolumeUnsupportedValidationResult IssueCode = "BIOS_RTM.UNSUPPORTED_VALIDATION_RESULT"
+	IssueCodeBIOSRTMVolumeVendorIDMismatch            IssueCode = "BIOS_RTM.VENDOR_ID_MISMATCH"
+	IssueCodeBIOSRTMVolumeAMDBIOSKeyUseDisabled       IssueCode = "BIOS_RTM.AMD_BIOS_KEY_USE_DISABLED"
+	IssueCodeBIOSRTMVolumeBIOSKeyAntiRollbackDisabled IssueCode = "BIOS_RTM.BIOS_KEY_ANTI_ROLLBACK_DISABLED"
+	IssueCodeBIOSRTMVolumeSecureDebugUnlockDisabled   IssueCode = "BIOS_RTM.SECURE_DEBUG_UNLOCK_DISABLED"
+
+	// Issues of analyzer APCBSecurityTokens.
+	IssueCodeAPCBSecurityTokensMeasureConfigMissing      IssueCode = "APCB_TOKENS.MEASURE_CONFIG_MISSING"
+	IssueCodeAPCBSecurityTokensMeasureConfigInvalidType  IssueCode = "APCB_TOKENS.MEASURE_CONFIG_INVALID_TYPE"
+	IssueCodeAPCBSecurityTokensMeasureConfigInvalidValue IssueCode = "APCB_TOKENS.MEASURE_CONFIG_INVALID_VALUE"
+
+	// Issues of analyzer PSPSecurityPatchLevel.
+	IssueCodePSPSecurityPatchLevelInvalidEntryHeader        IssueCode = "PSP_SPL.INVALID_ENTRY_HEADER"
+	IssueCodePSPSecurityPatchLevelOriginalUnavailable       IssueCode = "PSP_SPL.ORIGINAL_UNAVAILABLE"
+	IssueCodePSPSecurityPatchLevelEntryDowngrade            IssueCode = "PSP_SPL.ENTRY_DOWNGRADE"
+	IssueCodePSPSecurityPatchLevelSPLTableDowngrade         IssueCode = "PSP_SPL.SPL_TABLE_DOWNGRADE"
+	IssueCodePSPSecurityPatchLevelEntryBelowSPLTable        IssueCode = "PSP_SPL.ENTRY_BELOW_SPL_TABLE"
+	IssueCodePSPSecurityPatchLevelBIOSKeyRevisionBelowFuses IssueCode = "PSP_SPL.BIOS_KEY_REVISION_BELOW_FUSES"
+	IssueCodePSPSecurityPatchLevelAntiRollbackNotEnforced   IssueCode = "PSP_SPL.ANTI_ROLLBACK_NOT_ENFORCED"
+
+	// Issues of analyzer PSBFuses.
+	IssueCodePSBFusesPSBDisabled      IssueCode = "PSB_FUSES.PSB_DISABLED"
+	IssueCodePSBFusesTestStatusFailed IssueCode = "PSB_FUSES.TEST_STATUS_FAILED"
+	IssueCodePSBFusesNoOEMKey         IssueCode = "PSB_FUSES.NO_OEM_KEY"
+	IssueCodePSBFusesBindingMismatch  IssueCode = "PSB_FUSES.BINDING_MISMATCH"
+
+	// Issues of analyzer VulnerableModules.
+	IssueCodeVulnerableModulesAffectedModule IssueCode = "VULN_MODULES.AFFECTED_MODULE"
+
+	// Issues of analyzer FlashDegradation.
+	IssueCodeFlashDegradationHardwareDegradation IssueCode = "FLASH_DEGRADATION.HARDWARE_DEGRADATION"
+	IssueCodeFlashDegradationIntentionalWrite    IssueCode = "FLASH_DEGRADATION.INTENTIONAL_WRITE"
+
+	// Issues of analyzer FirmwareProvenance.
+	IssueCodeFirmwareProvenanceUnknownVersion IssueCode = "FW_PROVENANCE.UNKNOWN_VERSION"
+	IssueCodeFirmwareProvenanceRevoked        IssueCode = "FW_PROVENANCE.REVOKED"
+	IssueCodeFirmwareProvenanceSuperseded     IssueCode = "FW_PROVENANCE.SUPERSEDED"
+	IssueCodeFirmwareProvenanceOutdated       IssueCode = "FW_PROVENANCE.OUTDATED"
+
+	// Issues of analyzer OptionROMs.
+	IssueCodeOptionROMsModified IssueCode = "OPTION_ROMS.MODIFIED"
+	IssueCodeOptionROMsUnknown  IssueCode = "OPTION_ROMS.UNKNOWN"
+
+	// Issues of analyzer BootChain.
+	IssueCodeBootChainNotAllowlisted IssueCode = "BOOT_CHAIN.NOT_ALLOWLISTED"
+
+	// Issues of analyzer ACPITables.
+	IssueCodeACPITablesOriginalUnavailable      IssueCode = "ACPI_TABLES.ORIGINAL_UNAVAILABLE"
+	IssueCodeACPITablesInvalidTable             IssueCode = "ACPI_TABLES.INVALID_TABLE"
+	IssueCodeACPITablesInvalidChecksum          IssueCode = "ACPI_TABLES.INVALID_CHECKSUM"
+	IssueCodeACPITablesTableChanged             IssueCode = "ACPI_TABLES.TABLE_CHANGED"
+	IssueCodeACPITablesDMATableInvalid          IssueCode = "ACPI_TABLES.DMA_TABLE_INVALID"
+	IssueCodeACPITablesNoDMARemapping           IssueCode = "ACPI_TABLES.NO_DMA_REMAPPING"
+	IssueCodeACPITablesDMAOptInCleared          IssueCode = "ACPI_TABLES.DMA_OPT_IN_CLEARED"
+	IssueCodeACPITablesDMAOptInNotSet           IssueCode = "ACPI_TABLES.DMA_OPT_IN_NOT_SET"
+	IssueCodeACPITablesUnexpectedReservedRegion IssueCode = "ACPI_TABLES.UNEXPECTED_RESERVED_REGION"
+
+	// Issues of analyzer ImageDiff.
+	IssueCodeImageDiffDataAppended       IssueCode = "IMAGE_DIFF.DATA_APPENDED"
+	IssueCodeImageDiffTruncated          IssueCode = "IMAGE_DIFF.TRUNCATED"
+	IssueCodeImageDiffResized            IssueCode = "IMAGE_DIFF.RESIZED"
+	IssueCodeImageDiffMalformedContainer IssueCode = "IMAGE_DIFF.MALFORMED_CONTAINER"
+	IssueCodeImageDiffEntryChanged       IssueCode = "IMAGE_DIFF.ENTRY_CHANGED"
+	IssueCodeImageDiffMoreEntriesChanged IssueCode = "IMAGE_DIFF.MORE_ENTRIES_CHANGED"
+	IssueCodeImageDiffRawChunksChanged   IssueCode = "IMAGE_DIFF.RAW_CHUNKS_CHANGED"
+)
+
+var issueCodeCatalog = []IssueCodeInfo{
+	{
+		Code:        IssueCodeAnalysisRegistersNotVerified,
+		Description: "Neither a TPM event log nor a PCR0 value is provided, so the status registers reported by the host are used as is.",
+		Remediation: "Collect the TPM event log or PCR0 together with the status registers.",
+	},
+	{
+		Code:        IssueCodeAnalysisHostConfigurationIssue,
+		Description: "A non-fatal problem occurred while reconstructing the host configuration (status registers) from the measurements.",
+		Remediation: "Usually informational; if verdicts look wrong, replay the report and check the measurements of the image.",
+	},
+	{
+		Code:        IssueCodeAnalysisRegistersCheckFailed,
+		Description: "The status registers reported by the host could not be verified against the measurements, they are used as is.",
+		Remediation: "Check that the TPM event log and PCR0 belong to the analyzed firmware image.",
+	},
+	{
+		Code:        IssueCodeAnalysisUnexpectedRegister,
+		Params:      []string{"register"},
+		Description: "The host reported a status register which is not expected for the firmware.",
+		Remediation: "Check the tool collecting the status registers on the host.",
+	},
+	{
+		Code:        IssueCodeAnalysisRegisterCorrected,
+		Params:      []string{"register", "actual", "corrected"},
+		Description: "The value of a status register reported by the host was corrected to match the measurements.",
+		Remediation: "Usually informational; a persistent mismatch may indicate a bug in the register collection tool.",
+	},
+	{
+		Code:        IssueCodeAnalysisReferenceFirmwarePartial,
+		Description: "The reference firmware could be constructed only partially, so the analysis may be less precise.",
+		Remediation: "Provide both the original and the actual firmware images.",
+	},
+	{
+		Code:        IssueCodeReproducePCRACMPolicyStatusCorrected,
+		AnalyzerID:  "ReproducePCR",
+		Params:      []string{"actual", "corrected"},
+		Description: "The ACM_POLICY_STATUS register value was corrected to reproduce PCR0.",
+		Remediation: "Informational; check the tool collecting the status registers if it happens on every host.",
+	},
+	{
+		Code:        IssueCodeReproducePCRMatchedFlow,
+		AnalyzerID:  "ReproducePCR",
+		Params:      []string{"flow"},
+		Description: "PCR0 was reproduced using a boot flow other than the expected one.",
+		Remediation: "Check if the firmware or platform configuration enables the expected boot flow.",
+	},
+	{
+		Code:        IssueCodeReproducePCRReproductionFailed,
+		AnalyzerID:  "ReproducePCR",
+		Description: "An error occurred while reproducing PCR0.",
+		Remediation: "Check the inputs (image, registers, event log); replay the report to debug the failure.",
+	},
+	{
+		Code:        IssueCodeReproducePCRMismatch,
+		AnalyzerID:  "ReproducePCR",
+		Description: "PCR0 of the host could not be reproduced from the firmware image: the host may run a different or tampered firmware.",
+		Remediation: "Compare the running firmware with the original image (see DiffMeasuredBoot) and reflash the host if needed.",
+	},
+	{
+		Code:        IssueCodeReproducePCRMismatchLocality,
+		AnalyzerID:  "ReproducePCR",
+		Params:      []string{"actual_locality", "expected_locality"},
+		Description: "PCR0 was reproduced only with an unexpected TPM locality.",
+		Remediation: "Check the TXT/Boot Guard configuration of the host: an unexpected locality means an unexpected boot flow.",
+	},
+	{
+		Code:        IssueCodeReproducePCRDisabledMeasurements,
+		AnalyzerID:  "ReproducePCR",
+		Params:      []string{"measurements"},
+		Description: "PCR0 was reproduced only with some measurements disabled.",
+		Remediation: "Check the firmware and platform configuration, the listed components are not measured.",
+	},
+	{
+		Code:        IssueCodeReproducePCRACMPolicyStatusRecorrected,
+		AnalyzerID:  "ReproducePCR",
+		Params:      []string{"corrected", "found", "final"},
+		Description: "Internal problem: the ACM_POLICY_STATUS value was corrected twice to different values.",
+		Remediation: "Report the problem to the maintainers of AFAS with the analyzer report ID.",
+	},
+	{
+		Code:        IssueCodeReproducePCRNoEventLog,
+		AnalyzerID:  "ReproducePCR",
+		Description: "The TPM event log is not provided, so the result could not be cross-checked with the event log.",
+		Remediation: "Collect the TPM event log from the host.",
+	},
+	{
+		Code:        IssueCodeReproducePCREventLogACMPolicyStatus,
+		AnalyzerID:  "ReproducePCR",
+		Params:      []string{"status"},
+		Description: "The TPM event log suggests a different ACM_POLICY_STATUS value than the reported one.",
+		Remediation: "Check the tool collecting the status registers on the host.",
+	},
+	{
+		Code:        IssueCodeReproducePCREventLogReproductionError,
+		AnalyzerID:  "ReproducePCR",
+		Description: "An error occurred while reproducing the TPM event log.",
+		Remediation: "Check the TPM event log of the host is complete and belongs to the current boot.",
+	},
+	{
+		Code:        IssueCodeReproducePCREventLogReproductionIssue,
+		AnalyzerID:  "ReproducePCR",
+		Description: "A non-fatal problem occurred while reproducing the TPM event log.",
+		Remediation: "Usually informational; check the TPM event log of the host if it happens often.",
+	},
+	{
+		Code:        IssueCodeReproducePCREventLogReplayMatch,
+		AnalyzerID:  "ReproducePCR",
+		Description: "PCR0 replayed from the TPM event log matches the provided PCR0.",
+		Remediation: "No action required.",
+	},
+	{
+		Code:        IssueCodeReproducePCREventLogReplayMismatch,
+		AnalyzerID:  "ReproducePCR",
+		Description: "PCR0 replayed from the TPM event log does not match the provided PCR0: the event log is incomplete or not trustworthy.",
+		Remediation: "Re-collect the TPM event log and PCR0 from the host.",
+	},
+	{
+		Code:        IssueCodeReproducePCREventLogReplayFailed,
+		AnalyzerID:  "ReproducePCR",
+		Description: "PCR0 could not be replayed from the TPM event log.",
+		Remediation: "Check the TPM event log of the host is valid.",
+	},
+	{
+		Code:        IssueCodeDiffMeasuredBootSignificantNVRAMChange,
+		AnalyzerID:  "DiffMeasuredBoot",
+		Params:      []string{"guid", "name", "change"},
+		Description: "A security-relevant NVRAM variable is changed.",
+		Remediation: "Check if the change of the variable was intended (for example a BIOS setup change), restore it otherwise.",
+	},
+	{
+		Code:        IssueCodeDiffMeasuredBootNotSuspiciousDamage,
+		AnalyzerID:  "DiffMeasuredBoot",
+		Description: "The firmware is damaged, but the damage does not look like an attack.",
+		Remediation: "Reflash the firmware; consider checking the flash chip (see FlashDegradation).",
+	},
+	{
+		Code:        IssueCodeDiffMeasuredBootSuspiciousDamage,
+		AnalyzerID:  "DiffMeasuredBoot",
+		Description: "The measured firmware differs from the original one in a way which may be an attack.",
+		Remediation: "Isolate the host and escalate to the security team; reflash the firmware from a trusted source.",
+	},
+	{
+		Code:        IssueCodeDiffMeasuredBootBenignNVRAMChange,
+		AnalyzerID:  "DiffMeasuredBoot",
+		Description: "Only benign NVRAM variables are changed.",
+		Remediation: "No action required.",
+	},
+	{
+		Code:        IssueCodeDiffMeasuredBootDiagnosis,
+		AnalyzerID:  "DiffMeasuredBoot",
+		Params:      []string{"diagnosis"},
+		Description: "The difference between the measured and the original firmware got the given diagnosis.",
+		Remediation: "Follow the runbook of the diagnosis.",
+	},
+	{
+		Code:        IssueCodeIntelACMInfoUnavailable,
+		AnalyzerID:  "IntelACM",
+		Description: "Unable to extract information about the ACM from an image.",
+		Remediation: "Check the image is a complete Intel firmware image.",
+	},
+	{
+		Code:        IssueCodeIntelACMMismatch,
+		AnalyzerID:  "IntelACM",
+		Params:      []string{"original", "actual"},
+		Description: "The ACM of the actual image differs from the ACM of the original image.",
+		Remediation: "Reflash the firmware, unless the ACM update was intended.",
+	},
+	{
+		Code:        IssueCodeIntelFlashDescriptorOriginalUnparsable,
+		AnalyzerID:  "IntelFlashDescriptor",
+		Description: "The flash descriptor of the original image could not be parsed, so no comparison was made.",
+		Remediation: "Check the original image in the firmware database.",
+	},
+	{
+		Code:        IssueCodeIntelFlashDescriptorChanged,
+		AnalyzerID:  "IntelFlashDescriptor",
+		Params:      []string{"fields"},
+		Description: "The flash descriptor differs from the original one.",
+		Remediation: "Reflash the firmware, a modified descriptor may expose flash regions.",
+	},
+	{
+		Code:        IssueCodeIntelFlashDescriptorBIOSRegionWritable,
+		AnalyzerID:  "IntelFlashDescriptor",
+		Params:      []string{"master"},
+		Description: "The BIOS region is writable by a master other than the host CPU.",
+		Remediation: "Fix the flash master permissions in the firmware build and reflash.",
+	},
+	{
+		Code:        IssueCodeIntelFlashDescriptorUnlocked,
+		AnalyzerID:  "IntelFlashDescriptor",
+		Params:      []string{"writers"},
+		Description: "The flash descriptor is not locked.",
+		Remediation: "Lock the flash descriptor in the firmware build and reflash.",
+	},
+	{
+		Code:        IssueCodeIntelMEPartitionMismatch,
+		AnalyzerID:  "IntelME",
+		Params:      []string{"partition"},
+		Description: "An ME partition does not match its manifest.",
+		Remediation: "Reflash the firmware; escalate to the security team if it persists.",
+	},
+	{
+		Code:        IssueCodeIntelMEPartitionInvalidFormat,
+		AnalyzerID:  "IntelME",
+		Params:      []string{"partition"},
+		Description: "An ME partition has an invalid format.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeIntelMEOriginalUnparsable,
+		AnalyzerID:  "IntelME",
+		Description: "The ME region of the original image could not be parsed, so no comparison was made.",
+		Remediation: "Check the original image in the firmware database.",
+	},
+	{
+		Code:        IssueCodeIntelMEPartitionsChangedWithoutVersionChange,
+		AnalyzerID:  "IntelME",
+		Params:      []string{"partitions"},
+		Description: "ME partitions are changed while the ME firmware version is the same.",
+		Remediation: "Reflash the firmware; escalate to the security team if it persists.",
+	},
+	{
+		Code:        IssueCodeIntelMEVersionDowngrade,
+		AnalyzerID:  "IntelME",
+		Params:      []string{"original_version", "actual_version"},
+		Description: "The ME firmware is downgraded.",
+		Remediation: "Update the ME firmware to the expected version.",
+	},
+	{
+		Code:        IssueCodeIntelMESVNDowngrade,
+		AnalyzerID:  "IntelME",
+		Params:      []string{"original_svn", "actual_svn"},
+		Description: "The security version number of the ME firmware is downgraded.",
+		Remediation: "Update the ME firmware to the expected version.",
+	},
+	{
+		Code:        IssueCodeTXTErrorsUnknownError,
+		AnalyzerID:  "TXTErrors",
+		Params:      []string{"register", "source", "code"},
+		Description: "A TXT status register reports an error code, which is not in the TXT error code table.",
+		Remediation: "Add the code to the TXT error code table (see afasd --txt-error-codes) and follow its remediation.",
+	},
+	{
+		Code:        IssueCodeTXTErrorsError,
+		AnalyzerID:  "TXTErrors",
+		Params:      []string{"register", "source", "code", "name"},
+		Description: "A TXT status register reports a known error code.",
+		Remediation: "Follow the remediation of the error code.",
+	},
+	{
+		Code:        IssueCodeTXTErrorsReset,
+		AnalyzerID:  "TXTErrors",
+		Description: "TXT was reset because of an error.",
+		Remediation: "Check the other TXT errors of the report.",
+	},
+	{
+		Code:        IssueCodePSPSignatureInvalidFormat,
+		AnalyzerID:  "PSPSignature",
+		Params:      []string{"item"},
+		Description: "A PSP directory entry has an invalid format.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodePSPSignatureNotFound,
+		AnalyzerID:  "PSPSignature",
+		Params:      []string{"item"},
+		Description: "A PSP directory entry is not found.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodePSPSignatureIncorrectSignature,
+		AnalyzerID:  "PSPSignature",
+		Params:      []string{"item"},
+		Description: "A PSP directory entry has an incorrect signature.",
+		Remediation: "Isolate the host and escalate to the security team; reflash the firmware from a trusted source.",
+	},
+	{
+		Code:        IssueCodePSPSignatureKeyNotFound,
+		AnalyzerID:  "PSPSignature",
+		Params:      []string{"item"},
+		Description: "The key to verify the signature of a PSP directory entry is not found.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodePSPSignatureUnknownValidationResult,
+		AnalyzerID:  "PSPSignature",
+		Params:      []string{"item"},
+		Description: "A PSP directory entry failed the validation for an unknown reason.",
+		Remediation: "Replay the report and check the validation description.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeUnknownProblem,
+		AnalyzerID:  "BIOSRTMVolume",
+		Description: "The BIOS RTM volume failed the validation for an unknown reason.",
+		Remediation: "Replay the report and check the validation description.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeNotFound,
+		AnalyzerID:  "BIOSRTMVolume",
+		Description: "The BIOS RTM volume is not found.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeSignatureNotFound,
+		AnalyzerID:  "BIOSRTMVolume",
+		Description: "The signature of the BIOS RTM volume is not found.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeInvalidFormat,
+		AnalyzerID:  "BIOSRTMVolume",
+		Description: "The BIOS RTM volume has an invalid format.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeIncorrectSignature,
+		AnalyzerID:  "BIOSRTMVolume",
+		Description: "The BIOS RTM volume has an incorrect signature.",
+		Remediation: "Isolate the host and escalate to the security team; reflash the firmware from a trusted source.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeUnsupportedValidationResult,
+		AnalyzerID:  "BIOSRTMVolume",
+		Params:      []string{"result"},
+		Description: "Internal problem: the validation result is not supported by the analyzer.",
+		Remediation: "Report the problem to the maintainers of AFAS with the analyzer report ID.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeVendorIDMismatch,
+		AnalyzerID:  "BIOSRTMVolume",
+		Params:      []string{"vendor_id", "expected_vendor_id"},
+		Description: "The platform vendor ID of the BIOS RTM volume is unexpected.",
+		Remediation: "Check the firmware is built for the platform.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeAMDBIOSKeyUseDisabled,
+		AnalyzerID:  "BIOSRTMVolume",
+		Description: "DISABLE_AMD_BIOS_KEY_USE is set in the platform info.",
+		Remediation: "Fix the platform info in the firmware build and reflash.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeBIOSKeyAntiRollbackDisabled,
+		AnalyzerID:  "BIOSRTMVolume",
+		Description: "DISABLE_BIOS_KEY_ANTI_ROLLBACK is set in the platform info.",
+		Remediation: "Fix the platform info in the firmware build and reflash.",
+	},
+	{
+		Code:        IssueCodeBIOSRTMVolumeSecureDebugUnlockDisabled,
+		AnalyzerID:  "BIOSRTMVolume",
+		Description: "DISABLE_SECURE_DEBUG_UNLOCK is set in the platform info.",
+		Remediation: "Fix the platform info in the firmware build and reflash.",
+	},
+	{
+		Code:        IssueCodeAPCBSecurityTokensMeasureConfigMissing,
+		AnalyzerID:  "APCBSecurityTokens",
+		Params:      []string{"bios_directory_level"},
+		Description: "The APCB_TOKEN_UID_PSP_MEASURE_CONFIG token is not found.",
+		Remediation: "Enable the PSP measurements in the firmware build and reflash.",
+	},
+	{
+		Code:        IssueCodeAPCBSecurityTokensMeasureConfigInvalidType,
+		AnalyzerID:  "APCBSecurityTokens",
+		Params:      []string{"bios_directory_level"},
+		Description: "The APCB_TOKEN_UID_PSP_MEASURE_CONFIG token has an incorrect value type.",
+		Remediation: "Fix the APCB in the firmware build and reflash.",
+	},
+	{
+		Code:        IssueCodeAPCBSecurityTokensMeasureConfigInvalidValue,
+		AnalyzerID:  "APCBSecurityTokens",
+		Params:      []string{"bios_directory_level", "value"},
+		Description: "The APCB_TOKEN_UID_PSP_MEASURE_CONFIG token has a value other than 0x1, the PSP measurements are disabled.",
+		Remediation: "Enable the PSP measurements in the firmware build and reflash.",
+	},
+	{
+		Code:        IssueCodePSPSecurityPatchLevelInvalidEntryHeader,
+		AnalyzerID:  "PSPSecurityPatchLevel",
+		Params:      []string{"entry", "directory"},
+		Description: "The header of a PSP entry could not be parsed.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodePSPSecurityPatchLevelOriginalUnavailable,
+		AnalyzerID:  "PSPSecurityPatchLevel",
+		Description: "The security patch levels of the original image could not be extracted, so no comparison was made.",
+		Remediation: "Check the original image in the firmware database.",
+	},
+	{
+		Code:        IssueCodePSPSecurityPatchLevelEntryDowngrade,
+		AnalyzerID:  "PSPSecurityPatchLevel",
+		Params:      []string{"entry", "directory", "original_spl", "actual_spl"},
+		Description: "The security patch level of a PSP entry is downgraded.",
+		Remediation: "Update the firmware to the expected version.",
+	},
+	{
+		Code:        IssueCodePSPSecurityPatchLevelSPLTableDowngrade,
+		AnalyzerID:  "PSPSecurityPatchLevel",
+		Params:      []string{"entry", "directory", "original_spl", "actual_spl"},
+		Description: "The minimal security patch level of a PSP entry in the SPL table is lowered or removed, it allows to roll back the entry.",
+		Remediation: "Update the firmware to the expected version.",
+	},
+	{
+		Code:        IssueCodePSPSecurityPatchLevelEntryBelowSPLTable,
+		AnalyzerID:  "PSPSecurityPatchLevel",
+		Params:      []string{"entry", "directory", "actual_spl", "minimal_spl"},
+		Description: "The security patch level of a PSP entry is lower than the minimum required by the SPL table of the image, the PSP would refuse to load it.",
+		Remediation: "Reflash the firmware with a consistent image.",
+	},
+	{
+		Code:        IssueCodePSPSecurityPatchLevelBIOSKeyRevisionBelowFuses,
+		AnalyzerID:  "PSPSecurityPatchLevel",
+		Params:      []string{"actual_revision", "fused_revision"},
+		Description: "The BIOS signing key revision is lower than the fused revision, the CPU would refuse to boot the image.",
+		Remediation: "Do not flash the image; use an image signed with the current key revision.",
+	},
+	{
+		Code:        IssueCodePSPSecurityPatchLevelAntiRollbackNotEnforced,
+		AnalyzerID:  "PSPSecurityPatchLevel",
+		Description: "PSP firmware is downgraded and the anti-rollback is not enforced by the CPU fuses.",
+		Remediation: "Update the firmware to the expected version; consider enabling the anti-rollback fuses.",
+	},
+	{
+		Code:        IssueCodePSBFusesPSBDisabled,
+		AnalyzerID:  "PSBFuses",
+		Description: "Platform Secure Boot is not enabled in the CPU fuses.",
+		Remediation: "Enable Platform Secure Boot if it is required for the platform.",
+	},
+	{
+		Code:        IssueCodePSBFusesTestStatusFailed,
+		AnalyzerID:  "PSBFuses",
+		Params:      []string{"status"},
+		Description: "The PSP reported a failed PSB test status.",
+		Remediation: "Check the PSB configuration of the image and the CPU fuses.",
+	},
+	{
+		Code:        IssueCodePSBFusesNoOEMKey,
+		AnalyzerID:  "PSBFuses",
+		Description: "The image has no OEM signing key, it would brick a CPU with PSB enabled.",
+		Remediation: "Do not flash the image; use an image signed with the OEM key.",
+	},
+	{
+		Code:        IssueCodePSBFusesBindingMismatch,
+		AnalyzerID:  "PSBFuses",
+		Params:      []string{"mismatches"},
+		Description: "The PSB binding of the image does not match the CPU fuses.",
+		Remediation: "Do not flash the image on this CPU; use an image matching the fused binding.",
+	},
+	{
+		Code:        IssueCodeVulnerableModulesAffectedModule,
+		AnalyzerID:  "VulnerableModules",
+		Params:      []string{"module", "cve"},
+		Description: "A firmware module is affected by a known vulnerability.",
+		Remediation: "Update the firmware to a version with the vulnerability fixed.",
+	},
+	{
+		Code:        IssueCodeFlashDegradationHardwareDegradation,
+		AnalyzerID:  "FlashDegradation",
+		Params:      []string{"score"},
+		Description: "The difference from the original image looks like a degradation of the flash chip.",
+		Remediation: "Replace the flash chip (or the board) and reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeFlashDegradationIntentionalWrite,
+		AnalyzerID:  "FlashDegradation",
+		Params:      []string{"score"},
+		Description: "The difference from the original image looks like an intentional write.",
+		Remediation: "Check the other analyzers (DiffMeasuredBoot, ImageDiff) for the nature of the change.",
+	},
+	{
+		Code:        IssueCodeFirmwareProvenanceUnknownVersion,
+		AnalyzerID:  "FirmwareProvenance",
+		Params:      []string{"version"},
+		Description: "The running firmware version is not known to the firmware database.",
+		Remediation: "Upload the firmware to the firmware database or reflash a known version.",
+	},
+	{
+		Code:        IssueCodeFirmwareProvenanceRevoked,
+		AnalyzerID:  "FirmwareProvenance",
+		Params:      []string{"version"},
+		Description: "The running firmware version is revoked.",
+		Remediation: "Update the firmware to the newest version.",
+	},
+	{
+		Code:        IssueCodeFirmwareProvenanceSuperseded,
+		AnalyzerID:  "FirmwareProvenance",
+		Params:      []string{"version", "superseded_by"},
+		Description: "The running firmware version is superseded.",
+		Remediation: "Update the firmware to the superseding version.",
+	},
+	{
+		Code:        IssueCodeFirmwareProvenanceOutdated,
+		AnalyzerID:  "FirmwareProvenance",
+		Params:      []string{"version"},
+		Description: "The running firmware version is outdated.",
+		Remediation: "Update the firmware with the next maintenance.",
+	},
+	{
+		Code:        IssueCodeOptionROMsModified,
+		AnalyzerID:  "OptionROMs",
+		Params:      []string{"device_path", "expected"},
+		Description: "An option ROM differs from the expected one.",
+		Remediation: "Isolate the host and escalate to the security team; reflash the device firmware.",
+	},
+	{
+		Code:        IssueCodeOptionROMsUnknown,
+		AnalyzerID:  "OptionROMs",
+		Params:      []string{"device_path"},
+		Description: "An option ROM is not known to the catalog.",
+		Remediation: "Add the option ROM to the catalog (see afasd --option-rom-catalog) if it is legitimate.",
+	},
+	{
+		Code:        IssueCodeBootChainNotAllowlisted,
+		AnalyzerID:  "BootChain",
+		Params:      []string{"pcr", "component"},
+		Description: "A boot component is not in the allowlist.",
+		Remediation: "Add the component to the allowlist (see afasd --boot-allowlist-dir) if it is legitimate.",
+	},
+	{
+		Code:        IssueCodeACPITablesOriginalUnavailable,
+		AnalyzerID:  "ACPITables",
+		Description: "The ACPI tables of the original firmware could not be found, so no comparison was made.",
+		Remediation: "Check the original image in the firmware database.",
+	},
+	{
+		Code:        IssueCodeACPITablesInvalidTable,
+		AnalyzerID:  "ACPITables",
+		Params:      []string{"table"},
+		Description: "An ACPI table could not be parsed.",
+		Remediation: "Check the tool collecting the ACPI tables on the host.",
+	},
+	{
+		Code:        IssueCodeACPITablesInvalidChecksum,
+		AnalyzerID:  "ACPITables",
+		Params:      []string{"table"},
+		Description: "An ACPI table has an invalid checksum.",
+		Remediation: "Check the tool collecting the ACPI tables on the host; reflash the firmware if it persists.",
+	},
+	{
+		Code:        IssueCodeACPITablesTableChanged,
+		AnalyzerID:  "ACPITables",
+		Params:      []string{"table"},
+		Description: "An ACPI table differs from the original one.",
+		Remediation: "Usually informational; ACPI tables are partially generated at boot time.",
+	},
+	{
+		Code:        IssueCodeACPITablesDMATableInvalid,
+		AnalyzerID:  "ACPITables",
+		Description: "The DMA remapping table (DMAR or IVRS) could not be parsed.",
+		Remediation: "Check the tool collecting the ACPI tables on the host.",
+	},
+	{
+		Code:        IssueCodeACPITablesNoDMARemapping,
+		AnalyzerID:  "ACPITables",
+		Description: "Neither DMAR nor IVRS table is provided: DMA remapping is not available to the OS.",
+		Remediation: "Enable VT-d/AMD-Vi in the firmware settings.",
+	},
+	{
+		Code:        IssueCodeACPITablesDMAOptInCleared,
+		AnalyzerID:  "ACPITables",
+		Params:      []string{"table"},
+		Description: "The DMA protection platform opt-in is cleared, while it is set in the original firmware.",
+		Remediation: "Isolate the host and escalate to the security team; reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeACPITablesDMAOptInNotSet,
+		AnalyzerID:  "ACPITables",
+		Params:      []string{"table"},
+		Description: "The DMA protection platform opt-in is not set: the OS may not enable DMA protection during the boot.",
+		Remediation: "Enable the kernel DMA protection in the firmware settings.",
+	},
+	{
+		Code:        IssueCodeACPITablesUnexpectedReservedRegion,
+		AnalyzerID:  "ACPITables",
+		Params:      []string{"table", "region"},
+		Description: "A DMA remapping table reserves a memory region, which is not reserved in the original firmware.",
+		Remediation: "Isolate the host and escalate to the security team; reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeImageDiffDataAppended,
+		AnalyzerID:  "ImageDiff",
+		Params:      []string{"bytes"},
+		Description: "Data is appended to the original image.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeImageDiffTruncated,
+		AnalyzerID:  "ImageDiff",
+		Params:      []string{"bytes"},
+		Description: "The image is truncated.",
+		Remediation: "Check the image dump is complete; reflash the firmware if it is.",
+	},
+	{
+		Code:        IssueCodeImageDiffResized,
+		AnalyzerID:  "ImageDiff",
+		Params:      []string{"actual_size", "original_size"},
+		Description: "The size of the image differs from the size of the original image.",
+		Remediation: "Check the original image matches the flash chip of the host.",
+	},
+	{
+		Code:        IssueCodeImageDiffMalformedContainer,
+		AnalyzerID:  "ImageDiff",
+		Params:      []string{"format", "offset"},
+		Description: "A container of the image (for example a firmware volume) is malformed.",
+		Remediation: "Reflash the firmware.",
+	},
+	{
+		Code:        IssueCodeImageDiffEntryChanged,
+		AnalyzerID:  "ImageDiff",
+		Params:      []string{"path", "status"},
+		Description: "A file or a partition of the image differs from the original one.",
+		Remediation: "Check the change with the firmware vendor; reflash the firmware if it is not intended.",
+	},
+	{
+		Code:        IssueCodeImageDiffMoreEntriesChanged,
+		AnalyzerID:  "ImageDiff",
+		Params:      []string{"count"},
+		Description: "More files or partitions are changed than reported separately.",
+		Remediation: "See IMAGE_DIFF.ENTRY_CHANGED.",
+	},
+	{
+		Code:        IssueCodeImageDiffRawChunksChanged,
+		AnalyzerID:  "ImageDiff",
+		Params:      []string{"chunks", "bytes"},
+		Description: "Data outside of known containers is changed.",
+		Remediation: "Check the change with the firmware vendor; reflash the firmware if it is not intended.",
+	},
+}
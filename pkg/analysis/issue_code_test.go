@@ -0,0 +1,61 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueCodeValidate(t *testing.T) {
+	for _, code := range []IssueCode{"REPRO_PCR.MISMATCH_LOCALITY", "A.B", "ACPI_TABLES.DMA_OPT_IN_NOT_SET", "X1.Y2"} {
+		require.NoError(t, code.Validate(), code)
+	}
+	for _, code := range []IssueCode{"", "REPRO_PCR", "repro_pcr.mismatch", "REPRO_PCR.", ".MISMATCH", "A.B.C", "1A.B", "A-B.C"} {
+		require.Error(t, code.Validate(), code)
+	}
+}
+
+func TestIssueCodeCatalog(t *testing.T) {
+	codes := IssueCodes()
+	require.Len(t, codes, len(issueCodeCatalog))
+	for idx, info := range codes {
+		require.NoError(t, info.Code.Validate())
+		if idx > 0 {
+			require.Less(t, string(codes[idx-1].Code), string(info.Code), "codes are expected to be unique and sorted")
+		}
+		require.NotEmpty(t, info.Description, info.Code)
+		require.NotEmpty(t, info.Remediation, info.Code)
+
+		params := map[string]struct{}{}
+		for _, param := range info.Params {
+			require.NotEmpty(t, param, info.Code)
+			_, ok := params[param]
+			require.False(t, ok, "param '%s' of '%s' is listed twice", param, info.Code)
+			params[param] = struct{}{}
+		}
+
+		found := LookupIssueCode(info.Code)
+		require.NotNil(t, found)
+		require.Equal(t, info, *found)
+	}
+	require.Nil(t, LookupIssueCode("UNKNOWN.CODE"))
+}
+
+func TestIssueRemediationHint(t *testing.T) {
+	info := IssueCodes()[0]
+	require.Equal(t, info.Remediation, Issue{Code: info.Code}.RemediationHint())
+	require.Equal(t, "replace the chip", Issue{Code: info.Code, Remediation: "replace the chip"}.RemediationHint())
+	require.Empty(t, Issue{Code: "UNKNOWN.CODE"}.RemediationHint())
+}
@@ -0,0 +1,276 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"context"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"runtime"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/objhash"
+	"github.com/immune-gmbh/attestation-sdk/pkg/uefi"
+	"github.com/immune-gmbh/attestation-sdk/pkg/xjson"
+)
+
+// PersistentCache is an optional persistent tier of the DataCalculator cache,
+// which survives restarts of the process (see for example package "diskcache").
+type PersistentCache interface {
+	Get(key objhash.ObjHash) ([]byte, bool)
+	Set(key objhash.ObjHash, value []byte) error
+}
+
+// persistentCacheFormatVersion should be incremented on any change of the
+// key or the value format, to avoid using entries stored by older builds.
+const persistentCacheFormatVersion = 1
+
+const (
+	persistentCodecXJSON  = byte('j')
+	persistentCodecBinary = byte('b')
+)
+
+// SetPersistentCache enables the persistent cache tier.
+//
+// Only results of calculators with a non-empty version (see SetVersionedValueCalculator)
+// are stored there.
+func (dc *DataCalculator) SetPersistentCache(cache PersistentCache) {
+	dc.persistentCache = cache
+}
+
+// persistentCacheKey returns the key of the calculator result in the persistent cache.
+// The key consists of the calculator (function name and its version), the output type
+// and the input hash. Returns false if the result should not be stored persistently.
+func (dc *DataCalculator) persistentCacheKey(t reflect.Type, calculator reflect.Value, input reflect.Value) (objhash.ObjHash, bool) {
+	if dc.persistentCache == nil {
+		return objhash.ObjHash{}, false
+	}
+	version := dc.calculatorVersions[t]
+	if version == "" {
+		return objhash.ObjHash{}, false
+	}
+	calculatorName := runtime.FuncForPC(calculator.Pointer()).Name()
+	key, err := objhash.Build(persistentCacheFormatVersion, calculatorName, version, t.String(), input.Interface())
+	if err != nil {
+		return objhash.ObjHash{}, false
+	}
+	return key, true
+}
+
+// persistentInputRestorer is implemented by values which do not store
+// some of their fields in the persistent cache, because these fields are
+// already available in the calculator input.
+type persistentInputRestorer interface {
+	// restoreFromInput restores the fields which were not stored, `input`
+	// is the input structure of the calculator.
+	restoreFromInput(input any) error
+}
+
+func (dc *DataCalculator) loadPersistent(ctx context.Context, outType reflect.Type, key objhash.ObjHash, input reflect.Value) *calculatorResult {
+	b, ok := dc.persistentCache.Get(key)
+	if !ok {
+		return nil
+	}
+	value, issues, err := decodePersistentValue(outType, b)
+	if err != nil {
+		logger.FromCtx(ctx).Warnf("unable to decode the persistently cached value of type '%s': %v", outType, err)
+		return nil
+	}
+	valuePtr := value
+	if valuePtr.Kind() != reflect.Pointer {
+		valuePtr = valuePtr.Addr()
+	}
+	if restorer, ok := valuePtr.Interface().(persistentInputRestorer); ok {
+		if err := restorer.restoreFromInput(reflect.Indirect(input).Interface()); err != nil {
+			logger.FromCtx(ctx).Warnf("unable to restore the persistently cached value of type '%s': %v", outType, err)
+			return nil
+		}
+	}
+	logger.FromCtx(ctx).Debugf("Found result type '%s' and key 0x'%X' in persistent cache", outType, key)
+	return &calculatorResult{
+		value:  value,
+		issues: issues,
+	}
+}
+
+func (dc *DataCalculator) storePersistent(ctx context.Context, key objhash.ObjHash, result calculatorResult) {
+	b, err := encodePersistentValue(result.value, result.issues)
+	if err != nil {
+		logger.FromCtx(ctx).Debugf("unable to encode value of type '%s' for the persistent cache: %v", result.value.Type(), err)
+		return
+	}
+	if err := dc.persistentCache.Set(key, b); err != nil {
+		logger.FromCtx(ctx).Warnf("unable to store value of type '%s' in the persistent cache: %v", result.value.Type(), err)
+	}
+}
+
+// encodePersistentValue serializes a calculated value and its issues as:
+//
+//	codec (1 byte) | issues length (uvarint) | issues (xjson) | value
+//
+// The value is serialized with its own encoding.BinaryMarshaler if
+// implemented (used for large objects), or through xjson otherwise.
+func encodePersistentValue(value reflect.Value, issues []Issue) ([]byte, error) {
+	issuesBytes, err := xjson.MarshalWithTypeIDs(issues, typeRegistry)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize issues: %w", err)
+	}
+
+	codec := persistentCodecXJSON
+	var valueBytes []byte
+	if marshaler, ok := reflect.Indirect(value).Interface().(encoding.BinaryMarshaler); ok && isBinaryUnmarshaler(value.Type()) {
+		codec = persistentCodecBinary
+		valueBytes, err = marshaler.MarshalBinary()
+	} else {
+		valueBytes, err = xjson.MarshalWithTypeIDs(value.Interface(), typeRegistry)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize the value: %w", err)
+	}
+
+	result := make([]byte, 0, 1+binary.MaxVarintLen64+len(issuesBytes)+len(valueBytes))
+	result = append(result, codec)
+	result = binary.AppendUvarint(result, uint64(len(issuesBytes)))
+	result = append(result, issuesBytes...)
+	result = append(result, valueBytes...)
+	return result, nil
+}
+
+func decodePersistentValue(outType reflect.Type, b []byte) (reflect.Value, []Issue, error) {
+	if len(b) < 1 {
+		return reflect.Value{}, nil, fmt.Errorf("empty entry")
+	}
+	codec := b[0]
+	issuesLen, n := binary.Uvarint(b[1:])
+	if n <= 0 || uint64(len(b)-1-n) < issuesLen {
+		return reflect.Value{}, nil, fmt.Errorf("invalid issues length")
+	}
+	issuesBytes := b[1+n : 1+n+int(issuesLen)]
+	valueBytes := b[1+n+int(issuesLen):]
+
+	var issues []Issue
+	if err := xjson.UnmarshalWithTypeIDs(issuesBytes, &issues, typeRegistry); err != nil {
+		return reflect.Value{}, nil, fmt.Errorf("unable to deserialize issues: %w", err)
+	}
+
+	baseType := outType
+	if baseType.Kind() == reflect.Pointer {
+		baseType = baseType.Elem()
+	}
+	valuePtr := reflect.New(baseType)
+	switch codec {
+	case persistentCodecBinary:
+		unmarshaler, ok := valuePtr.Interface().(encoding.BinaryUnmarshaler)
+		if !ok {
+			return reflect.Value{}, nil, fmt.Errorf("type '%s' does not implement encoding.BinaryUnmarshaler", baseType)
+		}
+		if err := unmarshaler.UnmarshalBinary(valueBytes); err != nil {
+			return reflect.Value{}, nil, fmt.Errorf("unable to deserialize the value: %w", err)
+		}
+	case persistentCodecXJSON:
+		if err := xjson.UnmarshalWithTypeIDs(valueBytes, valuePtr.Interface(), typeRegistry); err != nil {
+			return reflect.Value{}, nil, fmt.Errorf("unable to deserialize the value: %w", err)
+		}
+	default:
+		return reflect.Value{}, nil, fmt.Errorf("unknown codec '%c'", codec)
+	}
+
+	if outType.Kind() == reflect.Pointer {
+		return valuePtr, issues, nil
+	}
+	return valuePtr.Elem(), issues, nil
+}
+
+func isBinaryUnmarshaler(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return reflect.PointerTo(t).Implements(reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem())
+}
+
+var (
+	_ encoding.BinaryMarshaler   = AlignedOriginalFirmware{}
+	_ encoding.BinaryUnmarshaler = (*AlignedOriginalFirmware)(nil)
+	_ persistentInputRestorer    = (*AlignedOriginalFirmware)(nil)
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+//
+// Only the aligned image and its offset are stored, the Blob of the original
+// firmware is not (it is restored from the calculator input, see restoreFromInput).
+func (ao AlignedOriginalFirmware) MarshalBinary() ([]byte, error) {
+	if ao.fw == nil {
+		return nil, fmt.Errorf("the aligned image is not set")
+	}
+	result := binary.LittleEndian.AppendUint64(nil, ao.ImageOffset)
+	return append(result, ao.fw.Buf()...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+//
+// The aligned image is re-parsed, the Blob is left unset.
+func (ao *AlignedOriginalFirmware) UnmarshalBinary(b []byte) error {
+	if len(b) < 8 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	fw, err := uefi.Parse(b[8:], false)
+	if err != nil {
+		return fmt.Errorf("unable to parse the aligned image: %w", err)
+	}
+	*ao = AlignedOriginalFirmware{
+		ImageOffset: binary.LittleEndian.Uint64(b[:8]),
+		fw:          fw,
+	}
+	return nil
+}
+
+func (ao *AlignedOriginalFirmware) restoreFromInput(input any) error {
+	in, ok := input.(getAlignedOriginalImageInput)
+	if !ok {
+		return fmt.Errorf("unexpected calculator input type %T", input)
+	}
+	ao.Blob = in.OriginalFirmware.Blob
+	return nil
+}
+
+var (
+	_ encoding.BinaryMarshaler   = FixedRegisters{}
+	_ encoding.BinaryUnmarshaler = (*FixedRegisters)(nil)
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+//
+// The registers are stored in their own JSON format (it preserves the
+// register types, which xjson does not know about).
+func (fr FixedRegisters) MarshalBinary() ([]byte, error) {
+	return json.Marshal(fr.Regs)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (fr *FixedRegisters) UnmarshalBinary(b []byte) error {
+	var regs registers.Registers
+	if err := json.Unmarshal(b, &regs); err != nil {
+		return fmt.Errorf("unable to parse the registers: %w", err)
+	}
+	result, err := NewFixedRegisters(regs)
+	if err != nil {
+		return err
+	}
+	*fr = result
+	return nil
+}
@@ -0,0 +1,264 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+)
+
+// ProfileEntryKind defines what was measured by a ProfileEntry.
+type ProfileEntryKind string
+
+const (
+	// ProfileEntryKindAnalyzer is an execution of an analyzer, see ExecuteAnalyzer.
+	ProfileEntryKindAnalyzer = ProfileEntryKind("Analyzer")
+
+	// ProfileEntryKindCalculator is a resolution of a value by a DataCalculator.
+	ProfileEntryKindCalculator = ProfileEntryKind("Calculator")
+)
+
+// CacheResult defines where a calculated value was taken from.
+type CacheResult string
+
+const (
+	// CacheResultMiss means the value was actually calculated.
+	CacheResultMiss = CacheResult("Miss")
+
+	// CacheResultDataCache means the value was found in the DataCache of the request.
+	CacheResultDataCache = CacheResult("DataCache")
+
+	// CacheResultGlobalCache means the value was found in the in-memory cache of the DataCalculator.
+	CacheResultGlobalCache = CacheResult("GlobalCache")
+
+	// CacheResultPersistentCache means the value was found in the PersistentCache.
+	CacheResultPersistentCache = CacheResult("PersistentCache")
+
+	// CacheResultConcurrent means the same value was being calculated by another
+	// goroutine and its result was awaited.
+	CacheResultConcurrent = CacheResult("Concurrent")
+)
+
+// ProfileEntry is a measurement of a single analyzer execution or of a single
+// calculated value.
+//
+// Duration and AllocatedBytes include the ones of Children.
+type ProfileEntry struct {
+	Kind ProfileEntryKind
+	Name string
+
+	// Start is the time moment the measurement started at, relative to Profile.StartedAt.
+	Start    time.Duration
+	Duration time.Duration
+
+	// Cache is set only for ProfileEntryKindCalculator.
+	Cache CacheResult `json:",omitempty"`
+
+	// AllocatedBytes is the amount of heap memory allocated by the whole process
+	// during the interval of the measurement ("process allocations during the interval").
+	//
+	// It is not a per-value number: analyzers and values are calculated concurrently,
+	// so it also includes allocations of everything executed at the same time.
+	AllocatedBytes uint64
+
+	// Error is the description of the error, if the value was not calculated.
+	Error string `json:",omitempty"`
+
+	Children []*ProfileEntry `json:",omitempty"`
+}
+
+// Profile contains timings, cache hits/misses and allocations of analyzers and
+// calculated values, for investigation of slow analysis.
+//
+// Profile is collected only if it is set in the context (see WithProfile).
+type Profile struct {
+	StartedAt time.Time
+	Entries   []*ProfileEntry
+
+	locker sync.Mutex
+}
+
+// NewProfile returns a new empty Profile which starts at the current time moment.
+func NewProfile() *Profile {
+	return &Profile{
+		StartedAt: time.Now(),
+	}
+}
+
+// Scan implements database/sql.Scanner
+func (p *Profile) Scan(src any) error {
+	var b []byte
+	switch src := src.(type) {
+	case string:
+		b = []byte(src)
+	case []byte:
+		b = src
+	default:
+		return fmt.Errorf("expected []byte or string, but received %T", src)
+	}
+	return json.Unmarshal(b, p)
+}
+
+// Value implements database/sql/driver.Valuer
+func (p *Profile) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	p.locker.Lock()
+	defer p.locker.Unlock()
+	return json.Marshal(p)
+}
+
+// Snapshot returns a deep copy of the profile. Measurements may still be
+// added to the profile (for example by an analyzer which exceeded its budget,
+// but did not return yet), so the profile should be read only through a snapshot.
+func (p *Profile) Snapshot() *Profile {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+	return &Profile{
+		StartedAt: p.StartedAt,
+		Entries:   copyProfileEntries(p.Entries),
+	}
+}
+
+func copyProfileEntries(entries []*ProfileEntry) []*ProfileEntry {
+	if entries == nil {
+		return nil
+	}
+	result := make([]*ProfileEntry, 0, len(entries))
+	for _, entry := range entries {
+		entryCopy := *entry
+		entryCopy.Children = copyProfileEntries(entry.Children)
+		result = append(result, &entryCopy)
+	}
+	return result
+}
+
+type ctxKeyProfileT struct{}
+
+var ctxKeyProfile = ctxKeyProfileT{}
+
+// profileScope is the current position in a Profile.
+type profileScope struct {
+	profile *Profile
+	parent  *ProfileEntry // nil means the root
+}
+
+// WithProfile returns a context derivative which makes ExecuteAnalyzer and DataCalculator
+// record their measurements into the given profile.
+func WithProfile(ctx context.Context, profile *Profile) context.Context {
+	return context.WithValue(ctx, ctxKeyProfile, profileScope{profile: profile})
+}
+
+// ProfileFromCtx returns the Profile set by WithProfile, or nil if it is not set.
+func ProfileFromCtx(ctx context.Context) *Profile {
+	scope, _ := ctx.Value(ctxKeyProfile).(profileScope)
+	return scope.profile
+}
+
+// profileMeasurement is an unfinished ProfileEntry together with the matching tracer span.
+type profileMeasurement struct {
+	profile        *Profile
+	entry          *ProfileEntry
+	span           tracer.Span
+	startedAt      time.Time
+	allocatedBytes uint64
+}
+
+// startProfileMeasurement starts a tracer span and (if a Profile is set in the context)
+// a ProfileEntry. The returned context should be used for nested measurements.
+//
+// The measurement should be finished by calling Finish.
+func startProfileMeasurement(ctx context.Context, kind ProfileEntryKind, name string) (*profileMeasurement, context.Context) {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("%s-%s", kind, name))
+	m := &profileMeasurement{
+		span: span,
+	}
+
+	scope, ok := ctx.Value(ctxKeyProfile).(profileScope)
+	if !ok || scope.profile == nil {
+		return m, ctx
+	}
+
+	m.profile = scope.profile
+	m.startedAt = time.Now()
+	m.allocatedBytes = heapAllocatedBytes()
+	m.entry = &ProfileEntry{
+		Kind:  kind,
+		Name:  name,
+		Start: m.startedAt.Sub(scope.profile.StartedAt),
+	}
+	scope.profile.locker.Lock()
+	if scope.parent == nil {
+		scope.profile.Entries = append(scope.profile.Entries, m.entry)
+	} else {
+		scope.parent.Children = append(scope.parent.Children, m.entry)
+	}
+	scope.profile.locker.Unlock()
+
+	return m, context.WithValue(ctx, ctxKeyProfile, profileScope{profile: scope.profile, parent: m.entry})
+}
+
+// SetCache records where the value was taken from.
+func (m *profileMeasurement) SetCache(cache CacheResult) {
+	m.span.SetField("cache", string(cache))
+	if m.entry == nil {
+		return
+	}
+	m.profile.locker.Lock()
+	defer m.profile.locker.Unlock()
+	m.entry.Cache = cache
+}
+
+// Finish finalizes the measurement, err is the outcome of the measured operation.
+func (m *profileMeasurement) Finish(err error) {
+	if err != nil {
+		m.span.SetField("error", err.Error())
+	}
+	defer m.span.Finish()
+	if m.entry == nil {
+		return
+	}
+	duration := time.Since(m.startedAt)
+	allocatedBytes := heapAllocatedBytes() - m.allocatedBytes
+	m.span.SetField("process_allocated_bytes", allocatedBytes)
+
+	m.profile.locker.Lock()
+	defer m.profile.locker.Unlock()
+	m.entry.Duration = duration
+	m.entry.AllocatedBytes = allocatedBytes
+	if err != nil {
+		m.entry.Error = err.Error()
+	}
+}
+
+const metricHeapAllocatedBytes = "/gc/heap/allocs:bytes"
+
+// heapAllocatedBytes returns the cumulative amount of memory allocated on the heap by the process.
+//
+// Unlike runtime.ReadMemStats it does not stop the world.
+func heapAllocatedBytes() uint64 {
+	sample := []metrics.Sample{{Name: metricHeapAllocatedBytes}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type profileTestDependency struct{ Value int }
+
+type profileTestOutput struct{ Value int }
+
+type profileTestOutputInput struct {
+	Dependency profileTestDependency
+}
+
+func TestProfile(t *testing.T) {
+	dataCalc, err := NewDataCalculator(10)
+	require.NoError(t, err)
+
+	err = SetValueCalculator(dataCalc, func(ctx context.Context, in dummyInput) (profileTestDependency, []Issue, error) {
+		return profileTestDependency{Value: 1}, nil, nil
+	})
+	require.NoError(t, err)
+	err = SetValueCalculator(dataCalc, func(ctx context.Context, in profileTestOutputInput) (profileTestOutput, []Issue, error) {
+		return profileTestOutput{Value: in.Dependency.Value + 1}, nil, nil
+	})
+	require.NoError(t, err)
+
+	profile := NewProfile()
+	ctx := WithProfile(context.Background(), profile)
+	for i := 0; i < 2; i++ {
+		v, _, err := dataCalc.Calculate(ctx, reflect.TypeOf(profileTestOutput{}), NewInput(), nil)
+		require.NoError(t, err)
+		require.Equal(t, profileTestOutput{Value: 2}, v.Interface())
+	}
+
+	require.Len(t, profile.Entries, 2)
+	for idx, expectedCache := range []CacheResult{CacheResultMiss, CacheResultGlobalCache} {
+		entry := profile.Entries[idx]
+		require.Equal(t, ProfileEntryKindCalculator, entry.Kind)
+		require.Equal(t, "analysis.profileTestOutput", entry.Name)
+		require.Equal(t, expectedCache, entry.Cache)
+		require.Len(t, entry.Children, 1)
+		require.Equal(t, "analysis.profileTestDependency", entry.Children[0].Name)
+		require.Equal(t, expectedCache, entry.Children[0].Cache)
+		require.LessOrEqual(t, entry.Children[0].Duration, entry.Duration)
+		require.LessOrEqual(t, entry.Children[0].AllocatedBytes, entry.AllocatedBytes)
+	}
+	require.LessOrEqual(t, profile.Entries[0].Start, profile.Entries[1].Start)
+
+	t.Run("without_profile", func(t *testing.T) {
+		require.Nil(t, ProfileFromCtx(context.Background()))
+		_, _, err := dataCalc.Calculate(context.Background(), reflect.TypeOf(profileTestOutput{}), NewInput(), nil)
+		require.NoError(t, err)
+		require.Len(t, profile.Entries, 2)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		snapshot := profile.Snapshot()
+		require.Equal(t, profile.StartedAt, snapshot.StartedAt)
+		require.Equal(t, profile.Entries, snapshot.Entries)
+
+		// the snapshot does not change together with the profile
+		_, _, err := dataCalc.Calculate(ctx, reflect.TypeOf(profileTestOutput{}), NewInput(), nil)
+		require.NoError(t, err)
+		require.Len(t, profile.Entries, 3)
+		require.Len(t, snapshot.Entries, 2)
+		snapshot.Entries[0].Children[0].Name = "modified"
+		require.Equal(t, "analysis.profileTestDependency", profile.Entries[0].Children[0].Name)
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		b, err := profile.Value()
+		require.NoError(t, err)
+
+		var restored Profile
+		require.NoError(t, restored.Scan(b))
+		require.Equal(t, profile.StartedAt.UnixNano(), restored.StartedAt.UnixNano())
+		require.Equal(t, profile.Entries, restored.Entries)
+
+		v, err := (*Profile)(nil).Value()
+		require.NoError(t, err)
+		require.Nil(t, v)
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ReferenceDataHash is the content hash of reference data of an analyzer (a database,
+// a catalog, an allowlist, etc).
+//
+// The reference data is injected into an analyzer through its constructor (see
+// ReferenceDataStore) and only the hash is added to the Input: this way cache keys
+// and stored reports depend on the content of the data, but the data itself is not
+// serialized into every stored Input.
+type ReferenceDataHash string
+
+// NewReferenceDataHash returns the content hash of the reference data.
+func NewReferenceDataHash(data any) (ReferenceDataHash, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("unable to serialize the reference data: %w", err)
+	}
+	hash := sha256.Sum256(b)
+	return ReferenceDataHash(hex.EncodeToString(hash[:])), nil
+}
+
+// ReferenceDataStore keeps the recently used versions of reference data of
+// an analyzer by their content hash. The Input of the analyzer contains the hash
+// of the data (see ReferenceDataHash), and the analyzer gets the data from the store.
+//
+// The store is bounded: the least recently used version is evicted when the limit is reached.
+type ReferenceDataStore[T any] struct {
+	locker sync.Mutex
+	limit  int
+	values map[ReferenceDataHash]*T
+	hashes map[*T]ReferenceDataHash
+	order  []ReferenceDataHash
+}
+
+// NewReferenceDataStore returns a new instance of ReferenceDataStore, which keeps
+// up to `limit` versions of the data.
+func NewReferenceDataStore[T any](limit int) *ReferenceDataStore[T] {
+	if limit < 1 {
+		limit = 1
+	}
+	return &ReferenceDataStore[T]{
+		limit:  limit,
+		values: make(map[ReferenceDataHash]*T, limit),
+		hashes: make(map[*T]ReferenceDataHash, limit),
+	}
+}
+
+// Put adds the data to the store and returns its content hash.
+//
+// The hash is calculated only once for the same pointer, so it is cheap to Put
+// the same (not modified) data on every request.
+func (s *ReferenceDataStore[T]) Put(data *T) (ReferenceDataHash, error) {
+	if s == nil {
+		return "", fmt.Errorf("the reference data store is not configured")
+	}
+	if data == nil {
+		return "", fmt.Errorf("the reference data is nil")
+	}
+
+	s.locker.Lock()
+	hash, found := s.hashes[data]
+	if found {
+		s.touch(hash)
+		s.locker.Unlock()
+		return hash, nil
+	}
+	s.locker.Unlock()
+
+	hash, err := NewReferenceDataHash(data)
+	if err != nil {
+		return "", err
+	}
+
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	if existing, found := s.values[hash]; found {
+		// the same content under another pointer
+		s.hashes[data] = hash
+		delete(s.hashes, existing)
+		s.values[hash] = data
+		s.touch(hash)
+		return hash, nil
+	}
+	for len(s.order) >= s.limit {
+		evicted := s.order[0]
+		s.order = s.order[1:]
+		delete(s.hashes, s.values[evicted])
+		delete(s.values, evicted)
+	}
+	s.values[hash] = data
+	s.hashes[data] = hash
+	s.order = append(s.order, hash)
+	return hash, nil
+}
+
+// Get returns the data with the given content hash.
+func (s *ReferenceDataStore[T]) Get(hash ReferenceDataHash) (*T, error) {
+	if s == nil {
+		return nil, ErrReferenceDataNotFound{Hash: hash}
+	}
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	data, found := s.values[hash]
+	if !found {
+		return nil, ErrReferenceDataNotFound{Hash: hash}
+	}
+	s.touch(hash)
+	return data, nil
+}
+
+// touch marks the data as the most recently used one.
+func (s *ReferenceDataStore[T]) touch(hash ReferenceDataHash) {
+	for idx, item := range s.order {
+		if item == hash {
+			s.order = append(append(s.order[:idx:idx], s.order[idx+1:]...), hash)
+			return
+		}
+	}
+}
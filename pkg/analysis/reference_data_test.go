@@ -0,0 +1,58 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceDataStore(t *testing.T) {
+	type data struct {
+		Entries []string
+	}
+	store := NewReferenceDataStore[data](2)
+
+	a := &data{Entries: []string{"a"}}
+	hashA, err := store.Put(a)
+	require.NoError(t, err)
+	hashA2, err := store.Put(&data{Entries: []string{"a"}})
+	require.NoError(t, err)
+	require.Equal(t, hashA, hashA2, "the same content should have the same hash")
+
+	hashB, err := store.Put(&data{Entries: []string{"b"}})
+	require.NoError(t, err)
+	require.NotEqual(t, hashA, hashB)
+
+	got, err := store.Get(hashA)
+	require.NoError(t, err)
+	require.Equal(t, a.Entries, got.Entries)
+
+	// "a" was used recently, so "b" is evicted
+	hashC, err := store.Put(&data{Entries: []string{"c"}})
+	require.NoError(t, err)
+	_, err = store.Get(hashB)
+	require.True(t, errors.As(err, &ErrReferenceDataNotFound{}))
+	_, err = store.Get(hashA)
+	require.NoError(t, err)
+	_, err = store.Get(hashC)
+	require.NoError(t, err)
+
+	var nilStore *ReferenceDataStore[data]
+	_, err = nilStore.Get(hashA)
+	require.Error(t, err)
+	_, err = nilStore.Put(a)
+	require.Error(t, err)
+}
@@ -33,6 +33,19 @@ const (
 	SeverityCritical
 )
 
+// String implements fmt.Stringer
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "INFO"
+	case SeverityWarning:
+		return "WARNING"
+	case SeverityCritical:
+		return "CRITICAL"
+	}
+	return fmt.Sprintf("UNKNOWN_SEVERITY_%d", uint32(s))
+}
+
 // Issue describes a single found problem in firmware
 type Issue struct {
 	// Custom is a custom information provided for issue description. Should be serialisable
@@ -43,6 +56,28 @@ type Issue struct {
 
 	// Description is a text description of a found problem
 	Description string
+
+	// Code is a stable machine-readable identifier of the kind of the problem, see IssueCodes
+	Code IssueCode
+
+	// Params are structured parameters of the problem (for example an expected and an actual value),
+	// the names of parameters are listed in the catalog entry of the Code
+	Params map[string]string
+
+	// Remediation is a hint on how to fix this specific problem, if empty then
+	// the hint of the Code is used (see RemediationHint)
+	Remediation string
+}
+
+// RemediationHint returns a hint on how to fix the problem
+func (issue Issue) RemediationHint() string {
+	if issue.Remediation != "" {
+		return issue.Remediation
+	}
+	if info := LookupIssueCode(issue.Code); info != nil {
+		return info.Remediation
+	}
+	return ""
 }
 
 // Report is an outcome of every firmware analysis algorithm
@@ -24,6 +24,7 @@ import (
 	"github.com/9elements/converged-security-suite/v2/pkg/uefi"
 	amd_manifest "github.com/linuxboot/fiano/pkg/amd/manifest"
 
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
 	"github.com/immune-gmbh/attestation-sdk/pkg/dmidecode"
 	"github.com/immune-gmbh/attestation-sdk/pkg/objhash"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
@@ -38,7 +39,10 @@ func init() {
 	RegisterType((tpmdetection.Type)(0))
 	RegisterType((*tpmeventlog.TPMEventLog)(nil))
 	RegisterType((ActualPCR0)(nil))
+	RegisterType((ActualACPITables)(nil))
+	RegisterType((PSBFusedKeyHash)(nil))
 	RegisterType((AssetID)(0))
+	RegisterType((ModelID)(0))
 	RegisterType((*OriginalBIOSInfo)(nil))
 	RegisterType((*ActualBIOSInfo)(nil))
 	RegisterType((*ReferenceFirmware)(nil))
@@ -52,6 +56,7 @@ type AnalyzerID string
 // Analyzer is an abstract interface that each analyzer should implement
 type Analyzer[inputType any] interface {
 	ID() AnalyzerID
+	Version() AnalyzerVersion
 	Analyze(context.Context, inputType) (*Report, error)
 }
 
@@ -210,6 +215,14 @@ var _ objhash.Custom = FixedRegisters{}
 // ActualPCR0 represents an actual PCR0 value of the host
 type ActualPCR0 []byte
 
+// ActualACPITables represents the ACPI tables provided by the firmware to the OS of the host
+type ActualACPITables []acpi.Table
+
+// PSBFusedKeyHash represents the SHA256 hash of the OEM root key fused into the AMD CPU of the host.
+//
+// PSP does not expose it through a register, so it is provided separately from ActualRegisters.
+type PSBFusedKeyHash []byte
+
 // AlignedOriginalFirmware represents a part of the original image which is aligned with the DumpedFirmware image.
 //
 // Often the only region we can dump from the target is BIOS region, while the original image usually consists
@@ -279,6 +292,9 @@ func NewReferenceFirmware(ctx context.Context, alignedOriginalFirmware *AlignedO
 // AssetID represents information about the asset id of the host that is being analyzed
 type AssetID int64
 
+// ModelID represents information about the model id of the host that is being analyzed
+type ModelID int64
+
 func cacheRegisters(regs registers.Registers) (objhash.ObjHash, error) {
 	sortedRegs := make([]registers.Register, 0, len(regs))
 	for _, reg := range regs {
@@ -30,6 +30,7 @@ func TestTypesUnique(t *testing.T) {
 		reflect.TypeOf(ActualRegisters{}),
 		reflect.TypeOf(FixedRegisters{}),
 		reflect.TypeOf(ActualPCR0(nil)),
+		reflect.TypeOf(ActualACPITables(nil)),
 		reflect.TypeOf(AlignedOriginalFirmware{}),
 		reflect.TypeOf(AssetID(0)),
 	}
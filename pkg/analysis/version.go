@@ -0,0 +1,101 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxAnalyzerVersionComponent is the exclusive upper bound of each
+// component of an AnalyzerVersion, see AnalyzerVersion.Ordinal.
+const maxAnalyzerVersionComponent = 1000000
+
+// AnalyzerVersion is a semantic version ("MAJOR.MINOR.PATCH") of the logic
+// of an analyzer. It is stored with every analyzer report, so that reports
+// produced by an outdated logic could be found and regenerated.
+//
+// Bump:
+//   - MAJOR if the report format is changed incompatibly;
+//   - MINOR if the verdict (issues or diagnosis) may change for the same input;
+//   - PATCH if the verdicts are not affected (for example a performance fix).
+//
+// The empty version is the version of reports stored before the versioning
+// was introduced, it is lower than any other version.
+type AnalyzerVersion string
+
+// ParseAnalyzerVersion validates the string and returns it as an AnalyzerVersion.
+func ParseAnalyzerVersion(s string) (AnalyzerVersion, error) {
+	v := AnalyzerVersion(s)
+	if _, err := v.components(); err != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+func (v AnalyzerVersion) components() ([3]uint64, error) {
+	var result [3]uint64
+	if v == "" {
+		return result, nil
+	}
+	parts := strings.Split(string(v), ".")
+	if len(parts) != len(result) {
+		return result, fmt.Errorf("invalid analyzer version '%s': expected format MAJOR.MINOR.PATCH", string(v))
+	}
+	for idx, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return result, fmt.Errorf("invalid analyzer version '%s': unable to parse component '%s': %w", string(v), part, err)
+		}
+		if n >= maxAnalyzerVersionComponent {
+			return result, fmt.Errorf("invalid analyzer version '%s': component %d is too large (max: %d)", string(v), n, maxAnalyzerVersionComponent-1)
+		}
+		result[idx] = n
+	}
+	return result, nil
+}
+
+// Ordinal returns a number, which preserves the order of versions. It is
+// used to compare versions on the DB side.
+//
+// The version is expected to be valid, see ParseAnalyzerVersion.
+func (v AnalyzerVersion) Ordinal() uint64 {
+	c, err := v.components()
+	if err != nil {
+		panic(err)
+	}
+	return (c[0]*maxAnalyzerVersionComponent+c[1])*maxAnalyzerVersionComponent + c[2]
+}
+
+// Compare returns -1 if v is lower than other, 1 if it is higher and 0 if they are equal.
+//
+// The versions are expected to be valid, see ParseAnalyzerVersion.
+func (v AnalyzerVersion) Compare(other AnalyzerVersion) int {
+	a, b := v.Ordinal(), other.Ordinal()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// String implements fmt.Stringer.
+func (v AnalyzerVersion) String() string {
+	if v == "" {
+		return "<unversioned>"
+	}
+	return string(v)
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzerVersion(t *testing.T) {
+	for _, s := range []string{"", "0.0.1", "1.0.0", "1.2.3", "999999.999999.999999"} {
+		_, err := ParseAnalyzerVersion(s)
+		require.NoError(t, err, s)
+	}
+	for _, s := range []string{"1", "1.0", "1.0.0.0", "v1.0.0", "1.-1.0", "1.1000000.0", "1.0.x"} {
+		_, err := ParseAnalyzerVersion(s)
+		require.Error(t, err, s)
+	}
+
+	ordered := []AnalyzerVersion{"", "0.0.1", "0.1.0", "0.1.10", "1.0.0", "1.2.0", "10.0.0"}
+	for i := range ordered {
+		require.Equal(t, 0, ordered[i].Compare(ordered[i]))
+		for j := i + 1; j < len(ordered); j++ {
+			require.Equal(t, -1, ordered[i].Compare(ordered[j]), "%s < %s", ordered[i], ordered[j])
+			require.Equal(t, 1, ordered[j].Compare(ordered[i]), "%s > %s", ordered[j], ordered[i])
+		}
+	}
+}
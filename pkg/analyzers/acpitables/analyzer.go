@@ -0,0 +1,365 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package acpitables
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables/report/generated/acpitablesanalysis"
+)
+
+func init() {
+	analysis.RegisterType((*acpitablesanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of ACPITables analyzer
+const ID analysis.AnalyzerID = acpitablesanalysis.ACPITablesAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
+// NewExecutorInput builds an analysis.Executor's input required for ACPITables analyzer
+//
+// Optional arguments: originalFirmware
+func NewExecutorInput(
+	tables []acpi.Table,
+	originalFirmware analysis.Blob,
+) (analysis.Input, error) {
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("ACPI tables should be specified")
+	}
+
+	result := analysis.NewInput()
+	result.AddActualACPITables(
+		tables,
+	)
+	if originalFirmware != nil {
+		result.AddOriginalFirmware(
+			originalFirmware,
+		)
+	}
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	ACPITables       analysis.ActualACPITables
+	OriginalFirmware *analysis.OriginalFirmwareBlob `exec:"optional"`
+}
+
+// ACPITables is analyzer that checks the DMA protection policy declared
+// by the firmware in DMAR (Intel) or IVRS (AMD) and compares the ACPI
+// tables of the host with the ones embedded into the original firmware.
+type ACPITables struct{}
+
+// New returns a new object of ACPITables analyzer
+func New() analysis.Analyzer[Input] {
+	return &ACPITables{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *ACPITables) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *ACPITables) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze checks the ACPI tables of the host
+func (analyzer *ACPITables) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	log := logger.FromCtx(ctx)
+	if len(in.ACPITables) == 0 {
+		return nil, analysis.NewErrNotApplicable("no ACPI tables")
+	}
+
+	result := &analysis.Report{}
+	var originalTables []acpi.Table
+	if in.OriginalFirmware != nil {
+		var err error
+		originalTables, err = acpi.FindTablesInFirmware(in.OriginalFirmware.Bytes())
+		if err != nil {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeACPITablesOriginalUnavailable,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("unable to find ACPI tables in the original firmware: %v", err),
+			})
+		}
+		log.Debugf("found %d ACPI tables in the original firmware", len(originalTables))
+	}
+
+	customReport := acpitablesanalysis.CustomReport{
+		OriginalTables: int32(len(originalTables)),
+	}
+	for _, table := range in.ACPITables {
+		header, err := table.Header()
+		if err != nil {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeACPITablesInvalidTable,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("invalid ACPI table '%s': %v", table.Name, err),
+				Params:      map[string]string{"table": table.Name},
+			})
+			continue
+		}
+		reportTable := &acpitablesanalysis.Table{
+			Name:          table.Name,
+			Signature:     header.Signature,
+			OEMID:         header.OEMID,
+			OEMTableID:    header.OEMTableID,
+			Length:        int32(len(table.Data)),
+			Checksum:      int16(header.Checksum),
+			ChecksumValid: table.ChecksumValid(),
+			Status:        acpitablesanalysis.TableStatus_NotVerifiable,
+		}
+		if !reportTable.ChecksumValid {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeACPITablesInvalidChecksum,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("ACPI table '%s' has an invalid checksum", table.Name),
+				Params:      map[string]string{"table": table.Name},
+			})
+		}
+		if in.OriginalFirmware != nil {
+			original := findOriginal(originalTables, table, header)
+			switch {
+			case original == nil:
+				reportTable.Status = acpitablesanalysis.TableStatus_NotInOriginal
+			case bytes.Equal(original.Data, table.Data):
+				reportTable.Status = acpitablesanalysis.TableStatus_Unchanged
+			default:
+				reportTable.Status = acpitablesanalysis.TableStatus_Modified
+			}
+			if original != nil {
+				checksum := int16(original.Data[9])
+				reportTable.OriginalChecksum = &checksum
+			}
+			if reportTable.Status == acpitablesanalysis.TableStatus_Modified {
+				result.Issues = append(result.Issues, analysis.Issue{
+					Code:        analysis.IssueCodeACPITablesTableChanged,
+					Severity:    analysis.SeverityInfo,
+					Description: fmt.Sprintf("ACPI table '%s' differs from the original one (checksum 0x%02X, original 0x%02X)", table.Name, reportTable.Checksum, *reportTable.OriginalChecksum),
+					Params:      map[string]string{"table": table.Name},
+				})
+			}
+		}
+		customReport.Tables = append(customReport.Tables, reportTable)
+	}
+
+	dmaProtection, issues := checkDMAProtection(in.ACPITables, originalTables, in.OriginalFirmware != nil)
+	customReport.DMAProtection = dmaProtection
+	result.Issues = append(result.Issues, issues...)
+
+	result.Custom = customReport
+	return result, nil
+}
+
+// findOriginal returns the table of the original firmware with the same signature and OEM table ID.
+//
+// If there are multiple such tables, the identical one is preferred.
+func findOriginal(originalTables []acpi.Table, table acpi.Table, header *acpi.Header) *acpi.Table {
+	var result *acpi.Table
+	for idx := range originalTables {
+		original := &originalTables[idx]
+		originalHeader, err := original.Header()
+		if err != nil || originalHeader.Signature != header.Signature || originalHeader.OEMTableID != header.OEMTableID {
+			continue
+		}
+		if bytes.Equal(original.Data, table.Data) {
+			return original
+		}
+		if result == nil {
+			result = original
+		}
+	}
+	return result
+}
+
+// dmaTable is the policy-relevant part of DMAR or IVRS
+type dmaTable struct {
+	Signature     string
+	Flags         uint64
+	PlatformOptIn bool
+	IOMMUs        int
+	Regions       []region
+}
+
+type region struct {
+	Base    uint64
+	Limit   uint64
+	Devices []string
+}
+
+func (r region) String() string {
+	return fmt.Sprintf("[0x%X-0x%X] for devices %s", r.Base, r.Limit, strings.Join(r.Devices, ", "))
+}
+
+// findDMATable parses the first DMAR or IVRS table found
+func findDMATable(tables []acpi.Table) (*dmaTable, error) {
+	for _, table := range tables {
+		header, err := table.Header()
+		if err != nil {
+			continue
+		}
+		switch header.Signature {
+		case acpi.SignatureDMAR:
+			dmar, err := acpi.ParseDMAR(table.Data)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse DMAR: %w", err)
+			}
+			result := &dmaTable{
+				Signature:     header.Signature,
+				Flags:         uint64(dmar.Flags),
+				PlatformOptIn: dmar.Flags&acpi.DMARFlagDMACtrlPlatformOptIn != 0,
+				IOMMUs:        dmar.DRHDs,
+			}
+			for _, rmrr := range dmar.RMRRs {
+				r := region{Base: rmrr.BaseAddress, Limit: rmrr.LimitAddress}
+				for _, device := range rmrr.Devices {
+					r.Devices = append(r.Devices, fmt.Sprintf("%04x:%s", rmrr.Segment, device))
+				}
+				sort.Strings(r.Devices)
+				result.Regions = append(result.Regions, r)
+			}
+			return result, nil
+		case acpi.SignatureIVRS:
+			ivrs, err := acpi.ParseIVRS(table.Data)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse IVRS: %w", err)
+			}
+			result := &dmaTable{
+				Signature:     header.Signature,
+				Flags:         uint64(ivrs.Info),
+				PlatformOptIn: ivrs.Info&acpi.IVRSInfoDMARemapSup != 0,
+				IOMMUs:        ivrs.IVHDs,
+			}
+			for _, ivmd := range ivrs.IVMDs {
+				limit := ivmd.StartAddress
+				if ivmd.Length > 0 {
+					limit += ivmd.Length - 1
+				}
+				result.Regions = append(result.Regions, region{
+					Base:    ivmd.StartAddress,
+					Limit:   limit,
+					Devices: []string{ivmd.Devices()},
+				})
+			}
+			return result, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkDMAProtection checks the DMA remapping policy declared by the firmware
+// and compares the reserved memory regions with the original firmware.
+func checkDMAProtection(
+	tables []acpi.Table,
+	originalTables []acpi.Table,
+	haveOriginal bool,
+) (*acpitablesanalysis.DMAProtection, []analysis.Issue) {
+	actual, err := findDMATable(tables)
+	if err != nil {
+		return nil, []analysis.Issue{{
+			Code:        analysis.IssueCodeACPITablesDMATableInvalid,
+			Severity:    analysis.SeverityWarning,
+			Description: err.Error(),
+		}}
+	}
+	if actual == nil {
+		return nil, []analysis.Issue{{
+			Code:        analysis.IssueCodeACPITablesNoDMARemapping,
+			Severity:    analysis.SeverityWarning,
+			Description: "neither DMAR nor IVRS table is provided: DMA remapping is not available to the OS",
+		}}
+	}
+
+	var issues []analysis.Issue
+	var original *dmaTable
+	if haveOriginal {
+		// an error is not reported: the original table is a template, which is
+		// allowed to be incomplete (or the table is generated in runtime)
+		original, _ = findDMATable(originalTables)
+		if original != nil && original.Signature != actual.Signature {
+			original = nil
+		}
+	}
+
+	result := &acpitablesanalysis.DMAProtection{
+		Signature:     actual.Signature,
+		Flags:         int64(actual.Flags),
+		PlatformOptIn: actual.PlatformOptIn,
+		IOMMUs:        int32(actual.IOMMUs),
+	}
+	if original != nil {
+		result.OriginalPlatformOptIn = &original.PlatformOptIn
+	}
+	switch {
+	case !actual.PlatformOptIn && original != nil && original.PlatformOptIn:
+		issues = append(issues, analysis.Issue{
+			Code:        analysis.IssueCodeACPITablesDMAOptInCleared,
+			Severity:    analysis.SeverityCritical,
+			Description: fmt.Sprintf("the DMA protection platform opt-in is cleared in %s, while it is set in the original firmware", actual.Signature),
+			Params:      map[string]string{"table": actual.Signature},
+		})
+	case !actual.PlatformOptIn:
+		issues = append(issues, analysis.Issue{
+			Code:        analysis.IssueCodeACPITablesDMAOptInNotSet,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("the DMA protection platform opt-in is not set in %s: the OS may not enable DMA protection during the boot", actual.Signature),
+			Params:      map[string]string{"table": actual.Signature},
+		})
+	}
+
+	for _, r := range actual.Regions {
+		reportRegion := &acpitablesanalysis.MemoryRegion{
+			Base:    int64(r.Base),
+			Limit:   int64(r.Limit),
+			Devices: r.Devices,
+			Status:  acpitablesanalysis.RegionStatus_NotVerifiable,
+		}
+		if original != nil {
+			reportRegion.Status = acpitablesanalysis.RegionStatus_Unexpected
+			for _, originalRegion := range original.Regions {
+				if strings.Join(originalRegion.Devices, ",") != strings.Join(r.Devices, ",") {
+					continue
+				}
+				if originalRegion.Base == r.Base && originalRegion.Limit == r.Limit {
+					reportRegion.Status = acpitablesanalysis.RegionStatus_Expected
+					break
+				}
+				reportRegion.Status = acpitablesanalysis.RegionStatus_Relocated
+			}
+		}
+		if reportRegion.Status == acpitablesanalysis.RegionStatus_Unexpected {
+			issues = append(issues, analysis.Issue{
+				Code:        analysis.IssueCodeACPITablesUnexpectedReservedRegion,
+				Severity:    analysis.SeverityCritical,
+				Description: fmt.Sprintf("%s reserves memory region %s, which is not reserved for these devices in the original firmware", actual.Signature, r),
+				Params:      map[string]string{"table": actual.Signature, "region": fmt.Sprint(r)},
+			})
+		}
+		result.ReservedRegions = append(result.ReservedRegions, reportRegion)
+	}
+	return result, issues
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package acpitables
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables/report/generated/acpitablesanalysis"
+)
+
+// testTable builds an ACPI table with a valid checksum
+func testTable(signature string, body []byte) []byte {
+	data := make([]byte, acpi.HeaderSize)
+	copy(data, signature)
+	binary.LittleEndian.PutUint32(data[4:], uint32(acpi.HeaderSize+len(body)))
+	copy(data[16:], "TEST")
+	data = append(data, body...)
+	var sum uint8
+	for _, b := range data {
+		sum += b
+	}
+	data[9] = -sum
+	return data
+}
+
+// testDMAR builds DMAR with an RMRR region for device 00:<device>.0
+func testDMAR(flags acpi.DMARFlags, base uint64, device uint8) []byte {
+	body := make([]byte, 12)
+	body[1] = uint8(flags)
+	rmrr := make([]byte, 24)
+	binary.LittleEndian.PutUint16(rmrr[0:], 1)
+	binary.LittleEndian.PutUint64(rmrr[8:], base)
+	binary.LittleEndian.PutUint64(rmrr[16:], base+0xFFFF)
+	rmrr = append(rmrr, 1, 8, 0, 0, 0, 0, device, 0)
+	binary.LittleEndian.PutUint16(rmrr[2:], uint16(len(rmrr)))
+	return testTable(acpi.SignatureDMAR, append(body, rmrr...))
+}
+
+func TestAnalyzeWithoutOriginal(t *testing.T) {
+	corrupted := testTable("SSDT", []byte{1, 2, 3})
+	corrupted[acpi.HeaderSize] = 0
+	report, err := New().Analyze(context.Background(), Input{
+		ACPITables: analysis.ActualACPITables{
+			{Name: "DMAR", Data: testDMAR(acpi.DMARFlagIntrRemap, 0xE0000, 0x14)},
+			{Name: "SSDT1", Data: corrupted},
+		},
+	})
+	require.NoError(t, err)
+	customReport := report.Custom.(acpitablesanalysis.CustomReport)
+	require.Len(t, customReport.Tables, 2)
+	require.Equal(t, acpitablesanalysis.TableStatus_NotVerifiable, customReport.Tables[0].Status)
+	require.True(t, customReport.Tables[0].ChecksumValid)
+	require.False(t, customReport.Tables[1].ChecksumValid)
+
+	dma := customReport.DMAProtection
+	require.NotNil(t, dma)
+	require.Equal(t, "DMAR", dma.Signature)
+	require.False(t, dma.PlatformOptIn)
+	require.Nil(t, dma.OriginalPlatformOptIn)
+	require.Len(t, dma.ReservedRegions, 1)
+	require.Equal(t, []string{"0000:00:14.0"}, dma.ReservedRegions[0].Devices)
+	require.Equal(t, acpitablesanalysis.RegionStatus_NotVerifiable, dma.ReservedRegions[0].Status)
+
+	// invalid checksum and no opt-in
+	require.Len(t, report.Issues, 2)
+	for _, issue := range report.Issues {
+		require.Equal(t, analysis.SeverityWarning, issue.Severity)
+	}
+
+	_, err = New().Analyze(context.Background(), Input{})
+	require.ErrorAs(t, err, &analysis.ErrNotApplicable{})
+}
+
+func TestCheckDMAProtection(t *testing.T) {
+	original := []acpi.Table{
+		{Name: "DMAR", Data: testDMAR(acpi.DMARFlagDMACtrlPlatformOptIn, 0, 0x14)},
+	}
+
+	dma, issues := checkDMAProtection([]acpi.Table{
+		{Name: "DMAR", Data: testDMAR(acpi.DMARFlagDMACtrlPlatformOptIn, 0xE0000, 0x14)},
+	}, original, true)
+	require.Empty(t, issues)
+	require.True(t, *dma.OriginalPlatformOptIn)
+	require.Equal(t, acpitablesanalysis.RegionStatus_Relocated, dma.ReservedRegions[0].Status)
+
+	dma, issues = checkDMAProtection([]acpi.Table{
+		{Name: "DMAR", Data: testDMAR(0, 0xE0000, 0x1F)},
+	}, original, true)
+	require.Len(t, issues, 2)
+	require.Equal(t, analysis.SeverityCritical, issues[0].Severity)
+	require.Equal(t, analysis.SeverityCritical, issues[1].Severity)
+	require.Equal(t, acpitablesanalysis.RegionStatus_Unexpected, dma.ReservedRegions[0].Status)
+
+	dma, issues = checkDMAProtection([]acpi.Table{
+		{Name: "FACP", Data: testTable("FACP", nil)},
+	}, nil, false)
+	require.Nil(t, dma)
+	require.Len(t, issues, 1)
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.acpitables.report.generated.acpitablesanalysis
+
+const string ACPITablesAnalyzerID = "ACPITables";
+
+enum TableStatus {
+  // Unchanged means the original firmware contains the same table
+  Unchanged = 0,
+  // Modified means the original firmware contains the table, but its checksum differs
+  // (it is expected for tables patched by the firmware during the boot)
+  Modified = 1,
+  // NotInOriginal means the table is not found in the original firmware
+  // (it is expected for tables generated by the firmware during the boot)
+  NotInOriginal = 2,
+  // NotVerifiable means the original firmware is not available
+  NotVerifiable = 3,
+}
+
+struct Table {
+  1: string Name;
+  2: string Signature;
+  3: string OEMID;
+  4: string OEMTableID;
+  5: i32 Length;
+  6: i16 Checksum;
+  // ChecksumValid is false if the bytes of the table do not sum to zero
+  7: bool ChecksumValid;
+  8: TableStatus Status;
+  9: optional i16 OriginalChecksum;
+}
+
+enum RegionStatus {
+  // Expected means the original firmware reserves the same region for the same devices
+  Expected = 0,
+  // Relocated means the original firmware reserves a region for the same devices,
+  // but at a different address (templates are usually patched during the boot)
+  Relocated = 1,
+  // Unexpected means the original firmware does not reserve a region for these devices
+  Unexpected = 2,
+  // NotVerifiable means the original table is not available
+  NotVerifiable = 3,
+}
+
+// MemoryRegion is a DMAR RMRR or an IVRS IVMD: a memory region the devices may access via DMA
+// regardless of the DMA remapping restrictions.
+struct MemoryRegion {
+  1: i64 Base;
+  2: i64 Limit;
+  3: list<string> Devices;
+  4: RegionStatus Status;
+}
+
+struct DMAProtection {
+  // Signature is "DMAR" (Intel VT-d) or "IVRS" (AMD-Vi)
+  1: string Signature;
+  // Flags are DMAR flags or IVRS IVinfo
+  2: i64 Flags;
+  // PlatformOptIn is DMA_CTRL_PLATFORM_OPT_IN of DMAR or DMA remap support of IVRS
+  3: bool PlatformOptIn;
+  4: list<MemoryRegion> ReservedRegions;
+  // OriginalPlatformOptIn is set if the table is found in the original firmware
+  5: optional bool OriginalPlatformOptIn;
+  // IOMMUs is the amount of DMAR DRHD structures or IVRS IVHD blocks
+  6: i32 IOMMUs;
+}
+
+struct CustomReport {
+  1: list<Table> Tables;
+  2: optional DMAProtection DMAProtection;
+  // OriginalTables is the amount of ACPI tables found in the original firmware
+  3: i32 OriginalTables;
+}
@@ -0,0 +1,1513 @@
+// Code generated by Thrift Compiler (0.14.0). DO NOT EDIT.
+
+package acpitablesanalysis
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"time"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+var _ = time.Now
+var _ = bytes.Equal
+
+type TableStatus int64
+
+const (
+	TableStatus_Unchanged     TableStatus = 0
+	TableStatus_Modified      TableStatus = 1
+	TableStatus_NotInOriginal TableStatus = 2
+	TableStatus_NotVerifiable TableStatus = 3
+)
+
+func (p TableStatus) String() string {
+	switch p {
+	case TableStatus_Unchanged:
+		return "Unchanged"
+	case TableStatus_Modified:
+		return "Modified"
+	case TableStatus_NotInOriginal:
+		return "NotInOriginal"
+	case TableStatus_NotVerifiable:
+		return "NotVerifiable"
+	}
+	return "<UNSET>"
+}
+
+func TableStatusFromString(s string) (TableStatus, error) {
+	switch s {
+	case "Unchanged":
+		return TableStatus_Unchanged, nil
+	case "Modified":
+		return TableStatus_Modified, nil
+	case "NotInOriginal":
+		return TableStatus_NotInOriginal, nil
+	case "NotVerifiable":
+		return TableStatus_NotVerifiable, nil
+	}
+	return TableStatus(0), fmt.Errorf("not a valid TableStatus string")
+}
+
+func TableStatusPtr(v TableStatus) *TableStatus { return &v }
+
+func (p TableStatus) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *TableStatus) UnmarshalText(text []byte) error {
+	q, err := TableStatusFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *TableStatus) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = TableStatus(v)
+	return nil
+}
+
+func (p *TableStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type RegionStatus int64
+
+const (
+	RegionStatus_Expected      RegionStatus = 0
+	RegionStatus_Relocated     RegionStatus = 1
+	RegionStatus_Unexpected    RegionStatus = 2
+	RegionStatus_NotVerifiable RegionStatus = 3
+)
+
+func (p RegionStatus) String() string {
+	switch p {
+	case RegionStatus_Expected:
+		return "Expected"
+	case RegionStatus_Relocated:
+		return "Relocated"
+	case RegionStatus_Unexpected:
+		return "Unexpected"
+	case RegionStatus_NotVerifiable:
+		return "NotVerifiable"
+	}
+	return "<UNSET>"
+}
+
+func RegionStatusFromString(s string) (RegionStatus, error) {
+	switch s {
+	case "Expected":
+		return RegionStatus_Expected, nil
+	case "Relocated":
+		return RegionStatus_Relocated, nil
+	case "Unexpected":
+		return RegionStatus_Unexpected, nil
+	case "NotVerifiable":
+		return RegionStatus_NotVerifiable, nil
+	}
+	return RegionStatus(0), fmt.Errorf("not a valid RegionStatus string")
+}
+
+func RegionStatusPtr(v RegionStatus) *RegionStatus { return &v }
+
+func (p RegionStatus) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *RegionStatus) UnmarshalText(text []byte) error {
+	q, err := RegionStatusFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *RegionStatus) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = RegionStatus(v)
+	return nil
+}
+
+func (p *RegionStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// Attributes:
+//   - Name
+//   - Signature
+//   - OEMID
+//   - OEMTableID
+//   - Length
+//   - Checksum
+//   - ChecksumValid
+//   - Status
+//   - OriginalChecksum
+type Table struct {
+	Name             string      `thrift:"Name,1" db:"Name" json:"Name"`
+	Signature        string      `thrift:"Signature,2" db:"Signature" json:"Signature"`
+	OEMID            string      `thrift:"OEMID,3" db:"OEMID" json:"OEMID"`
+	OEMTableID       string      `thrift:"OEMTableID,4" db:"OEMTableID" json:"OEMTableID"`
+	Length           int32       `thrift:"Length,5" db:"Length" json:"Length"`
+	Checksum         int16       `thrift:"Checksum,6" db:"Checksum" json:"Checksum"`
+	ChecksumValid    bool        `thrift:"ChecksumValid,7" db:"ChecksumValid" json:"ChecksumValid"`
+	Status           TableStatus `thrift:"Status,8" db:"Status" json:"Status"`
+	OriginalChecksum *int16      `thrift:"OriginalChecksum,9" db:"OriginalChecksum" json:"OriginalChecksum,omitempty"`
+}
+
+func NewTable() *Table {
+	return &Table{}
+}
+
+func (p *Table) GetName() string {
+	return p.Name
+}
+
+func (p *Table) GetSignature() string {
+	return p.Signature
+}
+
+func (p *Table) GetOEMID() string {
+	return p.OEMID
+}
+
+func (p *Table) GetOEMTableID() string {
+	return p.OEMTableID
+}
+
+func (p *Table) GetLength() int32 {
+	return p.Length
+}
+
+func (p *Table) GetChecksum() int16 {
+	return p.Checksum
+}
+
+func (p *Table) GetChecksumValid() bool {
+	return p.ChecksumValid
+}
+
+func (p *Table) GetStatus() TableStatus {
+	return p.Status
+}
+
+var Table_OriginalChecksum_DEFAULT int16
+
+func (p *Table) GetOriginalChecksum() int16 {
+	if !p.IsSetOriginalChecksum() {
+		return Table_OriginalChecksum_DEFAULT
+	}
+	return *p.OriginalChecksum
+}
+func (p *Table) IsSetOriginalChecksum() bool {
+	return p.OriginalChecksum != nil
+}
+
+func (p *Table) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Table) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *Table) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Signature = v
+	}
+	return nil
+}
+
+func (p *Table) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.OEMID = v
+	}
+	return nil
+}
+
+func (p *Table) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.OEMTableID = v
+	}
+	return nil
+}
+
+func (p *Table) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Length = v
+	}
+	return nil
+}
+
+func (p *Table) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.Checksum = v
+	}
+	return nil
+}
+
+func (p *Table) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.ChecksumValid = v
+	}
+	return nil
+}
+
+func (p *Table) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		temp := TableStatus(v)
+		p.Status = temp
+	}
+	return nil
+}
+
+func (p *Table) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.OriginalChecksum = &v
+	}
+	return nil
+}
+
+func (p *Table) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Table"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Table) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Name: ", p), err)
+	}
+	return err
+}
+
+func (p *Table) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Signature", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Signature: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Signature)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Signature (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Signature: ", p), err)
+	}
+	return err
+}
+
+func (p *Table) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "OEMID", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:OEMID: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.OEMID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.OEMID (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:OEMID: ", p), err)
+	}
+	return err
+}
+
+func (p *Table) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "OEMTableID", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:OEMTableID: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.OEMTableID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.OEMTableID (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:OEMTableID: ", p), err)
+	}
+	return err
+}
+
+func (p *Table) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Length", thrift.I32, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Length: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Length)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Length (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Length: ", p), err)
+	}
+	return err
+}
+
+func (p *Table) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Checksum", thrift.I16, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Checksum: ", p), err)
+	}
+	if err := oprot.WriteI16(ctx, int16(p.Checksum)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Checksum (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Checksum: ", p), err)
+	}
+	return err
+}
+
+func (p *Table) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ChecksumValid", thrift.BOOL, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:ChecksumValid: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.ChecksumValid)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ChecksumValid (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:ChecksumValid: ", p), err)
+	}
+	return err
+}
+
+func (p *Table) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Status", thrift.I32, 8); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:Status: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Status)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Status (8) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 8:Status: ", p), err)
+	}
+	return err
+}
+
+func (p *Table) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalChecksum() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalChecksum", thrift.I16, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:OriginalChecksum: ", p), err)
+		}
+		if err := oprot.WriteI16(ctx, int16(*p.OriginalChecksum)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalChecksum (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:OriginalChecksum: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Table) Equals(other *Table) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.Signature != other.Signature {
+		return false
+	}
+	if p.OEMID != other.OEMID {
+		return false
+	}
+	if p.OEMTableID != other.OEMTableID {
+		return false
+	}
+	if p.Length != other.Length {
+		return false
+	}
+	if p.Checksum != other.Checksum {
+		return false
+	}
+	if p.ChecksumValid != other.ChecksumValid {
+		return false
+	}
+	if p.Status != other.Status {
+		return false
+	}
+	if p.OriginalChecksum != other.OriginalChecksum {
+		if p.OriginalChecksum == nil || other.OriginalChecksum == nil {
+			return false
+		}
+		if (*p.OriginalChecksum) != (*other.OriginalChecksum) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Table) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Table(%+v)", *p)
+}
+
+// Attributes:
+//   - Base
+//   - Limit
+//   - Devices
+//   - Status
+type MemoryRegion struct {
+	Base    int64        `thrift:"Base,1" db:"Base" json:"Base"`
+	Limit   int64        `thrift:"Limit,2" db:"Limit" json:"Limit"`
+	Devices []string     `thrift:"Devices,3" db:"Devices" json:"Devices"`
+	Status  RegionStatus `thrift:"Status,4" db:"Status" json:"Status"`
+}
+
+func NewMemoryRegion() *MemoryRegion {
+	return &MemoryRegion{}
+}
+
+func (p *MemoryRegion) GetBase() int64 {
+	return p.Base
+}
+
+func (p *MemoryRegion) GetLimit() int64 {
+	return p.Limit
+}
+
+func (p *MemoryRegion) GetDevices() []string {
+	return p.Devices
+}
+
+func (p *MemoryRegion) GetStatus() RegionStatus {
+	return p.Status
+}
+func (p *MemoryRegion) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *MemoryRegion) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Base = v
+	}
+	return nil
+}
+
+func (p *MemoryRegion) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Limit = v
+	}
+	return nil
+}
+
+func (p *MemoryRegion) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Devices = tSlice
+	for i := 0; i < size; i++ {
+		var _elem0 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem0 = v
+		}
+		p.Devices = append(p.Devices, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *MemoryRegion) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := RegionStatus(v)
+		p.Status = temp
+	}
+	return nil
+}
+
+func (p *MemoryRegion) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "MemoryRegion"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *MemoryRegion) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Base", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Base: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Base)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Base (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Base: ", p), err)
+	}
+	return err
+}
+
+func (p *MemoryRegion) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Limit", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Limit: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Limit)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Limit (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Limit: ", p), err)
+	}
+	return err
+}
+
+func (p *MemoryRegion) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Devices", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Devices: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Devices)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Devices {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Devices: ", p), err)
+	}
+	return err
+}
+
+func (p *MemoryRegion) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Status", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Status: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Status)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Status (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Status: ", p), err)
+	}
+	return err
+}
+
+func (p *MemoryRegion) Equals(other *MemoryRegion) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Base != other.Base {
+		return false
+	}
+	if p.Limit != other.Limit {
+		return false
+	}
+	if len(p.Devices) != len(other.Devices) {
+		return false
+	}
+	for i, _tgt := range p.Devices {
+		_src1 := other.Devices[i]
+		if _tgt != _src1 {
+			return false
+		}
+	}
+	if p.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+func (p *MemoryRegion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MemoryRegion(%+v)", *p)
+}
+
+// Attributes:
+//   - Signature
+//   - Flags
+//   - PlatformOptIn
+//   - ReservedRegions
+//   - OriginalPlatformOptIn
+//   - IOMMUs
+type DMAProtection struct {
+	Signature             string          `thrift:"Signature,1" db:"Signature" json:"Signature"`
+	Flags                 int64           `thrift:"Flags,2" db:"Flags" json:"Flags"`
+	PlatformOptIn         bool            `thrift:"PlatformOptIn,3" db:"PlatformOptIn" json:"PlatformOptIn"`
+	ReservedRegions       []*MemoryRegion `thrift:"ReservedRegions,4" db:"ReservedRegions" json:"ReservedRegions"`
+	OriginalPlatformOptIn *bool           `thrift:"OriginalPlatformOptIn,5" db:"OriginalPlatformOptIn" json:"OriginalPlatformOptIn,omitempty"`
+	IOMMUs                int32           `thrift:"IOMMUs,6" db:"IOMMUs" json:"IOMMUs"`
+}
+
+func NewDMAProtection() *DMAProtection {
+	return &DMAProtection{}
+}
+
+func (p *DMAProtection) GetSignature() string {
+	return p.Signature
+}
+
+func (p *DMAProtection) GetFlags() int64 {
+	return p.Flags
+}
+
+func (p *DMAProtection) GetPlatformOptIn() bool {
+	return p.PlatformOptIn
+}
+
+func (p *DMAProtection) GetReservedRegions() []*MemoryRegion {
+	return p.ReservedRegions
+}
+
+var DMAProtection_OriginalPlatformOptIn_DEFAULT bool
+
+func (p *DMAProtection) GetOriginalPlatformOptIn() bool {
+	if !p.IsSetOriginalPlatformOptIn() {
+		return DMAProtection_OriginalPlatformOptIn_DEFAULT
+	}
+	return *p.OriginalPlatformOptIn
+}
+
+func (p *DMAProtection) GetIOMMUs() int32 {
+	return p.IOMMUs
+}
+func (p *DMAProtection) IsSetOriginalPlatformOptIn() bool {
+	return p.OriginalPlatformOptIn != nil
+}
+
+func (p *DMAProtection) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DMAProtection) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Signature = v
+	}
+	return nil
+}
+
+func (p *DMAProtection) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Flags = v
+	}
+	return nil
+}
+
+func (p *DMAProtection) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.PlatformOptIn = v
+	}
+	return nil
+}
+
+func (p *DMAProtection) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*MemoryRegion, 0, size)
+	p.ReservedRegions = tSlice
+	for i := 0; i < size; i++ {
+		_elem2 := &MemoryRegion{}
+		if err := _elem2.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
+		}
+		p.ReservedRegions = append(p.ReservedRegions, _elem2)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *DMAProtection) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.OriginalPlatformOptIn = &v
+	}
+	return nil
+}
+
+func (p *DMAProtection) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.IOMMUs = v
+	}
+	return nil
+}
+
+func (p *DMAProtection) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DMAProtection"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DMAProtection) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Signature", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Signature: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Signature)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Signature (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Signature: ", p), err)
+	}
+	return err
+}
+
+func (p *DMAProtection) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Flags", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Flags: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Flags)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Flags (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Flags: ", p), err)
+	}
+	return err
+}
+
+func (p *DMAProtection) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PlatformOptIn", thrift.BOOL, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:PlatformOptIn: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.PlatformOptIn)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PlatformOptIn (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:PlatformOptIn: ", p), err)
+	}
+	return err
+}
+
+func (p *DMAProtection) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ReservedRegions", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ReservedRegions: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.ReservedRegions)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.ReservedRegions {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ReservedRegions: ", p), err)
+	}
+	return err
+}
+
+func (p *DMAProtection) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginalPlatformOptIn() {
+		if err := oprot.WriteFieldBegin(ctx, "OriginalPlatformOptIn", thrift.BOOL, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:OriginalPlatformOptIn: ", p), err)
+		}
+		if err := oprot.WriteBool(ctx, bool(*p.OriginalPlatformOptIn)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.OriginalPlatformOptIn (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:OriginalPlatformOptIn: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DMAProtection) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "IOMMUs", thrift.I32, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:IOMMUs: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.IOMMUs)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.IOMMUs (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:IOMMUs: ", p), err)
+	}
+	return err
+}
+
+func (p *DMAProtection) Equals(other *DMAProtection) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Signature != other.Signature {
+		return false
+	}
+	if p.Flags != other.Flags {
+		return false
+	}
+	if p.PlatformOptIn != other.PlatformOptIn {
+		return false
+	}
+	if len(p.ReservedRegions) != len(other.ReservedRegions) {
+		return false
+	}
+	for i, _tgt := range p.ReservedRegions {
+		_src3 := other.ReservedRegions[i]
+		if !_tgt.Equals(_src3) {
+			return false
+		}
+	}
+	if p.OriginalPlatformOptIn != other.OriginalPlatformOptIn {
+		if p.OriginalPlatformOptIn == nil || other.OriginalPlatformOptIn == nil {
+			return false
+		}
+		if (*p.OriginalPlatformOptIn) != (*other.OriginalPlatformOptIn) {
+			return false
+		}
+	}
+	if p.IOMMUs != other.IOMMUs {
+		return false
+	}
+	return true
+}
+
+func (p *DMAProtection) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DMAProtection(%+v)", *p)
+}
+
+// Attributes:
+//   - Tables
+//   - DMAProtection
+//   - OriginalTables
+type CustomReport struct {
+	Tables         []*Table       `thrift:"Tables,1" db:"Tables" json:"Tables"`
+	DMAProtection  *DMAProtection `thrift:"DMAProtection,2" db:"DMAProtection" json:"DMAProtection,omitempty"`
+	OriginalTables int32          `thrift:"OriginalTables,3" db:"OriginalTables" json:"OriginalTables"`
+}
+
+func NewCustomReport() *CustomReport {
+	return &CustomReport{}
+}
+
+func (p *CustomReport) GetTables() []*Table {
+	return p.Tables
+}
+
+var CustomReport_DMAProtection_DEFAULT *DMAProtection
+
+func (p *CustomReport) GetDMAProtection() *DMAProtection {
+	if !p.IsSetDMAProtection() {
+		return CustomReport_DMAProtection_DEFAULT
+	}
+	return p.DMAProtection
+}
+
+func (p *CustomReport) GetOriginalTables() int32 {
+	return p.OriginalTables
+}
+func (p *CustomReport) IsSetDMAProtection() bool {
+	return p.DMAProtection != nil
+}
+
+func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Table, 0, size)
+	p.Tables = tSlice
+	for i := 0; i < size; i++ {
+		_elem4 := &Table{}
+		if err := _elem4.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		}
+		p.Tables = append(p.Tables, _elem4)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.DMAProtection = &DMAProtection{}
+	if err := p.DMAProtection.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DMAProtection), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.OriginalTables = v
+	}
+	return nil
+}
+
+func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Tables", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Tables: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Tables)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Tables {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Tables: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDMAProtection() {
+		if err := oprot.WriteFieldBegin(ctx, "DMAProtection", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:DMAProtection: ", p), err)
+		}
+		if err := p.DMAProtection.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DMAProtection), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:DMAProtection: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "OriginalTables", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:OriginalTables: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.OriginalTables)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.OriginalTables (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:OriginalTables: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) Equals(other *CustomReport) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.Tables) != len(other.Tables) {
+		return false
+	}
+	for i, _tgt := range p.Tables {
+		_src5 := other.Tables[i]
+		if !_tgt.Equals(_src5) {
+			return false
+		}
+	}
+	if !p.DMAProtection.Equals(other.DMAProtection) {
+		return false
+	}
+	if p.OriginalTables != other.OriginalTables {
+		return false
+	}
+	return true
+}
+
+func (p *CustomReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CustomReport(%+v)", *p)
+}
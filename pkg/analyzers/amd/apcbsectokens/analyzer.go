@@ -12,6 +12,8 @@
 
 package apcbsectokens
 
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
 import (
 	"context"
 	"fmt"
@@ -33,6 +35,9 @@ func init() {
 // ID represents the unique id of APCBSecurityTokens analyzer that checks BIOS
 const ID analysis.AnalyzerID = apcbsecanalysis.APCBSecurityTokensAnalyzerID
 
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // Input is an input structure required for analyzer
 type Input struct {
 	Firmware analysis.ActualPSPFirmware
@@ -66,6 +71,11 @@ func (analyzer *Analyzer) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *Analyzer) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze makes the APCB tokens gathering and analysis
 func (analyzer *Analyzer) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
 	log := logger.FromCtx(ctx)
@@ -193,8 +203,10 @@ func getTokensIssues(directoryTokens apcbsecanalysis.BIOSDirectoryTokens) []anal
 	if len(pspMeasureConfig) == 0 {
 		return []analysis.Issue{
 			{
+				Code:        analysis.IssueCodeAPCBSecurityTokensMeasureConfigMissing,
 				Severity:    analysis.SeverityCritical,
 				Description: fmt.Sprintf("No APCB_TOKEN_UID_PSP_MEASURE_CONFIG token is found in BIOS directory level %d", directoryTokens.BIOSDirectoryLevel),
+				Params:      map[string]string{"bios_directory_level": fmt.Sprint(directoryTokens.BIOSDirectoryLevel)},
 			},
 		}
 	}
@@ -203,20 +215,24 @@ func getTokensIssues(directoryTokens apcbsecanalysis.BIOSDirectoryTokens) []anal
 	for _, token := range pspMeasureConfig {
 		if !token.Value.IsSetDWord() {
 			result = append(result, analysis.Issue{
+				Code:        analysis.IssueCodeAPCBSecurityTokensMeasureConfigInvalidType,
 				Severity:    analysis.SeverityCritical,
 				Description: fmt.Sprintf("APCB_TOKEN_UID_PSP_MEASURE_CONFIG token found in BIOS directory level %d has incorrect value type", directoryTokens.BIOSDirectoryLevel),
+				Params:      map[string]string{"bios_directory_level": fmt.Sprint(directoryTokens.BIOSDirectoryLevel)},
 			})
 			continue
 		}
 
 		if token.Value.GetDWord() != 1 {
 			result = append(result, analysis.Issue{
+				Code:     analysis.IssueCodeAPCBSecurityTokensMeasureConfigInvalidValue,
 				Severity: analysis.SeverityCritical,
 				Description: fmt.Sprintf(
 					"APCB_TOKEN_UID_PSP_MEASURE_CONFIG token found in BIOS directory level %d has bad value of '0x%X', expected: '0x1'",
 					directoryTokens.BIOSDirectoryLevel,
 					token.Value.GetDWord(),
 				),
+				Params: map[string]string{"bios_directory_level": fmt.Sprint(directoryTokens.BIOSDirectoryLevel), "value": fmt.Sprintf("0x%X", token.Value.GetDWord())},
 			})
 		}
 	}
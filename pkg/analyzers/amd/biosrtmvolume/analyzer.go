@@ -12,6 +12,8 @@
 
 package biosrtmvolume
 
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
 import (
 	"context"
 	"errors"
@@ -36,6 +38,9 @@ const (
 // ID represents the unique id of BIOSRTMVolumeSignature analyzer that checks BIOS
 const ID analysis.AnalyzerID = biosrtmanalysis.BIOSRTMVolumeAnalyzerID
 
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // Input is an input structure required for analyzer
 type Input struct {
 	Firmware analysis.ActualPSPFirmware
@@ -69,6 +74,11 @@ func (analyzer *Analyzer) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *Analyzer) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze makes the ACM gathering
 func (analyzer *Analyzer) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
 	log := logger.FromCtx(ctx)
@@ -184,6 +194,7 @@ func getRTMVolumeIssues(rtmVolume biosrtmanalysis.BIOSRTMVolume) []analysis.Issu
 	switch rtmVolume.ValidationResult_ {
 	case biosrtmanalysis.Validation_Unknown:
 		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeBIOSRTMVolumeUnknownProblem,
 			Severity:    analysis.SeverityCritical,
 			Description: fmt.Sprintf("Unknown problem: %s", rtmVolume.ValidationDescription),
 		},
@@ -192,12 +203,14 @@ func getRTMVolumeIssues(rtmVolume biosrtmanalysis.BIOSRTMVolume) []analysis.Issu
 		// no issues
 	case biosrtmanalysis.Validation_RTMVolumeNotFound:
 		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeBIOSRTMVolumeNotFound,
 			Severity:    analysis.SeverityCritical,
 			Description: "RTM Volume was not found",
 		},
 		)
 	case biosrtmanalysis.Validation_RTMSignatureNotFound:
 		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeBIOSRTMVolumeSignatureNotFound,
 			Severity:    analysis.SeverityCritical,
 			Description: "RTM Signature was not found",
 		},
@@ -206,22 +219,26 @@ func getRTMVolumeIssues(rtmVolume biosrtmanalysis.BIOSRTMVolume) []analysis.Issu
 		// not an issue
 	case biosrtmanalysis.Validation_InvalidFormat:
 		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeBIOSRTMVolumeInvalidFormat,
 			Severity:    analysis.SeverityCritical,
 			Description: fmt.Sprintf("Invalid format: '%s'", rtmVolume.ValidationDescription),
 		},
 		)
 	case biosrtmanalysis.Validation_IncorrectSignature:
 		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeBIOSRTMVolumeIncorrectSignature,
 			Severity:    analysis.SeverityCritical,
 			Description: fmt.Sprintf("Incorrect signature: '%s'", rtmVolume.ValidationDescription),
 		},
 		)
 	default:
 		result = append(result, analysis.Issue{
+			Code:     analysis.IssueCodeBIOSRTMVolumeUnsupportedValidationResult,
 			Severity: analysis.SeverityCritical,
 			Description: fmt.Sprintf("Unsupported validation result (please fix AFAS): '%s', description: '%s'",
 				rtmVolume.ValidationResult_, rtmVolume.ValidationDescription,
 			),
+			Params: map[string]string{"result": rtmVolume.ValidationResult_.String()},
 		},
 		)
 	}
@@ -230,10 +247,12 @@ func getRTMVolumeIssues(rtmVolume biosrtmanalysis.BIOSRTMVolume) []analysis.Issu
 		platformInfo := rtmVolume.PlatformInfo
 		if platformInfo.VendorID != metaPlatformsVendorID {
 			result = append(result, analysis.Issue{
+				Code:     analysis.IssueCodeBIOSRTMVolumeVendorIDMismatch,
 				Severity: analysis.SeverityCritical,
 				Description: fmt.Sprintf("Not a Meta defined VendorID: '0x%X', expexted: '0x%X'",
 					platformInfo.VendorID, metaPlatformsVendorID,
 				),
+				Params: map[string]string{"vendor_id": fmt.Sprintf("0x%X", platformInfo.VendorID), "expected_vendor_id": fmt.Sprintf("0x%X", metaPlatformsVendorID)},
 			},
 			)
 		}
@@ -242,6 +261,7 @@ func getRTMVolumeIssues(rtmVolume biosrtmanalysis.BIOSRTMVolume) []analysis.Issu
 		securityFeatures := rtmVolume.SecurityFeatures
 		if securityFeatures.DisableAMDBIOSKeyUse {
 			result = append(result, analysis.Issue{
+				Code:        analysis.IssueCodeBIOSRTMVolumeAMDBIOSKeyUseDisabled,
 				Severity:    analysis.SeverityCritical,
 				Description: "DISABLE_AMD_BIOS_KEY_USE expected 0 but actual 1",
 			},
@@ -249,6 +269,7 @@ func getRTMVolumeIssues(rtmVolume biosrtmanalysis.BIOSRTMVolume) []analysis.Issu
 		}
 		if securityFeatures.DisableBIOSKeyAntiRollback {
 			result = append(result, analysis.Issue{
+				Code:        analysis.IssueCodeBIOSRTMVolumeBIOSKeyAntiRollbackDisabled,
 				Severity:    analysis.SeverityCritical,
 				Description: "DISABLE_BIOS_KEY_ANTI_ROLLBACK expected 0 but actual 1",
 			},
@@ -256,6 +277,7 @@ func getRTMVolumeIssues(rtmVolume biosrtmanalysis.BIOSRTMVolume) []analysis.Issu
 		}
 		if securityFeatures.DisableSecureDebugUnlock {
 			result = append(result, analysis.Issue{
+				Code:        analysis.IssueCodeBIOSRTMVolumeSecureDebugUnlockDisabled,
 				Severity:    analysis.SeverityCritical,
 				Description: "DISABLE_SECURE_DEBUG_UNLOCK expected 0 but actual 1",
 			},
@@ -0,0 +1,251 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package psbfuses
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/registers"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/linuxboot/fiano/pkg/amd/psb"
+
+	css_registers "github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+func init() {
+	analysis.RegisterType((*psbfusesanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of PSBFuses analyzer that compares the PSB binding of an image with the CPU fuses
+const ID analysis.AnalyzerID = psbfusesanalysis.PSBFusesAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
+// NewExecutorInput builds an analysis.Executor's input required for PSBFuses analyzer
+//
+// fusedKeyHash is optional, it is the SHA256 hash of the OEM root key fused into the CPU.
+func NewExecutorInput(
+	actualFirmware analysis.Blob,
+	regs css_registers.Registers,
+	fusedKeyHash []byte,
+) (analysis.Input, error) {
+	if actualFirmware == nil {
+		return nil, fmt.Errorf("firmware image should be specified")
+	}
+	if regs == nil {
+		return nil, fmt.Errorf("status registers should be specified")
+	}
+	actualRegisters, err := analysis.NewActualRegisters(regs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert registers: %w", err)
+	}
+
+	result := analysis.NewInput()
+	result.AddActualFirmware(
+		actualFirmware,
+	).AddActualRegisters(
+		actualRegisters,
+	)
+	if fusedKeyHash != nil {
+		result.AddPSBFusedKeyHash(fusedKeyHash)
+	}
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	Firmware        analysis.ActualPSPFirmware
+	ActualRegisters analysis.ActualRegisters
+	FusedKeyHash    analysis.PSBFusedKeyHash `exec:"optional"`
+}
+
+// PSBFuses is analyzer that checks that the PSB binding of the OEM key of an image
+// matches the state fused into the CPU
+type PSBFuses struct{}
+
+// New returns a new object of PSBFuses analyzer
+func New() analysis.Analyzer[Input] {
+	return &PSBFuses{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *PSBFuses) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *PSBFuses) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze compares the platform binding of the image with the fused PSB state
+func (analyzer *PSBFuses) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	log := logger.FromCtx(ctx)
+
+	regs := in.ActualRegisters.GetRegisters()
+	fuses, found := GetFusedState(regs, in.FusedKeyHash)
+	if !found {
+		return nil, analysis.NewErrNotApplicable("no PSB status register (MP0_C2P_MSG_37)")
+	}
+
+	customReport := psbfusesanalysis.CustomReport{
+		Fuses: fuses,
+	}
+	image, err := GetImageBinding(in.Firmware.AMDFirmware())
+	if err != nil {
+		if !errors.As(err, &psb.ErrNotFound{}) {
+			log.Errorf("Failed to get the platform binding of the image: %v", err)
+			return nil, err
+		}
+		log.Infof("The image has no OEM signing key: %v", err)
+	}
+	customReport.Image = image
+	customReport.Mismatches, customReport.WouldBrick = Compare(fuses, image)
+
+	return &analysis.Report{
+		Custom: customReport,
+		Issues: fusesIssues(customReport),
+	}, nil
+}
+
+// GetFusedState returns the PSB state fused into the CPU, returns false if MP0_C2P_MSG_37 is not provided.
+//
+// keyHash is the hash of the fused OEM root key, it is compared with the image only if provided.
+func GetFusedState(regs css_registers.Registers, keyHash []byte) (*psbfusesanalysis.FusedState, bool) {
+	status, found := registers.FindPSBStatus(regs)
+	if !found {
+		return nil, false
+	}
+	result := &psbfusesanalysis.FusedState{
+		PlatformSecureBootEnabled: status.PlatformSecureBootEnabled,
+		VendorID:                  int16(status.PlatformVendorID),
+		PlatformModelID:           int8(status.PlatformModelID),
+		KeyRevisionID:             int8(status.BIOSKeyRevisionID),
+		AntiRollbackEnabled:       !status.DisableBIOSKeyAntiRollback,
+		CustomerKeyLock:           status.CustomerKeyLock,
+	}
+	if len(keyHash) > 0 {
+		result.KeyHash = keyHash
+	}
+	if hstiStatus, found := registers.FindPSBHSTIStatus(regs); found {
+		testStatus := psbfusesanalysis.PSBTestStatus(hstiStatus.TestStatus)
+		result.TestStatus = &testStatus
+	}
+	return result, true
+}
+
+// Compare returns the differences between the fused PSB state and the image binding,
+// and whether the CPU would refuse to boot the image
+func Compare(fuses *psbfusesanalysis.FusedState, image *psbfusesanalysis.ImageBinding) ([]*psbfusesanalysis.Mismatch, bool) {
+	if image == nil {
+		return nil, fuses.PlatformSecureBootEnabled
+	}
+	if !fuses.PlatformSecureBootEnabled && fuses.VendorID == 0 {
+		// the platform binding is not fused yet
+		return nil, false
+	}
+
+	var (
+		mismatches []*psbfusesanalysis.Mismatch
+		wouldBrick bool
+	)
+	addMismatch := func(field, fused, image string, brick bool) {
+		mismatches = append(mismatches, &psbfusesanalysis.Mismatch{
+			Field: field,
+			Fused: fused,
+			Image: image,
+		})
+		wouldBrick = wouldBrick || (brick && fuses.PlatformSecureBootEnabled)
+	}
+
+	if fuses.VendorID != image.VendorID {
+		addMismatch("VendorID", fmt.Sprintf("0x%02X", fuses.VendorID), fmt.Sprintf("0x%02X", image.VendorID), true)
+	}
+	if fuses.PlatformModelID != image.PlatformModelID {
+		addMismatch("PlatformModelID", fmt.Sprintf("0x%X", fuses.PlatformModelID), fmt.Sprintf("0x%X", image.PlatformModelID), true)
+	}
+	if fuses.KeyRevisionID != image.KeyRevisionID {
+		// a newer key revision is accepted (and gets fused), an older one is rejected if anti-rollback is enabled
+		brick := fuses.AntiRollbackEnabled && image.KeyRevisionID < fuses.KeyRevisionID
+		addMismatch("KeyRevisionID", fmt.Sprintf("0x%X", fuses.KeyRevisionID), fmt.Sprintf("0x%X", image.KeyRevisionID), brick)
+	}
+	if fuses.KeyHash != nil && !bytes.Equal(fuses.KeyHash, image.KeyHash) {
+		addMismatch("KeyHash", fmt.Sprintf("%X", fuses.KeyHash), fmt.Sprintf("%X", image.KeyHash), true)
+	}
+	return mismatches, wouldBrick
+}
+
+func fusesIssues(report psbfusesanalysis.CustomReport) []analysis.Issue {
+	var result []analysis.Issue
+	fuses := report.Fuses
+	if !fuses.PlatformSecureBootEnabled {
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodePSBFusesPSBDisabled,
+			Severity:    analysis.SeverityWarning,
+			Description: "Platform Secure Boot is not enabled in the CPU fuses",
+		})
+	}
+	if fuses.TestStatus != nil && *fuses.TestStatus != psbfusesanalysis.PSBTestStatus_Pass {
+		severity := analysis.SeverityWarning
+		if fuses.PlatformSecureBootEnabled {
+			severity = analysis.SeverityCritical
+		}
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodePSBFusesTestStatusFailed,
+			Severity:    severity,
+			Description: fmt.Sprintf("PSP reported PSB test status %s", registers.PSBTestStatus(*fuses.TestStatus)),
+			Params:      map[string]string{"status": registers.PSBTestStatus(*fuses.TestStatus).String()},
+		})
+	}
+
+	if report.Image == nil {
+		if report.WouldBrick {
+			result = append(result, analysis.Issue{
+				Code:        analysis.IssueCodePSBFusesNoOEMKey,
+				Severity:    analysis.SeverityCritical,
+				Description: "the image has no OEM signing key, it would brick the CPU with PSB enabled",
+			})
+		}
+		return result
+	}
+
+	if len(report.Mismatches) > 0 {
+		var mismatches []string
+		for _, mismatch := range report.Mismatches {
+			mismatches = append(mismatches, fmt.Sprintf("%s (fused: %s, image: %s)", mismatch.Field, mismatch.Fused, mismatch.Image))
+		}
+		severity := analysis.SeverityWarning
+		description := "the image binding does not match the CPU fuses: "
+		if report.WouldBrick {
+			severity = analysis.SeverityCritical
+			description = "the image would brick the CPU with PSB enabled, the image binding does not match the CPU fuses: "
+		}
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodePSBFusesBindingMismatch,
+			Severity:    severity,
+			Description: description + strings.Join(mismatches, ", "),
+			Params:      map[string]string{"mismatches": strings.Join(mismatches, ",")},
+		})
+	}
+	return result
+}
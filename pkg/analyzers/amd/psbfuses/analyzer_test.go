@@ -0,0 +1,60 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package psbfuses
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+
+	css_registers "github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+func TestExecutorInputJSON(t *testing.T) {
+	keyHash := bytes.Repeat([]byte{0xAB}, 32)
+	regs := css_registers.Registers{
+		css_registers.ParseMP0C2PMsg37Register(0x1100238D),
+		css_registers.ParseMP0C2PMsg38Register(0),
+	}
+	input, err := NewExecutorInput(analysis.BytesBlob{1, 2, 3}, regs, keyHash)
+	require.NoError(t, err)
+
+	b, err := input.MarshalJSON()
+	require.NoError(t, err)
+
+	restored := analysis.NewInput()
+	require.NoError(t, restored.Scan(b))
+
+	b2, err := restored.MarshalJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, string(b), string(b2))
+
+	var restoredKeyHash, restoredRegs any
+	for _, v := range restored {
+		switch v := v.(type) {
+		case *analysis.PSBFusedKeyHash:
+			restoredKeyHash = []byte(*v)
+		case analysis.PSBFusedKeyHash:
+			restoredKeyHash = []byte(v)
+		case *analysis.ActualRegisters:
+			restoredRegs = v.GetRegisters()
+		case analysis.ActualRegisters:
+			restoredRegs = v.GetRegisters()
+		}
+	}
+	require.Equal(t, keyHash, restoredKeyHash)
+	require.Equal(t, regs, restoredRegs)
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package psbfuses
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
+	"github.com/linuxboot/fiano/pkg/amd/psb"
+
+	amd_manifest "github.com/linuxboot/fiano/pkg/amd/manifest"
+)
+
+const (
+	oemKeyUsageFlagOffset    = 0x24
+	oemKeyReservedOffset     = 0x28
+	oemKeyExponentSizeOffset = 0x38
+	oemKeyModulusSizeOffset  = 0x3C
+	oemKeyExponentOffset     = 0x40
+)
+
+// ParseImageBinding parses the OEM signing key token (BIOS directory entry 0x05)
+// and returns its platform binding.
+//
+// See "RSA Key Format Fields" of "Enabling Platform Secure Boot for AMD Family 17h Models 00h–0Fh
+// and 30h–3Fh and Family 19h Models 00h–0Fh Processor-Based Server Platforms".
+// psb.GetPlatformBindingInfo is not used, because it decodes the key revision ID and platform model ID incorrectly.
+func ParseImageBinding(oemKey []byte) (*psbfusesanalysis.ImageBinding, error) {
+	if len(oemKey) < oemKeyExponentOffset {
+		return nil, fmt.Errorf("OEM key is too short: %d < %d", len(oemKey), oemKeyExponentOffset)
+	}
+	if usage := psb.KeyUsageFlag(binary.LittleEndian.Uint32(oemKey[oemKeyUsageFlagOffset:])); usage != psb.PSBSignBIOS {
+		return nil, fmt.Errorf("incorrect key usage '%d', expected: '%d'", usage, psb.PSBSignBIOS)
+	}
+	exponentSize := uint64(binary.LittleEndian.Uint32(oemKey[oemKeyExponentSizeOffset:])) / 8
+	modulusSize := uint64(binary.LittleEndian.Uint32(oemKey[oemKeyModulusSizeOffset:])) / 8
+	publicKeyEnd := oemKeyExponentOffset + exponentSize + modulusSize
+	if publicKeyEnd > uint64(len(oemKey)) {
+		return nil, fmt.Errorf("OEM key is truncated: %d > %d", publicKeyEnd, len(oemKey))
+	}
+
+	keyHash := sha256.Sum256(oemKey[:publicKeyEnd])
+	reserved := oemKey[oemKeyReservedOffset:]
+	return &psbfusesanalysis.ImageBinding{
+		VendorID:        int16(reserved[0]),
+		KeyRevisionID:   int8(reserved[1] & 0xf),
+		PlatformModelID: int8(reserved[1] >> 4),
+		KeyHash:         keyHash[:],
+	}, nil
+}
+
+// GetImageBinding returns the platform binding of the OEM signing key of the firmware,
+// returns psb.ErrNotFound if the firmware has no OEM signing key (PSB is not supported by the image)
+func GetImageBinding(amdFw *amd_manifest.AMDFirmware) (*psbfusesanalysis.ImageBinding, error) {
+	var resultErr error
+	for _, level := range []uint{2, 1} {
+		oemKey, err := psb.ExtractBIOSEntry(amdFw, level, psb.OEMSigningKeyEntry, 0)
+		if err != nil {
+			if resultErr == nil || !errors.As(err, &psb.ErrNotFound{}) {
+				resultErr = err
+			}
+			continue
+		}
+		return ParseImageBinding(oemKey)
+	}
+	return nil, resultErr
+}
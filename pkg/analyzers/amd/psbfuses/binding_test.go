@@ -0,0 +1,102 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package psbfuses
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses/report/generated/psbfusesanalysis"
+
+	css_registers "github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+func testOEMKey(vendorID, revision, modelID uint8) []byte {
+	const exponentSize, modulusSize = 4, 8
+	key := make([]byte, oemKeyExponentOffset+exponentSize+modulusSize+16 /* signature */)
+	binary.LittleEndian.PutUint32(key[oemKeyUsageFlagOffset:], 8)
+	key[oemKeyReservedOffset] = vendorID
+	key[oemKeyReservedOffset+1] = revision | modelID<<4
+	binary.LittleEndian.PutUint32(key[oemKeyExponentSizeOffset:], exponentSize*8)
+	binary.LittleEndian.PutUint32(key[oemKeyModulusSizeOffset:], modulusSize*8)
+	for idx := oemKeyExponentOffset; idx < len(key); idx++ {
+		key[idx] = uint8(idx)
+	}
+	return key
+}
+
+func TestParseImageBinding(t *testing.T) {
+	key := testOEMKey(0x8D, 2, 3)
+	binding, err := ParseImageBinding(key)
+	require.NoError(t, err)
+	require.Equal(t, int16(0x8D), binding.VendorID)
+	require.Equal(t, int8(2), binding.KeyRevisionID)
+	require.Equal(t, int8(3), binding.PlatformModelID)
+	expectedHash := sha256.Sum256(key[:oemKeyExponentOffset+12])
+	require.Equal(t, expectedHash[:], binding.KeyHash)
+
+	binary.LittleEndian.PutUint32(key[oemKeyUsageFlagOffset:], 1)
+	_, err = ParseImageBinding(key)
+	require.Error(t, err)
+}
+
+func TestCompare(t *testing.T) {
+	image, err := ParseImageBinding(testOEMKey(0x8D, 2, 3))
+	require.NoError(t, err)
+
+	// vendor 0x8D, model 3, key revision 2, PSB enabled, customer key lock
+	regs := css_registers.Registers{
+		css_registers.ParseMP0C2PMsg37Register(0x1100238D),
+		css_registers.ParseMP0C2PMsg38Register(0),
+	}
+	fuses, found := GetFusedState(regs, image.KeyHash)
+	require.True(t, found)
+	require.True(t, fuses.PlatformSecureBootEnabled)
+	require.True(t, fuses.AntiRollbackEnabled)
+	require.Equal(t, psbfusesanalysis.PSBTestStatus_Pass, *fuses.TestStatus)
+
+	mismatches, wouldBrick := Compare(fuses, image)
+	require.Empty(t, mismatches)
+	require.False(t, wouldBrick)
+
+	newerImage := *image
+	newerImage.KeyRevisionID = 3
+	mismatches, wouldBrick = Compare(fuses, &newerImage)
+	require.Len(t, mismatches, 1)
+	require.False(t, wouldBrick)
+
+	olderImage := *image
+	olderImage.KeyRevisionID = 1
+	_, wouldBrick = Compare(fuses, &olderImage)
+	require.True(t, wouldBrick)
+
+	otherKeyImage := *image
+	otherKeyImage.KeyHash = make([]byte, len(image.KeyHash))
+	mismatches, wouldBrick = Compare(fuses, &otherKeyImage)
+	require.Len(t, mismatches, 1)
+	require.Equal(t, "KeyHash", mismatches[0].Field)
+	require.True(t, wouldBrick)
+
+	_, wouldBrick = Compare(fuses, nil)
+	require.True(t, wouldBrick)
+
+	notFused, found := GetFusedState(css_registers.Registers{css_registers.ParseMP0C2PMsg37Register(0)}, nil)
+	require.True(t, found)
+	mismatches, wouldBrick = Compare(notFused, image)
+	require.Empty(t, mismatches)
+	require.False(t, wouldBrick)
+	require.Len(t, fusesIssues(psbfusesanalysis.CustomReport{Fuses: notFused, Image: image}), 1)
+}
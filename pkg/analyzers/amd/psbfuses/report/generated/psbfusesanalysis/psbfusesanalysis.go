@@ -0,0 +1,1304 @@
+// Code generated by Thrift Compiler (0.14.0). DO NOT EDIT.
+
+package psbfusesanalysis
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"time"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+var _ = time.Now
+var _ = bytes.Equal
+
+type PSBTestStatus int64
+
+const (
+	PSBTestStatus_Pass                     PSBTestStatus = 0
+	PSBTestStatus_FuseReadError            PSBTestStatus = 62
+	PSBTestStatus_BIOSKeyBadUsage          PSBTestStatus = 129
+	PSBTestStatus_BIOSRTMSignatureNotFound PSBTestStatus = 130
+	PSBTestStatus_BIOSRTMCopyError         PSBTestStatus = 131
+	PSBTestStatus_BIOSRTMBadSignature      PSBTestStatus = 132
+	PSBTestStatus_OEMKeyInvalid            PSBTestStatus = 133
+	PSBTestStatus_PlatformBadID            PSBTestStatus = 134
+	PSBTestStatus_BIOSCopyBitUnset         PSBTestStatus = 135
+	PSBTestStatus_BIOSCABadSignature       PSBTestStatus = 138
+	PSBTestStatus_BIOSCABadUsage           PSBTestStatus = 139
+	PSBTestStatus_BIOSKeyBadRevision       PSBTestStatus = 140
+)
+
+func (p PSBTestStatus) String() string {
+	switch p {
+	case PSBTestStatus_Pass:
+		return "Pass"
+	case PSBTestStatus_FuseReadError:
+		return "FuseReadError"
+	case PSBTestStatus_BIOSKeyBadUsage:
+		return "BIOSKeyBadUsage"
+	case PSBTestStatus_BIOSRTMSignatureNotFound:
+		return "BIOSRTMSignatureNotFound"
+	case PSBTestStatus_BIOSRTMCopyError:
+		return "BIOSRTMCopyError"
+	case PSBTestStatus_BIOSRTMBadSignature:
+		return "BIOSRTMBadSignature"
+	case PSBTestStatus_OEMKeyInvalid:
+		return "OEMKeyInvalid"
+	case PSBTestStatus_PlatformBadID:
+		return "PlatformBadID"
+	case PSBTestStatus_BIOSCopyBitUnset:
+		return "BIOSCopyBitUnset"
+	case PSBTestStatus_BIOSCABadSignature:
+		return "BIOSCABadSignature"
+	case PSBTestStatus_BIOSCABadUsage:
+		return "BIOSCABadUsage"
+	case PSBTestStatus_BIOSKeyBadRevision:
+		return "BIOSKeyBadRevision"
+	}
+	return "<UNSET>"
+}
+
+func PSBTestStatusFromString(s string) (PSBTestStatus, error) {
+	switch s {
+	case "Pass":
+		return PSBTestStatus_Pass, nil
+	case "FuseReadError":
+		return PSBTestStatus_FuseReadError, nil
+	case "BIOSKeyBadUsage":
+		return PSBTestStatus_BIOSKeyBadUsage, nil
+	case "BIOSRTMSignatureNotFound":
+		return PSBTestStatus_BIOSRTMSignatureNotFound, nil
+	case "BIOSRTMCopyError":
+		return PSBTestStatus_BIOSRTMCopyError, nil
+	case "BIOSRTMBadSignature":
+		return PSBTestStatus_BIOSRTMBadSignature, nil
+	case "OEMKeyInvalid":
+		return PSBTestStatus_OEMKeyInvalid, nil
+	case "PlatformBadID":
+		return PSBTestStatus_PlatformBadID, nil
+	case "BIOSCopyBitUnset":
+		return PSBTestStatus_BIOSCopyBitUnset, nil
+	case "BIOSCABadSignature":
+		return PSBTestStatus_BIOSCABadSignature, nil
+	case "BIOSCABadUsage":
+		return PSBTestStatus_BIOSCABadUsage, nil
+	case "BIOSKeyBadRevision":
+		return PSBTestStatus_BIOSKeyBadRevision, nil
+	}
+	return PSBTestStatus(0), fmt.Errorf("not a valid PSBTestStatus string")
+}
+
+func PSBTestStatusPtr(v PSBTestStatus) *PSBTestStatus { return &v }
+
+func (p PSBTestStatus) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *PSBTestStatus) UnmarshalText(text []byte) error {
+	q, err := PSBTestStatusFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *PSBTestStatus) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = PSBTestStatus(v)
+	return nil
+}
+
+func (p *PSBTestStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// Attributes:
+//   - PlatformSecureBootEnabled
+//   - VendorID
+//   - PlatformModelID
+//   - KeyRevisionID
+//   - AntiRollbackEnabled
+//   - CustomerKeyLock
+//   - KeyHash
+//   - TestStatus
+type FusedState struct {
+	PlatformSecureBootEnabled bool           `thrift:"PlatformSecureBootEnabled,1" db:"PlatformSecureBootEnabled" json:"PlatformSecureBootEnabled"`
+	VendorID                  int16          `thrift:"VendorID,2" db:"VendorID" json:"VendorID"`
+	PlatformModelID           int8           `thrift:"PlatformModelID,3" db:"PlatformModelID" json:"PlatformModelID"`
+	KeyRevisionID             int8           `thrift:"KeyRevisionID,4" db:"KeyRevisionID" json:"KeyRevisionID"`
+	AntiRollbackEnabled       bool           `thrift:"AntiRollbackEnabled,5" db:"AntiRollbackEnabled" json:"AntiRollbackEnabled"`
+	CustomerKeyLock           bool           `thrift:"CustomerKeyLock,6" db:"CustomerKeyLock" json:"CustomerKeyLock"`
+	KeyHash                   []byte         `thrift:"KeyHash,7" db:"KeyHash" json:"KeyHash,omitempty"`
+	TestStatus                *PSBTestStatus `thrift:"TestStatus,8" db:"TestStatus" json:"TestStatus,omitempty"`
+}
+
+func NewFusedState() *FusedState {
+	return &FusedState{}
+}
+
+func (p *FusedState) GetPlatformSecureBootEnabled() bool {
+	return p.PlatformSecureBootEnabled
+}
+
+func (p *FusedState) GetVendorID() int16 {
+	return p.VendorID
+}
+
+func (p *FusedState) GetPlatformModelID() int8 {
+	return p.PlatformModelID
+}
+
+func (p *FusedState) GetKeyRevisionID() int8 {
+	return p.KeyRevisionID
+}
+
+func (p *FusedState) GetAntiRollbackEnabled() bool {
+	return p.AntiRollbackEnabled
+}
+
+func (p *FusedState) GetCustomerKeyLock() bool {
+	return p.CustomerKeyLock
+}
+
+var FusedState_KeyHash_DEFAULT []byte
+
+func (p *FusedState) GetKeyHash() []byte {
+	return p.KeyHash
+}
+
+var FusedState_TestStatus_DEFAULT PSBTestStatus
+
+func (p *FusedState) GetTestStatus() PSBTestStatus {
+	if !p.IsSetTestStatus() {
+		return FusedState_TestStatus_DEFAULT
+	}
+	return *p.TestStatus
+}
+func (p *FusedState) IsSetKeyHash() bool {
+	return p.KeyHash != nil
+}
+
+func (p *FusedState) IsSetTestStatus() bool {
+	return p.TestStatus != nil
+}
+
+func (p *FusedState) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BYTE {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BYTE {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FusedState) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.PlatformSecureBootEnabled = v
+	}
+	return nil
+}
+
+func (p *FusedState) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.VendorID = v
+	}
+	return nil
+}
+
+func (p *FusedState) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadByte(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := int8(v)
+		p.PlatformModelID = temp
+	}
+	return nil
+}
+
+func (p *FusedState) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadByte(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := int8(v)
+		p.KeyRevisionID = temp
+	}
+	return nil
+}
+
+func (p *FusedState) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.AntiRollbackEnabled = v
+	}
+	return nil
+}
+
+func (p *FusedState) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.CustomerKeyLock = v
+	}
+	return nil
+}
+
+func (p *FusedState) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.KeyHash = v
+	}
+	return nil
+}
+
+func (p *FusedState) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		temp := PSBTestStatus(v)
+		p.TestStatus = &temp
+	}
+	return nil
+}
+
+func (p *FusedState) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FusedState"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FusedState) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PlatformSecureBootEnabled", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:PlatformSecureBootEnabled: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.PlatformSecureBootEnabled)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PlatformSecureBootEnabled (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:PlatformSecureBootEnabled: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedState) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "VendorID", thrift.I16, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:VendorID: ", p), err)
+	}
+	if err := oprot.WriteI16(ctx, int16(p.VendorID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.VendorID (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:VendorID: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedState) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PlatformModelID", thrift.BYTE, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:PlatformModelID: ", p), err)
+	}
+	if err := oprot.WriteByte(ctx, int8(p.PlatformModelID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PlatformModelID (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:PlatformModelID: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedState) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "KeyRevisionID", thrift.BYTE, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:KeyRevisionID: ", p), err)
+	}
+	if err := oprot.WriteByte(ctx, int8(p.KeyRevisionID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.KeyRevisionID (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:KeyRevisionID: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedState) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AntiRollbackEnabled", thrift.BOOL, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:AntiRollbackEnabled: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.AntiRollbackEnabled)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AntiRollbackEnabled (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:AntiRollbackEnabled: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedState) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "CustomerKeyLock", thrift.BOOL, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:CustomerKeyLock: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.CustomerKeyLock)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.CustomerKeyLock (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:CustomerKeyLock: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedState) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyHash() {
+		if err := oprot.WriteFieldBegin(ctx, "KeyHash", thrift.STRING, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:KeyHash: ", p), err)
+		}
+		if err := oprot.WriteBinary(ctx, p.KeyHash); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.KeyHash (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:KeyHash: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FusedState) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetTestStatus() {
+		if err := oprot.WriteFieldBegin(ctx, "TestStatus", thrift.I32, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:TestStatus: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.TestStatus)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.TestStatus (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:TestStatus: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FusedState) Equals(other *FusedState) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.PlatformSecureBootEnabled != other.PlatformSecureBootEnabled {
+		return false
+	}
+	if p.VendorID != other.VendorID {
+		return false
+	}
+	if p.PlatformModelID != other.PlatformModelID {
+		return false
+	}
+	if p.KeyRevisionID != other.KeyRevisionID {
+		return false
+	}
+	if p.AntiRollbackEnabled != other.AntiRollbackEnabled {
+		return false
+	}
+	if p.CustomerKeyLock != other.CustomerKeyLock {
+		return false
+	}
+	if bytes.Compare(p.KeyHash, other.KeyHash) != 0 {
+		return false
+	}
+	if p.TestStatus != other.TestStatus {
+		if p.TestStatus == nil || other.TestStatus == nil {
+			return false
+		}
+		if (*p.TestStatus) != (*other.TestStatus) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *FusedState) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FusedState(%+v)", *p)
+}
+
+// Attributes:
+//   - VendorID
+//   - PlatformModelID
+//   - KeyRevisionID
+//   - KeyHash
+type ImageBinding struct {
+	VendorID        int16  `thrift:"VendorID,1" db:"VendorID" json:"VendorID"`
+	PlatformModelID int8   `thrift:"PlatformModelID,2" db:"PlatformModelID" json:"PlatformModelID"`
+	KeyRevisionID   int8   `thrift:"KeyRevisionID,3" db:"KeyRevisionID" json:"KeyRevisionID"`
+	KeyHash         []byte `thrift:"KeyHash,4" db:"KeyHash" json:"KeyHash"`
+}
+
+func NewImageBinding() *ImageBinding {
+	return &ImageBinding{}
+}
+
+func (p *ImageBinding) GetVendorID() int16 {
+	return p.VendorID
+}
+
+func (p *ImageBinding) GetPlatformModelID() int8 {
+	return p.PlatformModelID
+}
+
+func (p *ImageBinding) GetKeyRevisionID() int8 {
+	return p.KeyRevisionID
+}
+
+func (p *ImageBinding) GetKeyHash() []byte {
+	return p.KeyHash
+}
+func (p *ImageBinding) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BYTE {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BYTE {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ImageBinding) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.VendorID = v
+	}
+	return nil
+}
+
+func (p *ImageBinding) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadByte(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := int8(v)
+		p.PlatformModelID = temp
+	}
+	return nil
+}
+
+func (p *ImageBinding) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadByte(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := int8(v)
+		p.KeyRevisionID = temp
+	}
+	return nil
+}
+
+func (p *ImageBinding) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.KeyHash = v
+	}
+	return nil
+}
+
+func (p *ImageBinding) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "ImageBinding"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ImageBinding) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "VendorID", thrift.I16, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:VendorID: ", p), err)
+	}
+	if err := oprot.WriteI16(ctx, int16(p.VendorID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.VendorID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:VendorID: ", p), err)
+	}
+	return err
+}
+
+func (p *ImageBinding) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PlatformModelID", thrift.BYTE, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:PlatformModelID: ", p), err)
+	}
+	if err := oprot.WriteByte(ctx, int8(p.PlatformModelID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PlatformModelID (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:PlatformModelID: ", p), err)
+	}
+	return err
+}
+
+func (p *ImageBinding) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "KeyRevisionID", thrift.BYTE, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:KeyRevisionID: ", p), err)
+	}
+	if err := oprot.WriteByte(ctx, int8(p.KeyRevisionID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.KeyRevisionID (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:KeyRevisionID: ", p), err)
+	}
+	return err
+}
+
+func (p *ImageBinding) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "KeyHash", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:KeyHash: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.KeyHash); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.KeyHash (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:KeyHash: ", p), err)
+	}
+	return err
+}
+
+func (p *ImageBinding) Equals(other *ImageBinding) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.VendorID != other.VendorID {
+		return false
+	}
+	if p.PlatformModelID != other.PlatformModelID {
+		return false
+	}
+	if p.KeyRevisionID != other.KeyRevisionID {
+		return false
+	}
+	if bytes.Compare(p.KeyHash, other.KeyHash) != 0 {
+		return false
+	}
+	return true
+}
+
+func (p *ImageBinding) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ImageBinding(%+v)", *p)
+}
+
+// Attributes:
+//   - Field
+//   - Fused
+//   - Image
+type Mismatch struct {
+	Field string `thrift:"Field,1" db:"Field" json:"Field"`
+	Fused string `thrift:"Fused,2" db:"Fused" json:"Fused"`
+	Image string `thrift:"Image,3" db:"Image" json:"Image"`
+}
+
+func NewMismatch() *Mismatch {
+	return &Mismatch{}
+}
+
+func (p *Mismatch) GetField() string {
+	return p.Field
+}
+
+func (p *Mismatch) GetFused() string {
+	return p.Fused
+}
+
+func (p *Mismatch) GetImage() string {
+	return p.Image
+}
+func (p *Mismatch) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Mismatch) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Field = v
+	}
+	return nil
+}
+
+func (p *Mismatch) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Fused = v
+	}
+	return nil
+}
+
+func (p *Mismatch) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Image = v
+	}
+	return nil
+}
+
+func (p *Mismatch) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Mismatch"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Mismatch) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Field", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Field: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Field)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Field (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Field: ", p), err)
+	}
+	return err
+}
+
+func (p *Mismatch) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Fused", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Fused: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Fused)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Fused (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Fused: ", p), err)
+	}
+	return err
+}
+
+func (p *Mismatch) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Image", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Image: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Image)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Image (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Image: ", p), err)
+	}
+	return err
+}
+
+func (p *Mismatch) Equals(other *Mismatch) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Field != other.Field {
+		return false
+	}
+	if p.Fused != other.Fused {
+		return false
+	}
+	if p.Image != other.Image {
+		return false
+	}
+	return true
+}
+
+func (p *Mismatch) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Mismatch(%+v)", *p)
+}
+
+// Attributes:
+//   - Fuses
+//   - Image
+//   - Mismatches
+//   - WouldBrick
+type CustomReport struct {
+	Fuses      *FusedState   `thrift:"Fuses,1" db:"Fuses" json:"Fuses"`
+	Image      *ImageBinding `thrift:"Image,2" db:"Image" json:"Image,omitempty"`
+	Mismatches []*Mismatch   `thrift:"Mismatches,3" db:"Mismatches" json:"Mismatches"`
+	WouldBrick bool          `thrift:"WouldBrick,4" db:"WouldBrick" json:"WouldBrick"`
+}
+
+func NewCustomReport() *CustomReport {
+	return &CustomReport{}
+}
+
+var CustomReport_Fuses_DEFAULT *FusedState
+
+func (p *CustomReport) GetFuses() *FusedState {
+	if !p.IsSetFuses() {
+		return CustomReport_Fuses_DEFAULT
+	}
+	return p.Fuses
+}
+
+var CustomReport_Image_DEFAULT *ImageBinding
+
+func (p *CustomReport) GetImage() *ImageBinding {
+	if !p.IsSetImage() {
+		return CustomReport_Image_DEFAULT
+	}
+	return p.Image
+}
+
+func (p *CustomReport) GetMismatches() []*Mismatch {
+	return p.Mismatches
+}
+
+func (p *CustomReport) GetWouldBrick() bool {
+	return p.WouldBrick
+}
+func (p *CustomReport) IsSetFuses() bool {
+	return p.Fuses != nil
+}
+
+func (p *CustomReport) IsSetImage() bool {
+	return p.Image != nil
+}
+
+func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Fuses = &FusedState{}
+	if err := p.Fuses.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Fuses), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Image = &ImageBinding{}
+	if err := p.Image.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Image), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Mismatch, 0, size)
+	p.Mismatches = tSlice
+	for i := 0; i < size; i++ {
+		_elem0 := &Mismatch{}
+		if err := _elem0.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+		}
+		p.Mismatches = append(p.Mismatches, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.WouldBrick = v
+	}
+	return nil
+}
+
+func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Fuses", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Fuses: ", p), err)
+	}
+	if err := p.Fuses.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Fuses), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Fuses: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetImage() {
+		if err := oprot.WriteFieldBegin(ctx, "Image", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Image: ", p), err)
+		}
+		if err := p.Image.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Image), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Image: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Mismatches", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Mismatches: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Mismatches)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Mismatches {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Mismatches: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "WouldBrick", thrift.BOOL, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:WouldBrick: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.WouldBrick)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.WouldBrick (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:WouldBrick: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) Equals(other *CustomReport) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Fuses.Equals(other.Fuses) {
+		return false
+	}
+	if !p.Image.Equals(other.Image) {
+		return false
+	}
+	if len(p.Mismatches) != len(other.Mismatches) {
+		return false
+	}
+	for i, _tgt := range p.Mismatches {
+		_src1 := other.Mismatches[i]
+		if !_tgt.Equals(_src1) {
+			return false
+		}
+	}
+	if p.WouldBrick != other.WouldBrick {
+		return false
+	}
+	return true
+}
+
+func (p *CustomReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CustomReport(%+v)", *p)
+}
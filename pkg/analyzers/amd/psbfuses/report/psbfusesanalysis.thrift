@@ -0,0 +1,64 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.amd.psbfuses.report.generated.psbfusesanalysis
+
+const string PSBFusesAnalyzerID = "PSBFuses";
+
+// PSBTestStatus is the result of the PSB check performed by PSP during the boot (MP0_C2P_MSG_38[7:0])
+enum PSBTestStatus {
+  Pass = 0,
+  FuseReadError = 62, // 0x3E
+  BIOSKeyBadUsage = 129, // 0x81
+  BIOSRTMSignatureNotFound = 130, // 0x82
+  BIOSRTMCopyError = 131, // 0x83
+  BIOSRTMBadSignature = 132, // 0x84
+  OEMKeyInvalid = 133, // 0x85
+  PlatformBadID = 134, // 0x86
+  BIOSCopyBitUnset = 135, // 0x87
+  BIOSCABadSignature = 138, // 0x8A
+  BIOSCABadUsage = 139, // 0x8B
+  BIOSKeyBadRevision = 140, // 0x8C
+}
+
+// FusedState is the PSB state fused into the CPU (MP0_C2P_MSG_37 and the fused key hash)
+struct FusedState {
+  1: bool PlatformSecureBootEnabled;
+  2: i16 VendorID; // VendorID is 1 byte long, but thrift makes it signed which leads to warnings in typed languates
+  3: byte PlatformModelID;
+  4: byte KeyRevisionID;
+  5: bool AntiRollbackEnabled;
+  6: bool CustomerKeyLock;
+  7: optional binary KeyHash; // SHA256 of the OEM root key, not every platform provides it
+  8: optional PSBTestStatus TestStatus;
+}
+
+// ImageBinding is the platform binding of the OEM key found in the firmware image
+struct ImageBinding {
+  1: i16 VendorID;
+  2: byte PlatformModelID;
+  3: byte KeyRevisionID;
+  4: binary KeyHash; // SHA256 of the OEM root key
+}
+
+struct Mismatch {
+  1: string Field;
+  2: string Fused;
+  3: string Image;
+}
+
+struct CustomReport {
+  1: FusedState Fuses;
+  2: optional ImageBinding Image; // is not set if the image has no OEM signing key
+  3: list<Mismatch> Mismatches;
+  4: bool WouldBrick; // true if the fused CPU would refuse to boot the image
+}
@@ -12,6 +12,8 @@
 
 package pspsignature
 
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
 import (
 	"context"
 	"errors"
@@ -34,6 +36,9 @@ func init() {
 // ID represents the unique id of PSPSignature analyzer that checks all PSP signatures for validity
 const ID analysis.AnalyzerID = pspsignanalysis.PSPSignatureAnalyzerID
 
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // Input is an input structure required for analyzer
 type Input struct {
 	Firmware analysis.ActualPSPFirmware
@@ -67,6 +72,11 @@ func (analyzer *PSPSignature) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *PSPSignature) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze makes the ACM gathering
 func (analyzer *PSPSignature) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
 	log := logger.FromCtx(ctx)
@@ -411,24 +421,32 @@ func validatedItemsIssues(items []*pspsignanalysis.ValidatedItem) []analysis.Iss
 			continue
 		}
 
-		var issueDescription string
+		itemName := pspItemName(item.Directory, item.Entry)
+		issueCode := analysis.IssueCodePSPSignatureUnknownValidationResult
+		issueDescription := fmt.Sprintf("%s has unknown validation result %s", itemName, item.GetValidationResult_())
 		switch item.GetValidationResult_() {
 		case pspsignanalysis.Validation_InvalidFormat:
-			issueDescription = fmt.Sprintf("%s has invalid format", pspItemName(item.Directory, item.Entry))
+			issueCode = analysis.IssueCodePSPSignatureInvalidFormat
+			issueDescription = fmt.Sprintf("%s has invalid format", itemName)
 		case pspsignanalysis.Validation_NotFound:
-			issueDescription = fmt.Sprintf("%s was not found", pspItemName(item.Directory, item.Entry))
+			issueCode = analysis.IssueCodePSPSignatureNotFound
+			issueDescription = fmt.Sprintf("%s was not found", itemName)
 		case pspsignanalysis.Validation_IncorrectSignature:
-			issueDescription = fmt.Sprintf("%s has incorrect signature", pspItemName(item.Directory, item.Entry))
+			issueCode = analysis.IssueCodePSPSignatureIncorrectSignature
+			issueDescription = fmt.Sprintf("%s has incorrect signature", itemName)
 		case pspsignanalysis.Validation_KeyNotFound:
-			issueDescription = fmt.Sprintf("%s signature key was not found", pspItemName(item.Directory, item.Entry))
+			issueCode = analysis.IssueCodePSPSignatureKeyNotFound
+			issueDescription = fmt.Sprintf("%s signature key was not found", itemName)
 		}
 
 		if len(item.GetValidationDescription()) > 0 {
 			issueDescription += ": " + item.GetValidationDescription()
 		}
 		result = append(result, analysis.Issue{
+			Code:        issueCode,
 			Severity:    analysis.SeverityCritical,
 			Description: issueDescription,
+			Params:      map[string]string{"item": itemName},
 		})
 	}
 	return result
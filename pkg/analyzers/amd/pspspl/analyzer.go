@@ -0,0 +1,354 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package pspspl
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl/report/generated/pspsplanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/types/conv"
+	"github.com/immune-gmbh/attestation-sdk/pkg/registers"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/linuxboot/fiano/pkg/amd/psb"
+
+	css_registers "github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+func init() {
+	analysis.RegisterType((*pspsplanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of PSPSecurityPatchLevel analyzer that checks PSP firmware for downgrades
+const ID analysis.AnalyzerID = pspsplanalysis.PSPSecurityPatchLevelAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.2.0"
+
+// NewExecutorInput builds an analysis.Executor's input required for PSPSecurityPatchLevel analyzer
+func NewExecutorInput(
+	originalFirmware analysis.Blob,
+	actualFirmware analysis.Blob,
+	regs css_registers.Registers,
+) (analysis.Input, error) {
+	if actualFirmware == nil {
+		return nil, fmt.Errorf("the actual firmware image should be specified")
+	}
+
+	result := analysis.NewInput()
+	result.AddActualFirmware(
+		actualFirmware,
+	)
+	if originalFirmware != nil {
+		result.AddOriginalFirmware(
+			originalFirmware,
+		)
+	}
+	if regs != nil {
+		actualRegisters, err := analysis.NewActualRegisters(regs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert registers: %w", err)
+		}
+		result.AddActualRegisters(
+			actualRegisters,
+		)
+	}
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	Firmware         analysis.ActualPSPFirmware
+	OriginalFirmware *analysis.OriginalFirmwareBlob `exec:"optional"`
+	ActualRegisters  *analysis.ActualRegisters      `exec:"optional"`
+}
+
+// PSPSecurityPatchLevel is analyzer that checks security patch levels and
+// versions of PSP binaries against the original image and the fused anti-rollback state
+type PSPSecurityPatchLevel struct{}
+
+// New returns a new object of PSPSecurityPatchLevel analyzer
+func New() analysis.Analyzer[Input] {
+	return &PSPSecurityPatchLevel{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *PSPSecurityPatchLevel) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *PSPSecurityPatchLevel) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze collects security patch levels of the actual PSP firmware and looks for downgrades
+func (analyzer *PSPSecurityPatchLevel) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	log := logger.FromCtx(ctx)
+
+	actualSPL, err := GetFirmwareSPL(in.Firmware.AMDFirmware())
+	if err != nil {
+		log.Errorf("Failed to get security patch levels of the actual firmware: %v", err)
+		return nil, err
+	}
+
+	customReport := pspsplanalysis.CustomReport{
+		Actual: actualSPL,
+	}
+	result := &analysis.Report{}
+	for _, entry := range actualSPL.InvalidEntries {
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodePSPSecurityPatchLevelInvalidEntryHeader,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("invalid header of PSP entry %s in %s: %s", entry.Entry, entry.Directory, entry.Description),
+			Params:      map[string]string{"entry": fmt.Sprint(entry.Entry), "directory": fmt.Sprint(entry.Directory)},
+		})
+	}
+	result.Issues = append(result.Issues, splTableIssues(actualSPL)...)
+
+	if in.OriginalFirmware != nil {
+		originalSPL, err := getOriginalFirmwareSPL(in.OriginalFirmware.Bytes())
+		if err != nil {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodePSPSecurityPatchLevelOriginalUnavailable,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("unable to get security patch levels of the original image: %v", err),
+			})
+		} else {
+			customReport.Original = originalSPL
+			customReport.Downgrades = FindDowngrades(originalSPL, actualSPL)
+			customReport.SPLTableDowngrades = FindSPLTableDowngrades(originalSPL, actualSPL)
+			result.Issues = append(result.Issues, downgradeIssues(customReport.Downgrades)...)
+			result.Issues = append(result.Issues, splTableDowngradeIssues(customReport.SPLTableDowngrades)...)
+		}
+	}
+
+	if in.ActualRegisters != nil {
+		if status, found := registers.FindPSBStatus(in.ActualRegisters.GetRegisters()); found {
+			customReport.Fuses = &pspsplanalysis.FusedAntiRollback{
+				PlatformSecureBootEnabled: status.PlatformSecureBootEnabled,
+				AntiRollbackEnabled:       status.PlatformSecureBootEnabled && !status.DisableBIOSKeyAntiRollback,
+				BIOSKeyRevisionID:         int8(status.BIOSKeyRevisionID),
+			}
+			downgraded := len(customReport.Downgrades) > 0 || len(customReport.SPLTableDowngrades) > 0
+			result.Issues = append(result.Issues, fusesIssues(*customReport.Fuses, actualSPL, downgraded)...)
+		}
+	}
+
+	result.Custom = customReport
+	return result, nil
+}
+
+func getOriginalFirmwareSPL(image []byte) (*pspsplanalysis.FirmwareSPL, error) {
+	amdFw, err := psb.ParseAMDFirmware(image)
+	if err != nil {
+		return nil, err
+	}
+	return GetFirmwareSPL(amdFw)
+}
+
+type entryKey struct {
+	directory string
+	entry     string
+	instance  int
+}
+
+func entriesByKey(entries []*pspsplanalysis.EntryVersion) map[entryKey]*pspsplanalysis.EntryVersion {
+	result := make(map[entryKey]*pspsplanalysis.EntryVersion, len(entries))
+	for _, entry := range entries {
+		key := entryKey{directory: entry.Directory.String(), entry: entry.Entry.String()}
+		for result[key] != nil {
+			key.instance++
+		}
+		result[key] = entry
+	}
+	return result
+}
+
+// FindDowngrades returns PSP entries which security patch level or image version
+// in the actual firmware is lower than in the original one
+func FindDowngrades(original, actual *pspsplanalysis.FirmwareSPL) []*pspsplanalysis.EntryDowngrade {
+	originalEntries := entriesByKey(original.Entries)
+
+	var result []*pspsplanalysis.EntryDowngrade
+	for key, actualEntry := range entriesByKey(actual.Entries) {
+		originalEntry := originalEntries[key]
+		if originalEntry == nil {
+			continue
+		}
+		if actualEntry.SecurityPatchLevel < originalEntry.SecurityPatchLevel ||
+			actualEntry.ImageVersion < originalEntry.ImageVersion {
+			result = append(result, &pspsplanalysis.EntryDowngrade{
+				Original: originalEntry,
+				Actual:   actualEntry,
+			})
+		}
+	}
+	sortDowngrades(result)
+	return result
+}
+
+func sortDowngrades(downgrades []*pspsplanalysis.EntryDowngrade) {
+	sort.Slice(downgrades, func(i, j int) bool {
+		a, b := downgrades[i].Actual, downgrades[j].Actual
+		if a.Directory != b.Directory {
+			return a.Directory < b.Directory
+		}
+		return a.Entry < b.Entry
+	})
+}
+
+func downgradeIssues(downgrades []*pspsplanalysis.EntryDowngrade) []analysis.Issue {
+	var result []analysis.Issue
+	for _, downgrade := range downgrades {
+		original, actual := downgrade.Original, downgrade.Actual
+		severity := analysis.SeverityWarning
+		if actual.SecurityPatchLevel < original.SecurityPatchLevel ||
+			isBootCriticalEntry(conv.FromThriftPSPDirectoryTableEntryType(actual.Entry)) {
+			severity = analysis.SeverityCritical
+		}
+		result = append(result, analysis.Issue{
+			Code:     analysis.IssueCodePSPSecurityPatchLevelEntryDowngrade,
+			Severity: severity,
+			Description: fmt.Sprintf(
+				"PSP entry %s in %s is downgraded: security patch level 0x%X -> 0x%X, image version 0x%X -> 0x%X",
+				actual.Entry, actual.Directory,
+				original.SecurityPatchLevel, actual.SecurityPatchLevel,
+				original.ImageVersion, actual.ImageVersion,
+			),
+			Params: map[string]string{"entry": fmt.Sprint(actual.Entry), "directory": fmt.Sprint(actual.Directory), "original_spl": fmt.Sprintf("0x%X", original.SecurityPatchLevel), "actual_spl": fmt.Sprintf("0x%X", actual.SecurityPatchLevel)},
+		})
+	}
+	return result
+}
+
+type splTableKey struct {
+	directory string
+	entry     string
+}
+
+func splTableByKey(entries []*pspsplanalysis.SPLTableEntry) map[splTableKey]*pspsplanalysis.SPLTableEntry {
+	result := make(map[splTableKey]*pspsplanalysis.SPLTableEntry, len(entries))
+	for _, entry := range entries {
+		key := splTableKey{directory: entry.Directory.String(), entry: entry.Entry.String()}
+		// if an entry type is listed more than once, the highest requirement is the effective one
+		if existing := result[key]; existing == nil || existing.MinimalSecurityPatchLevel < entry.MinimalSecurityPatchLevel {
+			result[key] = entry
+		}
+	}
+	return result
+}
+
+// FindSPLTableDowngrades returns records of the SPL table which minimal security patch level
+// in the actual firmware is lower than in the original one or which are missing in the actual firmware
+func FindSPLTableDowngrades(original, actual *pspsplanalysis.FirmwareSPL) []*pspsplanalysis.SPLTableDowngrade {
+	actualTable := splTableByKey(actual.SPLTable)
+
+	var result []*pspsplanalysis.SPLTableDowngrade
+	for key, originalEntry := range splTableByKey(original.SPLTable) {
+		actualEntry := actualTable[key]
+		if actualEntry != nil && actualEntry.MinimalSecurityPatchLevel >= originalEntry.MinimalSecurityPatchLevel {
+			continue
+		}
+		result = append(result, &pspsplanalysis.SPLTableDowngrade{
+			Original: originalEntry,
+			Actual:   actualEntry,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].Original, result[j].Original
+		if a.Directory != b.Directory {
+			return a.Directory < b.Directory
+		}
+		return a.Entry < b.Entry
+	})
+	return result
+}
+
+func splTableDowngradeIssues(downgrades []*pspsplanalysis.SPLTableDowngrade) []analysis.Issue {
+	var result []analysis.Issue
+	for _, downgrade := range downgrades {
+		original := downgrade.Original
+		var actualSPL int64
+		if downgrade.IsSetActual() {
+			actualSPL = downgrade.Actual.MinimalSecurityPatchLevel
+		}
+		result = append(result, analysis.Issue{
+			Code:     analysis.IssueCodePSPSecurityPatchLevelSPLTableDowngrade,
+			Severity: analysis.SeverityCritical,
+			Description: fmt.Sprintf(
+				"minimal security patch level of PSP entry %s in the SPL table of %s is downgraded: 0x%X -> 0x%X",
+				original.Entry, original.Directory,
+				original.MinimalSecurityPatchLevel, actualSPL,
+			),
+			Params: map[string]string{"entry": fmt.Sprint(original.Entry), "directory": fmt.Sprint(original.Directory), "original_spl": fmt.Sprintf("0x%X", original.MinimalSecurityPatchLevel), "actual_spl": fmt.Sprintf("0x%X", actualSPL)},
+		})
+	}
+	return result
+}
+
+// splTableIssues reports PSP entries which security patch level is lower than
+// the minimum required by the SPL table of the same directory
+func splTableIssues(firmware *pspsplanalysis.FirmwareSPL) []analysis.Issue {
+	table := splTableByKey(firmware.SPLTable)
+
+	var result []analysis.Issue
+	for _, entry := range firmware.Entries {
+		requirement := table[splTableKey{directory: entry.Directory.String(), entry: entry.Entry.String()}]
+		if requirement == nil || entry.SecurityPatchLevel >= requirement.MinimalSecurityPatchLevel {
+			continue
+		}
+		result = append(result, analysis.Issue{
+			Code:     analysis.IssueCodePSPSecurityPatchLevelEntryBelowSPLTable,
+			Severity: analysis.SeverityWarning,
+			Description: fmt.Sprintf(
+				"security patch level 0x%X of PSP entry %s in %s is lower than the minimum 0x%X required by the SPL table",
+				entry.SecurityPatchLevel, entry.Entry, entry.Directory, requirement.MinimalSecurityPatchLevel,
+			),
+			Params: map[string]string{"entry": fmt.Sprint(entry.Entry), "directory": fmt.Sprint(entry.Directory), "actual_spl": fmt.Sprintf("0x%X", entry.SecurityPatchLevel), "minimal_spl": fmt.Sprintf("0x%X", requirement.MinimalSecurityPatchLevel)},
+		})
+	}
+	return result
+}
+
+func fusesIssues(
+	fuses pspsplanalysis.FusedAntiRollback,
+	actual *pspsplanalysis.FirmwareSPL,
+	downgraded bool,
+) []analysis.Issue {
+	var result []analysis.Issue
+	if fuses.AntiRollbackEnabled && actual.IsSetBIOSKeyRevisionID() && actual.GetBIOSKeyRevisionID() < fuses.BIOSKeyRevisionID {
+		result = append(result, analysis.Issue{
+			Code:     analysis.IssueCodePSPSecurityPatchLevelBIOSKeyRevisionBelowFuses,
+			Severity: analysis.SeverityCritical,
+			Description: fmt.Sprintf(
+				"BIOS signing key revision %d of the actual image is lower than the fused revision %d, the CPU would refuse to boot this image",
+				actual.GetBIOSKeyRevisionID(), fuses.BIOSKeyRevisionID,
+			),
+			Params: map[string]string{"actual_revision": fmt.Sprint(actual.GetBIOSKeyRevisionID()), "fused_revision": fmt.Sprint(fuses.BIOSKeyRevisionID)},
+		})
+	}
+	if downgraded && !fuses.AntiRollbackEnabled {
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodePSPSecurityPatchLevelAntiRollbackNotEnforced,
+			Severity:    analysis.SeverityWarning,
+			Description: "PSP firmware is downgraded and anti-rollback is not enforced by the CPU fuses",
+		})
+	}
+	return result
+}
@@ -0,0 +1,1912 @@
+// Code generated by Thrift Compiler (0.14.0). DO NOT EDIT.
+
+package pspsplanalysis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/types/generated/psptypes"
+	"time"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+var _ = time.Now
+var _ = bytes.Equal
+
+var _ = psptypes.GoUnusedProtection__
+
+// Attributes:
+//   - Directory
+//   - Entry
+//   - SecurityPatchLevel
+//   - ImageVersion
+type EntryVersion struct {
+	Directory          psptypes.DirectoryType              `thrift:"Directory,1" db:"Directory" json:"Directory"`
+	Entry              psptypes.PSPDirectoryTableEntryType `thrift:"Entry,2" db:"Entry" json:"Entry"`
+	SecurityPatchLevel int64                               `thrift:"SecurityPatchLevel,3" db:"SecurityPatchLevel" json:"SecurityPatchLevel"`
+	ImageVersion       int64                               `thrift:"ImageVersion,4" db:"ImageVersion" json:"ImageVersion"`
+}
+
+func NewEntryVersion() *EntryVersion {
+	return &EntryVersion{}
+}
+
+func (p *EntryVersion) GetDirectory() psptypes.DirectoryType {
+	return p.Directory
+}
+
+func (p *EntryVersion) GetEntry() psptypes.PSPDirectoryTableEntryType {
+	return p.Entry
+}
+
+func (p *EntryVersion) GetSecurityPatchLevel() int64 {
+	return p.SecurityPatchLevel
+}
+
+func (p *EntryVersion) GetImageVersion() int64 {
+	return p.ImageVersion
+}
+func (p *EntryVersion) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *EntryVersion) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := psptypes.DirectoryType(v)
+		p.Directory = temp
+	}
+	return nil
+}
+
+func (p *EntryVersion) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := psptypes.PSPDirectoryTableEntryType(v)
+		p.Entry = temp
+	}
+	return nil
+}
+
+func (p *EntryVersion) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.SecurityPatchLevel = v
+	}
+	return nil
+}
+
+func (p *EntryVersion) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.ImageVersion = v
+	}
+	return nil
+}
+
+func (p *EntryVersion) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "EntryVersion"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *EntryVersion) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Directory", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Directory: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Directory)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Directory (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Directory: ", p), err)
+	}
+	return err
+}
+
+func (p *EntryVersion) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entry", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Entry: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Entry)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Entry (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Entry: ", p), err)
+	}
+	return err
+}
+
+func (p *EntryVersion) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "SecurityPatchLevel", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:SecurityPatchLevel: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.SecurityPatchLevel)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.SecurityPatchLevel (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:SecurityPatchLevel: ", p), err)
+	}
+	return err
+}
+
+func (p *EntryVersion) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ImageVersion", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ImageVersion: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ImageVersion)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ImageVersion (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ImageVersion: ", p), err)
+	}
+	return err
+}
+
+func (p *EntryVersion) Equals(other *EntryVersion) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Directory != other.Directory {
+		return false
+	}
+	if p.Entry != other.Entry {
+		return false
+	}
+	if p.SecurityPatchLevel != other.SecurityPatchLevel {
+		return false
+	}
+	if p.ImageVersion != other.ImageVersion {
+		return false
+	}
+	return true
+}
+
+func (p *EntryVersion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EntryVersion(%+v)", *p)
+}
+
+// Attributes:
+//   - Directory
+//   - Entry
+//   - Description
+type InvalidEntry struct {
+	Directory   psptypes.DirectoryType              `thrift:"Directory,1" db:"Directory" json:"Directory"`
+	Entry       psptypes.PSPDirectoryTableEntryType `thrift:"Entry,2" db:"Entry" json:"Entry"`
+	Description string                              `thrift:"Description,3" db:"Description" json:"Description"`
+}
+
+func NewInvalidEntry() *InvalidEntry {
+	return &InvalidEntry{}
+}
+
+func (p *InvalidEntry) GetDirectory() psptypes.DirectoryType {
+	return p.Directory
+}
+
+func (p *InvalidEntry) GetEntry() psptypes.PSPDirectoryTableEntryType {
+	return p.Entry
+}
+
+func (p *InvalidEntry) GetDescription() string {
+	return p.Description
+}
+func (p *InvalidEntry) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *InvalidEntry) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := psptypes.DirectoryType(v)
+		p.Directory = temp
+	}
+	return nil
+}
+
+func (p *InvalidEntry) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := psptypes.PSPDirectoryTableEntryType(v)
+		p.Entry = temp
+	}
+	return nil
+}
+
+func (p *InvalidEntry) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *InvalidEntry) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "InvalidEntry"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *InvalidEntry) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Directory", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Directory: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Directory)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Directory (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Directory: ", p), err)
+	}
+	return err
+}
+
+func (p *InvalidEntry) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entry", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Entry: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Entry)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Entry (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Entry: ", p), err)
+	}
+	return err
+}
+
+func (p *InvalidEntry) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Description: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Description (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Description: ", p), err)
+	}
+	return err
+}
+
+func (p *InvalidEntry) Equals(other *InvalidEntry) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Directory != other.Directory {
+		return false
+	}
+	if p.Entry != other.Entry {
+		return false
+	}
+	if p.Description != other.Description {
+		return false
+	}
+	return true
+}
+
+func (p *InvalidEntry) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InvalidEntry(%+v)", *p)
+}
+
+// Attributes:
+//   - Directory
+//   - Entry
+//   - MinimalSecurityPatchLevel
+type SPLTableEntry struct {
+	Directory                 psptypes.DirectoryType              `thrift:"Directory,1" db:"Directory" json:"Directory"`
+	Entry                     psptypes.PSPDirectoryTableEntryType `thrift:"Entry,2" db:"Entry" json:"Entry"`
+	MinimalSecurityPatchLevel int64                               `thrift:"MinimalSecurityPatchLevel,3" db:"MinimalSecurityPatchLevel" json:"MinimalSecurityPatchLevel"`
+}
+
+func NewSPLTableEntry() *SPLTableEntry {
+	return &SPLTableEntry{}
+}
+
+func (p *SPLTableEntry) GetDirectory() psptypes.DirectoryType {
+	return p.Directory
+}
+
+func (p *SPLTableEntry) GetEntry() psptypes.PSPDirectoryTableEntryType {
+	return p.Entry
+}
+
+func (p *SPLTableEntry) GetMinimalSecurityPatchLevel() int64 {
+	return p.MinimalSecurityPatchLevel
+}
+func (p *SPLTableEntry) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *SPLTableEntry) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := psptypes.DirectoryType(v)
+		p.Directory = temp
+	}
+	return nil
+}
+
+func (p *SPLTableEntry) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := psptypes.PSPDirectoryTableEntryType(v)
+		p.Entry = temp
+	}
+	return nil
+}
+
+func (p *SPLTableEntry) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.MinimalSecurityPatchLevel = v
+	}
+	return nil
+}
+
+func (p *SPLTableEntry) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SPLTableEntry"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *SPLTableEntry) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Directory", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Directory: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Directory)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Directory (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Directory: ", p), err)
+	}
+	return err
+}
+
+func (p *SPLTableEntry) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entry", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Entry: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Entry)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Entry (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Entry: ", p), err)
+	}
+	return err
+}
+
+func (p *SPLTableEntry) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "MinimalSecurityPatchLevel", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:MinimalSecurityPatchLevel: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.MinimalSecurityPatchLevel)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.MinimalSecurityPatchLevel (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:MinimalSecurityPatchLevel: ", p), err)
+	}
+	return err
+}
+
+func (p *SPLTableEntry) Equals(other *SPLTableEntry) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Directory != other.Directory {
+		return false
+	}
+	if p.Entry != other.Entry {
+		return false
+	}
+	if p.MinimalSecurityPatchLevel != other.MinimalSecurityPatchLevel {
+		return false
+	}
+	return true
+}
+
+func (p *SPLTableEntry) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SPLTableEntry(%+v)", *p)
+}
+
+// Attributes:
+//   - Entries
+//   - InvalidEntries
+//   - BIOSKeyRevisionID
+//   - SPLTable
+type FirmwareSPL struct {
+	Entries           []*EntryVersion  `thrift:"Entries,1" db:"Entries" json:"Entries"`
+	InvalidEntries    []*InvalidEntry  `thrift:"InvalidEntries,2" db:"InvalidEntries" json:"InvalidEntries"`
+	BIOSKeyRevisionID *int8            `thrift:"BIOSKeyRevisionID,3" db:"BIOSKeyRevisionID" json:"BIOSKeyRevisionID,omitempty"`
+	SPLTable          []*SPLTableEntry `thrift:"SPLTable,4" db:"SPLTable" json:"SPLTable"`
+}
+
+func NewFirmwareSPL() *FirmwareSPL {
+	return &FirmwareSPL{}
+}
+
+func (p *FirmwareSPL) GetEntries() []*EntryVersion {
+	return p.Entries
+}
+
+func (p *FirmwareSPL) GetInvalidEntries() []*InvalidEntry {
+	return p.InvalidEntries
+}
+
+var FirmwareSPL_BIOSKeyRevisionID_DEFAULT int8
+
+func (p *FirmwareSPL) GetBIOSKeyRevisionID() int8 {
+	if !p.IsSetBIOSKeyRevisionID() {
+		return FirmwareSPL_BIOSKeyRevisionID_DEFAULT
+	}
+	return *p.BIOSKeyRevisionID
+}
+
+func (p *FirmwareSPL) GetSPLTable() []*SPLTableEntry {
+	return p.SPLTable
+}
+func (p *FirmwareSPL) IsSetBIOSKeyRevisionID() bool {
+	return p.BIOSKeyRevisionID != nil
+}
+
+func (p *FirmwareSPL) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BYTE {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FirmwareSPL) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*EntryVersion, 0, size)
+	p.Entries = tSlice
+	for i := 0; i < size; i++ {
+		_elem0 := &EntryVersion{}
+		if err := _elem0.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+		}
+		p.Entries = append(p.Entries, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FirmwareSPL) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*InvalidEntry, 0, size)
+	p.InvalidEntries = tSlice
+	for i := 0; i < size; i++ {
+		_elem1 := &InvalidEntry{}
+		if err := _elem1.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem1), err)
+		}
+		p.InvalidEntries = append(p.InvalidEntries, _elem1)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FirmwareSPL) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadByte(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := int8(v)
+		p.BIOSKeyRevisionID = &temp
+	}
+	return nil
+}
+
+func (p *FirmwareSPL) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*SPLTableEntry, 0, size)
+	p.SPLTable = tSlice
+	for i := 0; i < size; i++ {
+		_elem2 := &SPLTableEntry{}
+		if err := _elem2.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
+		}
+		p.SPLTable = append(p.SPLTable, _elem2)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FirmwareSPL) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FirmwareSPL"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FirmwareSPL) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Entries", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Entries: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Entries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Entries {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Entries: ", p), err)
+	}
+	return err
+}
+
+func (p *FirmwareSPL) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "InvalidEntries", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:InvalidEntries: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.InvalidEntries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.InvalidEntries {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:InvalidEntries: ", p), err)
+	}
+	return err
+}
+
+func (p *FirmwareSPL) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetBIOSKeyRevisionID() {
+		if err := oprot.WriteFieldBegin(ctx, "BIOSKeyRevisionID", thrift.BYTE, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:BIOSKeyRevisionID: ", p), err)
+		}
+		if err := oprot.WriteByte(ctx, int8(*p.BIOSKeyRevisionID)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.BIOSKeyRevisionID (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:BIOSKeyRevisionID: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FirmwareSPL) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "SPLTable", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:SPLTable: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.SPLTable)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.SPLTable {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:SPLTable: ", p), err)
+	}
+	return err
+}
+
+func (p *FirmwareSPL) Equals(other *FirmwareSPL) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.Entries) != len(other.Entries) {
+		return false
+	}
+	for i, _tgt := range p.Entries {
+		_src3 := other.Entries[i]
+		if !_tgt.Equals(_src3) {
+			return false
+		}
+	}
+	if len(p.InvalidEntries) != len(other.InvalidEntries) {
+		return false
+	}
+	for i, _tgt := range p.InvalidEntries {
+		_src4 := other.InvalidEntries[i]
+		if !_tgt.Equals(_src4) {
+			return false
+		}
+	}
+	if p.BIOSKeyRevisionID != other.BIOSKeyRevisionID {
+		if p.BIOSKeyRevisionID == nil || other.BIOSKeyRevisionID == nil {
+			return false
+		}
+		if (*p.BIOSKeyRevisionID) != (*other.BIOSKeyRevisionID) {
+			return false
+		}
+	}
+	if len(p.SPLTable) != len(other.SPLTable) {
+		return false
+	}
+	for i, _tgt := range p.SPLTable {
+		_src5 := other.SPLTable[i]
+		if !_tgt.Equals(_src5) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *FirmwareSPL) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FirmwareSPL(%+v)", *p)
+}
+
+// Attributes:
+//   - Original
+//   - Actual
+type EntryDowngrade struct {
+	Original *EntryVersion `thrift:"Original,1" db:"Original" json:"Original"`
+	Actual   *EntryVersion `thrift:"Actual,2" db:"Actual" json:"Actual"`
+}
+
+func NewEntryDowngrade() *EntryDowngrade {
+	return &EntryDowngrade{}
+}
+
+var EntryDowngrade_Original_DEFAULT *EntryVersion
+
+func (p *EntryDowngrade) GetOriginal() *EntryVersion {
+	if !p.IsSetOriginal() {
+		return EntryDowngrade_Original_DEFAULT
+	}
+	return p.Original
+}
+
+var EntryDowngrade_Actual_DEFAULT *EntryVersion
+
+func (p *EntryDowngrade) GetActual() *EntryVersion {
+	if !p.IsSetActual() {
+		return EntryDowngrade_Actual_DEFAULT
+	}
+	return p.Actual
+}
+func (p *EntryDowngrade) IsSetOriginal() bool {
+	return p.Original != nil
+}
+
+func (p *EntryDowngrade) IsSetActual() bool {
+	return p.Actual != nil
+}
+
+func (p *EntryDowngrade) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *EntryDowngrade) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Original = &EntryVersion{}
+	if err := p.Original.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Original), err)
+	}
+	return nil
+}
+
+func (p *EntryDowngrade) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Actual = &EntryVersion{}
+	if err := p.Actual.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Actual), err)
+	}
+	return nil
+}
+
+func (p *EntryDowngrade) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "EntryDowngrade"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *EntryDowngrade) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Original", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Original: ", p), err)
+	}
+	if err := p.Original.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Original), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Original: ", p), err)
+	}
+	return err
+}
+
+func (p *EntryDowngrade) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Actual", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Actual: ", p), err)
+	}
+	if err := p.Actual.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Actual), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Actual: ", p), err)
+	}
+	return err
+}
+
+func (p *EntryDowngrade) Equals(other *EntryDowngrade) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Original.Equals(other.Original) {
+		return false
+	}
+	if !p.Actual.Equals(other.Actual) {
+		return false
+	}
+	return true
+}
+
+func (p *EntryDowngrade) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EntryDowngrade(%+v)", *p)
+}
+
+// Attributes:
+//   - Original
+//   - Actual
+type SPLTableDowngrade struct {
+	Original *SPLTableEntry `thrift:"Original,1" db:"Original" json:"Original"`
+	Actual   *SPLTableEntry `thrift:"Actual,2" db:"Actual" json:"Actual,omitempty"`
+}
+
+func NewSPLTableDowngrade() *SPLTableDowngrade {
+	return &SPLTableDowngrade{}
+}
+
+var SPLTableDowngrade_Original_DEFAULT *SPLTableEntry
+
+func (p *SPLTableDowngrade) GetOriginal() *SPLTableEntry {
+	if !p.IsSetOriginal() {
+		return SPLTableDowngrade_Original_DEFAULT
+	}
+	return p.Original
+}
+
+var SPLTableDowngrade_Actual_DEFAULT *SPLTableEntry
+
+func (p *SPLTableDowngrade) GetActual() *SPLTableEntry {
+	if !p.IsSetActual() {
+		return SPLTableDowngrade_Actual_DEFAULT
+	}
+	return p.Actual
+}
+func (p *SPLTableDowngrade) IsSetOriginal() bool {
+	return p.Original != nil
+}
+
+func (p *SPLTableDowngrade) IsSetActual() bool {
+	return p.Actual != nil
+}
+
+func (p *SPLTableDowngrade) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *SPLTableDowngrade) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Original = &SPLTableEntry{}
+	if err := p.Original.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Original), err)
+	}
+	return nil
+}
+
+func (p *SPLTableDowngrade) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Actual = &SPLTableEntry{}
+	if err := p.Actual.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Actual), err)
+	}
+	return nil
+}
+
+func (p *SPLTableDowngrade) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "SPLTableDowngrade"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *SPLTableDowngrade) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Original", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Original: ", p), err)
+	}
+	if err := p.Original.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Original), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Original: ", p), err)
+	}
+	return err
+}
+
+func (p *SPLTableDowngrade) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetActual() {
+		if err := oprot.WriteFieldBegin(ctx, "Actual", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Actual: ", p), err)
+		}
+		if err := p.Actual.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Actual), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Actual: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SPLTableDowngrade) Equals(other *SPLTableDowngrade) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Original.Equals(other.Original) {
+		return false
+	}
+	if !p.Actual.Equals(other.Actual) {
+		return false
+	}
+	return true
+}
+
+func (p *SPLTableDowngrade) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SPLTableDowngrade(%+v)", *p)
+}
+
+// Attributes:
+//   - PlatformSecureBootEnabled
+//   - AntiRollbackEnabled
+//   - BIOSKeyRevisionID
+type FusedAntiRollback struct {
+	PlatformSecureBootEnabled bool `thrift:"PlatformSecureBootEnabled,1" db:"PlatformSecureBootEnabled" json:"PlatformSecureBootEnabled"`
+	AntiRollbackEnabled       bool `thrift:"AntiRollbackEnabled,2" db:"AntiRollbackEnabled" json:"AntiRollbackEnabled"`
+	BIOSKeyRevisionID         int8 `thrift:"BIOSKeyRevisionID,3" db:"BIOSKeyRevisionID" json:"BIOSKeyRevisionID"`
+}
+
+func NewFusedAntiRollback() *FusedAntiRollback {
+	return &FusedAntiRollback{}
+}
+
+func (p *FusedAntiRollback) GetPlatformSecureBootEnabled() bool {
+	return p.PlatformSecureBootEnabled
+}
+
+func (p *FusedAntiRollback) GetAntiRollbackEnabled() bool {
+	return p.AntiRollbackEnabled
+}
+
+func (p *FusedAntiRollback) GetBIOSKeyRevisionID() int8 {
+	return p.BIOSKeyRevisionID
+}
+func (p *FusedAntiRollback) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BYTE {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FusedAntiRollback) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.PlatformSecureBootEnabled = v
+	}
+	return nil
+}
+
+func (p *FusedAntiRollback) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.AntiRollbackEnabled = v
+	}
+	return nil
+}
+
+func (p *FusedAntiRollback) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadByte(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := int8(v)
+		p.BIOSKeyRevisionID = temp
+	}
+	return nil
+}
+
+func (p *FusedAntiRollback) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FusedAntiRollback"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FusedAntiRollback) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PlatformSecureBootEnabled", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:PlatformSecureBootEnabled: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.PlatformSecureBootEnabled)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PlatformSecureBootEnabled (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:PlatformSecureBootEnabled: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedAntiRollback) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AntiRollbackEnabled", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AntiRollbackEnabled: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.AntiRollbackEnabled)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AntiRollbackEnabled (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AntiRollbackEnabled: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedAntiRollback) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "BIOSKeyRevisionID", thrift.BYTE, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:BIOSKeyRevisionID: ", p), err)
+	}
+	if err := oprot.WriteByte(ctx, int8(p.BIOSKeyRevisionID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.BIOSKeyRevisionID (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:BIOSKeyRevisionID: ", p), err)
+	}
+	return err
+}
+
+func (p *FusedAntiRollback) Equals(other *FusedAntiRollback) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.PlatformSecureBootEnabled != other.PlatformSecureBootEnabled {
+		return false
+	}
+	if p.AntiRollbackEnabled != other.AntiRollbackEnabled {
+		return false
+	}
+	if p.BIOSKeyRevisionID != other.BIOSKeyRevisionID {
+		return false
+	}
+	return true
+}
+
+func (p *FusedAntiRollback) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FusedAntiRollback(%+v)", *p)
+}
+
+// Attributes:
+//   - Actual
+//   - Original
+//   - Downgrades
+//   - Fuses
+//   - SPLTableDowngrades
+type CustomReport struct {
+	Actual             *FirmwareSPL         `thrift:"Actual,1" db:"Actual" json:"Actual"`
+	Original           *FirmwareSPL         `thrift:"Original,2" db:"Original" json:"Original,omitempty"`
+	Downgrades         []*EntryDowngrade    `thrift:"Downgrades,3" db:"Downgrades" json:"Downgrades"`
+	Fuses              *FusedAntiRollback   `thrift:"Fuses,4" db:"Fuses" json:"Fuses,omitempty"`
+	SPLTableDowngrades []*SPLTableDowngrade `thrift:"SPLTableDowngrades,5" db:"SPLTableDowngrades" json:"SPLTableDowngrades"`
+}
+
+func NewCustomReport() *CustomReport {
+	return &CustomReport{}
+}
+
+var CustomReport_Actual_DEFAULT *FirmwareSPL
+
+func (p *CustomReport) GetActual() *FirmwareSPL {
+	if !p.IsSetActual() {
+		return CustomReport_Actual_DEFAULT
+	}
+	return p.Actual
+}
+
+var CustomReport_Original_DEFAULT *FirmwareSPL
+
+func (p *CustomReport) GetOriginal() *FirmwareSPL {
+	if !p.IsSetOriginal() {
+		return CustomReport_Original_DEFAULT
+	}
+	return p.Original
+}
+
+func (p *CustomReport) GetDowngrades() []*EntryDowngrade {
+	return p.Downgrades
+}
+
+var CustomReport_Fuses_DEFAULT *FusedAntiRollback
+
+func (p *CustomReport) GetFuses() *FusedAntiRollback {
+	if !p.IsSetFuses() {
+		return CustomReport_Fuses_DEFAULT
+	}
+	return p.Fuses
+}
+
+func (p *CustomReport) GetSPLTableDowngrades() []*SPLTableDowngrade {
+	return p.SPLTableDowngrades
+}
+func (p *CustomReport) IsSetActual() bool {
+	return p.Actual != nil
+}
+
+func (p *CustomReport) IsSetOriginal() bool {
+	return p.Original != nil
+}
+
+func (p *CustomReport) IsSetFuses() bool {
+	return p.Fuses != nil
+}
+
+func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Actual = &FirmwareSPL{}
+	if err := p.Actual.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Actual), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Original = &FirmwareSPL{}
+	if err := p.Original.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Original), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*EntryDowngrade, 0, size)
+	p.Downgrades = tSlice
+	for i := 0; i < size; i++ {
+		_elem6 := &EntryDowngrade{}
+		if err := _elem6.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
+		}
+		p.Downgrades = append(p.Downgrades, _elem6)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Fuses = &FusedAntiRollback{}
+	if err := p.Fuses.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Fuses), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*SPLTableDowngrade, 0, size)
+	p.SPLTableDowngrades = tSlice
+	for i := 0; i < size; i++ {
+		_elem7 := &SPLTableDowngrade{}
+		if err := _elem7.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem7), err)
+		}
+		p.SPLTableDowngrades = append(p.SPLTableDowngrades, _elem7)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Actual", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Actual: ", p), err)
+	}
+	if err := p.Actual.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Actual), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Actual: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginal() {
+		if err := oprot.WriteFieldBegin(ctx, "Original", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Original: ", p), err)
+		}
+		if err := p.Original.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Original), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Original: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Downgrades", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Downgrades: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Downgrades)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Downgrades {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Downgrades: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFuses() {
+		if err := oprot.WriteFieldBegin(ctx, "Fuses", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Fuses: ", p), err)
+		}
+		if err := p.Fuses.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Fuses), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Fuses: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "SPLTableDowngrades", thrift.LIST, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:SPLTableDowngrades: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.SPLTableDowngrades)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.SPLTableDowngrades {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:SPLTableDowngrades: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) Equals(other *CustomReport) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Actual.Equals(other.Actual) {
+		return false
+	}
+	if !p.Original.Equals(other.Original) {
+		return false
+	}
+	if len(p.Downgrades) != len(other.Downgrades) {
+		return false
+	}
+	for i, _tgt := range p.Downgrades {
+		_src8 := other.Downgrades[i]
+		if !_tgt.Equals(_src8) {
+			return false
+		}
+	}
+	if !p.Fuses.Equals(other.Fuses) {
+		return false
+	}
+	if len(p.SPLTableDowngrades) != len(other.SPLTableDowngrades) {
+		return false
+	}
+	for i, _tgt := range p.SPLTableDowngrades {
+		_src9 := other.SPLTableDowngrades[i]
+		if !_tgt.Equals(_src9) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *CustomReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CustomReport(%+v)", *p)
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.amd.pspspl.report.generated.pspsplanalysis
+
+include "../../types/psptypes.thrift"
+
+const string PSPSecurityPatchLevelAnalyzerID = "PSPSecurityPatchLevel";
+
+// EntryVersion contains security version fields of a PSP binary header.
+// SPL and version are 4 bytes long unsigned values in the header, i64 is used to avoid sign problems.
+struct EntryVersion {
+  1: psptypes.DirectoryType Directory;
+  2: psptypes.PSPDirectoryTableEntryType Entry;
+  3: i64 SecurityPatchLevel;
+  4: i64 ImageVersion;
+}
+
+// InvalidEntry is a PSP directory entry which header could not be parsed
+struct InvalidEntry {
+  1: psptypes.DirectoryType Directory;
+  2: psptypes.PSPDirectoryTableEntryType Entry;
+  3: string Description;
+}
+
+// SPLTableEntry is a record of the SPL table (PSP entry 0x55): the minimal
+// security patch level the PSP accepts for binaries of the given entry type
+struct SPLTableEntry {
+  1: psptypes.DirectoryType Directory;
+  2: psptypes.PSPDirectoryTableEntryType Entry;
+  3: i64 MinimalSecurityPatchLevel;
+}
+
+struct FirmwareSPL {
+  1: list<EntryVersion> Entries; // includes the SPL table (entry 0x55) if present
+  2: list<InvalidEntry> InvalidEntries;
+  3: optional byte BIOSKeyRevisionID; // revision of the OEM BIOS signing key (platform binding info)
+  4: list<SPLTableEntry> SPLTable;
+}
+
+struct EntryDowngrade {
+  1: EntryVersion Original;
+  2: EntryVersion Actual;
+}
+
+// SPLTableDowngrade is an SPL table record which minimal security patch level
+// in the actual firmware is lower than in the original one (or the record is missing)
+struct SPLTableDowngrade {
+  1: SPLTableEntry Original;
+  2: optional SPLTableEntry Actual;
+}
+
+// FusedAntiRollback is the anti-rollback state fused into the CPU, obtained from MP0_C2P_MSG_37 register
+struct FusedAntiRollback {
+  1: bool PlatformSecureBootEnabled;
+  2: bool AntiRollbackEnabled;
+  3: byte BIOSKeyRevisionID;
+}
+
+struct CustomReport {
+  1: FirmwareSPL Actual;
+  2: optional FirmwareSPL Original;
+  3: list<EntryDowngrade> Downgrades;
+  4: optional FusedAntiRollback Fuses;
+  5: list<SPLTableDowngrade> SPLTableDowngrades;
+}
@@ -0,0 +1,202 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package pspspl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl/report/generated/pspsplanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/types/conv"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/types/generated/psptypes"
+	"github.com/linuxboot/fiano/pkg/amd/psb"
+
+	amd_manifest "github.com/linuxboot/fiano/pkg/amd/manifest"
+)
+
+// SPLTableEntry is the PSP directory entry of the Security Patch Level table
+const SPLTableEntry amd_manifest.PSPDirectoryTableEntryType = 0x55
+
+// versionedPSPEntries is the list of PSP binaries which headers are checked for the security patch level
+var versionedPSPEntries = []amd_manifest.PSPDirectoryTableEntryType{
+	amd_manifest.PSPBootloaderFirmwareEntry,
+	psb.PSPRecoveryBootloader,
+	psb.SMUOffChipFirmwareEntry,
+	psb.SMUOffChipFirmware2Entry,
+	psb.SecurityPolicyBinaryEntry,
+	psb.MP5FirmwareEntry,
+	psb.AGESABinary0Entry,
+	psb.SEVCodeEntry,
+	psb.DXIOPHYSRAMFirmwareEntry,
+	psb.DRTMTAEntry,
+	SPLTableEntry,
+}
+
+// isBootCriticalEntry returns true for PSP binaries which downgrade allows to
+// bypass fixes of the early boot: the PSP bootloader and the SMU firmware
+func isBootCriticalEntry(entry amd_manifest.PSPDirectoryTableEntryType) bool {
+	switch entry {
+	case amd_manifest.PSPBootloaderFirmwareEntry,
+		psb.PSPRecoveryBootloader,
+		psb.SMUOffChipFirmwareEntry,
+		psb.SMUOffChipFirmware2Entry:
+		return true
+	}
+	return false
+}
+
+// ParsePSPHeader parses the header which is pre-pended to PSP binaries
+func ParsePSPHeader(data []byte) (*psb.PSPHeaderData, error) {
+	var header psb.PSPHeaderData
+	if len(data) < binary.Size(header) {
+		return nil, fmt.Errorf("PSP binary is too short: %d < %d", len(data), binary.Size(header))
+	}
+	if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("unable to parse PSP header: %w", err)
+	}
+	return &header, nil
+}
+
+// pspHeaderSize is the size of the header pre-pended to PSP binaries, the body follows it
+const pspHeaderSize = 0x100
+
+// SPLTableRecord is a record of the SPL table: the minimal security patch level
+// the PSP accepts for binaries of the given entry type
+type SPLTableRecord struct {
+	EntryType          amd_manifest.PSPDirectoryTableEntryType
+	SecurityPatchLevel uint32
+}
+
+// ParseSPLTable parses the body of the SPL table (PSP entry 0x55), which
+// follows the PSP header: the amount of records and the records themselves
+func ParseSPLTable(data []byte) ([]SPLTableRecord, error) {
+	if len(data) < pspHeaderSize+4 {
+		return nil, fmt.Errorf("SPL table is too short: %d < %d", len(data), pspHeaderSize+4)
+	}
+	body := data[pspHeaderSize:]
+	count := binary.LittleEndian.Uint32(body)
+	body = body[4:]
+	recordSize := binary.Size(splTableRawRecord{})
+	if uint64(count)*uint64(recordSize) > uint64(len(body)) {
+		return nil, fmt.Errorf("SPL table of %d records does not fit into %d bytes", count, len(body))
+	}
+	rawRecords := make([]splTableRawRecord, count)
+	if err := binary.Read(bytes.NewReader(body), binary.LittleEndian, rawRecords); err != nil {
+		return nil, fmt.Errorf("unable to parse SPL table: %w", err)
+	}
+	result := make([]SPLTableRecord, 0, count)
+	for _, rawRecord := range rawRecords {
+		result = append(result, SPLTableRecord{
+			// the type is 4 bytes long in the table, but only the lowest one is the entry type,
+			// the same as in PSP directory entries
+			EntryType:          amd_manifest.PSPDirectoryTableEntryType(rawRecord.EntryType),
+			SecurityPatchLevel: rawRecord.SecurityPatchLevel,
+		})
+	}
+	return result, nil
+}
+
+// splTableRawRecord is the on-flash layout of SPLTableRecord
+type splTableRawRecord struct {
+	EntryType          uint32
+	SecurityPatchLevel uint32
+}
+
+// GetFirmwareSPL collects the security patch levels and image versions of PSP binaries of both PSP directories
+func GetFirmwareSPL(amdFw *amd_manifest.AMDFirmware) (*pspsplanalysis.FirmwareSPL, error) {
+	pspFirmware := amdFw.PSPFirmware()
+	result := &pspsplanalysis.FirmwareSPL{}
+	for idx, directory := range []*amd_manifest.PSPDirectoryTable{
+		pspFirmware.PSPDirectoryLevel1,
+		pspFirmware.PSPDirectoryLevel2,
+	} {
+		if directory == nil {
+			continue
+		}
+		if err := addDirectoryEntries(result, amdFw, uint(idx+1)); err != nil {
+			return nil, err
+		}
+	}
+
+	// the binding is parsed the same way as by analyzer PSBFuses
+	if binding, err := psbfuses.GetImageBinding(amdFw); err == nil {
+		keyRevisionID := binding.KeyRevisionID
+		result.BIOSKeyRevisionID = &keyRevisionID
+	}
+	return result, nil
+}
+
+func addDirectoryEntries(result *pspsplanalysis.FirmwareSPL, amdFw *amd_manifest.AMDFirmware, level uint) error {
+	thriftDirectory, err := conv.ThriftPSPDirectoryOfLevel(level)
+	if err != nil {
+		return err
+	}
+	image := amdFw.Firmware().ImageBytes()
+	for _, entryType := range versionedPSPEntries {
+		entries, err := psb.GetPSPEntries(amdFw.PSPFirmware(), level, entryType)
+		if err != nil {
+			return fmt.Errorf("unable to get PSP entries 0x%X of level %d: %w", uint32(entryType), level, err)
+		}
+		for _, entry := range entries {
+			thriftEntry := conv.ToThriftPSPDirectoryTableEntryType(entry.Type)
+			if err := addEntry(result, image, entry, thriftDirectory); err != nil {
+				result.InvalidEntries = append(result.InvalidEntries, &pspsplanalysis.InvalidEntry{
+					Directory:   thriftDirectory,
+					Entry:       thriftEntry,
+					Description: err.Error(),
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func addEntry(
+	result *pspsplanalysis.FirmwareSPL,
+	image []byte,
+	entry amd_manifest.PSPDirectoryTableEntry,
+	thriftDirectory psptypes.DirectoryType,
+) error {
+	data, err := psb.GetRangeBytes(image, entry.LocationOrValue, uint64(entry.Size))
+	if err != nil {
+		return err
+	}
+	header, err := ParsePSPHeader(data)
+	if err != nil {
+		return err
+	}
+	result.Entries = append(result.Entries, &pspsplanalysis.EntryVersion{
+		Directory:          thriftDirectory,
+		Entry:              conv.ToThriftPSPDirectoryTableEntryType(entry.Type),
+		SecurityPatchLevel: int64(header.SecurityPatchLevel),
+		ImageVersion:       int64(header.ImageVersion),
+	})
+	if entry.Type != SPLTableEntry {
+		return nil
+	}
+
+	records, err := ParseSPLTable(data)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		result.SPLTable = append(result.SPLTable, &pspsplanalysis.SPLTableEntry{
+			Directory:                 thriftDirectory,
+			Entry:                     conv.ToThriftPSPDirectoryTableEntryType(record.EntryType),
+			MinimalSecurityPatchLevel: int64(record.SecurityPatchLevel),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package pspspl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl/report/generated/pspsplanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/types/generated/psptypes"
+	"github.com/immune-gmbh/attestation-sdk/pkg/registers"
+
+	css_registers "github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+func TestParsePSPHeader(t *testing.T) {
+	data := make([]byte, 0x100)
+	binary.LittleEndian.PutUint32(data[0x4C:], 0x12)
+	binary.LittleEndian.PutUint32(data[0x60:], 0x00230A05)
+
+	header, err := ParsePSPHeader(data)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0x12), header.SecurityPatchLevel)
+	require.Equal(t, uint32(0x00230A05), header.ImageVersion)
+
+	_, err = ParsePSPHeader(data[:0x50])
+	require.Error(t, err)
+}
+
+func TestParseSPLTable(t *testing.T) {
+	data := make([]byte, 0x100+4+3*8)
+	body := data[0x100:]
+	binary.LittleEndian.PutUint32(body, 3)
+	for idx, record := range [][2]uint32{
+		{0x01, 0x10}, // PSP bootloader
+		{0x08, 0x20}, // SMU firmware
+		{0x28, 0x30}, // AGESA binary 0
+	} {
+		binary.LittleEndian.PutUint32(body[4+idx*8:], record[0])
+		binary.LittleEndian.PutUint32(body[8+idx*8:], record[1])
+	}
+
+	records, err := ParseSPLTable(data)
+	require.NoError(t, err)
+	require.Equal(t, []SPLTableRecord{
+		{EntryType: 0x01, SecurityPatchLevel: 0x10},
+		{EntryType: 0x08, SecurityPatchLevel: 0x20},
+		{EntryType: 0x28, SecurityPatchLevel: 0x30},
+	}, records)
+
+	_, err = ParseSPLTable(data[:len(data)-1])
+	require.Error(t, err)
+	_, err = ParseSPLTable(data[:0x100])
+	require.Error(t, err)
+	binary.LittleEndian.PutUint32(body, 0xFFFFFFFF)
+	_, err = ParseSPLTable(data)
+	require.Error(t, err)
+}
+
+func TestSPLTableComparison(t *testing.T) {
+	tableEntry := func(entryType psptypes.PSPDirectoryTableEntryType, spl int64) *pspsplanalysis.SPLTableEntry {
+		return &pspsplanalysis.SPLTableEntry{
+			Directory:                 psptypes.DirectoryType_PSPTableLevel2,
+			Entry:                     entryType,
+			MinimalSecurityPatchLevel: spl,
+		}
+	}
+	original := &pspsplanalysis.FirmwareSPL{
+		SPLTable: []*pspsplanalysis.SPLTableEntry{
+			tableEntry(psptypes.PSPDirectoryTableEntryType_PSPBootloaderFirmwareEntry, 3),
+			tableEntry(psptypes.PSPDirectoryTableEntryType_SMUOffChipFirmwareEntry, 2),
+			tableEntry(psptypes.PSPDirectoryTableEntryType_AGESABinary0Entry, 1),
+		},
+	}
+	actual := &pspsplanalysis.FirmwareSPL{
+		Entries: []*pspsplanalysis.EntryVersion{
+			{
+				Directory:          psptypes.DirectoryType_PSPTableLevel2,
+				Entry:              psptypes.PSPDirectoryTableEntryType_PSPBootloaderFirmwareEntry,
+				SecurityPatchLevel: 2,
+			},
+			{
+				Directory:          psptypes.DirectoryType_PSPTableLevel2,
+				Entry:              psptypes.PSPDirectoryTableEntryType_SMUOffChipFirmwareEntry,
+				SecurityPatchLevel: 2,
+			},
+		},
+		SPLTable: []*pspsplanalysis.SPLTableEntry{
+			tableEntry(psptypes.PSPDirectoryTableEntryType_PSPBootloaderFirmwareEntry, 3),
+			tableEntry(psptypes.PSPDirectoryTableEntryType_SMUOffChipFirmwareEntry, 1),
+		},
+	}
+
+	downgrades := FindSPLTableDowngrades(original, actual)
+	require.Len(t, downgrades, 2)
+	require.Equal(t, psptypes.PSPDirectoryTableEntryType_SMUOffChipFirmwareEntry, downgrades[0].Original.Entry)
+	require.Equal(t, int64(1), downgrades[0].Actual.MinimalSecurityPatchLevel)
+	require.Equal(t, psptypes.PSPDirectoryTableEntryType_AGESABinary0Entry, downgrades[1].Original.Entry)
+	require.False(t, downgrades[1].IsSetActual())
+
+	issues := splTableDowngradeIssues(downgrades)
+	require.Len(t, issues, 2)
+	require.Equal(t, analysis.IssueCodePSPSecurityPatchLevelSPLTableDowngrade, issues[0].Code)
+	require.Equal(t, "0x0", issues[1].Params["actual_spl"])
+
+	require.Empty(t, FindSPLTableDowngrades(original, original))
+
+	issues = splTableIssues(actual)
+	require.Len(t, issues, 1)
+	require.Equal(t, analysis.IssueCodePSPSecurityPatchLevelEntryBelowSPLTable, issues[0].Code)
+	require.Equal(t, fmt.Sprint(psptypes.PSPDirectoryTableEntryType_PSPBootloaderFirmwareEntry), issues[0].Params["entry"])
+}
+
+func TestFindDowngrades(t *testing.T) {
+	entry := func(entryType psptypes.PSPDirectoryTableEntryType, spl, version int64) *pspsplanalysis.EntryVersion {
+		return &pspsplanalysis.EntryVersion{
+			Directory:          psptypes.DirectoryType_PSPTableLevel2,
+			Entry:              entryType,
+			SecurityPatchLevel: spl,
+			ImageVersion:       version,
+		}
+	}
+	original := &pspsplanalysis.FirmwareSPL{
+		Entries: []*pspsplanalysis.EntryVersion{
+			entry(psptypes.PSPDirectoryTableEntryType_PSPBootloaderFirmwareEntry, 3, 0x100),
+			entry(psptypes.PSPDirectoryTableEntryType_SMUOffChipFirmwareEntry, 2, 0x200),
+			entry(psptypes.PSPDirectoryTableEntryType_MP5FirmwareEntry, 1, 0x300),
+			entry(psptypes.PSPDirectoryTableEntryType_AGESABinary0Entry, 1, 0x400),
+		},
+	}
+	actual := &pspsplanalysis.FirmwareSPL{
+		Entries: []*pspsplanalysis.EntryVersion{
+			entry(psptypes.PSPDirectoryTableEntryType_PSPBootloaderFirmwareEntry, 3, 0x100),
+			entry(psptypes.PSPDirectoryTableEntryType_SMUOffChipFirmwareEntry, 2, 0x1FF),
+			entry(psptypes.PSPDirectoryTableEntryType_MP5FirmwareEntry, 1, 0x2FF),
+			entry(psptypes.PSPDirectoryTableEntryType_AGESABinary0Entry, 0, 0x500),
+		},
+	}
+
+	downgrades := FindDowngrades(original, actual)
+	require.Len(t, downgrades, 3)
+	require.Equal(t, psptypes.PSPDirectoryTableEntryType_SMUOffChipFirmwareEntry, downgrades[0].Actual.Entry)
+	require.Equal(t, psptypes.PSPDirectoryTableEntryType_MP5FirmwareEntry, downgrades[1].Actual.Entry)
+	require.Equal(t, psptypes.PSPDirectoryTableEntryType_AGESABinary0Entry, downgrades[2].Actual.Entry)
+
+	issues := downgradeIssues(downgrades)
+	require.Len(t, issues, 3)
+	require.Equal(t, analysis.SeverityCritical, issues[0].Severity) // SMU version downgrade
+	require.Equal(t, analysis.SeverityWarning, issues[1].Severity)  // MP5 version downgrade
+	require.Equal(t, analysis.SeverityCritical, issues[2].Severity) // SPL downgrade
+
+	require.Empty(t, FindDowngrades(original, original))
+}
+
+func TestFusesIssues(t *testing.T) {
+	keyRevision := int8(1)
+	actual := &pspsplanalysis.FirmwareSPL{BIOSKeyRevisionID: &keyRevision}
+	fuses := pspsplanalysis.FusedAntiRollback{
+		PlatformSecureBootEnabled: true,
+		AntiRollbackEnabled:       true,
+		BIOSKeyRevisionID:         2,
+	}
+
+	issues := fusesIssues(fuses, actual, false)
+	require.Len(t, issues, 1)
+	require.Equal(t, analysis.SeverityCritical, issues[0].Severity)
+
+	fuses.BIOSKeyRevisionID = 1
+	require.Empty(t, fusesIssues(fuses, actual, false))
+
+	fuses.AntiRollbackEnabled = false
+	issues = fusesIssues(fuses, actual, true)
+	require.Len(t, issues, 1)
+	require.Equal(t, analysis.SeverityWarning, issues[0].Severity)
+}
+
+func TestFusesIssuesHighKeyRevision(t *testing.T) {
+	// OEM signing key token with key revision 10 and platform model 3
+	oemKey := make([]byte, 0x40+4+8)
+	binary.LittleEndian.PutUint32(oemKey[0x24:], 8)
+	oemKey[0x28] = 0x8D
+	oemKey[0x29] = 0x3A
+	binary.LittleEndian.PutUint32(oemKey[0x38:], 4*8)
+	binary.LittleEndian.PutUint32(oemKey[0x3C:], 8*8)
+	binding, err := psbfuses.ParseImageBinding(oemKey)
+	require.NoError(t, err)
+	require.Equal(t, int8(10), binding.KeyRevisionID)
+	actual := &pspsplanalysis.FirmwareSPL{BIOSKeyRevisionID: &binding.KeyRevisionID}
+
+	// PSB enabled, anti-rollback enabled, fused key revision 10
+	status, found := registers.FindPSBStatus(css_registers.Registers{css_registers.ParseMP0C2PMsg37Register(0x0100A38D)})
+	require.True(t, found)
+	require.Equal(t, uint8(10), status.BIOSKeyRevisionID)
+	fuses := pspsplanalysis.FusedAntiRollback{
+		PlatformSecureBootEnabled: status.PlatformSecureBootEnabled,
+		AntiRollbackEnabled:       status.PlatformSecureBootEnabled && !status.DisableBIOSKeyAntiRollback,
+		BIOSKeyRevisionID:         int8(status.BIOSKeyRevisionID),
+	}
+	require.Empty(t, fusesIssues(fuses, actual, false))
+
+	fuses.BIOSKeyRevisionID = 11
+	require.Len(t, fusesIssues(fuses, actual, false), 1)
+}
@@ -105,6 +105,7 @@ const (
 	PSPDirectoryTableEntryType_DXIOPHYSRAMFirmwareEntry     PSPDirectoryTableEntryType = 66
 	PSPDirectoryTableEntryType_DRTMTAEntry                  PSPDirectoryTableEntryType = 71
 	PSPDirectoryTableEntryType_KeyDatabaseEntry             PSPDirectoryTableEntryType = 80
+	PSPDirectoryTableEntryType_SPLTableEntry                PSPDirectoryTableEntryType = 85
 )
 
 func (p PSPDirectoryTableEntryType) String() string {
@@ -139,6 +140,8 @@ func (p PSPDirectoryTableEntryType) String() string {
 		return "DRTMTAEntry"
 	case PSPDirectoryTableEntryType_KeyDatabaseEntry:
 		return "KeyDatabaseEntry"
+	case PSPDirectoryTableEntryType_SPLTableEntry:
+		return "SPLTableEntry"
 	}
 	return "<UNSET>"
 }
@@ -175,6 +178,8 @@ func PSPDirectoryTableEntryTypeFromString(s string) (PSPDirectoryTableEntryType,
 		return PSPDirectoryTableEntryType_DRTMTAEntry, nil
 	case "KeyDatabaseEntry":
 		return PSPDirectoryTableEntryType_KeyDatabaseEntry, nil
+	case "SPLTableEntry":
+		return PSPDirectoryTableEntryType_SPLTableEntry, nil
 	}
 	return PSPDirectoryTableEntryType(0), fmt.Errorf("not a valid PSPDirectoryTableEntryType string")
 }
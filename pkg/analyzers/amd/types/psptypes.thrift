@@ -35,6 +35,7 @@ enum PSPDirectoryTableEntryType {
   DXIOPHYSRAMFirmwareEntry = 66, // 0x42
   DRTMTAEntry = 71, // 0x47
   KeyDatabaseEntry = 80, // 0x50
+  SPLTableEntry = 85, // 0x55
 }
 
 enum BIOSDirectoryTableEntryType {
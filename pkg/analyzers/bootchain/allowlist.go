@@ -0,0 +1,137 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package bootchain
+
+import (
+	"bytes"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-tpm/tpm2"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/authenticode"
+)
+
+// hashAlgos are the PCR banks the allowlist digests are calculated for.
+var hashAlgos = []tpm2.Algorithm{tpm2.AlgSHA1, tpm2.AlgSHA256, tpm2.AlgSHA384}
+
+// Allowlist is a set of binaries allowed in the boot chain.
+//
+// It is provided to the analyzer through New, the analyzer input contains
+// only its content hash (see AllowlistHash).
+type Allowlist struct {
+	Binaries []AllowedBinary
+}
+
+// AllowedBinary contains the digests of an allowed binary as they are
+// expected to be measured.
+type AllowedBinary struct {
+	Name string
+
+	// Authenticode contains the Authenticode digests (measured by the firmware
+	// and shim to PCR4). It is empty if the binary is not a PE image.
+	Authenticode map[tpm2.Algorithm][]byte
+
+	// File contains the plain digests of the file (measured by the bootloader to PCR9).
+	File map[tpm2.Algorithm][]byte
+}
+
+// NewAllowedBinary calculates the digests of a binary.
+func NewAllowedBinary(name string, content []byte) (*AllowedBinary, error) {
+	result := &AllowedBinary{
+		Name: name,
+		File: map[tpm2.Algorithm][]byte{},
+	}
+	for _, hashAlgo := range hashAlgos {
+		hashFunc, err := hashAlgo.Hash()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the hash function of %v: %w", hashAlgo, err)
+		}
+		h := hashFunc.New()
+		h.Write(content)
+		result.File[hashAlgo] = h.Sum(nil)
+
+		if !bytes.HasPrefix(content, []byte("MZ")) {
+			continue
+		}
+		digest, err := authenticode.Hash(content, hashFunc)
+		if err != nil {
+			// for example, a bzImage without an EFI stub
+			continue
+		}
+		if result.Authenticode == nil {
+			result.Authenticode = map[tpm2.Algorithm][]byte{}
+		}
+		result.Authenticode[hashAlgo] = digest
+	}
+	return result, nil
+}
+
+// LoadAllowlistDir calculates the digests of all files in the directory
+// (recursively). The name of an entry is the path relative to the directory.
+func LoadAllowlistDir(dir string) (*Allowlist, error) {
+	var result Allowlist
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read '%s': %w", path, err)
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		binary, err := NewAllowedBinary(name, content)
+		if err != nil {
+			return fmt.Errorf("unable to calculate digests of '%s': %w", path, err)
+		}
+		result.Binaries = append(result.Binaries, *binary)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the allowlist directory '%s': %w", dir, err)
+	}
+	return &result, nil
+}
+
+// LookupAuthenticode returns the binary with the specified Authenticode digest (if any).
+func (allowlist Allowlist) LookupAuthenticode(hashAlgo tpm2.Algorithm, digest []byte) *AllowedBinary {
+	for idx := range allowlist.Binaries {
+		known := allowlist.Binaries[idx].Authenticode[hashAlgo]
+		if len(known) > 0 && bytes.Equal(known, digest) {
+			return &allowlist.Binaries[idx]
+		}
+	}
+	return nil
+}
+
+// LookupFile returns the binary with the specified file digest (if any).
+func (allowlist Allowlist) LookupFile(hashAlgo tpm2.Algorithm, digest []byte) *AllowedBinary {
+	for idx := range allowlist.Binaries {
+		known := allowlist.Binaries[idx].File[hashAlgo]
+		if len(known) > 0 && bytes.Equal(known, digest) {
+			return &allowlist.Binaries[idx]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package bootchain
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/pcr"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain/report/generated/bootchainanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/pcr0eventlog"
+	"github.com/immune-gmbh/attestation-sdk/pkg/xtpmeventlog"
+)
+
+func init() {
+	analysis.RegisterType((*Allowlist)(nil))
+	analysis.RegisterType((AllowlistHash)(""))
+	analysis.RegisterType((*bootchainanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of BootChain analyzer
+const ID analysis.AnalyzerID = bootchainanalysis.BootChainAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.1.0"
+
+// PCRs measured by the boot chain after the firmware.
+const (
+	PCRBootApplications = pcr.ID(4)
+	PCRCommands         = pcr.ID(8)
+	PCRFiles            = pcr.ID(9)
+)
+
+// AllowlistHash is the content hash of the allowlist used by the analyzer,
+// the allowlist itself is provided to the analyzer through New.
+type AllowlistHash analysis.ReferenceDataHash
+
+// NewExecutorInput builds an analysis.Executor's input required for BootChain analyzer
+//
+// allowlist is put into allowlists, which should be the store provided to New.
+func NewExecutorInput(
+	eventLog *tpmeventlog.TPMEventLog,
+	allowlists *analysis.ReferenceDataStore[Allowlist],
+	allowlist *Allowlist,
+) (analysis.Input, error) {
+	if eventLog == nil {
+		return nil, fmt.Errorf("the TPM EventLog should be specified")
+	}
+	if allowlist == nil {
+		return nil, fmt.Errorf("the allowlist should be specified")
+	}
+	allowlistHash, err := allowlists.Put(allowlist)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store the allowlist: %w", err)
+	}
+
+	result := analysis.NewInput()
+	result.AddTPMEventLog(
+		eventLog,
+	).AddCustomValue(
+		AllowlistHash(allowlistHash),
+	)
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	TPMEventLog   *tpmeventlog.TPMEventLog
+	AllowlistHash AllowlistHash
+}
+
+// BootChain is analyzer that identifies the boot components (shim, bootloader,
+// kernel and the files loaded by the bootloader) measured to PCR4, PCR8 and PCR9
+// and checks them against an allowlist.
+type BootChain struct {
+	allowlists *analysis.ReferenceDataStore[Allowlist]
+}
+
+// New returns a new object of BootChain analyzer
+//
+// allowlists provides the allowlists referenced by the inputs, see NewExecutorInput.
+func New(allowlists *analysis.ReferenceDataStore[Allowlist]) analysis.Analyzer[Input] {
+	return &BootChain{
+		allowlists: allowlists,
+	}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *BootChain) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *BootChain) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze matches the PCR4, PCR8 and PCR9 events against the allowlist
+func (analyzer *BootChain) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	log := logger.FromCtx(ctx)
+
+	allowlist, err := analyzer.allowlists.Get(analysis.ReferenceDataHash(in.AllowlistHash))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the allowlist: %w", err)
+	}
+
+	var events []*tpmeventlog.Event
+	for _, pcrIndex := range []pcr.ID{PCRBootApplications, PCRCommands, PCRFiles} {
+		events = append(events, pcr0eventlog.FilterPCR(in.TPMEventLog, pcrIndex, log)...)
+	}
+
+	customReport := bootchainanalysis.CustomReport{
+		AllowlistEntries: int32(len(allowlist.Binaries)),
+	}
+	result := &analysis.Report{}
+	for _, event := range xtpmeventlog.MergeBanks(events) {
+		component := &bootchainanalysis.Component{
+			PCR:    int16(event.PCRIndex),
+			Status: bootchainanalysis.ComponentStatus_Unknown,
+		}
+		lookup := allowlist.LookupFile
+		switch {
+		case event.PCRIndex == PCRBootApplications && event.Type == tpmeventlog.EV_EFI_BOOT_SERVICES_APPLICATION:
+			component.Type = bootchainanalysis.ComponentType_BootApplication
+			imageLoadEvent, err := xtpmeventlog.ParseImageLoadEvent(event.Data)
+			if err != nil {
+				log.Errorf("%v", err)
+			} else {
+				component.Description = xtpmeventlog.FormatDevicePath(imageLoadEvent.DevicePath)
+			}
+			lookup = allowlist.LookupAuthenticode
+		case event.PCRIndex == PCRCommands && event.Type == tpmeventlog.EV_IPL:
+			component.Type = bootchainanalysis.ComponentType_Command
+			component.Description = eventText(event.Data)
+			component.Status = bootchainanalysis.ComponentStatus_NotVerifiable
+			lookup = nil
+		case event.PCRIndex == PCRFiles && event.Type == tpmeventlog.EV_IPL:
+			component.Type = bootchainanalysis.ComponentType_File
+			component.Description = eventText(event.Data)
+		default:
+			continue
+		}
+
+		for _, digest := range event.Digests {
+			component.Digests = append(component.Digests, &bootchainanalysis.Digest{
+				HashAlgo: digest.HashAlgo.String(),
+				Value:    digest.Digest,
+			})
+			if lookup == nil || component.AllowlistName != nil {
+				continue
+			}
+			if binary := lookup(digest.HashAlgo, digest.Digest); binary != nil {
+				name := binary.Name
+				component.AllowlistName = &name
+				component.Status = bootchainanalysis.ComponentStatus_Allowed
+			}
+		}
+
+		if component.Status == bootchainanalysis.ComponentStatus_Unknown {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeBootChainNotAllowlisted,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("PCR%d: %s '%s' is not in the allowlist", component.PCR, componentTypeDescription(component.Type), component.Description),
+				Params:      map[string]string{"pcr": fmt.Sprint(component.PCR), "component": component.Description},
+			})
+		}
+		customReport.Components = append(customReport.Components, component)
+	}
+
+	result.Custom = customReport
+	return result, nil
+}
+
+// eventText returns the NUL-terminated string of the event data of the bootloader
+func eventText(data []byte) string {
+	return strings.TrimRight(string(data), "\x00")
+}
+
+func componentTypeDescription(componentType bootchainanalysis.ComponentType) string {
+	switch componentType {
+	case bootchainanalysis.ComponentType_BootApplication:
+		return "boot application"
+	case bootchainanalysis.ComponentType_Command:
+		return "command"
+	case bootchainanalysis.ComponentType_File:
+		return "file"
+	}
+	return componentType.String()
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package bootchain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/pcr"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain/report/generated/bootchainanalysis"
+)
+
+// testImageLoadEvent builds UEFI_IMAGE_LOAD_EVENT with a file path device path
+func testImageLoadEvent(path string) []byte {
+	var filePath []byte
+	for _, c := range path + "\x00" {
+		filePath = append(filePath, byte(c), 0)
+	}
+	var devicePath []byte
+	devicePath = append(devicePath, 0x04, 0x04, 0, 0)
+	binary.LittleEndian.PutUint16(devicePath[2:], uint16(4+len(filePath)))
+	devicePath = append(devicePath, filePath...)
+	devicePath = append(devicePath, 0x7F, 0xFF, 4, 0)
+
+	data := make([]byte, 32)
+	binary.LittleEndian.PutUint64(data[24:], uint64(len(devicePath)))
+	return append(data, devicePath...)
+}
+
+func testEvent(pcrIndex pcr.ID, eventType tpmeventlog.EventType, data []byte, digest []byte) *tpmeventlog.Event {
+	return &tpmeventlog.Event{
+		PCRIndex: pcrIndex,
+		Type:     eventType,
+		Data:     data,
+		Digest:   &tpmeventlog.Digest{HashAlgo: tpm2.AlgSHA256, Digest: digest},
+	}
+}
+
+func TestBootChain(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "boot"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "boot", "vmlinuz"), []byte("kernel"), 0644))
+	allowlist, err := LoadAllowlistDir(dir)
+	require.NoError(t, err)
+	require.Len(t, allowlist.Binaries, 1)
+	require.Equal(t, filepath.Join("boot", "vmlinuz"), allowlist.Binaries[0].Name)
+	require.Empty(t, allowlist.Binaries[0].Authenticode)
+
+	shimDigest := sha256.Sum256([]byte("shim authenticode"))
+	allowlist.Binaries = append(allowlist.Binaries, AllowedBinary{
+		Name:         "shimx64.efi",
+		Authenticode: map[tpm2.Algorithm][]byte{tpm2.AlgSHA256: shimDigest[:]},
+	})
+
+	kernelDigest := sha256.Sum256([]byte("kernel"))
+	grubDigest := sha256.Sum256([]byte("modified grub"))
+	var eventLog tpmeventlog.TPMEventLog
+	eventLog.Events = []*tpmeventlog.Event{
+		testEvent(PCRBootApplications, tpmeventlog.EV_EFI_BOOT_SERVICES_APPLICATION, testImageLoadEvent(`\EFI\BOOT\BOOTX64.EFI`), shimDigest[:]),
+		testEvent(PCRBootApplications, tpmeventlog.EV_EFI_BOOT_SERVICES_APPLICATION, testImageLoadEvent(`\EFI\ubuntu\grubx64.efi`), grubDigest[:]),
+		testEvent(PCRCommands, tpmeventlog.EV_IPL, []byte("grub_cmd: linux /boot/vmlinuz ro\x00"), make([]byte, 32)),
+		testEvent(PCRFiles, tpmeventlog.EV_IPL, []byte("/boot/vmlinuz\x00"), kernelDigest[:]),
+	}
+
+	allowlists := analysis.NewReferenceDataStore[Allowlist](1)
+	allowlistHash, err := allowlists.Put(allowlist)
+	require.NoError(t, err)
+	report, err := New(allowlists).Analyze(context.Background(), Input{
+		TPMEventLog:   &eventLog,
+		AllowlistHash: AllowlistHash(allowlistHash),
+	})
+	require.NoError(t, err)
+	customReport := report.Custom.(bootchainanalysis.CustomReport)
+	require.Equal(t, int32(2), customReport.AllowlistEntries)
+	require.Len(t, customReport.Components, 4)
+
+	shim, grub, command, kernel := customReport.Components[0], customReport.Components[1], customReport.Components[2], customReport.Components[3]
+	require.Equal(t, bootchainanalysis.ComponentType_BootApplication, shim.Type)
+	require.Equal(t, bootchainanalysis.ComponentStatus_Allowed, shim.Status)
+	require.Equal(t, "shimx64.efi", *shim.AllowlistName)
+	require.Equal(t, `\EFI\BOOT\BOOTX64.EFI`, shim.Description)
+
+	require.Equal(t, bootchainanalysis.ComponentStatus_Unknown, grub.Status)
+	require.Nil(t, grub.AllowlistName)
+
+	require.Equal(t, bootchainanalysis.ComponentType_Command, command.Type)
+	require.Equal(t, bootchainanalysis.ComponentStatus_NotVerifiable, command.Status)
+	require.Equal(t, "grub_cmd: linux /boot/vmlinuz ro", command.Description)
+
+	require.Equal(t, bootchainanalysis.ComponentType_File, kernel.Type)
+	require.Equal(t, bootchainanalysis.ComponentStatus_Allowed, kernel.Status)
+	require.Equal(t, int16(9), kernel.PCR)
+
+	require.Len(t, report.Issues, 1)
+	require.Equal(t, analysis.SeverityWarning, report.Issues[0].Severity)
+	require.Contains(t, report.Issues[0].Description, "grubx64.efi")
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.bootchain.report.generated.bootchainanalysis
+
+const string BootChainAnalyzerID = "BootChain";
+
+enum ComponentType {
+  // BootApplication is an EFI application (shim, bootloader, EFI stub kernel) measured to PCR4
+  BootApplication = 0,
+  // Command is a bootloader command or a kernel command line measured to PCR8
+  Command = 1,
+  // File is a file loaded by the bootloader (kernel, initrd, configuration) measured to PCR9
+  File = 2,
+}
+
+enum ComponentStatus {
+  // Allowed means a digest of the component is found in the allowlist
+  Allowed = 0,
+  // Unknown means the component is not found in the allowlist
+  Unknown = 1,
+  // NotVerifiable means the allowlist cannot describe the component (e.g. a command line)
+  NotVerifiable = 2,
+}
+
+struct Digest {
+  1: string HashAlgo;
+  2: binary Value;
+}
+
+struct Component {
+  1: i16 PCR;
+  2: ComponentType Type;
+  // Description is the device path of a boot application, the text of a command or the path of a file
+  3: string Description;
+  4: list<Digest> Digests;
+  5: ComponentStatus Status;
+  // AllowlistName is the name of the matched allowlist entry (for status Allowed)
+  6: optional string AllowlistName;
+}
+
+struct CustomReport {
+  1: list<Component> Components;
+  // AllowlistEntries is the amount of binaries in the allowlist
+  2: i32 AllowlistEntries;
+}
@@ -0,0 +1,855 @@
+// Code generated by Thrift Compiler (0.14.0). DO NOT EDIT.
+
+package bootchainanalysis
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"time"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+var _ = time.Now
+var _ = bytes.Equal
+
+type ComponentType int64
+
+const (
+	ComponentType_BootApplication ComponentType = 0
+	ComponentType_Command         ComponentType = 1
+	ComponentType_File            ComponentType = 2
+)
+
+func (p ComponentType) String() string {
+	switch p {
+	case ComponentType_BootApplication:
+		return "BootApplication"
+	case ComponentType_Command:
+		return "Command"
+	case ComponentType_File:
+		return "File"
+	}
+	return "<UNSET>"
+}
+
+func ComponentTypeFromString(s string) (ComponentType, error) {
+	switch s {
+	case "BootApplication":
+		return ComponentType_BootApplication, nil
+	case "Command":
+		return ComponentType_Command, nil
+	case "File":
+		return ComponentType_File, nil
+	}
+	return ComponentType(0), fmt.Errorf("not a valid ComponentType string")
+}
+
+func ComponentTypePtr(v ComponentType) *ComponentType { return &v }
+
+func (p ComponentType) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ComponentType) UnmarshalText(text []byte) error {
+	q, err := ComponentTypeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *ComponentType) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = ComponentType(v)
+	return nil
+}
+
+func (p *ComponentType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ComponentStatus int64
+
+const (
+	ComponentStatus_Allowed       ComponentStatus = 0
+	ComponentStatus_Unknown       ComponentStatus = 1
+	ComponentStatus_NotVerifiable ComponentStatus = 2
+)
+
+func (p ComponentStatus) String() string {
+	switch p {
+	case ComponentStatus_Allowed:
+		return "Allowed"
+	case ComponentStatus_Unknown:
+		return "Unknown"
+	case ComponentStatus_NotVerifiable:
+		return "NotVerifiable"
+	}
+	return "<UNSET>"
+}
+
+func ComponentStatusFromString(s string) (ComponentStatus, error) {
+	switch s {
+	case "Allowed":
+		return ComponentStatus_Allowed, nil
+	case "Unknown":
+		return ComponentStatus_Unknown, nil
+	case "NotVerifiable":
+		return ComponentStatus_NotVerifiable, nil
+	}
+	return ComponentStatus(0), fmt.Errorf("not a valid ComponentStatus string")
+}
+
+func ComponentStatusPtr(v ComponentStatus) *ComponentStatus { return &v }
+
+func (p ComponentStatus) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ComponentStatus) UnmarshalText(text []byte) error {
+	q, err := ComponentStatusFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *ComponentStatus) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = ComponentStatus(v)
+	return nil
+}
+
+func (p *ComponentStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// Attributes:
+//   - HashAlgo
+//   - Value
+type Digest struct {
+	HashAlgo string `thrift:"HashAlgo,1" db:"HashAlgo" json:"HashAlgo"`
+	Value    []byte `thrift:"Value,2" db:"Value" json:"Value"`
+}
+
+func NewDigest() *Digest {
+	return &Digest{}
+}
+
+func (p *Digest) GetHashAlgo() string {
+	return p.HashAlgo
+}
+
+func (p *Digest) GetValue() []byte {
+	return p.Value
+}
+func (p *Digest) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Digest) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.HashAlgo = v
+	}
+	return nil
+}
+
+func (p *Digest) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Value = v
+	}
+	return nil
+}
+
+func (p *Digest) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Digest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Digest) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "HashAlgo", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:HashAlgo: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.HashAlgo)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.HashAlgo (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:HashAlgo: ", p), err)
+	}
+	return err
+}
+
+func (p *Digest) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Value", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Value: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.Value); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Value (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Value: ", p), err)
+	}
+	return err
+}
+
+func (p *Digest) Equals(other *Digest) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.HashAlgo != other.HashAlgo {
+		return false
+	}
+	if bytes.Compare(p.Value, other.Value) != 0 {
+		return false
+	}
+	return true
+}
+
+func (p *Digest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Digest(%+v)", *p)
+}
+
+// Attributes:
+//   - PCR
+//   - Type
+//   - Description
+//   - Digests
+//   - Status
+//   - AllowlistName
+type Component struct {
+	PCR           int16           `thrift:"PCR,1" db:"PCR" json:"PCR"`
+	Type          ComponentType   `thrift:"Type,2" db:"Type" json:"Type"`
+	Description   string          `thrift:"Description,3" db:"Description" json:"Description"`
+	Digests       []*Digest       `thrift:"Digests,4" db:"Digests" json:"Digests"`
+	Status        ComponentStatus `thrift:"Status,5" db:"Status" json:"Status"`
+	AllowlistName *string         `thrift:"AllowlistName,6" db:"AllowlistName" json:"AllowlistName,omitempty"`
+}
+
+func NewComponent() *Component {
+	return &Component{}
+}
+
+func (p *Component) GetPCR() int16 {
+	return p.PCR
+}
+
+func (p *Component) GetType() ComponentType {
+	return p.Type
+}
+
+func (p *Component) GetDescription() string {
+	return p.Description
+}
+
+func (p *Component) GetDigests() []*Digest {
+	return p.Digests
+}
+
+func (p *Component) GetStatus() ComponentStatus {
+	return p.Status
+}
+
+var Component_AllowlistName_DEFAULT string
+
+func (p *Component) GetAllowlistName() string {
+	if !p.IsSetAllowlistName() {
+		return Component_AllowlistName_DEFAULT
+	}
+	return *p.AllowlistName
+}
+func (p *Component) IsSetAllowlistName() bool {
+	return p.AllowlistName != nil
+}
+
+func (p *Component) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Component) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.PCR = v
+	}
+	return nil
+}
+
+func (p *Component) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := ComponentType(v)
+		p.Type = temp
+	}
+	return nil
+}
+
+func (p *Component) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Description = v
+	}
+	return nil
+}
+
+func (p *Component) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Digest, 0, size)
+	p.Digests = tSlice
+	for i := 0; i < size; i++ {
+		_elem0 := &Digest{}
+		if err := _elem0.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+		}
+		p.Digests = append(p.Digests, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Component) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		temp := ComponentStatus(v)
+		p.Status = temp
+	}
+	return nil
+}
+
+func (p *Component) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.AllowlistName = &v
+	}
+	return nil
+}
+
+func (p *Component) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Component"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Component) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PCR", thrift.I16, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:PCR: ", p), err)
+	}
+	if err := oprot.WriteI16(ctx, int16(p.PCR)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.PCR (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:PCR: ", p), err)
+	}
+	return err
+}
+
+func (p *Component) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Type", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Type: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Type (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Type: ", p), err)
+	}
+	return err
+}
+
+func (p *Component) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Description", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Description: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Description)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Description (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Description: ", p), err)
+	}
+	return err
+}
+
+func (p *Component) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Digests", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Digests: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Digests)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Digests {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Digests: ", p), err)
+	}
+	return err
+}
+
+func (p *Component) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Status", thrift.I32, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Status: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Status)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Status (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Status: ", p), err)
+	}
+	return err
+}
+
+func (p *Component) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAllowlistName() {
+		if err := oprot.WriteFieldBegin(ctx, "AllowlistName", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:AllowlistName: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.AllowlistName)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.AllowlistName (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:AllowlistName: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Component) Equals(other *Component) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.PCR != other.PCR {
+		return false
+	}
+	if p.Type != other.Type {
+		return false
+	}
+	if p.Description != other.Description {
+		return false
+	}
+	if len(p.Digests) != len(other.Digests) {
+		return false
+	}
+	for i, _tgt := range p.Digests {
+		_src1 := other.Digests[i]
+		if !_tgt.Equals(_src1) {
+			return false
+		}
+	}
+	if p.Status != other.Status {
+		return false
+	}
+	if p.AllowlistName != other.AllowlistName {
+		if p.AllowlistName == nil || other.AllowlistName == nil {
+			return false
+		}
+		if (*p.AllowlistName) != (*other.AllowlistName) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Component) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Component(%+v)", *p)
+}
+
+// Attributes:
+//   - Components
+//   - AllowlistEntries
+type CustomReport struct {
+	Components       []*Component `thrift:"Components,1" db:"Components" json:"Components"`
+	AllowlistEntries int32        `thrift:"AllowlistEntries,2" db:"AllowlistEntries" json:"AllowlistEntries"`
+}
+
+func NewCustomReport() *CustomReport {
+	return &CustomReport{}
+}
+
+func (p *CustomReport) GetComponents() []*Component {
+	return p.Components
+}
+
+func (p *CustomReport) GetAllowlistEntries() int32 {
+	return p.AllowlistEntries
+}
+func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Component, 0, size)
+	p.Components = tSlice
+	for i := 0; i < size; i++ {
+		_elem2 := &Component{}
+		if err := _elem2.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
+		}
+		p.Components = append(p.Components, _elem2)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.AllowlistEntries = v
+	}
+	return nil
+}
+
+func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Components", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Components: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Components)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Components {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Components: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "AllowlistEntries", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AllowlistEntries: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.AllowlistEntries)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.AllowlistEntries (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AllowlistEntries: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) Equals(other *CustomReport) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if len(p.Components) != len(other.Components) {
+		return false
+	}
+	for i, _tgt := range p.Components {
+		_src3 := other.Components[i]
+		if !_tgt.Equals(_src3) {
+			return false
+		}
+	}
+	if p.AllowlistEntries != other.AllowlistEntries {
+		return false
+	}
+	return true
+}
+
+func (p *CustomReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CustomReport(%+v)", *p)
+}
@@ -12,12 +12,16 @@
 
 package diffmeasuredboot
 
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr"
 	"github.com/immune-gmbh/attestation-sdk/pkg/flowscompat"
 	"github.com/immune-gmbh/attestation-sdk/pkg/measurements"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
@@ -36,14 +40,33 @@ import (
 
 func init() {
 	analysis.RegisterType((*diffanalysis.CustomReport)(nil))
+	analysis.RegisterType((*NVRAMRules)(nil))
+	analysis.RegisterType((NVRAMRulesHash)(""))
+	analysis.RegisterType((*DiagnosisRules)(nil))
+	analysis.RegisterType((DiagnosisRulesHash)(""))
+	analysis.RegisterType((*analysis.AnalyzerReport[reproducepcr.Input])(nil))
 }
 
 // ID represents the unique id of DiffMeasuredBoot analyzer
 const ID analysis.AnalyzerID = diffanalysis.DiffMeasuredBootAnalyzerID
 
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.3.0"
+
+// NVRAMRulesHash is the content hash of the NVRAM rules used by the analyzer,
+// the rules themselves are provided to the analyzer through New.
+type NVRAMRulesHash analysis.ReferenceDataHash
+
+// DiagnosisRulesHash is the content hash of the diagnosis rules used by the analyzer,
+// the rules themselves are provided to the analyzer through New.
+type DiagnosisRulesHash analysis.ReferenceDataHash
+
 // NewExecutorInput builds an analysis.Executor's input required for DiffMeasuredBoot analyzer
 //
-// Optional arguments: tpm, eventlog, actualPCR and enforcedMeasurementsFlow
+// nvramRules are put into nvramRuleSets and diagnosisRules are put into diagnosisRuleSets,
+// which should be the stores provided to New.
+//
+// Optional arguments: tpm, eventlog, actualPCR, enforcedMeasurementsFlow, nvramRules and diagnosisRules
 func NewExecutorInput(
 	originalFirmware analysis.Blob,
 	actualFirmware analysis.Blob,
@@ -52,6 +75,10 @@ func NewExecutorInput(
 	eventlog *tpmeventlog.TPMEventLog, // optional
 	actualPCR []byte, // optional
 	enforcedMeasurementsFlow *pcr.Flow, // optional
+	nvramRuleSets *analysis.ReferenceDataStore[NVRAMRules],
+	nvramRules *NVRAMRules, // optional
+	diagnosisRuleSets *analysis.ReferenceDataStore[DiagnosisRules],
+	diagnosisRules *DiagnosisRules, // optional
 ) (analysis.Input, error) {
 	if originalFirmware == nil || actualFirmware == nil {
 		return nil, fmt.Errorf("firmware images should be specified")
@@ -81,6 +108,20 @@ func NewExecutorInput(
 	if enforcedMeasurementsFlow != nil {
 		result.ForceBootFlow(flowscompat.FromOld(*enforcedMeasurementsFlow))
 	}
+	if nvramRules != nil {
+		nvramRulesHash, err := nvramRuleSets.Put(nvramRules)
+		if err != nil {
+			return nil, fmt.Errorf("unable to store the NVRAM rules: %w", err)
+		}
+		result.AddCustomValue(NVRAMRulesHash(nvramRulesHash))
+	}
+	if diagnosisRules != nil {
+		diagnosisRulesHash, err := diagnosisRuleSets.Put(diagnosisRules)
+		if err != nil {
+			return nil, fmt.Errorf("unable to store the diagnosis rules: %w", err)
+		}
+		result.AddCustomValue(DiagnosisRulesHash(diagnosisRulesHash))
+	}
 	return result, nil
 }
 
@@ -94,15 +135,36 @@ type Input struct {
 	StatusRegisters  analysis.FixedRegisters
 	BootFlow         types.BootFlow
 	HostAssetID      *analysis.AssetID `exec:"optional"`
+	HostModelID      *analysis.ModelID `exec:"optional"`
+
+	// NVRAMRulesHash identifies the rules to classify changes of NVRAM variables, DefaultNVRAMRules are used if not set.
+	NVRAMRulesHash *NVRAMRulesHash `exec:"optional"`
+
+	// DiagnosisRulesHash identifies the rules to diagnose the difference, DefaultDiagnosisRules are used if not set.
+	DiagnosisRulesHash *DiagnosisRulesHash `exec:"optional"`
+
+	// ReproducePCRReport is the report of ReproducePCR: if the expected PCR0 was reproduced
+	// from the original firmware, then a suspicious damage is less alarming.
+	ReproducePCRReport *analysis.AnalyzerReport[reproducepcr.Input] `exec:"optional"`
 }
 
 // DiffMeasuredBoot represents the analyzer
 type DiffMeasuredBoot struct {
+	nvramRuleSets     *analysis.ReferenceDataStore[NVRAMRules]
+	diagnosisRuleSets *analysis.ReferenceDataStore[DiagnosisRules]
 }
 
 // New creates a new instance of DiffMeasuredBoot
-func New() analysis.Analyzer[Input] {
-	return &DiffMeasuredBoot{}
+//
+// nvramRuleSets and diagnosisRuleSets provide the rules referenced by the inputs, see NewExecutorInput.
+func New(
+	nvramRuleSets *analysis.ReferenceDataStore[NVRAMRules],
+	diagnosisRuleSets *analysis.ReferenceDataStore[DiagnosisRules],
+) analysis.Analyzer[Input] {
+	return &DiffMeasuredBoot{
+		nvramRuleSets:     nvramRuleSets,
+		diagnosisRuleSets: diagnosisRuleSets,
+	}
 }
 
 // ID implements the ID method required for analysis.Analyzer
@@ -110,6 +172,11 @@ func (analyzer *DiffMeasuredBoot) ID() analysis.AnalyzerID {
 	return "DiffMeasuredBoot"
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *DiffMeasuredBoot) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // TODO: create a dedicated package `typeconv` for conversions to Thrift and back
 func convDataChunk(chunk *diff.DataChunk) *diffanalysis.DataChunk {
 	if chunk == nil {
@@ -137,6 +204,23 @@ func (analyzer *DiffMeasuredBoot) Analyze(
 	input Input,
 ) (*analysis.Report, error) {
 
+	var nvramRules *NVRAMRules
+	if input.NVRAMRulesHash != nil {
+		var err error
+		nvramRules, err = analyzer.nvramRuleSets.Get(analysis.ReferenceDataHash(*input.NVRAMRulesHash))
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the NVRAM rules: %w", err)
+		}
+	}
+	var diagnosisRules *DiagnosisRules
+	if input.DiagnosisRulesHash != nil {
+		var err error
+		diagnosisRules, err = analyzer.diagnosisRuleSets.Get(analysis.ReferenceDataHash(*input.DiagnosisRulesHash))
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the diagnosis rules: %w", err)
+		}
+	}
+
 	// == getting the measurements ==
 
 	origBIOSImg := biosimage.NewFromParsed(input.OriginalFirmware.UEFI())
@@ -178,13 +262,28 @@ func (analyzer *DiffMeasuredBoot) Analyze(
 	diffEntries.SortAndMerge()
 
 	report := diff.Analyze(diffEntries, measurementsForDiffAnalysis(bootResult.Log), alignedOrigFW, input.ActualFirmware.Bytes())
-	diagnosis := Diagnose(
+
+	nvramDiff := &NVRAMDiff{}
+	if len(report.Entries) > 0 {
+		// Many changes are just NVRAM churn, find out which variables are changed.
+		d, err := DiffNVRAM(alignedOrigFW.Buf(), input.ActualFirmware.Bytes(), nvramRules)
+		if err != nil {
+			logger.FromCtx(ctx).Warnf("unable to compare NVRAM variables: %v", err)
+		} else {
+			nvramDiff = d
+		}
+	}
+
+	diagnosis, explanation := Diagnose(
 		logger.FromCtx(ctx),
+		diagnosisRules,
 		report.Entries.DiffRanges(),
+		nvramDiff.BenignRanges,
 		alignedOrigFW,
 		input.ActualFirmware,
 		input.ActualBIOSInfo,
 		input.OriginalBIOSInfo,
+		input.HostModelID,
 	)
 
 	// == compiling the report ==
@@ -228,25 +327,66 @@ func (analyzer *DiffMeasuredBoot) Analyze(
 	}
 
 	customReport.Diagnosis = diagnosis
+	if explanation != "" {
+		customReport.DiagnosisExplanation = &explanation
+	}
+	if diagnosis != diffanalysis.DiffDiagnosis_Match {
+		// Explain the difference in terms of modules, it is more human-friendly than byte ranges.
+		fileDiffs, err := DiffFiles(alignedOrigFW.Buf(), input.ActualFirmware.Bytes())
+		if err != nil {
+			logger.FromCtx(ctx).Warnf("unable to compare the images file by file: %v", err)
+		} else {
+			customReport.FileDiffs = fileDiffs
+		}
+	}
+	customReport.NVRAMDiffs = nvramDiff.Variables
+	for _, variable := range nvramDiff.Variables {
+		if variable.Class != diffanalysis.VariableClass_Significant {
+			continue
+		}
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeDiffMeasuredBootSignificantNVRAMChange,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("Significant NVRAM variable %s:%s is %s", variable.GUID, variable.Name, strings.ToLower(variable.Change.String())),
+			Params:      map[string]string{"guid": fmt.Sprint(variable.GUID), "name": fmt.Sprint(variable.Name), "change": variable.Change.String()},
+		})
+	}
 	result.Custom = customReport
 	switch diagnosis {
 	case diffanalysis.DiffDiagnosis_Match:
 	case diffanalysis.DiffDiagnosis_UnsuspiciousDamage:
 		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeDiffMeasuredBootNotSuspiciousDamage,
 			Severity:    analysis.SeverityInfo,
 			Description: "Not suspicious damage",
 		})
 	case diffanalysis.DiffDiagnosis_SuspiciousDamage:
+		severity := analysis.SeverityCritical
+		if input.ReproducePCRReport != nil && reproducepcr.Reproduced(input.ReproducePCRReport.Report) {
+			// The measured boot of the host is reproducible from the original firmware,
+			// thus the damage likely did not affect what was actually booted.
+			severity = analysis.SeverityWarning
+			result.Comments = append(result.Comments, "the expected PCR0 is reproduced from the original firmware")
+		}
 		result.Issues = append(result.Issues, analysis.Issue{
-			Severity:    analysis.SeverityCritical,
+			Code:        analysis.IssueCodeDiffMeasuredBootSuspiciousDamage,
+			Severity:    severity,
 			Description: "Suspicious damage",
 		})
+	case diffanalysis.DiffDiagnosis_BenignNVRAMChange:
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeDiffMeasuredBootBenignNVRAMChange,
+			Severity:    analysis.SeverityInfo,
+			Description: "Only benign NVRAM variables are changed",
+		})
 	case diffanalysis.DiffDiagnosis_KnownTamperedHost:
 		result.Comments = append(result.Comments, "the firmware was tampered by fwcompromised")
 	default:
 		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeDiffMeasuredBootDiagnosis,
 			Severity:    analysis.SeverityWarning,
 			Description: fmt.Sprintf("Result diagnosis: '%s'", diagnosis),
+			Params:      map[string]string{"diagnosis": diagnosis.String()},
 		})
 	}
 	return result, nil
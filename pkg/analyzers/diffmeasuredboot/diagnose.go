@@ -21,53 +21,92 @@ import (
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/immune-gmbh/attestation-sdk/pkg/uefi"
 	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
-	"github.com/steakknife/hamming"
 )
 
-// Diagnose provides the diagnosis how to interpret an image corruption.
+// Diagnose provides the diagnosis how to interpret an image corruption and
+// the explanation of the diagnosis. The diagnosis is defined by the first
+// matching rule; DefaultDiagnosisRules are used if rules is nil.
+//
+// benignRanges are the ranges which are known to change during normal
+// operation (see DiffNVRAM), it may be nil.
 func Diagnose(
 	log logger.Logger,
+	rules *DiagnosisRules,
 	diffRanges pkgbytes.Ranges,
+	benignRanges pkgbytes.Ranges,
 	origImage *uefi.UEFI,
 	modifiedImage analysis.ActualFirmwareBlob,
 	actualBIOSInfo *analysis.ActualBIOSInfo,
 	origBIOSInfo *analysis.OriginalBIOSInfo,
-) diffanalysis.DiffDiagnosis {
+	modelID *analysis.ModelID,
+) (diffanalysis.DiffDiagnosis, string) {
 	if len(origImage.Buf()) != len(modifiedImage.Bytes()) {
 		panic(fmt.Sprintf("images has different size: %d != %d", len(origImage.Buf()), len(modifiedImage.Bytes())))
 	}
+	if rules == nil {
+		rules = DefaultDiagnosisRules()
+	}
 
 	modifiedBytes := diffRanges.Compile(modifiedImage.Bytes())
 	if len(modifiedBytes) == 0 {
-		return diffanalysis.DiffDiagnosis_Match
+		return diffanalysis.DiffDiagnosis_Match, ""
 	}
 
-	origBytes := diffRanges.Compile(origImage.Buf())
-
-	if len(modifiedBytes) == 1 {
-		// damages we see just happening in our fleet
-
-		if hamming.Byte(origBytes[0], modifiedBytes[0]) == 1 {
-			// a bitflip
-			return diffanalysis.DiffDiagnosis_UnsuspiciousDamage
-		}
-
-		if modifiedBytes[0] == 0xff {
-			// a whole byte turned to 0xff
-			return diffanalysis.DiffDiagnosis_UnsuspiciousDamage
-		}
+	subject := &diagnosisSubject{
+		OriginalBytes: diffRanges.Compile(origImage.Buf()),
+		ActualBytes:   modifiedBytes,
+		BenignNVRAM:   isCoveredBy(diffRanges, benignRanges),
+	}
+	if modelID != nil {
+		subject.ModelID = (*int64)(modelID)
 	}
-
 	switch {
 	case actualBIOSInfo == nil:
 		log.Debugf("no actual BIOS info, assuming BIOS version match")
 	case origBIOSInfo == nil:
-		return diffanalysis.DiffDiagnosis_InvalidOriginalFirmware
+		subject.ActualFirmwareVersion = &actualBIOSInfo.Version
+		subject.OriginalFirmwareInfoMissing = true
 	default:
-		if origBIOSInfo.BIOSInfo != actualBIOSInfo.BIOSInfo {
-			return diffanalysis.DiffDiagnosis_FirmwareVersionMismatch
-		}
+		subject.ActualFirmwareVersion = &actualBIOSInfo.Version
+		subject.FirmwareVersionMismatch = origBIOSInfo.BIOSInfo != actualBIOSInfo.BIOSInfo
+	}
+	if rules.usesNodes() {
+		subject.NodePaths = nodePaths(log, origImage, diffRanges)
 	}
 
-	return diffanalysis.DiffDiagnosis_SuspiciousDamage
+	rule := rules.diagnose(subject)
+	if rule == nil {
+		return diffanalysis.DiffDiagnosis_SuspiciousDamage, "no diagnosis rule matched the difference"
+	}
+	diagnosis, err := diffanalysis.DiffDiagnosisFromString(rule.Diagnosis)
+	if err != nil {
+		// should never happen: the rules are validated when loaded
+		panic(fmt.Sprintf("invalid diagnosis '%s' in rule '%s'", rule.Diagnosis, rule.Name))
+	}
+	if rule.Explanation == "" {
+		return diagnosis, fmt.Sprintf("matched rule '%s'", rule.Name)
+	}
+	return diagnosis, fmt.Sprintf("%s (rule '%s')", rule.Explanation, rule.Name)
+}
+
+// nodePaths returns the GUIDs of the volumes and files containing each range,
+// from the outermost to the innermost one.
+func nodePaths(log logger.Logger, image *uefi.UEFI, ranges pkgbytes.Ranges) [][]string {
+	result := make([][]string, 0, len(ranges))
+	for _, r := range ranges {
+		nodes, err := image.GetByRange(r)
+		if err != nil {
+			log.Warnf("unable to get UEFI nodes of range %s: %v", r, err)
+		}
+		var nodePath []string
+		for _, node := range nodes {
+			nodeGUID := node.GUID()
+			if nodeGUID == nil || r.Offset < node.Offset || r.End() > node.End() {
+				continue
+			}
+			nodePath = append(nodePath, nodeGUID.String())
+		}
+		result = append(result, nodePath)
+	}
+	return result
 }
@@ -0,0 +1,382 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package diffmeasuredboot
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/linuxboot/fiano/pkg/guid"
+	"github.com/steakknife/hamming"
+	"gopkg.in/yaml.v3"
+)
+
+// DiagnosisRules is an ordered set of rules to diagnose a difference
+// between the original and the actual firmware. The first matching rule
+// defines the diagnosis; if no rule matches the diagnosis is SuspiciousDamage.
+type DiagnosisRules struct {
+	Rules []DiagnosisRule `json:"rules" yaml:"rules"`
+}
+
+// DiagnosisRule yields Diagnosis if all the conditions specified in Match are satisfied.
+type DiagnosisRule struct {
+	Name string `json:"name" yaml:"name"`
+
+	// Diagnosis is the name of a diffanalysis.DiffDiagnosis value, for example "UnsuspiciousDamage".
+	Diagnosis string `json:"diagnosis" yaml:"diagnosis"`
+
+	// Explanation is a human-readable explanation put to the report.
+	Explanation string `json:"explanation,omitempty" yaml:"explanation,omitempty"`
+
+	Match DiagnosisMatch `json:"match" yaml:"match"`
+}
+
+// DiagnosisMatch is the set of conditions of a DiagnosisRule. Conditions
+// which are not specified are ignored, an empty DiagnosisMatch matches everything.
+type DiagnosisMatch struct {
+	// NodeGUIDs requires each changed range to be within a UEFI volume or
+	// file with one of the GUIDs.
+	NodeGUIDs []string `json:"node_guids,omitempty" yaml:"node_guids,omitempty"`
+
+	// NodePaths requires each changed range to be within a UEFI node with
+	// a path matching one of the patterns (in the syntax of path.Match).
+	// A path consists of GUIDs of the volumes and files from the outermost
+	// to the innermost one, for example "*/CEF5B9A3-476D-497F-9FDC-E98143E0422C".
+	NodePaths []string `json:"node_paths,omitempty" yaml:"node_paths,omitempty"`
+
+	// MinChangedBytes and MaxChangedBytes limit the amount of changed bytes.
+	MinChangedBytes *uint64 `json:"min_changed_bytes,omitempty" yaml:"min_changed_bytes,omitempty"`
+	MaxChangedBytes *uint64 `json:"max_changed_bytes,omitempty" yaml:"max_changed_bytes,omitempty"`
+
+	// MaxHammingDistance limits the bitwise hamming distance between the original and the actual changed bytes.
+	MaxHammingDistance *uint64 `json:"max_hamming_distance,omitempty" yaml:"max_hamming_distance,omitempty"`
+
+	// ChangedBytesHex is a regular expression which should match the
+	// lower-case hex representation of the actual values of the changed bytes.
+	ChangedBytesHex string `json:"changed_bytes_hex,omitempty" yaml:"changed_bytes_hex,omitempty"`
+
+	// MinEntropy and MaxEntropy limit the Shannon entropy (bits per byte)
+	// of the actual values of the changed bytes.
+	MinEntropy *float64 `json:"min_entropy,omitempty" yaml:"min_entropy,omitempty"`
+	MaxEntropy *float64 `json:"max_entropy,omitempty" yaml:"max_entropy,omitempty"`
+
+	// ModelIDs requires the host to be of one of the models.
+	ModelIDs []int64 `json:"model_ids,omitempty" yaml:"model_ids,omitempty"`
+
+	// FirmwareVersions requires the actual BIOS version to match one of the patterns (in the syntax of path.Match).
+	FirmwareVersions []string `json:"firmware_versions,omitempty" yaml:"firmware_versions,omitempty"`
+
+	// FirmwareVersionMismatch requires the actual and the original BIOS info
+	// to be known and be different (if true) or not (if false).
+	FirmwareVersionMismatch *bool `json:"firmware_version_mismatch,omitempty" yaml:"firmware_version_mismatch,omitempty"`
+
+	// OriginalFirmwareInfoMissing requires the actual BIOS info to be known
+	// and the original BIOS info to be unknown (if true) or not (if false).
+	OriginalFirmwareInfoMissing *bool `json:"original_firmware_info_missing,omitempty" yaml:"original_firmware_info_missing,omitempty"`
+
+	// BenignNVRAM requires all changed bytes to be within benign NVRAM variables (if true) or not (if false), see DiffNVRAM.
+	BenignNVRAM *bool `json:"benign_nvram,omitempty" yaml:"benign_nvram,omitempty"`
+}
+
+// DefaultDiagnosisRules returns the rules used if no rules are provided.
+func DefaultDiagnosisRules() *DiagnosisRules {
+	one := uint64(1)
+	yes := true
+	rules := &DiagnosisRules{
+		Rules: []DiagnosisRule{
+			{
+				Name:        "bitflip",
+				Diagnosis:   diffanalysis.DiffDiagnosis_UnsuspiciousDamage.String(),
+				Explanation: "a single bit flip, a damage we see just happening in our fleet",
+				Match: DiagnosisMatch{
+					MaxChangedBytes:    &one,
+					MaxHammingDistance: &one,
+				},
+			},
+			{
+				Name:        "byte-to-0xff",
+				Diagnosis:   diffanalysis.DiffDiagnosis_UnsuspiciousDamage.String(),
+				Explanation: "a whole byte turned to 0xFF, a damage we see just happening in our fleet",
+				Match: DiagnosisMatch{
+					MaxChangedBytes: &one,
+					ChangedBytesHex: "^ff$",
+				},
+			},
+			{
+				Name:        "benign-nvram",
+				Diagnosis:   diffanalysis.DiffDiagnosis_BenignNVRAMChange.String(),
+				Explanation: "all changed bytes are within benign NVRAM variables or free space of variable stores",
+				Match: DiagnosisMatch{
+					BenignNVRAM: &yes,
+				},
+			},
+			{
+				Name:        "original-firmware-info-missing",
+				Diagnosis:   diffanalysis.DiffDiagnosis_InvalidOriginalFirmware.String(),
+				Explanation: "the BIOS info of the original firmware is unknown",
+				Match: DiagnosisMatch{
+					OriginalFirmwareInfoMissing: &yes,
+				},
+			},
+			{
+				Name:        "firmware-version-mismatch",
+				Diagnosis:   diffanalysis.DiffDiagnosis_FirmwareVersionMismatch.String(),
+				Explanation: "the BIOS info of the actual firmware differs from the original one",
+				Match: DiagnosisMatch{
+					FirmwareVersionMismatch: &yes,
+				},
+			},
+		},
+	}
+	if err := rules.normalize(); err != nil {
+		panic(err)
+	}
+	return rules
+}
+
+// ParseDiagnosisRules parses diagnosis rules in JSON or YAML format.
+func ParseDiagnosisRules(b []byte, isYAML bool) (*DiagnosisRules, error) {
+	var rules DiagnosisRules
+	var err error
+	if isYAML {
+		err = yaml.Unmarshal(b, &rules)
+	} else {
+		err = json.Unmarshal(b, &rules)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the diagnosis rules: %w", err)
+	}
+	if err := rules.normalize(); err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+// LoadDiagnosisRules reads diagnosis rules from a file. The format is chosen
+// by the file extension: ".yaml" and ".yml" are YAML, anything else is JSON.
+func LoadDiagnosisRules(path string) (*DiagnosisRules, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the diagnosis rules '%s': %w", path, err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseDiagnosisRules(b, true)
+	}
+	return ParseDiagnosisRules(b, false)
+}
+
+func (rules *DiagnosisRules) normalize() error {
+	for idx := range rules.Rules {
+		if err := rules.Rules[idx].normalize(); err != nil {
+			return fmt.Errorf("invalid diagnosis rule #%d (%s): %w", idx, rules.Rules[idx].Name, err)
+		}
+	}
+	return nil
+}
+
+func (rule *DiagnosisRule) normalize() error {
+	diagnosis, err := diffanalysis.DiffDiagnosisFromString(rule.Diagnosis)
+	if err != nil || diagnosis == diffanalysis.DiffDiagnosis_Undefined {
+		return fmt.Errorf("unknown diagnosis '%s'", rule.Diagnosis)
+	}
+
+	match := &rule.Match
+	for idx, nodeGUID := range match.NodeGUIDs {
+		parsed, err := guid.Parse(nodeGUID)
+		if err != nil {
+			return fmt.Errorf("invalid GUID '%s': %w", nodeGUID, err)
+		}
+		match.NodeGUIDs[idx] = parsed.String()
+	}
+	for idx, pattern := range match.NodePaths {
+		// GUIDs are compared in the canonical (upper-case) form.
+		match.NodePaths[idx] = strings.ToUpper(pattern)
+		if _, err := path.Match(match.NodePaths[idx], ""); err != nil {
+			return fmt.Errorf("invalid node path pattern '%s': %w", pattern, err)
+		}
+	}
+	for _, pattern := range match.FirmwareVersions {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid firmware version pattern '%s': %w", pattern, err)
+		}
+	}
+	if match.ChangedBytesHex != "" {
+		if _, err := regexp.Compile(match.ChangedBytesHex); err != nil {
+			return fmt.Errorf("invalid regular expression '%s': %w", match.ChangedBytesHex, err)
+		}
+	}
+	return nil
+}
+
+// diagnosisSubject is the difference to be diagnosed.
+type diagnosisSubject struct {
+	OriginalBytes []byte
+	ActualBytes   []byte
+
+	// NodePaths are the paths of the UEFI nodes containing each changed range.
+	// A nil value means that a range is not within any node.
+	NodePaths [][]string
+
+	ModelID                     *int64
+	ActualFirmwareVersion       *string
+	FirmwareVersionMismatch     bool
+	OriginalFirmwareInfoMissing bool
+	BenignNVRAM                 bool
+}
+
+// diagnose returns the first rule matching the subject (or nil).
+func (rules *DiagnosisRules) diagnose(subject *diagnosisSubject) *DiagnosisRule {
+	for idx := range rules.Rules {
+		rule := &rules.Rules[idx]
+		if rule.Match.matches(subject) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (match *DiagnosisMatch) matches(subject *diagnosisSubject) bool {
+	changedBytes := uint64(len(subject.ActualBytes))
+	if match.MinChangedBytes != nil && changedBytes < *match.MinChangedBytes {
+		return false
+	}
+	if match.MaxChangedBytes != nil && changedBytes > *match.MaxChangedBytes {
+		return false
+	}
+	if match.MaxHammingDistance != nil && uint64(hamming.Bytes(subject.OriginalBytes, subject.ActualBytes)) > *match.MaxHammingDistance {
+		return false
+	}
+	if match.ChangedBytesHex != "" {
+		// The expression is validated in normalize.
+		if matched, _ := regexp.MatchString(match.ChangedBytesHex, hex.EncodeToString(subject.ActualBytes)); !matched {
+			return false
+		}
+	}
+	if match.MinEntropy != nil || match.MaxEntropy != nil {
+		entropy := shannonEntropy(subject.ActualBytes)
+		if match.MinEntropy != nil && entropy < *match.MinEntropy {
+			return false
+		}
+		if match.MaxEntropy != nil && entropy > *match.MaxEntropy {
+			return false
+		}
+	}
+	if len(match.ModelIDs) > 0 && (subject.ModelID == nil || !containsInt64(match.ModelIDs, *subject.ModelID)) {
+		return false
+	}
+	if len(match.FirmwareVersions) > 0 && (subject.ActualFirmwareVersion == nil || !matchesAny(match.FirmwareVersions, *subject.ActualFirmwareVersion)) {
+		return false
+	}
+	if match.FirmwareVersionMismatch != nil && *match.FirmwareVersionMismatch != subject.FirmwareVersionMismatch {
+		return false
+	}
+	if match.OriginalFirmwareInfoMissing != nil && *match.OriginalFirmwareInfoMissing != subject.OriginalFirmwareInfoMissing {
+		return false
+	}
+	if match.BenignNVRAM != nil && *match.BenignNVRAM != subject.BenignNVRAM {
+		return false
+	}
+	if len(match.NodeGUIDs) > 0 {
+		for _, nodePath := range subject.NodePaths {
+			if !containsAnyString(nodePath, match.NodeGUIDs) {
+				return false
+			}
+		}
+	}
+	if len(match.NodePaths) > 0 {
+		for _, nodePath := range subject.NodePaths {
+			if !matchesAnyPrefix(match.NodePaths, nodePath) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// usesNodes returns true if any rule requires the UEFI node paths of the changed ranges.
+func (rules *DiagnosisRules) usesNodes() bool {
+	for _, rule := range rules.Rules {
+		if len(rule.Match.NodeGUIDs) > 0 || len(rule.Match.NodePaths) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func shannonEntropy(b []byte) float64 {
+	if len(b) == 0 {
+		return 0
+	}
+	var counts [256]int
+	for _, c := range b {
+		counts[c]++
+	}
+	var result float64
+	for _, count := range counts {
+		if count == 0 {
+			continue
+		}
+		p := float64(count) / float64(len(b))
+		result -= p * math.Log2(p)
+	}
+	return result
+}
+
+func containsInt64(s []int64, v int64) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAnyString(s []string, values []string) bool {
+	for _, item := range s {
+		for _, v := range values {
+			if item == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, s); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyPrefix returns true if any of the patterns matches the node
+// path or any of its parent paths.
+func matchesAnyPrefix(patterns []string, nodePath []string) bool {
+	for depth := len(nodePath); depth > 0; depth-- {
+		if matchesAny(patterns, strings.Join(nodePath[:depth], "/")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package diffmeasuredboot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+)
+
+func diagnoseWithRules(rules *DiagnosisRules, subject *diagnosisSubject) diffanalysis.DiffDiagnosis {
+	rule := rules.diagnose(subject)
+	if rule == nil {
+		return diffanalysis.DiffDiagnosis_SuspiciousDamage
+	}
+	diagnosis, err := diffanalysis.DiffDiagnosisFromString(rule.Diagnosis)
+	if err != nil {
+		panic(err)
+	}
+	return diagnosis
+}
+
+func TestDefaultDiagnosisRules(t *testing.T) {
+	rules := DefaultDiagnosisRules()
+	for name, tc := range map[string]struct {
+		subject  diagnosisSubject
+		expected diffanalysis.DiffDiagnosis
+	}{
+		"bitflip": {
+			subject:  diagnosisSubject{OriginalBytes: []byte{0x10}, ActualBytes: []byte{0x11}},
+			expected: diffanalysis.DiffDiagnosis_UnsuspiciousDamage,
+		},
+		"byte-to-0xff": {
+			subject:  diagnosisSubject{OriginalBytes: []byte{0x10}, ActualBytes: []byte{0xff}},
+			expected: diffanalysis.DiffDiagnosis_UnsuspiciousDamage,
+		},
+		"two-bytes-to-0xff": {
+			subject:  diagnosisSubject{OriginalBytes: []byte{0x10, 0x10}, ActualBytes: []byte{0xff, 0xff}},
+			expected: diffanalysis.DiffDiagnosis_SuspiciousDamage,
+		},
+		"benign-nvram": {
+			subject:  diagnosisSubject{OriginalBytes: []byte{1, 2}, ActualBytes: []byte{3, 4}, BenignNVRAM: true},
+			expected: diffanalysis.DiffDiagnosis_BenignNVRAMChange,
+		},
+		"original-firmware-info-missing": {
+			subject:  diagnosisSubject{OriginalBytes: []byte{1, 2}, ActualBytes: []byte{3, 4}, OriginalFirmwareInfoMissing: true},
+			expected: diffanalysis.DiffDiagnosis_InvalidOriginalFirmware,
+		},
+		"firmware-version-mismatch": {
+			subject:  diagnosisSubject{OriginalBytes: []byte{1, 2}, ActualBytes: []byte{3, 4}, FirmwareVersionMismatch: true},
+			expected: diffanalysis.DiffDiagnosis_FirmwareVersionMismatch,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, diagnoseWithRules(rules, &tc.subject))
+		})
+	}
+}
+
+func TestParseDiagnosisRules(t *testing.T) {
+	rules, err := ParseDiagnosisRules([]byte(`
+rules:
+  - name: nvar-padding-on-model-42
+    diagnosis: UnsuspiciousDamage
+    explanation: known NVAR corruption
+    match:
+      model_ids: [42]
+      firmware_versions: ["F0?"]
+      node_paths: ["*/cef5b9a3-476d-497f-9fdc-e98143e0422c"]
+  - name: high-entropy
+    diagnosis: SuspiciousDamage
+    match:
+      min_changed_bytes: 16
+      min_entropy: 3.5
+  - name: zeroed
+    diagnosis: UnsuspiciousDamage
+    match:
+      changed_bytes_hex: "^(00)+$"
+`), true)
+	require.NoError(t, err)
+	require.Equal(t, "*/CEF5B9A3-476D-497F-9FDC-E98143E0422C", rules.Rules[0].Match.NodePaths[0])
+
+	modelID := int64(42)
+	version := "F05"
+	nvarSubject := diagnosisSubject{
+		OriginalBytes:         []byte{1},
+		ActualBytes:           []byte{2},
+		ModelID:               &modelID,
+		ActualFirmwareVersion: &version,
+		NodePaths:             [][]string{{"FV-GUID", "CEF5B9A3-476D-497F-9FDC-E98143E0422C", "INNER"}},
+	}
+	require.Equal(t, "nvar-padding-on-model-42", rules.diagnose(&nvarSubject).Name)
+
+	otherModelID := int64(43)
+	nvarSubject.ModelID = &otherModelID
+	require.Nil(t, rules.diagnose(&nvarSubject))
+
+	random := []byte("0123456789abcdefghijklmnopqrstuv")
+	require.Equal(t, "high-entropy", rules.diagnose(&diagnosisSubject{OriginalBytes: make([]byte, len(random)), ActualBytes: random}).Name)
+	require.Equal(t, "zeroed", rules.diagnose(&diagnosisSubject{OriginalBytes: random, ActualBytes: make([]byte, len(random))}).Name)
+
+	for _, invalid := range []string{
+		`{"rules":[{"name":"x","diagnosis":"NoSuchDiagnosis"}]}`,
+		`{"rules":[{"name":"x","diagnosis":"Undefined"}]}`,
+		`{"rules":[{"name":"x","diagnosis":"Match","match":{"node_guids":["not a GUID"]}}]}`,
+		`{"rules":[{"name":"x","diagnosis":"Match","match":{"changed_bytes_hex":"("}}]}`,
+	} {
+		_, err := ParseDiagnosisRules([]byte(invalid), false)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestShannonEntropy(t *testing.T) {
+	require.Equal(t, float64(0), shannonEntropy(nil))
+	require.Equal(t, float64(0), shannonEntropy([]byte{7, 7, 7}))
+	require.Equal(t, float64(1), shannonEntropy([]byte{0, 1, 0, 1}))
+	require.Equal(t, float64(8), shannonEntropy(func() []byte {
+		b := make([]byte, 256)
+		for idx := range b {
+			b[idx] = byte(idx)
+		}
+		return b
+	}()))
+}
@@ -0,0 +1,282 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package diffmeasuredboot
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/linuxboot/fiano/pkg/guid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/nvram"
+)
+
+const (
+	// efiGlobalVariableGUID is EFI_GLOBAL_VARIABLE
+	efiGlobalVariableGUID = "8BE4DF61-93CA-11D2-AA0D-00E098032B8C"
+	// efiImageSecurityDatabaseGUID is EFI_IMAGE_SECURITY_DATABASE_GUID
+	efiImageSecurityDatabaseGUID = "D719B2CB-3D3A-4596-A3BC-DAD00E67656F"
+)
+
+// NVRAMRules is a set of rules to classify changes of NVRAM variables.
+type NVRAMRules struct {
+	Rules []NVRAMRule `json:"rules" yaml:"rules"`
+}
+
+// NVRAMRule classifies the variables matching GUID and Name.
+type NVRAMRule struct {
+	// GUID is the vendor GUID of the variable, empty value matches any GUID.
+	GUID string `json:"guid,omitempty" yaml:"guid,omitempty"`
+
+	// Name is a pattern of the variable name in the syntax of path.Match, for example "Boot????".
+	Name string `json:"name" yaml:"name"`
+
+	// Class is either "benign" or "significant".
+	Class string `json:"class" yaml:"class"`
+}
+
+// DefaultNVRAMRules returns the rules used if no rules are provided:
+// boot order, console and timestamp-like variables are benign, while Setup
+// and Secure Boot related variables are significant.
+func DefaultNVRAMRules() *NVRAMRules {
+	var rules NVRAMRules
+	add := func(class string, vendorGUID string, names ...string) {
+		for _, name := range names {
+			rules.Rules = append(rules.Rules, NVRAMRule{GUID: vendorGUID, Name: name, Class: class})
+		}
+	}
+	add("significant", efiGlobalVariableGUID, "SecureBoot", "SetupMode", "AuditMode", "DeployedMode", "VendorKeys", "PK", "KEK")
+	add("significant", efiImageSecurityDatabaseGUID, "db", "dbx", "dbt", "dbr")
+	add("significant", "", "Setup")
+	add("benign", efiGlobalVariableGUID,
+		"BootOrder", "BootNext", "BootCurrent", "Boot[0-9A-F][0-9A-F][0-9A-F][0-9A-F]", "Timeout",
+		"ConIn", "ConOut", "ErrOut", "ConInDev", "ConOutDev", "ErrOutDev", "Lang", "PlatformLang",
+	)
+	add("benign", "", "MTC", "MonotonicCounter")
+	if err := rules.normalize(); err != nil {
+		panic(err)
+	}
+	return &rules
+}
+
+// ParseNVRAMRules parses NVRAM rules in JSON or YAML format.
+func ParseNVRAMRules(b []byte, isYAML bool) (*NVRAMRules, error) {
+	var rules NVRAMRules
+	var err error
+	if isYAML {
+		err = yaml.Unmarshal(b, &rules)
+	} else {
+		err = json.Unmarshal(b, &rules)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the NVRAM rules: %w", err)
+	}
+	if err := rules.normalize(); err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+// LoadNVRAMRules reads NVRAM rules from a file. The format is chosen by
+// the file extension: ".yaml" and ".yml" are YAML, anything else is JSON.
+func LoadNVRAMRules(path string) (*NVRAMRules, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the NVRAM rules '%s': %w", path, err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseNVRAMRules(b, true)
+	}
+	return ParseNVRAMRules(b, false)
+}
+
+func (rules *NVRAMRules) normalize() error {
+	for idx := range rules.Rules {
+		rule := &rules.Rules[idx]
+		if rule.GUID != "" {
+			vendorGUID, err := guid.Parse(rule.GUID)
+			if err != nil {
+				return fmt.Errorf("invalid GUID '%s' in rule #%d: %w", rule.GUID, idx, err)
+			}
+			rule.GUID = vendorGUID.String()
+		}
+		if _, err := path.Match(rule.Name, ""); err != nil {
+			return fmt.Errorf("invalid name pattern '%s' in rule #%d: %w", rule.Name, idx, err)
+		}
+		if _, err := rule.class(); err != nil {
+			return fmt.Errorf("invalid rule #%d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+func (rule NVRAMRule) class() (diffanalysis.VariableClass, error) {
+	switch strings.ToLower(rule.Class) {
+	case "benign":
+		return diffanalysis.VariableClass_Benign, nil
+	case "significant":
+		return diffanalysis.VariableClass_Significant, nil
+	}
+	return diffanalysis.VariableClass_Unknown, fmt.Errorf("unknown class '%s'", rule.Class)
+}
+
+// Classify returns the class of the first rule matching the variable.
+func (rules *NVRAMRules) Classify(key nvram.VariableKey) diffanalysis.VariableClass {
+	for _, rule := range rules.Rules {
+		if rule.GUID != "" && rule.GUID != key.GUID.String() {
+			continue
+		}
+		if matched, _ := path.Match(rule.Name, key.Name); !matched {
+			continue
+		}
+		class, _ := rule.class()
+		return class
+	}
+	return diffanalysis.VariableClass_Unknown
+}
+
+// NVRAMDiff is the result of DiffNVRAM
+type NVRAMDiff struct {
+	// Variables are the changed variables
+	Variables []*diffanalysis.VariableDiff
+
+	// BenignRanges are the ranges which belong to benign variables or to
+	// free space of the variable stores in both images.
+	BenignRanges pkgbytes.Ranges
+}
+
+// DiffNVRAM compares NVRAM variable stores of the images. The images are
+// expected to be aligned, so that the same offset points to the same data.
+func DiffNVRAM(originalImage, actualImage []byte, rules *NVRAMRules) (*NVRAMDiff, error) {
+	if rules == nil {
+		rules = DefaultNVRAMRules()
+	}
+	origStores, err := nvram.Parse(originalImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse NVRAM of the original image: %w", err)
+	}
+	actualStores, err := nvram.Parse(actualImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse NVRAM of the actual image: %w", err)
+	}
+
+	origVars, actualVars := variables(origStores), variables(actualStores)
+	var keys []nvram.VariableKey
+	for key := range origVars {
+		keys = append(keys, key)
+	}
+	for key := range actualVars {
+		if _, ok := origVars[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Name != keys[j].Name {
+			return keys[i].Name < keys[j].Name
+		}
+		return keys[i].GUID.String() < keys[j].GUID.String()
+	})
+
+	result := &NVRAMDiff{}
+	for _, key := range keys {
+		origData, inOrig := origVars[key]
+		actualData, inActual := actualVars[key]
+		var change diffanalysis.ChangeType
+		switch {
+		case !inOrig:
+			change = diffanalysis.ChangeType_Added
+		case !inActual:
+			change = diffanalysis.ChangeType_Removed
+		case !bytes.Equal(origData, actualData):
+			change = diffanalysis.ChangeType_Modified
+		default:
+			continue
+		}
+		result.Variables = append(result.Variables, &diffanalysis.VariableDiff{
+			GUID:   key.GUID.String(),
+			Name:   key.Name,
+			Change: change,
+			Class:  rules.Classify(key),
+		})
+	}
+
+	result.BenignRanges = intersectRanges(
+		benignRanges(origStores, rules),
+		benignRanges(actualStores, rules),
+	)
+	return result, nil
+}
+
+func variables(stores []*nvram.Store) map[nvram.VariableKey][]byte {
+	result := map[nvram.VariableKey][]byte{}
+	for _, store := range stores {
+		for key, data := range store.Variables() {
+			result[key] = data
+		}
+	}
+	return result
+}
+
+func benignRanges(stores []*nvram.Store, rules *NVRAMRules) pkgbytes.Ranges {
+	var result pkgbytes.Ranges
+	for _, store := range stores {
+		if store.FreeSpace.Length > 0 {
+			result = append(result, store.FreeSpace)
+		}
+		for _, entry := range store.Entries {
+			// Old values of a benign variable are benign as well: their state
+			// (or link) bytes are changed when a new value is written.
+			if entry.Name != "" && rules.Classify(entry.VariableKey) == diffanalysis.VariableClass_Benign {
+				result = append(result, entry.Range)
+			}
+		}
+	}
+	result.SortAndMerge()
+	return result
+}
+
+func intersectRanges(a, b pkgbytes.Ranges) pkgbytes.Ranges {
+	var notInB pkgbytes.Ranges
+	for _, r := range a {
+		notInB = append(notInB, r.Exclude(b...)...)
+	}
+	var result pkgbytes.Ranges
+	for _, r := range a {
+		result = append(result, r.Exclude(notInB...)...)
+	}
+	result.SortAndMerge()
+	return result
+}
+
+// isCoveredBy returns true if each byte of ranges is within coverage.
+func isCoveredBy(ranges, coverage pkgbytes.Ranges) bool {
+	if len(coverage) == 0 {
+		return false
+	}
+	for _, r := range ranges {
+		if len(r.Exclude(coverage...)) > 0 {
+			return false
+		}
+	}
+	return true
+}
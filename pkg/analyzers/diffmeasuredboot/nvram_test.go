@@ -0,0 +1,78 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package diffmeasuredboot
+
+import (
+	"testing"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/linuxboot/fiano/pkg/guid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/nvram"
+)
+
+func TestDefaultNVRAMRules(t *testing.T) {
+	rules := DefaultNVRAMRules()
+	globalGUID := *guid.MustParse(efiGlobalVariableGUID)
+	otherGUID := *guid.MustParse("EC87D643-EBA4-4BB5-A1E5-3F3E36B20DA9")
+
+	for name, expected := range map[nvram.VariableKey]diffanalysis.VariableClass{
+		{GUID: globalGUID, Name: "BootOrder"}:  diffanalysis.VariableClass_Benign,
+		{GUID: globalGUID, Name: "Boot000A"}:   diffanalysis.VariableClass_Benign,
+		{GUID: otherGUID, Name: "BootOrder"}:   diffanalysis.VariableClass_Unknown,
+		{GUID: globalGUID, Name: "SecureBoot"}: diffanalysis.VariableClass_Significant,
+		{GUID: otherGUID, Name: "Setup"}:       diffanalysis.VariableClass_Significant,
+		{GUID: otherGUID, Name: "MTC"}:         diffanalysis.VariableClass_Benign,
+		{GUID: otherGUID, Name: "Unknown"}:     diffanalysis.VariableClass_Unknown,
+	} {
+		require.Equal(t, expected, rules.Classify(name), name.String())
+	}
+}
+
+func TestParseNVRAMRules(t *testing.T) {
+	rules, err := ParseNVRAMRules([]byte(`
+rules:
+  - name: "Setup*"
+    class: significant
+  - guid: ec87d643-eba4-4bb5-a1e5-3f3e36b20da9
+    name: "*"
+    class: Benign
+`), true)
+	require.NoError(t, err)
+	require.Equal(t, "EC87D643-EBA4-4BB5-A1E5-3F3E36B20DA9", rules.Rules[1].GUID)
+
+	vendorGUID := *guid.MustParse("EC87D643-EBA4-4BB5-A1E5-3F3E36B20DA9")
+	require.Equal(t, diffanalysis.VariableClass_Significant, rules.Classify(nvram.VariableKey{GUID: vendorGUID, Name: "SetupVolatile"}))
+	require.Equal(t, diffanalysis.VariableClass_Benign, rules.Classify(nvram.VariableKey{GUID: vendorGUID, Name: "Whatever"}))
+
+	_, err = ParseNVRAMRules([]byte(`{"rules":[{"name":"X","class":"harmless"}]}`), false)
+	require.Error(t, err)
+	_, err = ParseNVRAMRules([]byte(`{"rules":[{"name":"[","class":"benign"}]}`), false)
+	require.Error(t, err)
+	_, err = ParseNVRAMRules([]byte(`{"rules":[{"guid":"not a GUID","name":"X","class":"benign"}]}`), false)
+	require.Error(t, err)
+}
+
+func TestBenignRangesCoverage(t *testing.T) {
+	benign := intersectRanges(
+		pkgbytes.Ranges{{Offset: 0, Length: 10}, {Offset: 20, Length: 10}},
+		pkgbytes.Ranges{{Offset: 5, Length: 20}},
+	)
+	require.Equal(t, pkgbytes.Ranges{{Offset: 5, Length: 5}, {Offset: 20, Length: 5}}, benign)
+
+	require.True(t, isCoveredBy(pkgbytes.Ranges{{Offset: 6, Length: 2}, {Offset: 21, Length: 1}}, benign))
+	require.False(t, isCoveredBy(pkgbytes.Ranges{{Offset: 8, Length: 4}}, benign))
+	require.False(t, isCoveredBy(pkgbytes.Ranges{{Offset: 8, Length: 1}}, nil))
+}
@@ -22,6 +22,9 @@ enum DiffDiagnosis {
   FirmwareVersionMismatch = 4,
   InvalidOriginalFirmware = 5,
   KnownTamperedHost = 6,
+  // BenignNVRAMChange means all the changed bytes are within NVRAM variables
+  // (or free space of variable stores) known to change during normal operation.
+  BenignNVRAMChange = 7,
 }
 
 struct NodeInfo {
@@ -71,6 +74,44 @@ struct DiffEntry {
   7: list<NodeInfo> Nodes;
 }
 
+enum ChangeType {
+  Modified = 0,
+  Added = 1,
+  Removed = 2,
+}
+
+// PESectionDiff describes a changed section of a PE32 image (like ".text")
+struct PESectionDiff {
+  1: string Name;
+  2: ChangeType Change;
+  3: i64 ChangedBytes;
+}
+
+// FileDiff describes a changed FFS file
+struct FileDiff {
+  1: string GUID;
+  2: optional string Name;
+  3: string FileType;
+  4: ChangeType Change;
+  5: i64 ChangedBytes;
+  // PESections is set only if the PE32 image of the file was parsed in both images
+  6: list<PESectionDiff> PESections;
+}
+
+enum VariableClass {
+  Unknown = 0,
+  Benign = 1,
+  Significant = 2,
+}
+
+// VariableDiff describes a changed NVRAM variable
+struct VariableDiff {
+  1: string GUID;
+  2: string Name;
+  3: ChangeType Change;
+  4: VariableClass Class;
+}
+
 struct CustomReport {
   1: DiffDiagnosis Diagnosis;
   2: list<DiffEntry> DiffEntries;
@@ -78,4 +119,16 @@ struct CustomReport {
   // ImageOffset is the offset used to align the actual and the original images:
   // AddressInOriginalImage = AddressInActualImage + ImageOffset
   3: i64 ImageOffset;
+
+  // FileDiffs is the structural (FFS file by FFS file) difference
+  // between the original and the actual images.
+  4: optional list<FileDiff> FileDiffs;
+
+  // NVRAMDiffs is the variable by variable difference of NVRAM variable
+  // stores (NVAR and VSS) between the original and the actual images.
+  5: optional list<VariableDiff> NVRAMDiffs;
+
+  // DiagnosisExplanation explains why this Diagnosis was chosen
+  // (usually it is the explanation of the matched diagnosis rule).
+  6: optional string DiagnosisExplanation;
 }
@@ -29,6 +29,7 @@ const (
 	DiffDiagnosis_FirmwareVersionMismatch DiffDiagnosis = 4
 	DiffDiagnosis_InvalidOriginalFirmware DiffDiagnosis = 5
 	DiffDiagnosis_KnownTamperedHost       DiffDiagnosis = 6
+	DiffDiagnosis_BenignNVRAMChange       DiffDiagnosis = 7
 )
 
 func (p DiffDiagnosis) String() string {
@@ -47,6 +48,8 @@ func (p DiffDiagnosis) String() string {
 		return "InvalidOriginalFirmware"
 	case DiffDiagnosis_KnownTamperedHost:
 		return "KnownTamperedHost"
+	case DiffDiagnosis_BenignNVRAMChange:
+		return "BenignNVRAMChange"
 	}
 	return "<UNSET>"
 }
@@ -67,6 +70,8 @@ func DiffDiagnosisFromString(s string) (DiffDiagnosis, error) {
 		return DiffDiagnosis_InvalidOriginalFirmware, nil
 	case "KnownTamperedHost":
 		return DiffDiagnosis_KnownTamperedHost, nil
+	case "BenignNVRAMChange":
+		return DiffDiagnosis_BenignNVRAMChange, nil
 	}
 	return DiffDiagnosis(0), fmt.Errorf("not a valid DiffDiagnosis string")
 }
@@ -102,6 +107,132 @@ func (p *DiffDiagnosis) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type ChangeType int64
+
+const (
+	ChangeType_Modified ChangeType = 0
+	ChangeType_Added    ChangeType = 1
+	ChangeType_Removed  ChangeType = 2
+)
+
+func (p ChangeType) String() string {
+	switch p {
+	case ChangeType_Modified:
+		return "Modified"
+	case ChangeType_Added:
+		return "Added"
+	case ChangeType_Removed:
+		return "Removed"
+	}
+	return "<UNSET>"
+}
+
+func ChangeTypeFromString(s string) (ChangeType, error) {
+	switch s {
+	case "Modified":
+		return ChangeType_Modified, nil
+	case "Added":
+		return ChangeType_Added, nil
+	case "Removed":
+		return ChangeType_Removed, nil
+	}
+	return ChangeType(0), fmt.Errorf("not a valid ChangeType string")
+}
+
+func ChangeTypePtr(v ChangeType) *ChangeType { return &v }
+
+func (p ChangeType) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ChangeType) UnmarshalText(text []byte) error {
+	q, err := ChangeTypeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *ChangeType) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = ChangeType(v)
+	return nil
+}
+
+func (p *ChangeType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type VariableClass int64
+
+const (
+	VariableClass_Unknown     VariableClass = 0
+	VariableClass_Benign      VariableClass = 1
+	VariableClass_Significant VariableClass = 2
+)
+
+func (p VariableClass) String() string {
+	switch p {
+	case VariableClass_Unknown:
+		return "Unknown"
+	case VariableClass_Benign:
+		return "Benign"
+	case VariableClass_Significant:
+		return "Significant"
+	}
+	return "<UNSET>"
+}
+
+func VariableClassFromString(s string) (VariableClass, error) {
+	switch s {
+	case "Unknown":
+		return VariableClass_Unknown, nil
+	case "Benign":
+		return VariableClass_Benign, nil
+	case "Significant":
+		return VariableClass_Significant, nil
+	}
+	return VariableClass(0), fmt.Errorf("not a valid VariableClass string")
+}
+
+func VariableClassPtr(v VariableClass) *VariableClass { return &v }
+
+func (p VariableClass) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *VariableClass) UnmarshalText(text []byte) error {
+	q, err := VariableClassFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *VariableClass) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = VariableClass(v)
+	return nil
+}
+
+func (p *VariableClass) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 // Attributes:
 //   - UUID
 //   - Description
@@ -1581,31 +1712,31 @@ func (p *DiffEntry) String() string {
 }
 
 // Attributes:
-//   - Diagnosis
-//   - DiffEntries
-//   - ImageOffset
-type CustomReport struct {
-	Diagnosis   DiffDiagnosis `thrift:"Diagnosis,1" db:"Diagnosis" json:"Diagnosis"`
-	DiffEntries []*DiffEntry  `thrift:"DiffEntries,2" db:"DiffEntries" json:"DiffEntries"`
-	ImageOffset int64         `thrift:"ImageOffset,3" db:"ImageOffset" json:"ImageOffset"`
+//   - Name
+//   - Change
+//   - ChangedBytes
+type PESectionDiff struct {
+	Name         string     `thrift:"Name,1" db:"Name" json:"Name"`
+	Change       ChangeType `thrift:"Change,2" db:"Change" json:"Change"`
+	ChangedBytes int64      `thrift:"ChangedBytes,3" db:"ChangedBytes" json:"ChangedBytes"`
 }
 
-func NewCustomReport() *CustomReport {
-	return &CustomReport{}
+func NewPESectionDiff() *PESectionDiff {
+	return &PESectionDiff{}
 }
 
-func (p *CustomReport) GetDiagnosis() DiffDiagnosis {
-	return p.Diagnosis
+func (p *PESectionDiff) GetName() string {
+	return p.Name
 }
 
-func (p *CustomReport) GetDiffEntries() []*DiffEntry {
-	return p.DiffEntries
+func (p *PESectionDiff) GetChange() ChangeType {
+	return p.Change
 }
 
-func (p *CustomReport) GetImageOffset() int64 {
-	return p.ImageOffset
+func (p *PESectionDiff) GetChangedBytes() int64 {
+	return p.ChangedBytes
 }
-func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *PESectionDiff) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(ctx); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -1620,7 +1751,7 @@ func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
 		}
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I32 {
+			if fieldTypeId == thrift.STRING {
 				if err := p.ReadField1(ctx, iprot); err != nil {
 					return err
 				}
@@ -1630,7 +1761,7 @@ func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
 				}
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err := p.ReadField2(ctx, iprot); err != nil {
 					return err
 				}
@@ -1664,47 +1795,36 @@ func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(ctx); err != nil {
+func (p *PESectionDiff) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		temp := DiffDiagnosis(v)
-		p.Diagnosis = temp
+		p.Name = v
 	}
 	return nil
 }
 
-func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin(ctx)
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*DiffEntry, 0, size)
-	p.DiffEntries = tSlice
-	for i := 0; i < size; i++ {
-		_elem8 := &DiffEntry{}
-		if err := _elem8.Read(ctx, iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
-		}
-		p.DiffEntries = append(p.DiffEntries, _elem8)
-	}
-	if err := iprot.ReadListEnd(ctx); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *PESectionDiff) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := ChangeType(v)
+		p.Change = temp
 	}
 	return nil
 }
 
-func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *PESectionDiff) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI64(ctx); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.ImageOffset = v
+		p.ChangedBytes = v
 	}
 	return nil
 }
 
-func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+func (p *PESectionDiff) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "PESectionDiff"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if p != nil {
@@ -1727,77 +1847,1120 @@ func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "Diagnosis", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Diagnosis: ", p), err)
+func (p *PESectionDiff) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Name: ", p), err)
 	}
-	if err := oprot.WriteI32(ctx, int32(p.Diagnosis)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.Diagnosis (1) field write error: ", p), err)
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Name (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Diagnosis: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Name: ", p), err)
 	}
 	return err
 }
 
-func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "DiffEntries", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:DiffEntries: ", p), err)
-	}
-	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.DiffEntries)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.DiffEntries {
-		if err := v.Write(ctx, oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+func (p *PESectionDiff) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Change", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Change: ", p), err)
 	}
-	if err := oprot.WriteListEnd(ctx); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := oprot.WriteI32(ctx, int32(p.Change)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Change (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:DiffEntries: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Change: ", p), err)
 	}
 	return err
 }
 
-func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin(ctx, "ImageOffset", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ImageOffset: ", p), err)
+func (p *PESectionDiff) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ChangedBytes", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ChangedBytes: ", p), err)
 	}
-	if err := oprot.WriteI64(ctx, int64(p.ImageOffset)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.ImageOffset (3) field write error: ", p), err)
+	if err := oprot.WriteI64(ctx, int64(p.ChangedBytes)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ChangedBytes (3) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(ctx); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ImageOffset: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ChangedBytes: ", p), err)
 	}
 	return err
 }
 
-func (p *CustomReport) Equals(other *CustomReport) bool {
+func (p *PESectionDiff) Equals(other *PESectionDiff) bool {
 	if p == other {
 		return true
 	} else if p == nil || other == nil {
 		return false
 	}
-	if p.Diagnosis != other.Diagnosis {
+	if p.Name != other.Name {
 		return false
 	}
-	if len(p.DiffEntries) != len(other.DiffEntries) {
+	if p.Change != other.Change {
 		return false
 	}
-	for i, _tgt := range p.DiffEntries {
-		_src9 := other.DiffEntries[i]
-		if !_tgt.Equals(_src9) {
-			return false
-		}
-	}
-	if p.ImageOffset != other.ImageOffset {
+	if p.ChangedBytes != other.ChangedBytes {
 		return false
 	}
 	return true
 }
 
+func (p *PESectionDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PESectionDiff(%+v)", *p)
+}
+
+// Attributes:
+//   - GUID
+//   - Name
+//   - FileType
+//   - Change
+//   - ChangedBytes
+//   - PESections
+type FileDiff struct {
+	GUID         string           `thrift:"GUID,1" db:"GUID" json:"GUID"`
+	Name         *string          `thrift:"Name,2" db:"Name" json:"Name,omitempty"`
+	FileType     string           `thrift:"FileType,3" db:"FileType" json:"FileType"`
+	Change       ChangeType       `thrift:"Change,4" db:"Change" json:"Change"`
+	ChangedBytes int64            `thrift:"ChangedBytes,5" db:"ChangedBytes" json:"ChangedBytes"`
+	PESections   []*PESectionDiff `thrift:"PESections,6" db:"PESections" json:"PESections"`
+}
+
+func NewFileDiff() *FileDiff {
+	return &FileDiff{}
+}
+
+func (p *FileDiff) GetGUID() string {
+	return p.GUID
+}
+
+var FileDiff_Name_DEFAULT string
+
+func (p *FileDiff) GetName() string {
+	if !p.IsSetName() {
+		return FileDiff_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+func (p *FileDiff) GetFileType() string {
+	return p.FileType
+}
+
+func (p *FileDiff) GetChange() ChangeType {
+	return p.Change
+}
+
+func (p *FileDiff) GetChangedBytes() int64 {
+	return p.ChangedBytes
+}
+
+func (p *FileDiff) GetPESections() []*PESectionDiff {
+	return p.PESections
+}
+func (p *FileDiff) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *FileDiff) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FileDiff) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.GUID = v
+	}
+	return nil
+}
+
+func (p *FileDiff) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Name = &v
+	}
+	return nil
+}
+
+func (p *FileDiff) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.FileType = v
+	}
+	return nil
+}
+
+func (p *FileDiff) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := ChangeType(v)
+		p.Change = temp
+	}
+	return nil
+}
+
+func (p *FileDiff) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.ChangedBytes = v
+	}
+	return nil
+}
+
+func (p *FileDiff) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*PESectionDiff, 0, size)
+	p.PESections = tSlice
+	for i := 0; i < size; i++ {
+		_elem8 := &PESectionDiff{}
+		if err := _elem8.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
+		}
+		p.PESections = append(p.PESections, _elem8)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FileDiff) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FileDiff"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FileDiff) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "GUID", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:GUID: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.GUID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.GUID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:GUID: ", p), err)
+	}
+	return err
+}
+
+func (p *FileDiff) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err := oprot.WriteFieldBegin(ctx, "Name", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Name: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.Name)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.Name (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Name: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileDiff) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "FileType", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:FileType: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.FileType)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.FileType (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:FileType: ", p), err)
+	}
+	return err
+}
+
+func (p *FileDiff) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Change", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Change: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Change)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Change (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Change: ", p), err)
+	}
+	return err
+}
+
+func (p *FileDiff) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ChangedBytes", thrift.I64, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:ChangedBytes: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ChangedBytes)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ChangedBytes (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:ChangedBytes: ", p), err)
+	}
+	return err
+}
+
+func (p *FileDiff) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "PESections", thrift.LIST, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:PESections: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.PESections)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.PESections {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:PESections: ", p), err)
+	}
+	return err
+}
+
+func (p *FileDiff) Equals(other *FileDiff) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.GUID != other.GUID {
+		return false
+	}
+	if p.Name != other.Name {
+		if p.Name == nil || other.Name == nil {
+			return false
+		}
+		if (*p.Name) != (*other.Name) {
+			return false
+		}
+	}
+	if p.FileType != other.FileType {
+		return false
+	}
+	if p.Change != other.Change {
+		return false
+	}
+	if p.ChangedBytes != other.ChangedBytes {
+		return false
+	}
+	if len(p.PESections) != len(other.PESections) {
+		return false
+	}
+	for i, _tgt := range p.PESections {
+		_src9 := other.PESections[i]
+		if !_tgt.Equals(_src9) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *FileDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FileDiff(%+v)", *p)
+}
+
+// Attributes:
+//   - GUID
+//   - Name
+//   - Change
+//   - Class
+type VariableDiff struct {
+	GUID   string        `thrift:"GUID,1" db:"GUID" json:"GUID"`
+	Name   string        `thrift:"Name,2" db:"Name" json:"Name"`
+	Change ChangeType    `thrift:"Change,3" db:"Change" json:"Change"`
+	Class  VariableClass `thrift:"Class,4" db:"Class" json:"Class"`
+}
+
+func NewVariableDiff() *VariableDiff {
+	return &VariableDiff{}
+}
+
+func (p *VariableDiff) GetGUID() string {
+	return p.GUID
+}
+
+func (p *VariableDiff) GetName() string {
+	return p.Name
+}
+
+func (p *VariableDiff) GetChange() ChangeType {
+	return p.Change
+}
+
+func (p *VariableDiff) GetClass() VariableClass {
+	return p.Class
+}
+func (p *VariableDiff) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *VariableDiff) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.GUID = v
+	}
+	return nil
+}
+
+func (p *VariableDiff) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *VariableDiff) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := ChangeType(v)
+		p.Change = temp
+	}
+	return nil
+}
+
+func (p *VariableDiff) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := VariableClass(v)
+		p.Class = temp
+	}
+	return nil
+}
+
+func (p *VariableDiff) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "VariableDiff"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *VariableDiff) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "GUID", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:GUID: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.GUID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.GUID (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:GUID: ", p), err)
+	}
+	return err
+}
+
+func (p *VariableDiff) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Name", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Name (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Name: ", p), err)
+	}
+	return err
+}
+
+func (p *VariableDiff) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Change", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Change: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Change)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Change (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Change: ", p), err)
+	}
+	return err
+}
+
+func (p *VariableDiff) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Class", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Class: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Class)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Class (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Class: ", p), err)
+	}
+	return err
+}
+
+func (p *VariableDiff) Equals(other *VariableDiff) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.GUID != other.GUID {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.Change != other.Change {
+		return false
+	}
+	if p.Class != other.Class {
+		return false
+	}
+	return true
+}
+
+func (p *VariableDiff) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VariableDiff(%+v)", *p)
+}
+
+// Attributes:
+//   - Diagnosis
+//   - DiffEntries
+//   - ImageOffset
+//   - FileDiffs
+//   - NVRAMDiffs
+//   - DiagnosisExplanation
+type CustomReport struct {
+	Diagnosis            DiffDiagnosis   `thrift:"Diagnosis,1" db:"Diagnosis" json:"Diagnosis"`
+	DiffEntries          []*DiffEntry    `thrift:"DiffEntries,2" db:"DiffEntries" json:"DiffEntries"`
+	ImageOffset          int64           `thrift:"ImageOffset,3" db:"ImageOffset" json:"ImageOffset"`
+	FileDiffs            []*FileDiff     `thrift:"FileDiffs,4" db:"FileDiffs" json:"FileDiffs,omitempty"`
+	NVRAMDiffs           []*VariableDiff `thrift:"NVRAMDiffs,5" db:"NVRAMDiffs" json:"NVRAMDiffs,omitempty"`
+	DiagnosisExplanation *string         `thrift:"DiagnosisExplanation,6" db:"DiagnosisExplanation" json:"DiagnosisExplanation,omitempty"`
+}
+
+func NewCustomReport() *CustomReport {
+	return &CustomReport{}
+}
+
+func (p *CustomReport) GetDiagnosis() DiffDiagnosis {
+	return p.Diagnosis
+}
+
+func (p *CustomReport) GetDiffEntries() []*DiffEntry {
+	return p.DiffEntries
+}
+
+func (p *CustomReport) GetImageOffset() int64 {
+	return p.ImageOffset
+}
+
+var CustomReport_FileDiffs_DEFAULT []*FileDiff
+
+func (p *CustomReport) GetFileDiffs() []*FileDiff {
+	return p.FileDiffs
+}
+
+var CustomReport_NVRAMDiffs_DEFAULT []*VariableDiff
+
+func (p *CustomReport) GetNVRAMDiffs() []*VariableDiff {
+	return p.NVRAMDiffs
+}
+
+var CustomReport_DiagnosisExplanation_DEFAULT string
+
+func (p *CustomReport) GetDiagnosisExplanation() string {
+	if !p.IsSetDiagnosisExplanation() {
+		return CustomReport_DiagnosisExplanation_DEFAULT
+	}
+	return *p.DiagnosisExplanation
+}
+func (p *CustomReport) IsSetFileDiffs() bool {
+	return p.FileDiffs != nil
+}
+
+func (p *CustomReport) IsSetNVRAMDiffs() bool {
+	return p.NVRAMDiffs != nil
+}
+
+func (p *CustomReport) IsSetDiagnosisExplanation() bool {
+	return p.DiagnosisExplanation != nil
+}
+
+func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := DiffDiagnosis(v)
+		p.Diagnosis = temp
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*DiffEntry, 0, size)
+	p.DiffEntries = tSlice
+	for i := 0; i < size; i++ {
+		_elem10 := &DiffEntry{}
+		if err := _elem10.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
+		}
+		p.DiffEntries = append(p.DiffEntries, _elem10)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.ImageOffset = v
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*FileDiff, 0, size)
+	p.FileDiffs = tSlice
+	for i := 0; i < size; i++ {
+		_elem11 := &FileDiff{}
+		if err := _elem11.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem11), err)
+		}
+		p.FileDiffs = append(p.FileDiffs, _elem11)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*VariableDiff, 0, size)
+	p.NVRAMDiffs = tSlice
+	for i := 0; i < size; i++ {
+		_elem12 := &VariableDiff{}
+		if err := _elem12.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem12), err)
+		}
+		p.NVRAMDiffs = append(p.NVRAMDiffs, _elem12)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.DiagnosisExplanation = &v
+	}
+	return nil
+}
+
+func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Diagnosis", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Diagnosis: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Diagnosis)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Diagnosis (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Diagnosis: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "DiffEntries", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:DiffEntries: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.DiffEntries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.DiffEntries {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:DiffEntries: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ImageOffset", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ImageOffset: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.ImageOffset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ImageOffset (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ImageOffset: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetFileDiffs() {
+		if err := oprot.WriteFieldBegin(ctx, "FileDiffs", thrift.LIST, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:FileDiffs: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.FileDiffs)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.FileDiffs {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:FileDiffs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetNVRAMDiffs() {
+		if err := oprot.WriteFieldBegin(ctx, "NVRAMDiffs", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:NVRAMDiffs: ", p), err)
+		}
+		if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.NVRAMDiffs)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.NVRAMDiffs {
+			if err := v.Write(ctx, oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(ctx); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:NVRAMDiffs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiagnosisExplanation() {
+		if err := oprot.WriteFieldBegin(ctx, "DiagnosisExplanation", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:DiagnosisExplanation: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.DiagnosisExplanation)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.DiagnosisExplanation (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:DiagnosisExplanation: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) Equals(other *CustomReport) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Diagnosis != other.Diagnosis {
+		return false
+	}
+	if len(p.DiffEntries) != len(other.DiffEntries) {
+		return false
+	}
+	for i, _tgt := range p.DiffEntries {
+		_src13 := other.DiffEntries[i]
+		if !_tgt.Equals(_src13) {
+			return false
+		}
+	}
+	if p.ImageOffset != other.ImageOffset {
+		return false
+	}
+	if len(p.FileDiffs) != len(other.FileDiffs) {
+		return false
+	}
+	for i, _tgt := range p.FileDiffs {
+		_src14 := other.FileDiffs[i]
+		if !_tgt.Equals(_src14) {
+			return false
+		}
+	}
+	if len(p.NVRAMDiffs) != len(other.NVRAMDiffs) {
+		return false
+	}
+	for i, _tgt := range p.NVRAMDiffs {
+		_src15 := other.NVRAMDiffs[i]
+		if !_tgt.Equals(_src15) {
+			return false
+		}
+	}
+	if p.DiagnosisExplanation != other.DiagnosisExplanation {
+		if p.DiagnosisExplanation == nil || other.DiagnosisExplanation == nil {
+			return false
+		}
+		if (*p.DiagnosisExplanation) != (*other.DiagnosisExplanation) {
+			return false
+		}
+	}
+	return true
+}
+
 func (p *CustomReport) String() string {
 	if p == nil {
 		return "<nil>"
@@ -0,0 +1,261 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package diffmeasuredboot
+
+import (
+	"bytes"
+	"debug/pe"
+	"fmt"
+
+	"github.com/linuxboot/fiano/pkg/guid"
+	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/uefi"
+)
+
+// peHeadersSectionName is the pseudo-section name used for the PE headers
+// (everything before the first PE section).
+const peHeadersSectionName = "<headers>"
+
+// DiffFiles compares the images FFS file by FFS file.
+//
+// Files are matched by GUID (and by the order of appearance if there are
+// multiple files with the same GUID). Files which contain other files
+// (like compressed firmware volumes) are not reported themselves, only
+// the files inside them are.
+func DiffFiles(originalImage, actualImage []byte) ([]*diffanalysis.FileDiff, error) {
+	originalFiles, err := collectFiles(originalImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the original image: %w", err)
+	}
+	actualFiles, err := collectFiles(actualImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the actual image: %w", err)
+	}
+
+	actualFilesMap := make(map[string]*ffsFile, len(actualFiles))
+	for _, file := range actualFiles {
+		actualFilesMap[file.Key] = file
+	}
+
+	var result []*diffanalysis.FileDiff
+	for _, originalFile := range originalFiles {
+		actualFile := actualFilesMap[originalFile.Key]
+		if actualFile == nil {
+			result = append(result, originalFile.fileDiff(diffanalysis.ChangeType_Removed, int64(len(originalFile.Data))))
+			continue
+		}
+		delete(actualFilesMap, originalFile.Key)
+		if bytes.Equal(originalFile.Data, actualFile.Data) {
+			continue
+		}
+		fileDiff := actualFile.fileDiff(diffanalysis.ChangeType_Modified, countChangedBytes(originalFile.Data, actualFile.Data))
+		fileDiff.PESections = DiffPESections(originalFile.PE32, actualFile.PE32)
+		result = append(result, fileDiff)
+	}
+	for _, actualFile := range actualFiles {
+		if _, isAdded := actualFilesMap[actualFile.Key]; isAdded {
+			result = append(result, actualFile.fileDiff(diffanalysis.ChangeType_Added, int64(len(actualFile.Data))))
+		}
+	}
+	return result, nil
+}
+
+// DiffPESections compares the sections of two PE32 images.
+//
+// Returns nil if any of images could not be parsed.
+func DiffPESections(originalPE32, actualPE32 []byte) []*diffanalysis.PESectionDiff {
+	originalSections, err := peSections(originalPE32)
+	if err != nil {
+		return nil
+	}
+	actualSections, err := peSections(actualPE32)
+	if err != nil {
+		return nil
+	}
+
+	actualSectionsMap := make(map[string][]byte, len(actualSections))
+	for _, section := range actualSections {
+		actualSectionsMap[section.Name] = section.Data
+	}
+
+	var result []*diffanalysis.PESectionDiff
+	for _, originalSection := range originalSections {
+		actualData, found := actualSectionsMap[originalSection.Name]
+		if !found {
+			result = append(result, &diffanalysis.PESectionDiff{
+				Name:         originalSection.Name,
+				Change:       diffanalysis.ChangeType_Removed,
+				ChangedBytes: int64(len(originalSection.Data)),
+			})
+			continue
+		}
+		delete(actualSectionsMap, originalSection.Name)
+		if bytes.Equal(originalSection.Data, actualData) {
+			continue
+		}
+		result = append(result, &diffanalysis.PESectionDiff{
+			Name:         originalSection.Name,
+			Change:       diffanalysis.ChangeType_Modified,
+			ChangedBytes: countChangedBytes(originalSection.Data, actualData),
+		})
+	}
+	for _, actualSection := range actualSections {
+		if _, isAdded := actualSectionsMap[actualSection.Name]; isAdded {
+			result = append(result, &diffanalysis.PESectionDiff{
+				Name:         actualSection.Name,
+				Change:       diffanalysis.ChangeType_Added,
+				ChangedBytes: int64(len(actualSection.Data)),
+			})
+		}
+	}
+	return result
+}
+
+type peSection struct {
+	Name string
+	Data []byte
+}
+
+func peSections(image []byte) ([]peSection, error) {
+	if image == nil {
+		return nil, fmt.Errorf("no PE32 image")
+	}
+	peFile, err := pe.NewFile(bytes.NewReader(image))
+	if err != nil {
+		return nil, err
+	}
+	defer peFile.Close()
+
+	headersEnd := uint32(len(image))
+	result := make([]peSection, 0, len(peFile.Sections)+1)
+	for _, section := range peFile.Sections {
+		data, err := section.Data()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read section '%s': %w", section.Name, err)
+		}
+		if section.Offset != 0 && section.Offset < headersEnd {
+			headersEnd = section.Offset
+		}
+		result = append(result, peSection{Name: section.Name, Data: data})
+	}
+	return append([]peSection{{Name: peHeadersSectionName, Data: image[:headersEnd]}}, result...), nil
+}
+
+// countChangedBytes returns the amount of different bytes at the same offsets
+// plus the difference in length.
+func countChangedBytes(a, b []byte) int64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	result := int64(len(b) - len(a))
+	for idx := range a {
+		if a[idx] != b[idx] {
+			result++
+		}
+	}
+	return result
+}
+
+type ffsFile struct {
+	Key  string // GUID + the index among files with the same GUID
+	GUID guid.GUID
+	Name *string
+	Type string
+	Data []byte
+
+	// PE32 is the body of the first PE32 section of the file
+	PE32 []byte
+
+	isContainer bool
+}
+
+func (file *ffsFile) fileDiff(change diffanalysis.ChangeType, changedBytes int64) *diffanalysis.FileDiff {
+	return &diffanalysis.FileDiff{
+		GUID:         file.GUID.String(),
+		Name:         file.Name,
+		FileType:     file.Type,
+		Change:       change,
+		ChangedBytes: changedBytes,
+	}
+}
+
+func collectFiles(image []byte) ([]*ffsFile, error) {
+	// Modules are usually stored in compressed volumes, thus decompression is required
+	fw, err := uefi.Parse(image, true)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &fileCollector{
+		guidCount: map[guid.GUID]int{},
+	}
+	if err := collector.Run(fw.Firmware); err != nil {
+		return nil, err
+	}
+	return collector.Files, nil
+}
+
+type fileCollector struct {
+	Files     []*ffsFile
+	guidCount map[guid.GUID]int
+	stack     []*ffsFile
+}
+
+// Run implements fianoUEFI.Visitor
+func (v *fileCollector) Run(f fianoUEFI.Firmware) error {
+	return f.Apply(v)
+}
+
+// Visit implements fianoUEFI.Visitor
+func (v *fileCollector) Visit(f fianoUEFI.Firmware) error {
+	switch f := f.(type) {
+	case *fianoUEFI.File:
+		if f.Header.Type == fianoUEFI.FVFileTypePad {
+			return nil
+		}
+		if len(v.stack) > 0 {
+			v.stack[len(v.stack)-1].isContainer = true
+		}
+		file := &ffsFile{
+			Key:  fmt.Sprintf("%s#%d", f.Header.GUID, v.guidCount[f.Header.GUID]),
+			GUID: f.Header.GUID,
+			Type: f.Type,
+			Data: f.Buf(),
+		}
+		v.guidCount[f.Header.GUID]++
+		v.stack = append(v.stack, file)
+		err := f.ApplyChildren(v)
+		v.stack = v.stack[:len(v.stack)-1]
+		if !file.isContainer {
+			v.Files = append(v.Files, file)
+		}
+		return err
+	case *fianoUEFI.Section:
+		if len(v.stack) == 0 {
+			break
+		}
+		file := v.stack[len(v.stack)-1]
+		switch f.Header.Type {
+		case fianoUEFI.SectionTypePE32:
+			if file.PE32 == nil {
+				file.PE32 = uefi.SectionBody(f)
+			}
+		case fianoUEFI.SectionTypeUserInterface:
+			name := f.Name
+			file.Name = &name
+		}
+	}
+	return f.ApplyChildren(v)
+}
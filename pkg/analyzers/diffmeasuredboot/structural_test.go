@@ -0,0 +1,152 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package diffmeasuredboot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/linuxboot/fiano/pkg/guid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot/report/generated/diffanalysis"
+)
+
+// testPE returns a minimal PE32+ image with sections ".text" and ".data" (0x20 bytes each)
+func testPE(text, data byte) []byte {
+	image := make([]byte, 0x140)
+	copy(image, "MZ")
+	binary.LittleEndian.PutUint32(image[0x3C:], 0x40)
+	copy(image[0x40:], "PE\x00\x00")
+	binary.LittleEndian.PutUint16(image[0x44:], 0x8664) // Machine
+	binary.LittleEndian.PutUint16(image[0x46:], 2)      // NumberOfSections
+	for idx, section := range []struct {
+		name   string
+		offset uint32
+		fill   byte
+	}{{".text", 0x100, text}, {".data", 0x120, data}} {
+		header := image[0x58+idx*40:]
+		copy(header, section.name)
+		binary.LittleEndian.PutUint32(header[8:], 0x20)            // VirtualSize
+		binary.LittleEndian.PutUint32(header[12:], section.offset) // VirtualAddress
+		binary.LittleEndian.PutUint32(header[16:], 0x20)           // SizeOfRawData
+		binary.LittleEndian.PutUint32(header[20:], section.offset) // PointerToRawData
+		for offset := section.offset; offset < section.offset+0x20; offset++ {
+			image[offset] = section.fill
+		}
+	}
+	return image
+}
+
+// testFFSFile returns an FFS file of type DXE driver with a user interface section and a PE32 section
+func testFFSFile(fileGUID guid.GUID, name string, pe32 []byte) []byte {
+	section := func(sectionType byte, body []byte) []byte {
+		result := make([]byte, 4, 4+len(body)+3)
+		size := uint32(4 + len(body))
+		result[0], result[1], result[2], result[3] = byte(size), byte(size>>8), byte(size>>16), sectionType
+		result = append(result, body...)
+		for len(result)%4 != 0 {
+			result = append(result, 0)
+		}
+		return result
+	}
+	var ui []byte
+	for _, ch := range utf16.Encode([]rune(name + "\x00")) {
+		ui = binary.LittleEndian.AppendUint16(ui, ch)
+	}
+
+	file := make([]byte, 0x18)
+	copy(file, fileGUID[:])
+	file = append(file, section(0x15, ui)...)
+	file = append(file, section(0x10, pe32)...)
+	size := uint32(len(file))
+	file[0x12] = 0x07 // EFI_FV_FILETYPE_DRIVER
+	file[0x14], file[0x15], file[0x16] = byte(size), byte(size>>8), byte(size>>16)
+	file[0x17] = 0xF8
+	return file
+}
+
+// testFV returns a firmware volume (FFSv2, erase polarity 1) with the files
+func testFV(files ...[]byte) []byte {
+	const fvLength = 0x1000
+	fv := make([]byte, 0x48, fvLength)
+	ffs2 := guid.MustParse("8C8CE578-8A3D-4F1C-9935-896185C32DD3")
+	copy(fv[0x10:], ffs2[:])
+	binary.LittleEndian.PutUint64(fv[0x20:], fvLength)
+	copy(fv[0x28:], "_FVH")
+	binary.LittleEndian.PutUint32(fv[0x2C:], 0x800) // EFI_FVB2_ERASE_POLARITY
+	binary.LittleEndian.PutUint16(fv[0x30:], 0x48)  // HeaderLength
+	fv[0x37] = 2                                    // Revision
+	binary.LittleEndian.PutUint32(fv[0x38:], 1)     // NumBlocks
+	binary.LittleEndian.PutUint32(fv[0x3C:], fvLength)
+	for _, file := range files {
+		fv = append(fv, file...)
+		for len(fv)%8 != 0 {
+			fv = append(fv, 0xFF)
+		}
+	}
+	for len(fv) < fvLength {
+		fv = append(fv, 0xFF)
+	}
+	return fv
+}
+
+func TestCountChangedBytes(t *testing.T) {
+	require.Equal(t, int64(0), countChangedBytes([]byte{1, 2, 3}, []byte{1, 2, 3}))
+	require.Equal(t, int64(2), countChangedBytes([]byte{1, 2, 3}, []byte{0, 2, 0}))
+	require.Equal(t, int64(3), countChangedBytes([]byte{1, 2}, []byte{0, 2, 3, 4}))
+	require.Equal(t, int64(3), countChangedBytes([]byte{0, 2, 3, 4}, []byte{1, 2}))
+	require.Equal(t, int64(2), countChangedBytes(nil, []byte{1, 2}))
+}
+
+func TestDiffPESectionsNotPE(t *testing.T) {
+	require.Nil(t, DiffPESections(nil, []byte("MZ")))
+	require.Nil(t, DiffPESections([]byte("not a PE"), []byte("not a PE either")))
+}
+
+func TestDiffFiles(t *testing.T) {
+	driverGUID := *guid.MustParse("11111111-2222-3333-4444-555555555555")
+	otherGUID := *guid.MustParse("66666666-7777-8888-9999-AAAAAAAAAAAA")
+	original := testFV(
+		testFFSFile(driverGUID, "Driver", testPE(0x01, 0x02)),
+		testFFSFile(otherGUID, "Other", testPE(0x03, 0x04)),
+	)
+	actual := testFV(
+		testFFSFile(driverGUID, "Driver", testPE(0x01, 0x02)),
+		testFFSFile(otherGUID, "Other", testPE(0x03, 0x04)),
+	)
+
+	diffs, err := DiffFiles(original, actual)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	// modify one byte of section ".text" of the PE32 image of the driver
+	peOffset := bytes.Index(actual, testPE(0x01, 0x02))
+	require.Positive(t, peOffset)
+	actual[peOffset+0x110] = 0xFF
+
+	diffs, err = DiffFiles(original, actual)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Equal(t, driverGUID.String(), diffs[0].GUID)
+	require.Equal(t, "Driver", diffs[0].GetName())
+	require.Equal(t, diffanalysis.ChangeType_Modified, diffs[0].Change)
+	require.Equal(t, int64(1), diffs[0].ChangedBytes)
+	require.Equal(t, []*diffanalysis.PESectionDiff{{
+		Name:         ".text",
+		Change:       diffanalysis.ChangeType_Modified,
+		ChangedBytes: 1,
+	}}, diffs[0].PESections)
+}
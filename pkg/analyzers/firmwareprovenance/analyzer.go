@@ -0,0 +1,198 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwareprovenance
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance/report/generated/firmwareprovenanceanalysis"
+)
+
+func init() {
+	analysis.RegisterType((*Catalog)(nil))
+	analysis.RegisterType((*firmwareprovenanceanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of FirmwareProvenance analyzer
+const ID analysis.AnalyzerID = firmwareprovenanceanalysis.FirmwareProvenanceAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
+// smbiosDateLayout is the format of the BIOS release date in SMBIOS type 0
+const smbiosDateLayout = "01/02/2006"
+
+// NewExecutorInput builds an analysis.Executor's input required for FirmwareProvenance analyzer
+func NewExecutorInput(
+	actualFirmware analysis.Blob,
+	catalog Catalog,
+) (analysis.Input, error) {
+	if actualFirmware == nil {
+		return nil, fmt.Errorf("the actual firmware image should be specified")
+	}
+
+	result := analysis.NewInput()
+	result.AddActualFirmware(
+		actualFirmware,
+	).AddCustomValue(
+		catalog,
+	)
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	ActualBIOSInfo analysis.ActualBIOSInfo
+	Catalog        Catalog
+	HostModelID    *analysis.ModelID `exec:"optional"`
+}
+
+// FirmwareProvenance is analyzer that compares the running firmware version
+// with the newest version known to the firmware database for the same model.
+type FirmwareProvenance struct{}
+
+// New returns a new object of FirmwareProvenance analyzer
+func New() analysis.Analyzer[Input] {
+	return &FirmwareProvenance{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *FirmwareProvenance) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *FirmwareProvenance) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze checks if the running firmware version is revoked, superseded or outdated
+func (analyzer *FirmwareProvenance) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	runningVersion := in.ActualBIOSInfo.BIOSInfo.Version
+	if runningVersion == "" {
+		return nil, fmt.Errorf("unable to get the BIOS version from SMBIOS of the actual image")
+	}
+
+	var modelID *int64
+	if in.HostModelID != nil {
+		modelID = (*int64)(in.HostModelID)
+	}
+	candidates := in.Catalog.Candidates(runningVersion, modelID)
+
+	customReport := firmwareprovenanceanalysis.CustomReport{
+		RunningVersion: runningVersion,
+		ModelID:        modelID,
+		KnownVersions:  int32(len(candidates)),
+	}
+	result := &analysis.Report{}
+
+	var running *CatalogEntry
+	for idx := range candidates {
+		if candidates[idx].Version == runningVersion {
+			running = &candidates[idx]
+			break
+		}
+	}
+	latest := newest(candidates)
+	if latest != nil {
+		customReport.Latest = latest.toThrift()
+	}
+
+	runningReleaseDate := parseSMBIOSDate(in.ActualBIOSInfo.BIOSInfo.ReleaseDate)
+	if running != nil {
+		customReport.Running = running.toThrift()
+		if running.ReleaseDate != nil {
+			runningReleaseDate = running.ReleaseDate
+		}
+	}
+	if latest != nil && latest.ReleaseDate != nil && runningReleaseDate != nil {
+		gap := int32(latest.ReleaseDate.Sub(*runningReleaseDate) / (24 * time.Hour))
+		customReport.ReleaseDateGapDays = &gap
+	}
+
+	switch {
+	case running == nil:
+		customReport.Status = firmwareprovenanceanalysis.Status_UnknownVersion
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeFirmwareProvenanceUnknownVersion,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("running firmware version '%s' is not known to the firmware database", runningVersion),
+			Params:      map[string]string{"version": runningVersion},
+		})
+	case running.Revoked:
+		customReport.Status = firmwareprovenanceanalysis.Status_Revoked
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeFirmwareProvenanceRevoked,
+			Severity:    analysis.SeverityCritical,
+			Description: fmt.Sprintf("running firmware version '%s' is revoked%s", runningVersion, newestSuffix(latest, customReport.ReleaseDateGapDays)),
+			Params:      map[string]string{"version": runningVersion},
+		})
+	case running.SupersededBy != nil:
+		customReport.Status = firmwareprovenanceanalysis.Status_Superseded
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeFirmwareProvenanceSuperseded,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("running firmware version '%s' is superseded by '%s'%s", runningVersion, *running.SupersededBy, newestSuffix(latest, customReport.ReleaseDateGapDays)),
+			Params:      map[string]string{"version": runningVersion, "superseded_by": *running.SupersededBy},
+		})
+	case latest != nil && latest.Version != runningVersion && isNewer(*latest, *running):
+		customReport.Status = firmwareprovenanceanalysis.Status_Outdated
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeFirmwareProvenanceOutdated,
+			Severity:    analysis.SeverityInfo,
+			Description: fmt.Sprintf("running firmware version '%s' is outdated%s", runningVersion, newestSuffix(latest, customReport.ReleaseDateGapDays)),
+			Params:      map[string]string{"version": runningVersion},
+		})
+	default:
+		customReport.Status = firmwareprovenanceanalysis.Status_UpToDate
+	}
+
+	result.Custom = customReport
+	return result, nil
+}
+
+func newestSuffix(latest *CatalogEntry, gapDays *int32) string {
+	if latest == nil {
+		return ""
+	}
+	if gapDays == nil {
+		return fmt.Sprintf(", the newest version is '%s'", latest.Version)
+	}
+	return fmt.Sprintf(", the newest version is '%s' (released %d days later)", latest.Version, *gapDays)
+}
+
+func parseSMBIOSDate(s string) *time.Time {
+	t, err := time.Parse(smbiosDateLayout, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func (entry CatalogEntry) toThrift() *firmwareprovenanceanalysis.VersionInfo {
+	result := &firmwareprovenanceanalysis.VersionInfo{
+		Version:      entry.Version,
+		Revoked:      entry.Revoked,
+		SupersededBy: entry.SupersededBy,
+	}
+	if entry.ReleaseDate != nil {
+		releaseDate := entry.ReleaseDate.Format("2006-01-02")
+		result.ReleaseDate = &releaseDate
+	}
+	return result
+}
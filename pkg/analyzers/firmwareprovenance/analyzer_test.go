@@ -0,0 +1,114 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwareprovenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance/report/generated/firmwareprovenanceanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/dmidecode"
+	"github.com/immune-gmbh/attestation-sdk/pkg/firmwaredb"
+	"github.com/stretchr/testify/require"
+)
+
+func testCatalog() Catalog {
+	date := func(s string) *time.Time {
+		t, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			panic(err)
+		}
+		return &t
+	}
+	int64Ptr := func(v int64) *int64 { return &v }
+	targets := func(modelIDs ...int64) []*firmwaredb.FirmwareTarget {
+		var result []*firmwaredb.FirmwareTarget
+		for _, id := range modelIDs {
+			result = append(result, &firmwaredb.FirmwareTarget{ModelID: int64Ptr(id)})
+		}
+		return result
+	}
+
+	return NewCatalog([]*firmwaredb.Firmware{
+		{ID: 1, Version: "F01", ReleaseDate: date("2022-01-10"), Revoked: true, Targets: targets(1)},
+		{ID: 2, Version: "F02", ReleaseDate: date("2022-03-01"), SupersededBy: int64Ptr(3), Targets: targets(1)},
+		{ID: 3, Version: "F03", ReleaseDate: date("2022-05-30"), Targets: targets(1)},
+		{ID: 4, Version: "F04", ReleaseDate: date("2022-04-01"), Targets: targets(1)},
+		{ID: 5, Version: "F05", ReleaseDate: date("2023-01-01"), Revoked: true, Targets: targets(1)},
+		{ID: 6, Version: "G01", ReleaseDate: date("2023-01-01"), Targets: targets(2)},
+	})
+}
+
+func analyze(t *testing.T, version, releaseDate string, modelID *int64) (*analysis.Report, firmwareprovenanceanalysis.CustomReport) {
+	in := Input{
+		ActualBIOSInfo: analysis.ActualBIOSInfo{BIOSInfo: dmidecode.BIOSInfo{
+			Version:     version,
+			ReleaseDate: releaseDate,
+		}},
+		Catalog:     testCatalog(),
+		HostModelID: (*analysis.ModelID)(modelID),
+	}
+	report, err := New().Analyze(context.Background(), in)
+	require.NoError(t, err)
+	return report, report.Custom.(firmwareprovenanceanalysis.CustomReport)
+}
+
+func TestFirmwareProvenance(t *testing.T) {
+	modelID := int64(1)
+
+	t.Run("revoked", func(t *testing.T) {
+		report, custom := analyze(t, "F01", "", &modelID)
+		require.Equal(t, firmwareprovenanceanalysis.Status_Revoked, custom.Status)
+		require.Len(t, report.Issues, 1)
+		require.Equal(t, analysis.SeverityCritical, report.Issues[0].Severity)
+		require.Equal(t, "F03", custom.Latest.Version)
+		require.Equal(t, int32(140), *custom.ReleaseDateGapDays)
+		require.Equal(t, int32(5), custom.KnownVersions)
+	})
+
+	t.Run("superseded", func(t *testing.T) {
+		_, custom := analyze(t, "F02", "", &modelID)
+		require.Equal(t, firmwareprovenanceanalysis.Status_Superseded, custom.Status)
+		require.Equal(t, "F03", *custom.Running.SupersededBy)
+	})
+
+	t.Run("outdated", func(t *testing.T) {
+		report, custom := analyze(t, "F04", "", &modelID)
+		require.Equal(t, firmwareprovenanceanalysis.Status_Outdated, custom.Status)
+		require.Equal(t, analysis.SeverityInfo, report.Issues[0].Severity)
+		require.Equal(t, int32(59), *custom.ReleaseDateGapDays)
+	})
+
+	t.Run("up_to_date", func(t *testing.T) {
+		report, custom := analyze(t, "F03", "", &modelID)
+		require.Equal(t, firmwareprovenanceanalysis.Status_UpToDate, custom.Status)
+		require.Empty(t, report.Issues)
+		require.Equal(t, int32(0), *custom.ReleaseDateGapDays)
+	})
+
+	t.Run("unknown_version", func(t *testing.T) {
+		report, custom := analyze(t, "F99", "01/10/2022", &modelID)
+		require.Equal(t, firmwareprovenanceanalysis.Status_UnknownVersion, custom.Status)
+		require.Equal(t, analysis.SeverityWarning, report.Issues[0].Severity)
+		require.Nil(t, custom.Running)
+		require.Equal(t, int32(140), *custom.ReleaseDateGapDays)
+	})
+
+	t.Run("model_by_version", func(t *testing.T) {
+		_, custom := analyze(t, "G01", "", nil)
+		require.Equal(t, firmwareprovenanceanalysis.Status_UpToDate, custom.Status)
+		require.Equal(t, int32(1), custom.KnownVersions)
+	})
+}
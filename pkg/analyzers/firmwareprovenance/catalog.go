@@ -0,0 +1,133 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package firmwareprovenance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/firmwaredb"
+)
+
+// CatalogEntry is a firmware version known to the firmware database.
+type CatalogEntry struct {
+	ID           int64
+	Version      string
+	ReleaseDate  *time.Time
+	Revoked      bool
+	SupersededBy *string
+	ModelIDs     []int64
+}
+
+// Catalog is a snapshot of firmware database entries relevant for
+// the analyzed host. It is collected before the analysis to keep
+// the analyzer deterministic (and replayable).
+type Catalog struct {
+	Entries []CatalogEntry
+}
+
+// NewCatalog converts firmware database entries to a Catalog.
+func NewCatalog(firmwares []*firmwaredb.Firmware) Catalog {
+	versionByID := map[int64]string{}
+	for _, fw := range firmwares {
+		versionByID[fw.ID] = fw.Version
+	}
+
+	var result Catalog
+	for _, fw := range firmwares {
+		entry := CatalogEntry{
+			ID:          fw.ID,
+			Version:     fw.Version,
+			ReleaseDate: fw.ReleaseDate,
+			Revoked:     fw.Revoked,
+		}
+		if fw.SupersededBy != nil {
+			supersededBy, ok := versionByID[*fw.SupersededBy]
+			if !ok {
+				// the superseding firmware is not in the selection (e.g. it targets
+				// another set of models), but the fact of superseding still matters.
+				supersededBy = fmt.Sprintf("#%d", *fw.SupersededBy)
+			}
+			entry.SupersededBy = &supersededBy
+		}
+		for _, target := range fw.Targets {
+			if target.ModelID == nil {
+				continue
+			}
+			entry.ModelIDs = append(entry.ModelIDs, *target.ModelID)
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	return result
+}
+
+// Candidates returns the entries relevant for a host running the
+// specified version. If the model is not known, then it is assumed to
+// be any model the running version is targeted to.
+func (c Catalog) Candidates(runningVersion string, modelID *int64) []CatalogEntry {
+	models := map[int64]struct{}{}
+	if modelID != nil {
+		models[*modelID] = struct{}{}
+	} else {
+		for _, entry := range c.Entries {
+			if entry.Version != runningVersion {
+				continue
+			}
+			for _, id := range entry.ModelIDs {
+				models[id] = struct{}{}
+			}
+		}
+	}
+
+	var result []CatalogEntry
+	for _, entry := range c.Entries {
+		if len(models) == 0 {
+			if modelID == nil && entry.Version == runningVersion {
+				result = append(result, entry)
+			}
+			continue
+		}
+		for _, id := range entry.ModelIDs {
+			if _, ok := models[id]; ok {
+				result = append(result, entry)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// isNewer returns true if entry "a" was released after entry "b". If any of
+// release dates is unknown, then the entry added later to the database
+// is considered the newer one.
+func isNewer(a, b CatalogEntry) bool {
+	if a.ReleaseDate != nil && b.ReleaseDate != nil && !a.ReleaseDate.Equal(*b.ReleaseDate) {
+		return a.ReleaseDate.After(*b.ReleaseDate)
+	}
+	return a.ID > b.ID
+}
+
+// newest returns the newest non-revoked entry.
+func newest(entries []CatalogEntry) *CatalogEntry {
+	var result *CatalogEntry
+	for idx := range entries {
+		entry := &entries[idx]
+		if entry.Revoked {
+			continue
+		}
+		if result == nil || isNewer(*entry, *result) {
+			result = entry
+		}
+	}
+	return result
+}
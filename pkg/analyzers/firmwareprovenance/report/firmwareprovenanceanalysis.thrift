@@ -0,0 +1,51 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.firmwareprovenance.report.generated.firmwareprovenanceanalysis
+
+const string FirmwareProvenanceAnalyzerID = "FirmwareProvenance";
+
+enum Status {
+  // UpToDate means the running version is the newest non-revoked version for the model
+  UpToDate = 0,
+  // Outdated means there is a newer non-revoked version for the model
+  Outdated = 1,
+  // Superseded means the running version is explicitly replaced by another version
+  Superseded = 2,
+  // Revoked means the running version is marked as revoked in the firmware database
+  Revoked = 3,
+  // UnknownVersion means the running version is not found in the firmware database
+  UnknownVersion = 4,
+}
+
+// VersionInfo describes a firmware version known to the firmware database
+struct VersionInfo {
+  1: string Version;
+  // ReleaseDate is in format YYYY-MM-DD
+  2: optional string ReleaseDate;
+  3: bool Revoked;
+  4: optional string SupersededBy;
+}
+
+struct CustomReport {
+  1: Status Status;
+  // RunningVersion is the BIOS version reported by SMBIOS of the actual image
+  2: string RunningVersion;
+  3: optional VersionInfo Running;
+  4: optional VersionInfo Latest;
+  // ReleaseDateGapDays is the amount of days between the release of
+  // the running version and the release of the latest version
+  5: optional i32 ReleaseDateGapDays;
+  6: optional i64 ModelID;
+  // KnownVersions is the amount of versions known to the firmware database for the model
+  7: i32 KnownVersions;
+}
@@ -0,0 +1,796 @@
+// Code generated by Thrift Compiler (0.14.0). DO NOT EDIT.
+
+package firmwareprovenanceanalysis
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"time"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+var _ = time.Now
+var _ = bytes.Equal
+
+type Status int64
+
+const (
+	Status_UpToDate       Status = 0
+	Status_Outdated       Status = 1
+	Status_Superseded     Status = 2
+	Status_Revoked        Status = 3
+	Status_UnknownVersion Status = 4
+)
+
+func (p Status) String() string {
+	switch p {
+	case Status_UpToDate:
+		return "UpToDate"
+	case Status_Outdated:
+		return "Outdated"
+	case Status_Superseded:
+		return "Superseded"
+	case Status_Revoked:
+		return "Revoked"
+	case Status_UnknownVersion:
+		return "UnknownVersion"
+	}
+	return "<UNSET>"
+}
+
+func StatusFromString(s string) (Status, error) {
+	switch s {
+	case "UpToDate":
+		return Status_UpToDate, nil
+	case "Outdated":
+		return Status_Outdated, nil
+	case "Superseded":
+		return Status_Superseded, nil
+	case "Revoked":
+		return Status_Revoked, nil
+	case "UnknownVersion":
+		return Status_UnknownVersion, nil
+	}
+	return Status(0), fmt.Errorf("not a valid Status string")
+}
+
+func StatusPtr(v Status) *Status { return &v }
+
+func (p Status) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Status) UnmarshalText(text []byte) error {
+	q, err := StatusFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *Status) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = Status(v)
+	return nil
+}
+
+func (p *Status) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// Attributes:
+//   - Version
+//   - ReleaseDate
+//   - Revoked
+//   - SupersededBy
+type VersionInfo struct {
+	Version      string  `thrift:"Version,1" db:"Version" json:"Version"`
+	ReleaseDate  *string `thrift:"ReleaseDate,2" db:"ReleaseDate" json:"ReleaseDate,omitempty"`
+	Revoked      bool    `thrift:"Revoked,3" db:"Revoked" json:"Revoked"`
+	SupersededBy *string `thrift:"SupersededBy,4" db:"SupersededBy" json:"SupersededBy,omitempty"`
+}
+
+func NewVersionInfo() *VersionInfo {
+	return &VersionInfo{}
+}
+
+func (p *VersionInfo) GetVersion() string {
+	return p.Version
+}
+
+var VersionInfo_ReleaseDate_DEFAULT string
+
+func (p *VersionInfo) GetReleaseDate() string {
+	if !p.IsSetReleaseDate() {
+		return VersionInfo_ReleaseDate_DEFAULT
+	}
+	return *p.ReleaseDate
+}
+
+func (p *VersionInfo) GetRevoked() bool {
+	return p.Revoked
+}
+
+var VersionInfo_SupersededBy_DEFAULT string
+
+func (p *VersionInfo) GetSupersededBy() string {
+	if !p.IsSetSupersededBy() {
+		return VersionInfo_SupersededBy_DEFAULT
+	}
+	return *p.SupersededBy
+}
+func (p *VersionInfo) IsSetReleaseDate() bool {
+	return p.ReleaseDate != nil
+}
+
+func (p *VersionInfo) IsSetSupersededBy() bool {
+	return p.SupersededBy != nil
+}
+
+func (p *VersionInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *VersionInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Version = v
+	}
+	return nil
+}
+
+func (p *VersionInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.ReleaseDate = &v
+	}
+	return nil
+}
+
+func (p *VersionInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Revoked = v
+	}
+	return nil
+}
+
+func (p *VersionInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.SupersededBy = &v
+	}
+	return nil
+}
+
+func (p *VersionInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "VersionInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *VersionInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Version: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Version (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Version: ", p), err)
+	}
+	return err
+}
+
+func (p *VersionInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetReleaseDate() {
+		if err := oprot.WriteFieldBegin(ctx, "ReleaseDate", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ReleaseDate: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.ReleaseDate)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ReleaseDate (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ReleaseDate: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *VersionInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Revoked", thrift.BOOL, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Revoked: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Revoked)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Revoked (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Revoked: ", p), err)
+	}
+	return err
+}
+
+func (p *VersionInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSupersededBy() {
+		if err := oprot.WriteFieldBegin(ctx, "SupersededBy", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:SupersededBy: ", p), err)
+		}
+		if err := oprot.WriteString(ctx, string(*p.SupersededBy)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.SupersededBy (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:SupersededBy: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *VersionInfo) Equals(other *VersionInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Version != other.Version {
+		return false
+	}
+	if p.ReleaseDate != other.ReleaseDate {
+		if p.ReleaseDate == nil || other.ReleaseDate == nil {
+			return false
+		}
+		if (*p.ReleaseDate) != (*other.ReleaseDate) {
+			return false
+		}
+	}
+	if p.Revoked != other.Revoked {
+		return false
+	}
+	if p.SupersededBy != other.SupersededBy {
+		if p.SupersededBy == nil || other.SupersededBy == nil {
+			return false
+		}
+		if (*p.SupersededBy) != (*other.SupersededBy) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *VersionInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VersionInfo(%+v)", *p)
+}
+
+// Attributes:
+//   - Status
+//   - RunningVersion
+//   - Running
+//   - Latest
+//   - ReleaseDateGapDays
+//   - ModelID
+//   - KnownVersions
+type CustomReport struct {
+	Status             Status       `thrift:"Status,1" db:"Status" json:"Status"`
+	RunningVersion     string       `thrift:"RunningVersion,2" db:"RunningVersion" json:"RunningVersion"`
+	Running            *VersionInfo `thrift:"Running,3" db:"Running" json:"Running,omitempty"`
+	Latest             *VersionInfo `thrift:"Latest,4" db:"Latest" json:"Latest,omitempty"`
+	ReleaseDateGapDays *int32       `thrift:"ReleaseDateGapDays,5" db:"ReleaseDateGapDays" json:"ReleaseDateGapDays,omitempty"`
+	ModelID            *int64       `thrift:"ModelID,6" db:"ModelID" json:"ModelID,omitempty"`
+	KnownVersions      int32        `thrift:"KnownVersions,7" db:"KnownVersions" json:"KnownVersions"`
+}
+
+func NewCustomReport() *CustomReport {
+	return &CustomReport{}
+}
+
+func (p *CustomReport) GetStatus() Status {
+	return p.Status
+}
+
+func (p *CustomReport) GetRunningVersion() string {
+	return p.RunningVersion
+}
+
+var CustomReport_Running_DEFAULT *VersionInfo
+
+func (p *CustomReport) GetRunning() *VersionInfo {
+	if !p.IsSetRunning() {
+		return CustomReport_Running_DEFAULT
+	}
+	return p.Running
+}
+
+var CustomReport_Latest_DEFAULT *VersionInfo
+
+func (p *CustomReport) GetLatest() *VersionInfo {
+	if !p.IsSetLatest() {
+		return CustomReport_Latest_DEFAULT
+	}
+	return p.Latest
+}
+
+var CustomReport_ReleaseDateGapDays_DEFAULT int32
+
+func (p *CustomReport) GetReleaseDateGapDays() int32 {
+	if !p.IsSetReleaseDateGapDays() {
+		return CustomReport_ReleaseDateGapDays_DEFAULT
+	}
+	return *p.ReleaseDateGapDays
+}
+
+var CustomReport_ModelID_DEFAULT int64
+
+func (p *CustomReport) GetModelID() int64 {
+	if !p.IsSetModelID() {
+		return CustomReport_ModelID_DEFAULT
+	}
+	return *p.ModelID
+}
+
+func (p *CustomReport) GetKnownVersions() int32 {
+	return p.KnownVersions
+}
+func (p *CustomReport) IsSetRunning() bool {
+	return p.Running != nil
+}
+
+func (p *CustomReport) IsSetLatest() bool {
+	return p.Latest != nil
+}
+
+func (p *CustomReport) IsSetReleaseDateGapDays() bool {
+	return p.ReleaseDateGapDays != nil
+}
+
+func (p *CustomReport) IsSetModelID() bool {
+	return p.ModelID != nil
+}
+
+func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := Status(v)
+		p.Status = temp
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.RunningVersion = v
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Running = &VersionInfo{}
+	if err := p.Running.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Running), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Latest = &VersionInfo{}
+	if err := p.Latest.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Latest), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.ReleaseDateGapDays = &v
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ModelID = &v
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.KnownVersions = v
+	}
+	return nil
+}
+
+func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Status", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Status: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Status)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Status (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Status: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "RunningVersion", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:RunningVersion: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.RunningVersion)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.RunningVersion (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:RunningVersion: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetRunning() {
+		if err := oprot.WriteFieldBegin(ctx, "Running", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Running: ", p), err)
+		}
+		if err := p.Running.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Running), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Running: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetLatest() {
+		if err := oprot.WriteFieldBegin(ctx, "Latest", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Latest: ", p), err)
+		}
+		if err := p.Latest.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Latest), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Latest: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetReleaseDateGapDays() {
+		if err := oprot.WriteFieldBegin(ctx, "ReleaseDateGapDays", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:ReleaseDateGapDays: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.ReleaseDateGapDays)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ReleaseDateGapDays (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:ReleaseDateGapDays: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetModelID() {
+		if err := oprot.WriteFieldBegin(ctx, "ModelID", thrift.I64, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:ModelID: ", p), err)
+		}
+		if err := oprot.WriteI64(ctx, int64(*p.ModelID)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.ModelID (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:ModelID: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "KnownVersions", thrift.I32, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:KnownVersions: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.KnownVersions)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.KnownVersions (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:KnownVersions: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) Equals(other *CustomReport) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Status != other.Status {
+		return false
+	}
+	if p.RunningVersion != other.RunningVersion {
+		return false
+	}
+	if !p.Running.Equals(other.Running) {
+		return false
+	}
+	if !p.Latest.Equals(other.Latest) {
+		return false
+	}
+	if p.ReleaseDateGapDays != other.ReleaseDateGapDays {
+		if p.ReleaseDateGapDays == nil || other.ReleaseDateGapDays == nil {
+			return false
+		}
+		if (*p.ReleaseDateGapDays) != (*other.ReleaseDateGapDays) {
+			return false
+		}
+	}
+	if p.ModelID != other.ModelID {
+		if p.ModelID == nil || other.ModelID == nil {
+			return false
+		}
+		if (*p.ModelID) != (*other.ModelID) {
+			return false
+		}
+	}
+	if p.KnownVersions != other.KnownVersions {
+		return false
+	}
+	return true
+}
+
+func (p *CustomReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CustomReport(%+v)", *p)
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashdegradation
+
+import (
+	"sort"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+)
+
+// HostTrend summarizes FlashDegradation reports of a single host.
+type HostTrend struct {
+	// Reports is the amount of aggregated reports
+	Reports int
+
+	// ChangedBytes is the amount of changed bytes in each report, from the oldest to the newest one
+	ChangedBytes []int64
+
+	// DegradationVerdicts is the amount of reports with verdict LikelyHardwareDegradation
+	DegradationVerdicts int
+
+	// RecurringSectors are the offsets of erase blocks changed in more than one report
+	RecurringSectors []int64
+
+	// Degrading is true if the flash chip of the host is likely degrading over time:
+	// most of the changes look like a hardware degradation and the amount of
+	// changed bytes grows.
+	Degrading bool
+}
+
+// AggregateReports aggregates FlashDegradation reports of a single host.
+// The reports are expected to be in chronological order.
+func AggregateReports(reports []*flashdegradationanalysis.CustomReport) HostTrend {
+	result := HostTrend{
+		Reports: len(reports),
+	}
+
+	var withDifference int
+	sectorReports := map[int64]int{}
+	for _, report := range reports {
+		result.ChangedBytes = append(result.ChangedBytes, report.ChangedBytes)
+		if report.Verdict == flashdegradationanalysis.Verdict_NoDifference {
+			continue
+		}
+		withDifference++
+		if report.Verdict == flashdegradationanalysis.Verdict_LikelyHardwareDegradation {
+			result.DegradationVerdicts++
+		}
+		for _, sector := range report.Sectors {
+			sectorReports[sector.Offset]++
+		}
+	}
+
+	for offset, count := range sectorReports {
+		if count > 1 {
+			result.RecurringSectors = append(result.RecurringSectors, offset)
+		}
+	}
+	sort.Slice(result.RecurringSectors, func(i, j int) bool {
+		return result.RecurringSectors[i] < result.RecurringSectors[j]
+	})
+
+	result.Degrading = len(reports) >= 2 &&
+		result.DegradationVerdicts*2 > withDifference &&
+		isGrowing(result.ChangedBytes)
+	return result
+}
+
+// isGrowing returns true if the values never decrease and the last one is greater than the first one.
+func isGrowing(values []int64) bool {
+	for idx := 1; idx < len(values); idx++ {
+		if values[idx] < values[idx-1] {
+			return false
+		}
+	}
+	return len(values) > 0 && values[len(values)-1] > values[0]
+}
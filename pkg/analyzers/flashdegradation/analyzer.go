@@ -0,0 +1,154 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashdegradation
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/nvram"
+)
+
+func init() {
+	analysis.RegisterType((*flashdegradationanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of FlashDegradation analyzer
+const ID analysis.AnalyzerID = flashdegradationanalysis.FlashDegradationAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.1.0"
+
+// NewExecutorInput builds an analysis.Executor's input required for FlashDegradation analyzer
+func NewExecutorInput(
+	originalFirmware analysis.Blob,
+	actualFirmware analysis.Blob,
+) (analysis.Input, error) {
+	if originalFirmware == nil || actualFirmware == nil {
+		return nil, fmt.Errorf("firmware images should be specified")
+	}
+
+	result := analysis.NewInput()
+	result.AddOriginalFirmware(
+		originalFirmware,
+	).AddActualFirmware(
+		actualFirmware,
+	)
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	ActualFirmware analysis.ActualFirmwareBlob
+	AlignedOrigFW  analysis.AlignedOriginalFirmware
+}
+
+// FlashDegradation is analyzer which characterizes the difference between
+// the actual and the original images to tell a degradation of the flash chip
+// (bit-rot) from an intentional write.
+type FlashDegradation struct{}
+
+// New returns a new object of FlashDegradation analyzer
+func New() analysis.Analyzer[Input] {
+	return &FlashDegradation{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *FlashDegradation) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *FlashDegradation) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze characterizes every changed byte of the actual image except
+// the ones of the NVRAM variable stores
+func (analyzer *FlashDegradation) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	return analyze(in.AlignedOrigFW.UEFI().Buf(), in.ActualFirmware.Bytes())
+}
+
+func analyze(original, actual []byte) (*analysis.Report, error) {
+	actual, err := excludeNVRAM(original, actual)
+	if err != nil {
+		return nil, err
+	}
+	customReport, err := Characterize(
+		original,
+		actual,
+		DefaultEraseBlockSize,
+		DefaultPageSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare the images: %w", err)
+	}
+
+	result := &analysis.Report{
+		Custom: *customReport,
+	}
+	switch customReport.Verdict {
+	case flashdegradationanalysis.Verdict_LikelyHardwareDegradation:
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeFlashDegradationHardwareDegradation,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("the difference looks like a degradation of the flash chip (score %d/100), consider replacing it", customReport.DegradationScore),
+			Params:      map[string]string{"score": fmt.Sprint(customReport.DegradationScore)},
+		})
+	case flashdegradationanalysis.Verdict_LikelyIntentionalWrite:
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeFlashDegradationIntentionalWrite,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("the difference looks like an intentional write (score %d/100)", customReport.DegradationScore),
+			Params:      map[string]string{"score": fmt.Sprint(customReport.DegradationScore)},
+		})
+	}
+	for _, evidence := range customReport.Evidence {
+		result.Comments = append(result.Comments, fmt.Sprintf("%+d: %s", evidence.Weight, evidence.Description))
+	}
+	return result, nil
+}
+
+// excludeNVRAM returns a copy of the actual image with the NVRAM variable
+// stores (of both images) replaced by the original bytes. The stores are
+// rewritten by the firmware itself during a normal boot (the same is
+// considered benign by DiffMeasuredBoot, see diffmeasuredboot.DiffNVRAM),
+// so their changes are neither a degradation nor an intentional write.
+func excludeNVRAM(original, actual []byte) ([]byte, error) {
+	if len(original) != len(actual) {
+		// the lengths are validated by Characterize
+		return actual, nil
+	}
+	origStores, err := nvram.Parse(original)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse NVRAM of the original image: %w", err)
+	}
+	actualStores, err := nvram.Parse(actual)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse NVRAM of the actual image: %w", err)
+	}
+
+	stores := append(origStores, actualStores...)
+	if len(stores) == 0 {
+		return actual, nil
+	}
+	result := append([]byte{}, actual...)
+	for _, store := range stores {
+		copy(result[store.Range.Offset:store.Range.End()], original[store.Range.Offset:store.Range.End()])
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+package flashdegradation
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/linuxboot/fiano/pkg/guid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+)
+
+// putVSSStore writes an empty EDK2 variable store header at the offset,
+// the rest of the store is free space.
+func putVSSStore(image []byte, offset int, size int) {
+	copy(image[offset:], guid.MustParse("DDCF3616-3275-4164-98B6-FE85707FFE7D")[:])
+	binary.LittleEndian.PutUint32(image[offset+16:], uint32(size))
+	image[offset+20] = 0x5A // formatted
+	image[offset+21] = 0xFE // healthy
+}
+
+func TestAnalyzeNVRAMChurn(t *testing.T) {
+	original := testImage(64 * 1024)
+	putVSSStore(original, 0x8000, 0x2000)
+	actual := append([]byte{}, original...)
+	// the firmware rewrote a whole erase block of the variable store
+	rand.New(rand.NewSource(1)).Read(actual[0x9000:0xa000])
+
+	report, err := analyze(original, actual)
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+	require.Equal(t, flashdegradationanalysis.Verdict_NoDifference, report.Custom.(flashdegradationanalysis.CustomReport).Verdict)
+
+	// the same write outside of the store is still reported
+	rand.New(rand.NewSource(1)).Read(actual[0x3000:0x4000])
+	report, err = analyze(original, actual)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 1)
+	require.Equal(t, flashdegradationanalysis.Verdict_LikelyIntentionalWrite, report.Custom.(flashdegradationanalysis.CustomReport).Verdict)
+}
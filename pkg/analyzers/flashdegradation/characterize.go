@@ -0,0 +1,237 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashdegradation
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+)
+
+const (
+	// DefaultEraseBlockSize is the typical minimal erase unit (sector) of SPI NOR flash chips
+	DefaultEraseBlockSize = 4096
+
+	// DefaultPageSize is the typical program unit (page) of SPI NOR flash chips
+	DefaultPageSize = 256
+
+	// rangesMergeDistance is the maximal distance between changed bytes to be considered
+	// a part of the same changed area.
+	rangesMergeDistance = 16
+
+	// degradationThreshold and intentionalWriteThreshold are the DegradationScore
+	// thresholds of the verdicts.
+	degradationThreshold      = 65
+	intentionalWriteThreshold = 35
+)
+
+type changedArea struct {
+	Offset uint64
+	Length uint64
+}
+
+// Characterize compares the original and the actual images (of the same size)
+// and tells if the difference looks like a hardware degradation of the flash chip
+// or like an intentional write.
+//
+// Degradation of NOR flash mostly looks like sparse single bit flips in
+// the same direction (charge loss or gain of the floating gates), while
+// a write erases and programs whole pages/blocks resulting in dense changes
+// with bits flipped in both directions.
+func Characterize(original, actual []byte, eraseBlockSize, pageSize uint64) (*flashdegradationanalysis.CustomReport, error) {
+	if len(original) != len(actual) {
+		return nil, fmt.Errorf("images have different sizes: %d != %d", len(original), len(actual))
+	}
+	if eraseBlockSize == 0 || pageSize == 0 {
+		return nil, fmt.Errorf("erase block size and page size should be positive")
+	}
+
+	result := &flashdegradationanalysis.CustomReport{
+		EraseBlockSize: int64(eraseBlockSize),
+		PageSize:       int64(pageSize),
+	}
+
+	var (
+		areas         []changedArea
+		sector        *flashdegradationanalysis.SectorDiff
+		sectorValues  map[byte]struct{}
+		lastChangeEnd uint64
+	)
+	finishSector := func() {
+		if sector == nil {
+			return
+		}
+		if len(sectorValues) == 1 && sector.ChangedBytes > 1 {
+			for value := range sectorValues {
+				if value == 0x00 || value == 0xff {
+					stuckAt := int16(value)
+					sector.StuckAtValue = &stuckAt
+				}
+			}
+		}
+		result.Sectors = append(result.Sectors, sector)
+		sector = nil
+	}
+	for idx := range actual {
+		o, a := original[idx], actual[idx]
+		if o == a {
+			continue
+		}
+		offset := uint64(idx)
+		flipped := o ^ a
+		flips1To0 := int64(bits.OnesCount8(flipped & o))
+		flips0To1 := int64(bits.OnesCount8(flipped & a))
+
+		sectorOffset := offset / eraseBlockSize * eraseBlockSize
+		if sector == nil || uint64(sector.Offset) != sectorOffset {
+			finishSector()
+			sector = &flashdegradationanalysis.SectorDiff{Offset: int64(sectorOffset)}
+			sectorValues = map[byte]struct{}{}
+		}
+		sector.ChangedBytes++
+		sector.FlippedBits1To0 += flips1To0
+		sector.FlippedBits0To1 += flips0To1
+		sectorValues[a] = struct{}{}
+
+		result.ChangedBytes++
+		result.FlippedBits1To0 += flips1To0
+		result.FlippedBits0To1 += flips0To1
+		switch a {
+		case 0x00:
+			result.StuckAtZeroBytes++
+		case 0xff:
+			result.StuckAtOneBytes++
+		}
+
+		if len(areas) > 0 && offset-lastChangeEnd <= rangesMergeDistance {
+			areas[len(areas)-1].Length = offset + 1 - areas[len(areas)-1].Offset
+		} else {
+			areas = append(areas, changedArea{Offset: offset, Length: 1})
+		}
+		lastChangeEnd = offset + 1
+	}
+	finishSector()
+
+	result.DiffRanges = int64(len(areas))
+	for _, area := range areas {
+		switch {
+		case isAligned(area, eraseBlockSize):
+			result.EraseBlockAlignedRanges++
+		case isAligned(area, pageSize):
+			result.PageAlignedRanges++
+		}
+	}
+
+	if result.ChangedBytes == 0 {
+		result.Verdict = flashdegradationanalysis.Verdict_NoDifference
+		return result, nil
+	}
+	result.Evidence = collectEvidence(result)
+	score := 50
+	for _, evidence := range result.Evidence {
+		score += int(evidence.Weight)
+	}
+	switch {
+	case score > 100:
+		score = 100
+	case score < 0:
+		score = 0
+	}
+	result.DegradationScore = int32(score)
+	switch {
+	case score >= degradationThreshold:
+		result.Verdict = flashdegradationanalysis.Verdict_LikelyHardwareDegradation
+	case score <= intentionalWriteThreshold:
+		result.Verdict = flashdegradationanalysis.Verdict_LikelyIntentionalWrite
+	default:
+		result.Verdict = flashdegradationanalysis.Verdict_Inconclusive
+	}
+	return result, nil
+}
+
+// isAligned returns true if the area starts on a unit boundary and covers most of the unit
+// (all bytes of a unit are rarely changed even if the whole unit was rewritten).
+func isAligned(area changedArea, unitSize uint64) bool {
+	return area.Offset%unitSize == 0 && area.Length >= unitSize/2
+}
+
+func collectEvidence(report *flashdegradationanalysis.CustomReport) []*flashdegradationanalysis.Evidence {
+	var result []*flashdegradationanalysis.Evidence
+	add := func(weight int32, format string, args ...any) {
+		result = append(result, &flashdegradationanalysis.Evidence{
+			Description: fmt.Sprintf(format, args...),
+			Weight:      weight,
+		})
+	}
+
+	flippedBits := report.FlippedBits1To0 + report.FlippedBits0To1
+	if report.ChangedBytes == 1 && flippedBits == 1 {
+		add(20, "a single bit flip")
+	}
+
+	if flippedBits >= 4 {
+		dominant, direction := report.FlippedBits0To1, "0->1 (charge loss)"
+		if report.FlippedBits1To0 > dominant {
+			dominant, direction = report.FlippedBits1To0, "1->0 (charge gain)"
+		}
+		share := dominant * 100 / flippedBits
+		switch {
+		case share >= 90:
+			add(20, "%d%% of flipped bits are %s, typical for worn out cells", share, direction)
+		case share <= 70:
+			add(-15, "flipped bits are balanced between 1->0 and 0->1 (%d/%d), typical for newly programmed data", report.FlippedBits1To0, report.FlippedBits0To1)
+		}
+	}
+
+	bitsPerByte := float64(flippedBits) / float64(report.ChangedBytes)
+	switch {
+	case bitsPerByte <= 1.5:
+		add(20, "%.2f bits flipped per changed byte on average", bitsPerByte)
+	case bitsPerByte >= 3:
+		add(-20, "%.2f bits flipped per changed byte on average, typical for unrelated data", bitsPerByte)
+	}
+
+	var denseSectors int
+	for _, sector := range report.Sectors {
+		if sector.ChangedBytes >= report.EraseBlockSize/4 {
+			denseSectors++
+		}
+	}
+	switch {
+	case denseSectors > 0:
+		add(-15, "%d erase block(s) have at least a quarter of bytes changed", denseSectors)
+	case report.ChangedBytes <= 4*int64(len(report.Sectors)):
+		add(15, "changes are sparse: %d byte(s) in %d erase block(s)", report.ChangedBytes, len(report.Sectors))
+	}
+
+	if report.EraseBlockAlignedRanges > 0 {
+		add(-25, "%d changed area(s) are aligned to erase blocks", report.EraseBlockAlignedRanges)
+	}
+	if report.PageAlignedRanges > 0 {
+		add(-10, "%d changed area(s) are aligned to pages", report.PageAlignedRanges)
+	}
+
+	stuckAt := map[int16]int{}
+	for _, sector := range report.Sectors {
+		if sector.StuckAtValue != nil {
+			stuckAt[*sector.StuckAtValue]++
+		}
+	}
+	for _, value := range []int16{0x00, 0xff} {
+		if stuckAt[value] > 0 {
+			add(15, "%d erase block(s) have all changed bytes stuck at 0x%02X", stuckAt[value], value)
+		}
+	}
+	return result
+}
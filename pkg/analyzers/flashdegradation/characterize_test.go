@@ -0,0 +1,139 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package flashdegradation
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation/report/generated/flashdegradationanalysis"
+)
+
+func testImage(size int) []byte {
+	image := make([]byte, size)
+	rand.New(rand.NewSource(0)).Read(image)
+	return image
+}
+
+func characterize(t *testing.T, original, actual []byte) *flashdegradationanalysis.CustomReport {
+	report, err := Characterize(original, actual, DefaultEraseBlockSize, DefaultPageSize)
+	require.NoError(t, err)
+	return report
+}
+
+func TestCharacterizeNoDifference(t *testing.T) {
+	image := testImage(64 * 1024)
+	report := characterize(t, image, image)
+	require.Equal(t, flashdegradationanalysis.Verdict_NoDifference, report.Verdict)
+	require.Zero(t, report.ChangedBytes)
+	require.Empty(t, report.Sectors)
+}
+
+func TestCharacterizeBitRot(t *testing.T) {
+	original := testImage(64 * 1024)
+	actual := append([]byte{}, original...)
+	// sparse 0->1 flips (charge loss) in different sectors
+	flipped := 0
+	for _, offset := range []int{0x123, 0x2345, 0x2400, 0x7777, 0xabcd, 0xf001} {
+		for bit := 0; bit < 8; bit++ {
+			if actual[offset]&(1<<bit) == 0 {
+				actual[offset] |= 1 << bit
+				flipped++
+				break
+			}
+		}
+	}
+
+	report := characterize(t, original, actual)
+	require.Equal(t, flashdegradationanalysis.Verdict_LikelyHardwareDegradation, report.Verdict, report.Evidence)
+	require.Equal(t, int64(6), report.ChangedBytes)
+	require.Equal(t, int64(flipped), report.FlippedBits0To1)
+	require.Zero(t, report.FlippedBits1To0)
+	require.Len(t, report.Sectors, 5)
+	require.Equal(t, int64(0x2000), report.Sectors[1].Offset)
+	require.Equal(t, int64(2), report.Sectors[1].ChangedBytes)
+	require.Equal(t, int64(6), report.DiffRanges)
+}
+
+func TestCharacterizeIntentionalWrite(t *testing.T) {
+	original := testImage(64 * 1024)
+	actual := append([]byte{}, original...)
+	// a whole erase block is rewritten with other data
+	rand.New(rand.NewSource(1)).Read(actual[0x3000:0x4000])
+
+	report := characterize(t, original, actual)
+	require.Equal(t, flashdegradationanalysis.Verdict_LikelyIntentionalWrite, report.Verdict, report.Evidence)
+	require.Equal(t, int64(1), report.EraseBlockAlignedRanges)
+	require.Len(t, report.Sectors, 1)
+	require.Nil(t, report.Sectors[0].StuckAtValue)
+}
+
+func TestCharacterizeStuckAt(t *testing.T) {
+	original := testImage(64 * 1024)
+	actual := append([]byte{}, original...)
+	for _, offset := range []int{0x5010, 0x5200, 0x5801} {
+		actual[offset] = 0x00
+	}
+
+	report := characterize(t, original, actual)
+	require.Len(t, report.Sectors, 1)
+	require.NotNil(t, report.Sectors[0].StuckAtValue)
+	require.Equal(t, int16(0), *report.Sectors[0].StuckAtValue)
+	require.Equal(t, int64(3), report.StuckAtZeroBytes)
+}
+
+func TestCharacterizeInvalidInput(t *testing.T) {
+	_, err := Characterize(make([]byte, 2), make([]byte, 3), DefaultEraseBlockSize, DefaultPageSize)
+	require.Error(t, err)
+	_, err = Characterize(make([]byte, 2), make([]byte, 2), 0, DefaultPageSize)
+	require.Error(t, err)
+}
+
+func TestAggregateReports(t *testing.T) {
+	report := func(verdict flashdegradationanalysis.Verdict, changedBytes int64, sectors ...int64) *flashdegradationanalysis.CustomReport {
+		result := &flashdegradationanalysis.CustomReport{
+			Verdict:      verdict,
+			ChangedBytes: changedBytes,
+		}
+		for _, offset := range sectors {
+			result.Sectors = append(result.Sectors, &flashdegradationanalysis.SectorDiff{Offset: offset})
+		}
+		return result
+	}
+
+	trend := AggregateReports([]*flashdegradationanalysis.CustomReport{
+		report(flashdegradationanalysis.Verdict_NoDifference, 0),
+		report(flashdegradationanalysis.Verdict_LikelyHardwareDegradation, 1, 0x2000),
+		report(flashdegradationanalysis.Verdict_LikelyHardwareDegradation, 3, 0x2000, 0x7000),
+		report(flashdegradationanalysis.Verdict_Inconclusive, 5, 0x2000, 0x7000, 0x9000),
+	})
+	require.Equal(t, 4, trend.Reports)
+	require.Equal(t, []int64{0, 1, 3, 5}, trend.ChangedBytes)
+	require.Equal(t, 2, trend.DegradationVerdicts)
+	require.Equal(t, []int64{0x2000, 0x7000}, trend.RecurringSectors)
+	require.True(t, trend.Degrading)
+
+	trend = AggregateReports([]*flashdegradationanalysis.CustomReport{
+		report(flashdegradationanalysis.Verdict_LikelyHardwareDegradation, 1, 0x2000),
+		report(flashdegradationanalysis.Verdict_LikelyIntentionalWrite, 200, 0x3000),
+	})
+	require.False(t, trend.Degrading) // only a half of the reports are LikelyHardwareDegradation
+
+	trend = AggregateReports([]*flashdegradationanalysis.CustomReport{
+		report(flashdegradationanalysis.Verdict_LikelyHardwareDegradation, 2, 0x2000),
+		report(flashdegradationanalysis.Verdict_LikelyHardwareDegradation, 1, 0x2000),
+	})
+	require.False(t, trend.Degrading) // the chip was reflashed
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.flashdegradation.report.generated.flashdegradationanalysis
+
+const string FlashDegradationAnalyzerID = "FlashDegradation";
+
+enum Verdict {
+  NoDifference = 0,
+  LikelyHardwareDegradation = 1,
+  LikelyIntentionalWrite = 2,
+  Inconclusive = 3,
+}
+
+// SectorDiff describes the changes within a single erase block
+struct SectorDiff {
+  1: i64 Offset;
+  2: i64 ChangedBytes;
+  3: i64 FlippedBits1To0;
+  4: i64 FlippedBits0To1;
+  // StuckAtValue is set if all the changed bytes of the sector turned to the same value 0x00 or 0xFF
+  5: optional i16 StuckAtValue;
+}
+
+// Evidence is a single observation contributing to DegradationScore
+struct Evidence {
+  1: string Description;
+  // Weight is positive if the observation supports the hardware degradation
+  // hypothesis and negative if it supports the intentional write hypothesis.
+  2: i32 Weight;
+}
+
+struct CustomReport {
+  1: Verdict Verdict;
+  // DegradationScore is in range [0, 100]: 100 means a certain hardware
+  // degradation, 0 means a certain intentional write.
+  2: i32 DegradationScore;
+  3: list<Evidence> Evidence;
+
+  4: i64 ChangedBytes;
+  5: i64 FlippedBits1To0;
+  6: i64 FlippedBits0To1;
+  // StuckAtZeroBytes and StuckAtOneBytes are the amounts of changed bytes which turned to 0x00 and 0xFF
+  7: i64 StuckAtZeroBytes;
+  8: i64 StuckAtOneBytes;
+
+  9: i64 EraseBlockSize;
+  10: i64 PageSize;
+  // DiffRanges is the amount of contiguous changed areas
+  11: i64 DiffRanges;
+  // EraseBlockAlignedRanges and PageAlignedRanges are the amounts of changed
+  // areas which start on an erase block (page) boundary and cover most of it
+  12: i64 EraseBlockAlignedRanges;
+  13: i64 PageAlignedRanges;
+  14: list<SectorDiff> Sectors;
+}
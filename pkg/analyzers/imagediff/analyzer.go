@@ -0,0 +1,204 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagediff
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff/report/generated/imagediffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/imagecontainer"
+)
+
+func init() {
+	analysis.RegisterType((*imagediffanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of ImageDiff analyzer
+const ID analysis.AnalyzerID = imagediffanalysis.ImageDiffAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
+// maxEntryIssues is the maximal amount of changed entries reported
+// as separate issues, the rest are reported as a single issue.
+const maxEntryIssues = 10
+
+// NewExecutorInput builds an analysis.Executor's input required for ImageDiff analyzer
+func NewExecutorInput(
+	originalFirmware analysis.Blob,
+	actualFirmware analysis.Blob,
+) (analysis.Input, error) {
+	if originalFirmware == nil || actualFirmware == nil {
+		return nil, fmt.Errorf("firmware images should be specified")
+	}
+
+	result := analysis.NewInput()
+	result.AddOriginalFirmware(
+		originalFirmware,
+	).AddActualFirmware(
+		actualFirmware,
+	)
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	ActualFirmware   analysis.ActualFirmwareBlob
+	OriginalFirmware analysis.OriginalFirmwareBlob
+}
+
+// ImageDiff is analyzer which compares firmware images which are not UEFI
+// (BMC, NIC and SSD firmware) by splitting them into files and partitions
+// of known container formats (uImage, FIT, squashfs, UBI and raw chunks).
+type ImageDiff struct{}
+
+// New returns a new object of ImageDiff analyzer
+func New() analysis.Analyzer[Input] {
+	return &ImageDiff{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *ImageDiff) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *ImageDiff) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze compares the actual image with the original one
+func (analyzer *ImageDiff) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	customReport := Compare(in.OriginalFirmware.Bytes(), in.ActualFirmware.Bytes())
+	return &analysis.Report{
+		Custom: customReport,
+		Issues: Issues(customReport),
+	}, nil
+}
+
+// Issues returns the issues found in the report of ImageDiff
+//
+// A change of an entry of a parsed container (like a file of the root
+// filesystem) is critical, while a change of a raw chunk is only a warning,
+// since raw regions usually include writable partitions (like the U-Boot
+// environment or persistent settings).
+func Issues(report imagediffanalysis.CustomReport) []analysis.Issue {
+	var result []analysis.Issue
+	switch report.SizeChange {
+	case imagediffanalysis.SizeChange_Appended:
+		if !report.TailErased {
+			result = append(result, analysis.Issue{
+				Code:        analysis.IssueCodeImageDiffDataAppended,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("%d bytes of data are appended to the original image", report.ActualSize-report.OriginalSize),
+				Params:      map[string]string{"bytes": fmt.Sprint(report.ActualSize - report.OriginalSize)},
+			})
+		}
+	case imagediffanalysis.SizeChange_Truncated:
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeImageDiffTruncated,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("the image is truncated: %d bytes of the original image are missing", report.OriginalSize-report.ActualSize),
+			Params:      map[string]string{"bytes": fmt.Sprint(report.OriginalSize - report.ActualSize)},
+		})
+	case imagediffanalysis.SizeChange_Resized:
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeImageDiffResized,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("the size of the image (%d) differs from the size of the original image (%d)", report.ActualSize, report.OriginalSize),
+			Params:      map[string]string{"actual_size": fmt.Sprint(report.ActualSize), "original_size": fmt.Sprint(report.OriginalSize)},
+		})
+	}
+
+	for _, container := range report.ActualContainers {
+		if container.ParseError == nil || originalHasError(report, container) {
+			continue
+		}
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeImageDiffMalformedContainer,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("%s container at 0x%X is malformed: %s", container.Format, container.Offset, *container.ParseError),
+			Params:      map[string]string{"format": container.Format, "offset": fmt.Sprintf("0x%X", container.Offset)},
+		})
+	}
+
+	var (
+		rawChunks      int
+		rawBytes       int64
+		changedEntries []analysis.Issue
+	)
+	for _, entry := range report.ChangedEntries {
+		if entry.Container == string(imagecontainer.FormatRaw) {
+			rawChunks++
+			rawBytes += maxInt64(entry.GetActualSize(), entry.GetOriginalSize())
+			continue
+		}
+		description := fmt.Sprintf("%s is %s", entry.Path, strings.ToLower(entry.Status.String()))
+		if entry.Status == imagediffanalysis.EntryStatus_Modified && entry.GetActualAttributes() != entry.GetOriginalAttributes() {
+			description += fmt.Sprintf(" (attributes '%s' -> '%s')", entry.GetOriginalAttributes(), entry.GetActualAttributes())
+		}
+		changedEntries = append(changedEntries, analysis.Issue{
+			Code:        analysis.IssueCodeImageDiffEntryChanged,
+			Severity:    analysis.SeverityCritical,
+			Description: description,
+			Params: map[string]string{
+				"path":   entry.Path,
+				"status": strings.ToLower(entry.Status.String()),
+			},
+		})
+	}
+	for idx, issue := range changedEntries {
+		if idx == maxEntryIssues && len(changedEntries) > maxEntryIssues+1 {
+			result = append(result, analysis.Issue{
+				Code:        analysis.IssueCodeImageDiffMoreEntriesChanged,
+				Severity:    analysis.SeverityCritical,
+				Description: fmt.Sprintf("%d more files or partitions are changed", len(changedEntries)-maxEntryIssues),
+				Params:      map[string]string{"count": fmt.Sprint(len(changedEntries) - maxEntryIssues)},
+			})
+			break
+		}
+		result = append(result, issue)
+	}
+	if rawChunks > 0 {
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeImageDiffRawChunksChanged,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("%d raw chunks (up to %d bytes) outside of known containers are changed", rawChunks, rawBytes),
+			Params:      map[string]string{"chunks": fmt.Sprint(rawChunks), "bytes": fmt.Sprint(rawBytes)},
+		})
+	}
+	return result
+}
+
+// originalHasError returns true if the original image has the same
+// container and it is malformed the same way.
+func originalHasError(report imagediffanalysis.CustomReport, container *imagediffanalysis.Container) bool {
+	for _, original := range report.OriginalContainers {
+		if original.Format == container.Format && original.Offset == container.Offset && original.GetParseError() == container.GetParseError() {
+			return true
+		}
+	}
+	return false
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagediff
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff/report/generated/imagediffanalysis"
+)
+
+// testImage builds an image with a uImage at 0 followed by a raw region
+func testImage(kernel []byte, raw []byte) []byte {
+	header := make([]byte, 64)
+	binary.BigEndian.PutUint32(header[0:], 0x27051956)
+	binary.BigEndian.PutUint32(header[12:], uint32(len(kernel)))
+	binary.BigEndian.PutUint32(header[24:], crc32.ChecksumIEEE(kernel))
+	copy(header[32:], "kernel")
+	binary.BigEndian.PutUint32(header[4:], crc32.ChecksumIEEE(header))
+	image := append(header, kernel...)
+	image = append(image, make([]byte, 1024-len(image))...)
+	return append(image, raw...)
+}
+
+func analyze(t *testing.T, original, actual []byte) (imagediffanalysis.CustomReport, []analysis.Issue) {
+	report, err := New().Analyze(context.Background(), Input{
+		ActualFirmware:   analysis.NewActualFirmwareBlob(analysis.BytesBlob(actual)),
+		OriginalFirmware: analysis.NewOriginalFirmwareBlob(analysis.BytesBlob(original)),
+	})
+	require.NoError(t, err)
+	return report.Custom.(imagediffanalysis.CustomReport), report.Issues
+}
+
+func TestAnalyze(t *testing.T) {
+	original := testImage([]byte("kernel"), bytes.Repeat([]byte{1}, 4096))
+
+	t.Run("identical", func(t *testing.T) {
+		report, issues := analyze(t, original, original)
+		require.Empty(t, issues)
+		require.Empty(t, report.ChangedEntries)
+		require.NotZero(t, report.UnchangedEntries)
+		require.Equal(t, imagediffanalysis.SizeChange_None, report.SizeChange)
+	})
+
+	t.Run("modified_kernel", func(t *testing.T) {
+		report, issues := analyze(t, original, testImage([]byte("KERNEL"), bytes.Repeat([]byte{1}, 4096)))
+		// the header contains the CRC of the data, so it is changed as well
+		require.Len(t, report.ChangedEntries, 2)
+		require.Equal(t, "uImage@0x0/data", report.ChangedEntries[0].Path)
+		require.Equal(t, imagediffanalysis.EntryStatus_Modified, report.ChangedEntries[0].Status)
+		require.Equal(t, "uImage@0x0/header", report.ChangedEntries[1].Path)
+		require.Len(t, issues, 2)
+		require.Equal(t, analysis.SeverityCritical, issues[0].Severity)
+	})
+
+	t.Run("modified_raw", func(t *testing.T) {
+		report, issues := analyze(t, original, testImage([]byte("kernel"), bytes.Repeat([]byte{2}, 4096)))
+		require.Len(t, report.ChangedEntries, 1)
+		require.Equal(t, "raw", report.ChangedEntries[0].Container)
+		require.Len(t, issues, 1)
+		require.Equal(t, analysis.SeverityWarning, issues[0].Severity)
+	})
+
+	t.Run("appended", func(t *testing.T) {
+		report, issues := analyze(t, original, append(append([]byte{}, original...), "payload"...))
+		require.Equal(t, imagediffanalysis.SizeChange_Appended, report.SizeChange)
+		require.False(t, report.TailErased)
+		require.NotEmpty(t, issues)
+		require.Equal(t, analysis.SeverityWarning, issues[0].Severity)
+
+		report, issues = analyze(t, original, append(append([]byte{}, original...), 0xFF, 0xFF))
+		require.Equal(t, imagediffanalysis.SizeChange_Appended, report.SizeChange)
+		require.True(t, report.TailErased)
+		require.Len(t, issues, 1) // only the changed raw chunk
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		report, issues := analyze(t, original, original[:67])
+		require.Equal(t, imagediffanalysis.SizeChange_Truncated, report.SizeChange)
+		require.NotNil(t, report.ActualContainers[0].ParseError)
+		var descriptions []string
+		for _, issue := range issues {
+			descriptions = append(descriptions, issue.Description)
+		}
+		require.Contains(t, descriptions, "uImage container at 0x0 is malformed: the container is truncated: expected 70 bytes, but only 67 are available")
+	})
+}
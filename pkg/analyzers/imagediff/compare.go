@@ -0,0 +1,155 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagediff
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff/report/generated/imagediffanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/imagecontainer"
+)
+
+type containerEntry struct {
+	imagecontainer.Entry
+	Format imagecontainer.Format
+}
+
+// Compare splits both images into containers and compares them entry by entry.
+func Compare(original, actual []byte) imagediffanalysis.CustomReport {
+	originalContainers := imagecontainer.Parse(original)
+	actualContainers := imagecontainer.Parse(actual)
+
+	report := imagediffanalysis.CustomReport{
+		ActualSize:         int64(len(actual)),
+		OriginalSize:       int64(len(original)),
+		ActualContainers:   convertContainers(actualContainers),
+		OriginalContainers: convertContainers(originalContainers),
+	}
+	report.SizeChange, report.TailSHA256, report.TailErased = compareSizes(original, actual)
+
+	originalEntries := entriesByPath(originalContainers)
+	actualEntries := entriesByPath(actualContainers)
+	paths := make([]string, 0, len(originalEntries)+len(actualEntries))
+	for path := range originalEntries {
+		paths = append(paths, path)
+	}
+	for path := range actualEntries {
+		if _, ok := originalEntries[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		originalEntry, inOriginal := originalEntries[path]
+		actualEntry, inActual := actualEntries[path]
+		entry := &imagediffanalysis.Entry{
+			Path: path,
+		}
+		switch {
+		case !inActual:
+			entry.Status = imagediffanalysis.EntryStatus_Removed
+		case !inOriginal:
+			entry.Status = imagediffanalysis.EntryStatus_Added
+		case originalEntry.Entry != actualEntry.Entry:
+			entry.Status = imagediffanalysis.EntryStatus_Modified
+		default:
+			report.UnchangedEntries++
+			continue
+		}
+		if inOriginal {
+			entry.Container = string(originalEntry.Format)
+			entry.OriginalSize = ptr(int64(originalEntry.Size))
+			entry.OriginalSHA256 = originalEntry.SHA256[:]
+			if originalEntry.Attributes != "" {
+				entry.OriginalAttributes = ptr(originalEntry.Attributes)
+			}
+		}
+		if inActual {
+			entry.Container = string(actualEntry.Format)
+			entry.ActualSize = ptr(int64(actualEntry.Size))
+			entry.ActualSHA256 = actualEntry.SHA256[:]
+			if actualEntry.Attributes != "" {
+				entry.ActualAttributes = ptr(actualEntry.Attributes)
+			}
+		}
+		report.ChangedEntries = append(report.ChangedEntries, entry)
+	}
+	return report
+}
+
+func compareSizes(original, actual []byte) (imagediffanalysis.SizeChange, []byte, bool) {
+	switch {
+	case len(original) == len(actual):
+		return imagediffanalysis.SizeChange_None, nil, false
+	case len(actual) > len(original) && bytes.HasPrefix(actual, original):
+		tail := actual[len(original):]
+		return imagediffanalysis.SizeChange_Appended, sha256Slice(tail), isErased(tail)
+	case len(actual) < len(original) && bytes.HasPrefix(original, actual):
+		tail := original[len(actual):]
+		return imagediffanalysis.SizeChange_Truncated, sha256Slice(tail), isErased(tail)
+	default:
+		return imagediffanalysis.SizeChange_Resized, nil, false
+	}
+}
+
+func convertContainers(containers []imagecontainer.Container) []*imagediffanalysis.Container {
+	result := make([]*imagediffanalysis.Container, 0, len(containers))
+	for _, container := range containers {
+		c := &imagediffanalysis.Container{
+			Format:  string(container.Format),
+			Offset:  int64(container.Offset),
+			Size:    int64(container.Size),
+			Name:    container.Name,
+			Entries: int32(len(container.Entries)),
+		}
+		if container.ParseError != nil {
+			c.ParseError = ptr(container.ParseError.Error())
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func entriesByPath(containers []imagecontainer.Container) map[string]containerEntry {
+	result := map[string]containerEntry{}
+	for _, container := range containers {
+		for _, entry := range container.Entries {
+			result[entry.Path] = containerEntry{
+				Entry:  entry,
+				Format: container.Format,
+			}
+		}
+	}
+	return result
+}
+
+func isErased(b []byte) bool {
+	for _, v := range b {
+		if v != 0xFF {
+			return false
+		}
+	}
+	return true
+}
+
+func sha256Slice(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return h[:]
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
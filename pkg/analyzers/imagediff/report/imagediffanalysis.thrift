@@ -0,0 +1,77 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.imagediff.report.generated.imagediffanalysis
+
+const string ImageDiffAnalyzerID = "ImageDiff";
+
+enum EntryStatus {
+  Unchanged = 0,
+  // Modified means the content or the attributes of the entry differ
+  Modified = 1,
+  // Added means the entry is found only in the actual image
+  Added = 2,
+  // Removed means the entry is found only in the original image
+  Removed = 3,
+}
+
+// Entry is a file or a partition of a container
+struct Entry {
+  // Path identifies the entry within the image, for example "squashfs@0x4A0000/usr/bin/ipmid"
+  1: string Path;
+  // Container is the format of the container: "uImage", "FIT", "FDT", "squashfs", "UBI" or "raw"
+  2: string Container;
+  3: EntryStatus Status;
+  4: optional i64 ActualSize;
+  5: optional binary ActualSHA256;
+  // ActualAttributes are the file attributes (like "mode=0755 uid=0 gid=0") for entries of filesystems
+  6: optional string ActualAttributes;
+  7: optional i64 OriginalSize;
+  8: optional binary OriginalSHA256;
+  9: optional string OriginalAttributes;
+}
+
+struct Container {
+  1: string Format;
+  2: i64 Offset;
+  3: i64 Size;
+  // Name is the image name stored in the container (if any)
+  4: string Name;
+  5: i32 Entries;
+  // ParseError is set if the container is malformed (for example truncated) or not supported
+  6: optional string ParseError;
+}
+
+enum SizeChange {
+  None = 0,
+  // Appended means the original image is a prefix of the actual one
+  Appended = 1,
+  // Truncated means the actual image is a prefix of the original one
+  Truncated = 2,
+  // Resized means the sizes differ and neither image is a prefix of the other
+  Resized = 3,
+}
+
+struct CustomReport {
+  1: i64 ActualSize;
+  2: i64 OriginalSize;
+  3: SizeChange SizeChange;
+  // TailSHA256 is the hash of the appended data (if Appended) or the cut off data (if Truncated)
+  4: optional binary TailSHA256;
+  // TailErased is true if the appended or cut off data consists of 0xFF bytes only
+  5: bool TailErased;
+  6: list<Container> ActualContainers;
+  7: list<Container> OriginalContainers;
+  // ChangedEntries are the entries with status other than Unchanged
+  8: list<Entry> ChangedEntries;
+  9: i32 UnchangedEntries;
+}
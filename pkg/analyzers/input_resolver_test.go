@@ -0,0 +1,185 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analyzers
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
+	"github.com/immune-gmbh/attestation-sdk/pkg/dmidecode"
+)
+
+// testImage returns an image which is parsed as an UEFI image and as an AMD
+// firmware (it contains only an Embedded Firmware Structure).
+func testImage() []byte {
+	image := bytes.Repeat([]byte{0xff}, 0x100000)
+	binary.LittleEndian.PutUint32(image[0x20000:], 0x55AA55AA)
+	return image
+}
+
+type testInput struct {
+	Input analysis.Input
+
+	// Resolvable defines if the inputs of all analyzers are expected to be resolved.
+	Resolvable bool
+}
+
+func testInputs(t *testing.T) map[string]testInput {
+	actualRegisters, err := analysis.NewActualRegisters(nil)
+	require.NoError(t, err)
+
+	withFirmwares := func() analysis.Input {
+		return analysis.NewInput().
+			AddActualFirmware(analysis.BytesBlob(testImage())).
+			AddOriginalFirmware(analysis.BytesBlob(testImage()))
+	}
+
+	return map[string]testInput{
+		"empty":     {Input: analysis.NewInput()},
+		"firmwares": {Input: withFirmwares()},
+		"everything": {
+			Input: withFirmwares().
+				AddActualRegisters(actualRegisters).
+				AddTPMDevice(tpmdetection.TypeTPM20).
+				AddTPMEventLog(&tpmeventlog.TPMEventLog{}).
+				AddActualPCR0([]byte{1, 2, 3}).
+				AddActualACPITables(nil).
+				AddPSBFusedKeyHash([]byte{4, 5, 6}).
+				AddAssetID(1).
+				AddModelID(2).
+				AddActualBIOSInfo(*analysis.NewActualBIOSInfo(dmidecode.BIOSInfo{Vendor: "vendor", Version: "1.0"})).
+				AddCustomValue(bootchain.AllowlistHash("allowlist")).
+				AddCustomValue(diffmeasuredboot.NVRAMRulesHash("nvram rules")).
+				AddCustomValue(diffmeasuredboot.DiagnosisRulesHash("diagnosis rules")).
+				AddCustomValue(firmwareprovenance.Catalog{}).
+				AddCustomValue(optionroms.Catalog{}).
+				AddCustomValue(reproducepcr.ExpectedPCR0{1, 2, 3}).
+				AddCustomValue(txterrors.ErrorCodesHash("error codes")).
+				AddCustomValue(vulnerablemodules.AdvisoryDBHash("advisories")),
+			Resolvable: true,
+		},
+	}
+}
+
+// checkInputResolver verifies the generated input resolver of an analyzer
+// produces exactly the same results as the reflection-based one.
+func checkInputResolver[inputType any](t *testing.T, id analysis.AnalyzerID) {
+	t.Run(string(id), func(t *testing.T) {
+		require.True(t, analysis.HasGeneratedInputResolver[inputType](), "run 'go generate ./pkg/analyzers/...'")
+
+		require.Equal(t,
+			analysis.DescribeInputByReflection(reflect.TypeOf((*inputType)(nil)).Elem()),
+			analysis.DescribeInput[inputType](),
+		)
+
+		for name, testCase := range testInputs(t) {
+			in := testCase.Input
+			t.Run(name, func(t *testing.T) {
+				ctx := context.Background()
+
+				dcReflection, err := analysis.NewDataCalculator(0)
+				require.NoError(t, err)
+				expected, expectedIssues, expectedErr := analysis.ResolveInputByReflection[inputType](ctx, dcReflection, in, analysis.NewDataCache())
+
+				dcGenerated, err := analysis.NewDataCalculator(0)
+				require.NoError(t, err)
+				actual, actualIssues, actualErr := analysis.ResolveInput[inputType](ctx, dcGenerated, in, analysis.NewDataCache())
+
+				if testCase.Resolvable {
+					require.NoError(t, expectedErr)
+					require.NoError(t, actualErr)
+					require.NotEmpty(t, expected)
+				}
+				require.Equal(t, expectedErr, actualErr)
+				require.Equal(t, expectedIssues, actualIssues)
+				require.Equal(t, expected, actual)
+			})
+		}
+	})
+}
+
+func TestGeneratedInputResolvers(t *testing.T) {
+	checked := []analysis.AnalyzerID{
+		acpitables.ID,
+		apcbsectokens.ID,
+		biosrtmvolume.ID,
+		bootchain.ID,
+		diffmeasuredboot.ID,
+		firmwareprovenance.ID,
+		flashdegradation.ID,
+		imagediff.ID,
+		intelacm.ID,
+		intelifd.ID,
+		intelme.ID,
+		optionroms.ID,
+		psbfuses.ID,
+		pspsignature.ID,
+		pspspl.ID,
+		reproducepcr.ID,
+		txterrors.ID,
+		vulnerablemodules.ID,
+	}
+	checkInputResolver[acpitables.Input](t, acpitables.ID)
+	checkInputResolver[apcbsectokens.Input](t, apcbsectokens.ID)
+	checkInputResolver[biosrtmvolume.Input](t, biosrtmvolume.ID)
+	checkInputResolver[bootchain.Input](t, bootchain.ID)
+	checkInputResolver[diffmeasuredboot.Input](t, diffmeasuredboot.ID)
+	checkInputResolver[firmwareprovenance.Input](t, firmwareprovenance.ID)
+	checkInputResolver[flashdegradation.Input](t, flashdegradation.ID)
+	checkInputResolver[imagediff.Input](t, imagediff.ID)
+	checkInputResolver[intelacm.Input](t, intelacm.ID)
+	checkInputResolver[intelifd.Input](t, intelifd.ID)
+	checkInputResolver[intelme.Input](t, intelme.ID)
+	checkInputResolver[optionroms.Input](t, optionroms.ID)
+	checkInputResolver[psbfuses.Input](t, psbfuses.ID)
+	checkInputResolver[pspsignature.Input](t, pspsignature.ID)
+	checkInputResolver[pspspl.Input](t, pspspl.ID)
+	checkInputResolver[reproducepcr.Input](t, reproducepcr.ID)
+	checkInputResolver[txterrors.Input](t, txterrors.ID)
+	checkInputResolver[vulnerablemodules.Input](t, vulnerablemodules.ID)
+
+	// make sure no analyzer is forgotten
+	registry, err := NewRegistryWithKnownAnalyzers(nil)
+	require.NoError(t, err)
+	registered := registry.IDs()
+	sort.Slice(registered, func(i, j int) bool { return registered[i] < registered[j] })
+	sort.Slice(checked, func(i, j int) bool { return checked[i] < checked[j] })
+	require.Equal(t, registered, checked)
+}
@@ -12,6 +12,8 @@
 
 package intelacm
 
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
 import (
 	"context"
 	"errors"
@@ -32,6 +34,9 @@ func init() {
 // ID represents the unique id of DiffMeasuredBoot analyzer
 const ID analysis.AnalyzerID = intelacmanalysis.IntelACMAnalyzerID
 
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // NewExecutorInput builds an analysis.Executor's input required for IntelACM analyzer
 func NewExecutorInput(
 	originalFirmware analysis.Blob,
@@ -69,6 +74,11 @@ func (analyzer *IntelACM) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *IntelACM) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze makes the ACM gathering
 func (analyzer *IntelACM) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
 	var wg sync.WaitGroup
@@ -107,6 +117,7 @@ func (analyzer *IntelACM) Analyze(ctx context.Context, in Input) (*analysis.Repo
 	for _, err := range []error{errOriginal, errReceived} {
 		if err != nil {
 			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeIntelACMInfoUnavailable,
 				Severity:    analysis.SeverityWarning,
 				Description: err.Error(),
 			})
@@ -117,8 +128,10 @@ func (analyzer *IntelACM) Analyze(ctx context.Context, in Input) (*analysis.Repo
 		if !reflect.DeepEqual(originalACM, receivedACM) {
 			// TODO: use internal types instead of thrift ones, and define GoString() instead of `formatACM`.
 			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeIntelACMMismatch,
 				Severity:    analysis.SeverityCritical,
 				Description: fmt.Sprintf("Different ACM info. Original: '%s', actual: '%s'", formatACM(originalACM), formatACM(receivedACM)),
+				Params:      map[string]string{"original": formatACM(originalACM), "actual": formatACM(receivedACM)},
 			})
 		}
 	}
@@ -0,0 +1,266 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package intelifd
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/linuxboot/fiano/pkg/uefi"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+)
+
+func init() {
+	analysis.RegisterType((*intelifdanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of IntelFlashDescriptor analyzer
+const ID analysis.AnalyzerID = intelifdanalysis.IntelFlashDescriptorAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
+// NewExecutorInput builds an analysis.Executor's input required for IntelFlashDescriptor analyzer
+//
+// Optional arguments: originalFirmware
+func NewExecutorInput(
+	originalFirmware analysis.Blob,
+	actualFirmware analysis.Blob,
+) (analysis.Input, error) {
+	if actualFirmware == nil {
+		return nil, fmt.Errorf("the actual firmware image should be specified")
+	}
+
+	result := analysis.NewInput()
+	result.AddActualFirmware(
+		actualFirmware,
+	)
+	if originalFirmware != nil {
+		result.AddOriginalFirmware(
+			originalFirmware,
+		)
+	}
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	ActualFirmware   analysis.ActualFirmwareBlob
+	OriginalFirmware *analysis.OriginalFirmwareBlob `exec:"optional"`
+}
+
+// IntelFlashDescriptor is analyzer that checks the Intel Flash Descriptor: the
+// region layout, the masters access permissions and the ME-disable straps.
+//
+// The descriptor is usually not measured, so a modification of it is not
+// detected by measured boot.
+type IntelFlashDescriptor struct{}
+
+// New returns a new object of IntelFlashDescriptor analyzer
+func New() analysis.Analyzer[Input] {
+	return &IntelFlashDescriptor{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *IntelFlashDescriptor) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *IntelFlashDescriptor) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze parses the flash descriptor of the actual image and compares it with the original one
+func (analyzer *IntelFlashDescriptor) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	log := logger.FromCtx(ctx)
+
+	actualImage := in.ActualFirmware.Bytes()
+	actualInfo, err := GetFlashDescriptorInfo(actualImage)
+	if err != nil {
+		if errors.As(err, &ErrNoFlashDescriptor{}) {
+			log.Infof("No Intel flash descriptor in the actual image, skip analysis")
+			return nil, analysis.NewErrNotApplicable("no Intel flash descriptor")
+		}
+		return nil, fmt.Errorf("unable to parse the flash descriptor of the actual image: %w", err)
+	}
+
+	customReport := intelifdanalysis.CustomReport{
+		Actual: actualInfo,
+	}
+	result := &analysis.Report{}
+	result.Issues = append(result.Issues, accessIssues(actualInfo)...)
+
+	if in.OriginalFirmware != nil {
+		originalImage := in.OriginalFirmware.Bytes()
+		originalInfo, err := GetFlashDescriptorInfo(originalImage)
+		if err != nil {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeIntelFlashDescriptorOriginalUnparsable,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("unable to parse the flash descriptor of the original image: %v", err),
+			})
+		} else {
+			customReport.Original = originalInfo
+			customReport.Differences = compareDescriptors(
+				originalInfo, actualInfo,
+				originalImage[:uefi.FlashDescriptorLength], actualImage[:uefi.FlashDescriptorLength],
+			)
+		}
+	}
+
+	if len(customReport.Differences) > 0 {
+		fields := make([]string, 0, len(customReport.Differences))
+		for _, difference := range customReport.Differences {
+			fields = append(fields, difference.Field)
+		}
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeIntelFlashDescriptorChanged,
+			Severity:    analysis.SeverityCritical,
+			Description: fmt.Sprintf("the flash descriptor differs from the original one, fields: %s", strings.Join(fields, ", ")),
+			Params:      map[string]string{"fields": strings.Join(fields, ",")},
+		})
+	}
+
+	result.Custom = customReport
+	return result, nil
+}
+
+func accessIssues(info *intelifdanalysis.FlashDescriptorInfo) []analysis.Issue {
+	var result []analysis.Issue
+	var descriptorWriters []string
+	for _, master := range info.Masters {
+		if hasRegion(master.WritableRegions, intelifdanalysis.FlashRegionType_Descriptor) {
+			descriptorWriters = append(descriptorWriters, master.Master.String())
+		}
+		switch master.Master {
+		case intelifdanalysis.FlashMaster_ManagementEngine, intelifdanalysis.FlashMaster_GigabitEthernet:
+			if hasRegion(master.WritableRegions, intelifdanalysis.FlashRegionType_BIOS) {
+				result = append(result, analysis.Issue{
+					Code:        analysis.IssueCodeIntelFlashDescriptorBIOSRegionWritable,
+					Severity:    analysis.SeverityCritical,
+					Description: fmt.Sprintf("the BIOS region is writable by master %s (FLMSTR%d: 0x%08X)", master.Master, master.Master, uint32(master.RawValue)),
+					Params:      map[string]string{"master": master.Master.String()},
+				})
+			}
+		}
+	}
+	if !info.Locked {
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeIntelFlashDescriptorUnlocked,
+			Severity:    analysis.SeverityWarning,
+			Description: fmt.Sprintf("the flash descriptor is not locked, it is writable by: %s", strings.Join(descriptorWriters, ", ")),
+			Params:      map[string]string{"writers": strings.Join(descriptorWriters, ",")},
+		})
+	}
+	return result
+}
+
+func compareDescriptors(
+	original, actual *intelifdanalysis.FlashDescriptorInfo,
+	originalBytes, actualBytes []byte,
+) []*intelifdanalysis.DescriptorDifference {
+	var result []*intelifdanalysis.DescriptorDifference
+	addIfDiffers := func(field string, originalValue, actualValue string) {
+		if originalValue == actualValue {
+			return
+		}
+		result = append(result, &intelifdanalysis.DescriptorDifference{
+			Field:    field,
+			Original: originalValue,
+			Actual:   actualValue,
+		})
+	}
+
+	addIfDiffers("Version", original.Version.String(), actual.Version.String())
+
+	originalRegions, actualRegions := regionsByType(original.Regions), regionsByType(actual.Regions)
+	for regionType := intelifdanalysis.FlashRegionType_Descriptor; regionType <= intelifdanalysis.FlashRegionType_PTT; regionType++ {
+		addIfDiffers(
+			fmt.Sprintf("Regions.%s", regionType),
+			formatRegion(originalRegions[regionType]), formatRegion(actualRegions[regionType]),
+		)
+	}
+
+	originalMasters, actualMasters := mastersByType(original.Masters), mastersByType(actual.Masters)
+	for _, master := range mastersIFDv2 {
+		addIfDiffers(
+			fmt.Sprintf("Masters.%s", master),
+			formatMasterAccess(originalMasters[master]), formatMasterAccess(actualMasters[master]),
+		)
+	}
+
+	addIfDiffers("Locked", fmt.Sprint(original.Locked), fmt.Sprint(actual.Locked))
+	addIfDiffers("HAP", formatOptionalBool(original.HAP), formatOptionalBool(actual.HAP))
+	addIfDiffers("AltMEDisable", formatOptionalBool(original.AltMEDisable), formatOptionalBool(actual.AltMEDisable))
+
+	// Everything else (straps, VSCC table, OEM section) is compared as raw data
+	if !bytes.Equal(originalBytes, actualBytes) {
+		addIfDiffers("Bytes", fmt.Sprintf("SHA256:%X", sha256.Sum256(originalBytes)), fmt.Sprintf("SHA256:%X", sha256.Sum256(actualBytes)))
+	}
+	return result
+}
+
+func regionsByType(regions []*intelifdanalysis.FlashRegion) map[intelifdanalysis.FlashRegionType]*intelifdanalysis.FlashRegion {
+	result := make(map[intelifdanalysis.FlashRegionType]*intelifdanalysis.FlashRegion, len(regions))
+	for _, region := range regions {
+		result[region.Type] = region
+	}
+	return result
+}
+
+func mastersByType(masters []*intelifdanalysis.MasterAccess) map[intelifdanalysis.FlashMaster]*intelifdanalysis.MasterAccess {
+	result := make(map[intelifdanalysis.FlashMaster]*intelifdanalysis.MasterAccess, len(masters))
+	for _, master := range masters {
+		result[master.Master] = master
+	}
+	return result
+}
+
+func formatRegion(region *intelifdanalysis.FlashRegion) string {
+	if region == nil {
+		return "<absent>"
+	}
+	return fmt.Sprintf("0x%08X-0x%08X", region.Offset, region.Offset+region.Length)
+}
+
+func formatMasterAccess(master *intelifdanalysis.MasterAccess) string {
+	if master == nil {
+		return "<absent>"
+	}
+	return fmt.Sprintf("read: [%s], write: [%s]", formatRegionTypes(master.ReadableRegions), formatRegionTypes(master.WritableRegions))
+}
+
+func formatRegionTypes(regions []intelifdanalysis.FlashRegionType) string {
+	result := make([]string, 0, len(regions))
+	for _, region := range regions {
+		result = append(result, region.String())
+	}
+	return strings.Join(result, ",")
+}
+
+func formatOptionalBool(v *bool) string {
+	if v == nil {
+		return "<not applicable>"
+	}
+	return fmt.Sprint(*v)
+}
@@ -0,0 +1,265 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package intelifd
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/linuxboot/fiano/pkg/uefi"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+)
+
+// See "Intel 100 Series Chipset Family PCH SPI Programming Guide" and
+// coreboot's util/ifdtool for the layout of the descriptor.
+const (
+	flcompReadFreqShift = 17
+	flcompReadFreqMask  = 0x7
+
+	readFreq20MHz    = 0x0
+	readFreq50_30MHz = 0x4
+	readFreq17MHz    = 0x6
+
+	flregBaseMask  = 0x7fff
+	flregLimitMask = 0x7fff0000
+
+	hapStrapIndex = 0
+	hapStrapBit   = 16
+
+	altMEDisableStrapIndex = 10
+	altMEDisableStrapBit   = 7
+)
+
+var (
+	mastersIFDv1 = []intelifdanalysis.FlashMaster{
+		intelifdanalysis.FlashMaster_HostCPU,
+		intelifdanalysis.FlashMaster_ManagementEngine,
+		intelifdanalysis.FlashMaster_GigabitEthernet,
+	}
+	mastersIFDv2 = []intelifdanalysis.FlashMaster{
+		intelifdanalysis.FlashMaster_HostCPU,
+		intelifdanalysis.FlashMaster_ManagementEngine,
+		intelifdanalysis.FlashMaster_GigabitEthernet,
+		intelifdanalysis.FlashMaster_EmbeddedController,
+	}
+)
+
+// GetFlashDescriptorInfo parses the Intel Flash Descriptor of a firmware image
+func GetFlashDescriptorInfo(image []byte) (*intelifdanalysis.FlashDescriptorInfo, error) {
+	if len(image) < uefi.FlashDescriptorLength {
+		return nil, ErrNoFlashDescriptor{err: fmt.Errorf("image is too small: %d < %d", len(image), uefi.FlashDescriptorLength)}
+	}
+	descriptor := image[:uefi.FlashDescriptorLength]
+
+	descriptorMapStart, err := uefi.FindSignature(descriptor)
+	if err != nil {
+		return nil, ErrNoFlashDescriptor{err: err}
+	}
+	descriptorMap, err := uefi.NewFlashDescriptorMap(descriptor[descriptorMapStart:])
+	if err != nil {
+		return nil, ErrInvalidFlashDescriptor{err: fmt.Errorf("unable to parse the descriptor map: %w", err)}
+	}
+
+	version, err := getDescriptorVersion(descriptor, descriptorMap)
+	if err != nil {
+		return nil, ErrInvalidFlashDescriptor{err: err}
+	}
+
+	regions, err := getRegions(descriptor, descriptorMap, version)
+	if err != nil {
+		return nil, ErrInvalidFlashDescriptor{err: err}
+	}
+
+	masters, err := getMasters(descriptor, descriptorMap, version)
+	if err != nil {
+		return nil, ErrInvalidFlashDescriptor{err: err}
+	}
+
+	result := &intelifdanalysis.FlashDescriptorInfo{
+		Version: version,
+		Regions: regions,
+		Masters: masters,
+		Locked:  true,
+	}
+	for _, master := range masters {
+		if hasRegion(master.WritableRegions, intelifdanalysis.FlashRegionType_Descriptor) {
+			result.Locked = false
+		}
+	}
+
+	switch version {
+	case intelifdanalysis.DescriptorVersion_IFDv1:
+		if v, ok := getPCHStrapBit(descriptor, descriptorMap, altMEDisableStrapIndex, altMEDisableStrapBit); ok {
+			result.AltMEDisable = &v
+		}
+	case intelifdanalysis.DescriptorVersion_IFDv2:
+		if v, ok := getPCHStrapBit(descriptor, descriptorMap, hapStrapIndex, hapStrapBit); ok {
+			result.HAP = &v
+		}
+	}
+	return result, nil
+}
+
+// getDescriptorVersion guesses the descriptor version by the SPI read clock
+// frequency, the same way as coreboot's ifdtool does.
+func getDescriptorVersion(descriptor []byte, descriptorMap *uefi.FlashDescriptorMap) (intelifdanalysis.DescriptorVersion, error) {
+	flcomp, err := readDWord(descriptor, uint(descriptorMap.ComponentBase)*0x10)
+	if err != nil {
+		return intelifdanalysis.DescriptorVersion_Unknown, fmt.Errorf("unable to read FLCOMP: %w", err)
+	}
+	switch readFreq := (flcomp >> flcompReadFreqShift) & flcompReadFreqMask; readFreq {
+	case readFreq20MHz:
+		return intelifdanalysis.DescriptorVersion_IFDv1, nil
+	case readFreq17MHz, readFreq50_30MHz:
+		return intelifdanalysis.DescriptorVersion_IFDv2, nil
+	default:
+		return intelifdanalysis.DescriptorVersion_Unknown, fmt.Errorf("unknown SPI read clock frequency: 0x%X", readFreq)
+	}
+}
+
+func getRegions(
+	descriptor []byte,
+	descriptorMap *uefi.FlashDescriptorMap,
+	version intelifdanalysis.DescriptorVersion,
+) ([]*intelifdanalysis.FlashRegion, error) {
+	regionsCount := 5
+	if version == intelifdanalysis.DescriptorVersion_IFDv2 {
+		regionsCount = 16
+	}
+
+	var result []*intelifdanalysis.FlashRegion
+	for idx := 0; idx < regionsCount; idx++ {
+		flreg, err := readDWord(descriptor, uint(descriptorMap.RegionBase)*0x10+uint(idx)*4)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read FLREG%d: %w", idx, err)
+		}
+		base := int64(flreg&flregBaseMask) << 12
+		limit := int64(flreg&flregLimitMask)>>4 | 0xfff
+		if limit < base {
+			// the region is not used
+			continue
+		}
+		result = append(result, &intelifdanalysis.FlashRegion{
+			Type:   intelifdanalysis.FlashRegionType(idx),
+			Offset: base,
+			Length: limit - base + 1,
+		})
+	}
+	return result, nil
+}
+
+func getMasters(
+	descriptor []byte,
+	descriptorMap *uefi.FlashDescriptorMap,
+	version intelifdanalysis.DescriptorVersion,
+) ([]*intelifdanalysis.MasterAccess, error) {
+	masters := mastersIFDv1
+	if version == intelifdanalysis.DescriptorVersion_IFDv2 {
+		masters = mastersIFDv2
+	}
+
+	result := make([]*intelifdanalysis.MasterAccess, 0, len(masters))
+	for _, master := range masters {
+		flmstr, err := readDWord(descriptor, uint(descriptorMap.MasterBase)*0x10+uint(master-1)*4)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read FLMSTR%d: %w", master, err)
+		}
+
+		var readAccess, writeAccess uint32
+		switch version {
+		case intelifdanalysis.DescriptorVersion_IFDv1:
+			// bits 23:16 are read access for regions 0-7, bits 31:24 are write access
+			readAccess = (flmstr >> 16) & 0xff
+			writeAccess = (flmstr >> 24) & 0xff
+		default:
+			// bits 19:8 are read access for regions 0-11, bits 31:20 are write access,
+			// bits 3:0 and 7:4 are the same for regions 12-15
+			readAccess = (flmstr>>8)&0xfff | (flmstr&0xf)<<12
+			writeAccess = (flmstr>>20)&0xfff | ((flmstr>>4)&0xf)<<12
+		}
+
+		result = append(result, &intelifdanalysis.MasterAccess{
+			Master:          master,
+			ReadableRegions: accessBitsToRegions(readAccess),
+			WritableRegions: accessBitsToRegions(writeAccess),
+			RawValue:        int32(flmstr),
+		})
+	}
+	return result, nil
+}
+
+func accessBitsToRegions(bits uint32) []intelifdanalysis.FlashRegionType {
+	var result []intelifdanalysis.FlashRegionType
+	for idx := 0; idx < 16; idx++ {
+		if bits&(1<<idx) != 0 {
+			result = append(result, intelifdanalysis.FlashRegionType(idx))
+		}
+	}
+	return result
+}
+
+func getPCHStrapBit(descriptor []byte, descriptorMap *uefi.FlashDescriptorMap, strapIndex uint, bit uint) (bool, bool) {
+	if strapIndex >= uint(descriptorMap.NumberOfPchStraps) {
+		return false, false
+	}
+	strap, err := readDWord(descriptor, uint(descriptorMap.PchStrapsBase)*0x10+strapIndex*4)
+	if err != nil {
+		return false, false
+	}
+	return strap&(1<<bit) != 0, true
+}
+
+func readDWord(descriptor []byte, offset uint) (uint32, error) {
+	if offset+4 > uint(len(descriptor)) {
+		return 0, fmt.Errorf("offset 0x%X is out of the descriptor bounds", offset)
+	}
+	return binary.LittleEndian.Uint32(descriptor[offset:]), nil
+}
+
+func hasRegion(regions []intelifdanalysis.FlashRegionType, region intelifdanalysis.FlashRegionType) bool {
+	for _, r := range regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrNoFlashDescriptor means that the image does not start with an Intel Flash Descriptor
+type ErrNoFlashDescriptor struct {
+	err error
+}
+
+func (e ErrNoFlashDescriptor) Error() string {
+	return fmt.Sprintf("Intel flash descriptor is not found: %v", e.err)
+}
+
+// Unwrap returns the underlying error
+func (e ErrNoFlashDescriptor) Unwrap() error {
+	return e.err
+}
+
+// ErrInvalidFlashDescriptor means that the Intel Flash Descriptor was found, but could not be parsed
+type ErrInvalidFlashDescriptor struct {
+	err error
+}
+
+func (e ErrInvalidFlashDescriptor) Error() string {
+	return fmt.Sprintf("invalid Intel flash descriptor: %v", e.err)
+}
+
+// Unwrap returns the underlying error
+func (e ErrInvalidFlashDescriptor) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package intelifd
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+)
+
+const (
+	testFLMSTRBIOS = 0x00a00f00 // read: Descriptor,BIOS,ME,GbE; write: BIOS,GbE
+	testFLMSTRME   = 0x00400700 // read: Descriptor,BIOS,ME; write: ME
+	testFLMSTRGbE  = 0x00800800 // read: GbE; write: GbE
+)
+
+// newTestImage builds a minimal IFDv2 image with a descriptor, an ME region and a BIOS region.
+func newTestImage(flmstrME uint32) []byte {
+	image := make([]byte, 0x400000)
+	for idx := range image[:0x10] {
+		image[idx] = 0xff
+	}
+	copy(image[0x10:], []byte{0x5a, 0xa5, 0xf0, 0x0f})
+	put := func(offset int, value uint32) {
+		binary.LittleEndian.PutUint32(image[offset:], value)
+	}
+	put(0x14, 0x00040003) // FLMAP0: FCBA=0x30, FRBA=0x40
+	put(0x18, 0x20100008) // FLMAP1: FMBA=0x80, FPSBA=0x100, PSL=0x20
+	put(0x30, 0x6<<17)    // FLCOMP: 17MHz read clock frequency
+
+	put(0x40, 0x00000000) // FLREG0: Descriptor
+	put(0x44, 0x03ff0200) // FLREG1: BIOS
+	put(0x48, 0x01ff0001) // FLREG2: ME
+	for idx := 3; idx < 16; idx++ {
+		put(0x40+idx*4, 0x00007fff)
+	}
+
+	put(0x80, testFLMSTRBIOS)
+	put(0x84, flmstrME)
+	put(0x88, testFLMSTRGbE)
+
+	put(0x100, 1<<16) // PCHSTRP0: HAP
+	return image
+}
+
+func TestGetFlashDescriptorInfo(t *testing.T) {
+	info, err := GetFlashDescriptorInfo(newTestImage(testFLMSTRME))
+	require.NoError(t, err)
+
+	require.Equal(t, intelifdanalysis.DescriptorVersion_IFDv2, info.Version)
+	require.Equal(t, []*intelifdanalysis.FlashRegion{
+		{Type: intelifdanalysis.FlashRegionType_Descriptor, Offset: 0, Length: 0x1000},
+		{Type: intelifdanalysis.FlashRegionType_BIOS, Offset: 0x200000, Length: 0x200000},
+		{Type: intelifdanalysis.FlashRegionType_ME, Offset: 0x1000, Length: 0x1ff000},
+	}, info.Regions)
+
+	require.Len(t, info.Masters, 4)
+	require.Equal(t, intelifdanalysis.FlashMaster_HostCPU, info.Masters[0].Master)
+	require.Equal(t, []intelifdanalysis.FlashRegionType{
+		intelifdanalysis.FlashRegionType_Descriptor,
+		intelifdanalysis.FlashRegionType_BIOS,
+		intelifdanalysis.FlashRegionType_ME,
+		intelifdanalysis.FlashRegionType_GbE,
+	}, info.Masters[0].ReadableRegions)
+	require.Equal(t, []intelifdanalysis.FlashRegionType{
+		intelifdanalysis.FlashRegionType_BIOS,
+		intelifdanalysis.FlashRegionType_GbE,
+	}, info.Masters[0].WritableRegions)
+	require.Equal(t, []intelifdanalysis.FlashRegionType{
+		intelifdanalysis.FlashRegionType_ME,
+	}, info.Masters[1].WritableRegions)
+
+	require.True(t, info.Locked)
+	require.NotNil(t, info.HAP)
+	require.True(t, *info.HAP)
+	require.Nil(t, info.AltMEDisable)
+}
+
+func TestGetFlashDescriptorInfoNoDescriptor(t *testing.T) {
+	_, err := GetFlashDescriptorInfo(make([]byte, 0x10000))
+	require.ErrorAs(t, err, &ErrNoFlashDescriptor{})
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		report, err := New().Analyze(context.Background(), Input{
+			ActualFirmware:   analysis.NewActualFirmwareBlob(analysis.BytesBlob(newTestImage(testFLMSTRME))),
+			OriginalFirmware: &[]analysis.OriginalFirmwareBlob{analysis.NewOriginalFirmwareBlob(analysis.BytesBlob(newTestImage(testFLMSTRME)))}[0],
+		})
+		require.NoError(t, err)
+		require.Empty(t, report.Issues)
+		require.Empty(t, report.Custom.(intelifdanalysis.CustomReport).Differences)
+	})
+
+	t.Run("bios_writable_by_me", func(t *testing.T) {
+		report, err := New().Analyze(context.Background(), Input{
+			ActualFirmware:   analysis.NewActualFirmwareBlob(analysis.BytesBlob(newTestImage(testFLMSTRME | 1<<(20+intelifdanalysis.FlashRegionType_BIOS)))),
+			OriginalFirmware: &[]analysis.OriginalFirmwareBlob{analysis.NewOriginalFirmwareBlob(analysis.BytesBlob(newTestImage(testFLMSTRME)))}[0],
+		})
+		require.NoError(t, err)
+		require.Len(t, report.Issues, 2)
+		for _, issue := range report.Issues {
+			require.Equal(t, analysis.SeverityCritical, issue.Severity)
+		}
+
+		differences := report.Custom.(intelifdanalysis.CustomReport).Differences
+		require.Len(t, differences, 2)
+		require.Equal(t, "Masters.ManagementEngine", differences[0].Field)
+		require.Equal(t, "Bytes", differences[1].Field)
+	})
+
+	t.Run("not_applicable", func(t *testing.T) {
+		_, err := New().Analyze(context.Background(), Input{
+			ActualFirmware: analysis.NewActualFirmwareBlob(analysis.BytesBlob(make([]byte, 0x10000))),
+		})
+		require.ErrorAs(t, err, &analysis.ErrNotApplicable{})
+	})
+}
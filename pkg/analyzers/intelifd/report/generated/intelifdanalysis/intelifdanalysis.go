@@ -0,0 +1,1634 @@
+// Code generated by Thrift Compiler (0.14.0). DO NOT EDIT.
+
+package intelifdanalysis
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"time"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+var _ = time.Now
+var _ = bytes.Equal
+
+type DescriptorVersion int64
+
+const (
+	DescriptorVersion_Unknown DescriptorVersion = 0
+	DescriptorVersion_IFDv1   DescriptorVersion = 1
+	DescriptorVersion_IFDv2   DescriptorVersion = 2
+)
+
+func (p DescriptorVersion) String() string {
+	switch p {
+	case DescriptorVersion_Unknown:
+		return "Unknown"
+	case DescriptorVersion_IFDv1:
+		return "IFDv1"
+	case DescriptorVersion_IFDv2:
+		return "IFDv2"
+	}
+	return "<UNSET>"
+}
+
+func DescriptorVersionFromString(s string) (DescriptorVersion, error) {
+	switch s {
+	case "Unknown":
+		return DescriptorVersion_Unknown, nil
+	case "IFDv1":
+		return DescriptorVersion_IFDv1, nil
+	case "IFDv2":
+		return DescriptorVersion_IFDv2, nil
+	}
+	return DescriptorVersion(0), fmt.Errorf("not a valid DescriptorVersion string")
+}
+
+func DescriptorVersionPtr(v DescriptorVersion) *DescriptorVersion { return &v }
+
+func (p DescriptorVersion) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *DescriptorVersion) UnmarshalText(text []byte) error {
+	q, err := DescriptorVersionFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *DescriptorVersion) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = DescriptorVersion(v)
+	return nil
+}
+
+func (p *DescriptorVersion) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type FlashRegionType int64
+
+const (
+	FlashRegionType_Descriptor       FlashRegionType = 0
+	FlashRegionType_BIOS             FlashRegionType = 1
+	FlashRegionType_ME               FlashRegionType = 2
+	FlashRegionType_GbE              FlashRegionType = 3
+	FlashRegionType_PlatformData     FlashRegionType = 4
+	FlashRegionType_DeviceExpansion1 FlashRegionType = 5
+	FlashRegionType_BIOS2            FlashRegionType = 6
+	FlashRegionType_Microcode        FlashRegionType = 7
+	FlashRegionType_EC               FlashRegionType = 8
+	FlashRegionType_DeviceExpansion2 FlashRegionType = 9
+	FlashRegionType_IE               FlashRegionType = 10
+	FlashRegionType_TenGbE1          FlashRegionType = 11
+	FlashRegionType_TenGbE2          FlashRegionType = 12
+	FlashRegionType_Reserved1        FlashRegionType = 13
+	FlashRegionType_Reserved2        FlashRegionType = 14
+	FlashRegionType_PTT              FlashRegionType = 15
+)
+
+func (p FlashRegionType) String() string {
+	switch p {
+	case FlashRegionType_Descriptor:
+		return "Descriptor"
+	case FlashRegionType_BIOS:
+		return "BIOS"
+	case FlashRegionType_ME:
+		return "ME"
+	case FlashRegionType_GbE:
+		return "GbE"
+	case FlashRegionType_PlatformData:
+		return "PlatformData"
+	case FlashRegionType_DeviceExpansion1:
+		return "DeviceExpansion1"
+	case FlashRegionType_BIOS2:
+		return "BIOS2"
+	case FlashRegionType_Microcode:
+		return "Microcode"
+	case FlashRegionType_EC:
+		return "EC"
+	case FlashRegionType_DeviceExpansion2:
+		return "DeviceExpansion2"
+	case FlashRegionType_IE:
+		return "IE"
+	case FlashRegionType_TenGbE1:
+		return "TenGbE1"
+	case FlashRegionType_TenGbE2:
+		return "TenGbE2"
+	case FlashRegionType_Reserved1:
+		return "Reserved1"
+	case FlashRegionType_Reserved2:
+		return "Reserved2"
+	case FlashRegionType_PTT:
+		return "PTT"
+	}
+	return "<UNSET>"
+}
+
+func FlashRegionTypeFromString(s string) (FlashRegionType, error) {
+	switch s {
+	case "Descriptor":
+		return FlashRegionType_Descriptor, nil
+	case "BIOS":
+		return FlashRegionType_BIOS, nil
+	case "ME":
+		return FlashRegionType_ME, nil
+	case "GbE":
+		return FlashRegionType_GbE, nil
+	case "PlatformData":
+		return FlashRegionType_PlatformData, nil
+	case "DeviceExpansion1":
+		return FlashRegionType_DeviceExpansion1, nil
+	case "BIOS2":
+		return FlashRegionType_BIOS2, nil
+	case "Microcode":
+		return FlashRegionType_Microcode, nil
+	case "EC":
+		return FlashRegionType_EC, nil
+	case "DeviceExpansion2":
+		return FlashRegionType_DeviceExpansion2, nil
+	case "IE":
+		return FlashRegionType_IE, nil
+	case "TenGbE1":
+		return FlashRegionType_TenGbE1, nil
+	case "TenGbE2":
+		return FlashRegionType_TenGbE2, nil
+	case "Reserved1":
+		return FlashRegionType_Reserved1, nil
+	case "Reserved2":
+		return FlashRegionType_Reserved2, nil
+	case "PTT":
+		return FlashRegionType_PTT, nil
+	}
+	return FlashRegionType(0), fmt.Errorf("not a valid FlashRegionType string")
+}
+
+func FlashRegionTypePtr(v FlashRegionType) *FlashRegionType { return &v }
+
+func (p FlashRegionType) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *FlashRegionType) UnmarshalText(text []byte) error {
+	q, err := FlashRegionTypeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *FlashRegionType) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = FlashRegionType(v)
+	return nil
+}
+
+func (p *FlashRegionType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type FlashMaster int64
+
+const (
+	FlashMaster_HostCPU            FlashMaster = 1
+	FlashMaster_ManagementEngine   FlashMaster = 2
+	FlashMaster_GigabitEthernet    FlashMaster = 3
+	FlashMaster_EmbeddedController FlashMaster = 5
+)
+
+func (p FlashMaster) String() string {
+	switch p {
+	case FlashMaster_HostCPU:
+		return "HostCPU"
+	case FlashMaster_ManagementEngine:
+		return "ManagementEngine"
+	case FlashMaster_GigabitEthernet:
+		return "GigabitEthernet"
+	case FlashMaster_EmbeddedController:
+		return "EmbeddedController"
+	}
+	return "<UNSET>"
+}
+
+func FlashMasterFromString(s string) (FlashMaster, error) {
+	switch s {
+	case "HostCPU":
+		return FlashMaster_HostCPU, nil
+	case "ManagementEngine":
+		return FlashMaster_ManagementEngine, nil
+	case "GigabitEthernet":
+		return FlashMaster_GigabitEthernet, nil
+	case "EmbeddedController":
+		return FlashMaster_EmbeddedController, nil
+	}
+	return FlashMaster(0), fmt.Errorf("not a valid FlashMaster string")
+}
+
+func FlashMasterPtr(v FlashMaster) *FlashMaster { return &v }
+
+func (p FlashMaster) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *FlashMaster) UnmarshalText(text []byte) error {
+	q, err := FlashMasterFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *FlashMaster) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = FlashMaster(v)
+	return nil
+}
+
+func (p *FlashMaster) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// Attributes:
+//   - Type
+//   - Offset
+//   - Length
+type FlashRegion struct {
+	Type   FlashRegionType `thrift:"Type,1" db:"Type" json:"Type"`
+	Offset int64           `thrift:"Offset,2" db:"Offset" json:"Offset"`
+	Length int64           `thrift:"Length,3" db:"Length" json:"Length"`
+}
+
+func NewFlashRegion() *FlashRegion {
+	return &FlashRegion{}
+}
+
+func (p *FlashRegion) GetType() FlashRegionType {
+	return p.Type
+}
+
+func (p *FlashRegion) GetOffset() int64 {
+	return p.Offset
+}
+
+func (p *FlashRegion) GetLength() int64 {
+	return p.Length
+}
+func (p *FlashRegion) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FlashRegion) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := FlashRegionType(v)
+		p.Type = temp
+	}
+	return nil
+}
+
+func (p *FlashRegion) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *FlashRegion) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Length = v
+	}
+	return nil
+}
+
+func (p *FlashRegion) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FlashRegion"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FlashRegion) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Type", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Type: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Type (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Type: ", p), err)
+	}
+	return err
+}
+
+func (p *FlashRegion) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Offset", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Offset: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Offset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Offset (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Offset: ", p), err)
+	}
+	return err
+}
+
+func (p *FlashRegion) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Length", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Length: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Length)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Length (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Length: ", p), err)
+	}
+	return err
+}
+
+func (p *FlashRegion) Equals(other *FlashRegion) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Type != other.Type {
+		return false
+	}
+	if p.Offset != other.Offset {
+		return false
+	}
+	if p.Length != other.Length {
+		return false
+	}
+	return true
+}
+
+func (p *FlashRegion) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FlashRegion(%+v)", *p)
+}
+
+// Attributes:
+//   - Master
+//   - ReadableRegions
+//   - WritableRegions
+//   - RawValue
+type MasterAccess struct {
+	Master          FlashMaster       `thrift:"Master,1" db:"Master" json:"Master"`
+	ReadableRegions []FlashRegionType `thrift:"ReadableRegions,2" db:"ReadableRegions" json:"ReadableRegions"`
+	WritableRegions []FlashRegionType `thrift:"WritableRegions,3" db:"WritableRegions" json:"WritableRegions"`
+	RawValue        int32             `thrift:"RawValue,4" db:"RawValue" json:"RawValue"`
+}
+
+func NewMasterAccess() *MasterAccess {
+	return &MasterAccess{}
+}
+
+func (p *MasterAccess) GetMaster() FlashMaster {
+	return p.Master
+}
+
+func (p *MasterAccess) GetReadableRegions() []FlashRegionType {
+	return p.ReadableRegions
+}
+
+func (p *MasterAccess) GetWritableRegions() []FlashRegionType {
+	return p.WritableRegions
+}
+
+func (p *MasterAccess) GetRawValue() int32 {
+	return p.RawValue
+}
+func (p *MasterAccess) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *MasterAccess) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := FlashMaster(v)
+		p.Master = temp
+	}
+	return nil
+}
+
+func (p *MasterAccess) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]FlashRegionType, 0, size)
+	p.ReadableRegions = tSlice
+	for i := 0; i < size; i++ {
+		var _elem0 FlashRegionType
+		if v, err := iprot.ReadI32(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := FlashRegionType(v)
+			_elem0 = temp
+		}
+		p.ReadableRegions = append(p.ReadableRegions, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *MasterAccess) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]FlashRegionType, 0, size)
+	p.WritableRegions = tSlice
+	for i := 0; i < size; i++ {
+		var _elem1 FlashRegionType
+		if v, err := iprot.ReadI32(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := FlashRegionType(v)
+			_elem1 = temp
+		}
+		p.WritableRegions = append(p.WritableRegions, _elem1)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *MasterAccess) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.RawValue = v
+	}
+	return nil
+}
+
+func (p *MasterAccess) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "MasterAccess"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *MasterAccess) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Master", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Master: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Master)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Master (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Master: ", p), err)
+	}
+	return err
+}
+
+func (p *MasterAccess) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ReadableRegions", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ReadableRegions: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.I32, len(p.ReadableRegions)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.ReadableRegions {
+		if err := oprot.WriteI32(ctx, int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ReadableRegions: ", p), err)
+	}
+	return err
+}
+
+func (p *MasterAccess) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "WritableRegions", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:WritableRegions: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.I32, len(p.WritableRegions)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.WritableRegions {
+		if err := oprot.WriteI32(ctx, int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:WritableRegions: ", p), err)
+	}
+	return err
+}
+
+func (p *MasterAccess) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "RawValue", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:RawValue: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.RawValue)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.RawValue (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:RawValue: ", p), err)
+	}
+	return err
+}
+
+func (p *MasterAccess) Equals(other *MasterAccess) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Master != other.Master {
+		return false
+	}
+	if len(p.ReadableRegions) != len(other.ReadableRegions) {
+		return false
+	}
+	for i, _tgt := range p.ReadableRegions {
+		_src2 := other.ReadableRegions[i]
+		if _tgt != _src2 {
+			return false
+		}
+	}
+	if len(p.WritableRegions) != len(other.WritableRegions) {
+		return false
+	}
+	for i, _tgt := range p.WritableRegions {
+		_src3 := other.WritableRegions[i]
+		if _tgt != _src3 {
+			return false
+		}
+	}
+	if p.RawValue != other.RawValue {
+		return false
+	}
+	return true
+}
+
+func (p *MasterAccess) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MasterAccess(%+v)", *p)
+}
+
+// Attributes:
+//   - Version
+//   - Regions
+//   - Masters
+//   - Locked
+//   - HAP
+//   - AltMEDisable
+type FlashDescriptorInfo struct {
+	Version      DescriptorVersion `thrift:"Version,1" db:"Version" json:"Version"`
+	Regions      []*FlashRegion    `thrift:"Regions,2" db:"Regions" json:"Regions"`
+	Masters      []*MasterAccess   `thrift:"Masters,3" db:"Masters" json:"Masters"`
+	Locked       bool              `thrift:"Locked,4" db:"Locked" json:"Locked"`
+	HAP          *bool             `thrift:"HAP,5" db:"HAP" json:"HAP,omitempty"`
+	AltMEDisable *bool             `thrift:"AltMEDisable,6" db:"AltMEDisable" json:"AltMEDisable,omitempty"`
+}
+
+func NewFlashDescriptorInfo() *FlashDescriptorInfo {
+	return &FlashDescriptorInfo{}
+}
+
+func (p *FlashDescriptorInfo) GetVersion() DescriptorVersion {
+	return p.Version
+}
+
+func (p *FlashDescriptorInfo) GetRegions() []*FlashRegion {
+	return p.Regions
+}
+
+func (p *FlashDescriptorInfo) GetMasters() []*MasterAccess {
+	return p.Masters
+}
+
+func (p *FlashDescriptorInfo) GetLocked() bool {
+	return p.Locked
+}
+
+var FlashDescriptorInfo_HAP_DEFAULT bool
+
+func (p *FlashDescriptorInfo) GetHAP() bool {
+	if !p.IsSetHAP() {
+		return FlashDescriptorInfo_HAP_DEFAULT
+	}
+	return *p.HAP
+}
+
+var FlashDescriptorInfo_AltMEDisable_DEFAULT bool
+
+func (p *FlashDescriptorInfo) GetAltMEDisable() bool {
+	if !p.IsSetAltMEDisable() {
+		return FlashDescriptorInfo_AltMEDisable_DEFAULT
+	}
+	return *p.AltMEDisable
+}
+func (p *FlashDescriptorInfo) IsSetHAP() bool {
+	return p.HAP != nil
+}
+
+func (p *FlashDescriptorInfo) IsSetAltMEDisable() bool {
+	return p.AltMEDisable != nil
+}
+
+func (p *FlashDescriptorInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *FlashDescriptorInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := DescriptorVersion(v)
+		p.Version = temp
+	}
+	return nil
+}
+
+func (p *FlashDescriptorInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*FlashRegion, 0, size)
+	p.Regions = tSlice
+	for i := 0; i < size; i++ {
+		_elem4 := &FlashRegion{}
+		if err := _elem4.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		}
+		p.Regions = append(p.Regions, _elem4)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FlashDescriptorInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*MasterAccess, 0, size)
+	p.Masters = tSlice
+	for i := 0; i < size; i++ {
+		_elem5 := &MasterAccess{}
+		if err := _elem5.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem5), err)
+		}
+		p.Masters = append(p.Masters, _elem5)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FlashDescriptorInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Locked = v
+	}
+	return nil
+}
+
+func (p *FlashDescriptorInfo) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.HAP = &v
+	}
+	return nil
+}
+
+func (p *FlashDescriptorInfo) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(ctx); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.AltMEDisable = &v
+	}
+	return nil
+}
+
+func (p *FlashDescriptorInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "FlashDescriptorInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FlashDescriptorInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Version", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Version: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Version (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Version: ", p), err)
+	}
+	return err
+}
+
+func (p *FlashDescriptorInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Regions", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Regions: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Regions)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Regions {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Regions: ", p), err)
+	}
+	return err
+}
+
+func (p *FlashDescriptorInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Masters", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Masters: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Masters)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Masters {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Masters: ", p), err)
+	}
+	return err
+}
+
+func (p *FlashDescriptorInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Locked", thrift.BOOL, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Locked: ", p), err)
+	}
+	if err := oprot.WriteBool(ctx, bool(p.Locked)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Locked (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Locked: ", p), err)
+	}
+	return err
+}
+
+func (p *FlashDescriptorInfo) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetHAP() {
+		if err := oprot.WriteFieldBegin(ctx, "HAP", thrift.BOOL, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:HAP: ", p), err)
+		}
+		if err := oprot.WriteBool(ctx, bool(*p.HAP)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.HAP (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:HAP: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FlashDescriptorInfo) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetAltMEDisable() {
+		if err := oprot.WriteFieldBegin(ctx, "AltMEDisable", thrift.BOOL, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:AltMEDisable: ", p), err)
+		}
+		if err := oprot.WriteBool(ctx, bool(*p.AltMEDisable)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.AltMEDisable (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:AltMEDisable: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FlashDescriptorInfo) Equals(other *FlashDescriptorInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Version != other.Version {
+		return false
+	}
+	if len(p.Regions) != len(other.Regions) {
+		return false
+	}
+	for i, _tgt := range p.Regions {
+		_src6 := other.Regions[i]
+		if !_tgt.Equals(_src6) {
+			return false
+		}
+	}
+	if len(p.Masters) != len(other.Masters) {
+		return false
+	}
+	for i, _tgt := range p.Masters {
+		_src7 := other.Masters[i]
+		if !_tgt.Equals(_src7) {
+			return false
+		}
+	}
+	if p.Locked != other.Locked {
+		return false
+	}
+	if p.HAP != other.HAP {
+		if p.HAP == nil || other.HAP == nil {
+			return false
+		}
+		if (*p.HAP) != (*other.HAP) {
+			return false
+		}
+	}
+	if p.AltMEDisable != other.AltMEDisable {
+		if p.AltMEDisable == nil || other.AltMEDisable == nil {
+			return false
+		}
+		if (*p.AltMEDisable) != (*other.AltMEDisable) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *FlashDescriptorInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FlashDescriptorInfo(%+v)", *p)
+}
+
+// Attributes:
+//   - Field
+//   - Original
+//   - Actual
+type DescriptorDifference struct {
+	Field    string `thrift:"Field,1" db:"Field" json:"Field"`
+	Original string `thrift:"Original,2" db:"Original" json:"Original"`
+	Actual   string `thrift:"Actual,3" db:"Actual" json:"Actual"`
+}
+
+func NewDescriptorDifference() *DescriptorDifference {
+	return &DescriptorDifference{}
+}
+
+func (p *DescriptorDifference) GetField() string {
+	return p.Field
+}
+
+func (p *DescriptorDifference) GetOriginal() string {
+	return p.Original
+}
+
+func (p *DescriptorDifference) GetActual() string {
+	return p.Actual
+}
+func (p *DescriptorDifference) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *DescriptorDifference) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Field = v
+	}
+	return nil
+}
+
+func (p *DescriptorDifference) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Original = v
+	}
+	return nil
+}
+
+func (p *DescriptorDifference) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Actual = v
+	}
+	return nil
+}
+
+func (p *DescriptorDifference) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "DescriptorDifference"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DescriptorDifference) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Field", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Field: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Field)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Field (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Field: ", p), err)
+	}
+	return err
+}
+
+func (p *DescriptorDifference) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Original", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Original: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Original)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Original (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Original: ", p), err)
+	}
+	return err
+}
+
+func (p *DescriptorDifference) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Actual", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Actual: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Actual)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Actual (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Actual: ", p), err)
+	}
+	return err
+}
+
+func (p *DescriptorDifference) Equals(other *DescriptorDifference) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Field != other.Field {
+		return false
+	}
+	if p.Original != other.Original {
+		return false
+	}
+	if p.Actual != other.Actual {
+		return false
+	}
+	return true
+}
+
+func (p *DescriptorDifference) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DescriptorDifference(%+v)", *p)
+}
+
+// Attributes:
+//   - Actual
+//   - Original
+//   - Differences
+type CustomReport struct {
+	Actual      *FlashDescriptorInfo    `thrift:"Actual,1" db:"Actual" json:"Actual"`
+	Original    *FlashDescriptorInfo    `thrift:"Original,2" db:"Original" json:"Original,omitempty"`
+	Differences []*DescriptorDifference `thrift:"Differences,3" db:"Differences" json:"Differences"`
+}
+
+func NewCustomReport() *CustomReport {
+	return &CustomReport{}
+}
+
+var CustomReport_Actual_DEFAULT *FlashDescriptorInfo
+
+func (p *CustomReport) GetActual() *FlashDescriptorInfo {
+	if !p.IsSetActual() {
+		return CustomReport_Actual_DEFAULT
+	}
+	return p.Actual
+}
+
+var CustomReport_Original_DEFAULT *FlashDescriptorInfo
+
+func (p *CustomReport) GetOriginal() *FlashDescriptorInfo {
+	if !p.IsSetOriginal() {
+		return CustomReport_Original_DEFAULT
+	}
+	return p.Original
+}
+
+func (p *CustomReport) GetDifferences() []*DescriptorDifference {
+	return p.Differences
+}
+func (p *CustomReport) IsSetActual() bool {
+	return p.Actual != nil
+}
+
+func (p *CustomReport) IsSetOriginal() bool {
+	return p.Original != nil
+}
+
+func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Actual = &FlashDescriptorInfo{}
+	if err := p.Actual.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Actual), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Original = &FlashDescriptorInfo{}
+	if err := p.Original.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Original), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*DescriptorDifference, 0, size)
+	p.Differences = tSlice
+	for i := 0; i < size; i++ {
+		_elem8 := &DescriptorDifference{}
+		if err := _elem8.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
+		}
+		p.Differences = append(p.Differences, _elem8)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Actual", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Actual: ", p), err)
+	}
+	if err := p.Actual.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Actual), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Actual: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginal() {
+		if err := oprot.WriteFieldBegin(ctx, "Original", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Original: ", p), err)
+		}
+		if err := p.Original.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Original), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Original: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Differences", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Differences: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Differences)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Differences {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Differences: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) Equals(other *CustomReport) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Actual.Equals(other.Actual) {
+		return false
+	}
+	if !p.Original.Equals(other.Original) {
+		return false
+	}
+	if len(p.Differences) != len(other.Differences) {
+		return false
+	}
+	for i, _tgt := range p.Differences {
+		_src9 := other.Differences[i]
+		if !_tgt.Equals(_src9) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *CustomReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CustomReport(%+v)", *p)
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.intelifd.report.generated.intelifdanalysis
+
+const string IntelFlashDescriptorAnalyzerID = "IntelFlashDescriptor";
+
+enum DescriptorVersion {
+  Unknown = 0,
+  IFDv1 = 1, // ICH8 .. 9-series PCH
+  IFDv2 = 2, // 100-series PCH and newer
+}
+
+// FlashRegionType is the index of a region in the Flash Region section (FLREGn)
+enum FlashRegionType {
+  Descriptor = 0,
+  BIOS = 1,
+  ME = 2,
+  GbE = 3,
+  PlatformData = 4,
+  DeviceExpansion1 = 5,
+  BIOS2 = 6,
+  Microcode = 7,
+  EC = 8,
+  DeviceExpansion2 = 9,
+  IE = 10,
+  TenGbE1 = 11,
+  TenGbE2 = 12,
+  Reserved1 = 13,
+  Reserved2 = 14,
+  PTT = 15,
+}
+
+// FlashMaster is the number of a master in the Flash Master section (FLMSTRn)
+enum FlashMaster {
+  HostCPU = 1,
+  ManagementEngine = 2,
+  GigabitEthernet = 3,
+  EmbeddedController = 5,
+}
+
+struct FlashRegion {
+  1: FlashRegionType Type;
+  2: i64 Offset;
+  3: i64 Length;
+}
+
+struct MasterAccess {
+  1: FlashMaster Master;
+  2: list<FlashRegionType> ReadableRegions;
+  3: list<FlashRegionType> WritableRegions;
+  4: i32 RawValue; // the raw FLMSTRn value
+}
+
+struct FlashDescriptorInfo {
+  1: DescriptorVersion Version;
+  2: list<FlashRegion> Regions;
+  3: list<MasterAccess> Masters;
+  // Locked is true if no master is permitted to write the descriptor region
+  4: bool Locked;
+  // HAP is the "High Assurance Platform" ME-disable strap (IFDv2 only)
+  5: optional bool HAP;
+  // AltMEDisable is the ME-disable strap of IFDv1 platforms
+  6: optional bool AltMEDisable;
+}
+
+// DescriptorDifference describes a field of the flash descriptor which differs
+// between the original and the actual firmware images.
+struct DescriptorDifference {
+  1: string Field;
+  2: string Original;
+  3: string Actual;
+}
+
+struct CustomReport {
+  1: FlashDescriptorInfo Actual;
+  2: optional FlashDescriptorInfo Original; // is not set if the original image is not available
+  3: list<DescriptorDifference> Differences;
+}
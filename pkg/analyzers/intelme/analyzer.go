@@ -0,0 +1,266 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package intelme
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme/report/generated/intelmeanalysis"
+)
+
+func init() {
+	analysis.RegisterType((*intelmeanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of IntelME analyzer
+const ID analysis.AnalyzerID = intelmeanalysis.IntelMEAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.1.0"
+
+// fptName is used in ChangedPartitions to denote the Flash Partition Table itself
+const fptName = "$FPT"
+
+// NewExecutorInput builds an analysis.Executor's input required for IntelME analyzer
+//
+// Optional arguments: originalFirmware
+func NewExecutorInput(
+	originalFirmware analysis.Blob,
+	actualFirmware analysis.Blob,
+) (analysis.Input, error) {
+	if actualFirmware == nil {
+		return nil, fmt.Errorf("the actual firmware image should be specified")
+	}
+
+	result := analysis.NewInput()
+	result.AddActualFirmware(
+		actualFirmware,
+	)
+	if originalFirmware != nil {
+		result.AddOriginalFirmware(
+			originalFirmware,
+		)
+	}
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	ActualFirmware   analysis.ActualFirmwareBlob
+	OriginalFirmware *analysis.OriginalFirmwareBlob `exec:"optional"`
+}
+
+// IntelME is analyzer that parses the Intel ME/CSME region: the firmware
+// version, SVN and the consistency of partitions with their manifests.
+type IntelME struct{}
+
+// New returns a new object of IntelME analyzer
+func New() analysis.Analyzer[Input] {
+	return &IntelME{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *IntelME) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *IntelME) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze parses the ME region of the actual image and explains its difference with the original one
+func (analyzer *IntelME) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	log := logger.FromCtx(ctx)
+
+	actualInfo, err := GetMEInfo(in.ActualFirmware.Bytes())
+	if err != nil {
+		if errors.As(err, &ErrNoMERegion{}) {
+			log.Infof("No ME region in the actual image, skip analysis: %v", err)
+			return nil, analysis.NewErrNotApplicable("no Intel ME region")
+		}
+		return nil, fmt.Errorf("unable to parse the ME region of the actual image: %w", err)
+	}
+
+	customReport := intelmeanalysis.CustomReport{
+		Actual: actualInfo,
+	}
+	result := &analysis.Report{}
+	for _, partition := range actualInfo.Partitions {
+		switch partition.ManifestValidation {
+		case intelmeanalysis.ManifestValidation_HashMismatch:
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeIntelMEPartitionMismatch,
+				Severity:    analysis.SeverityCritical,
+				Description: fmt.Sprintf("ME partition '%s' does not match its manifest: %s", partition.Name, partition.ValidationDescription),
+				Params:      map[string]string{"partition": partition.Name},
+			})
+		case intelmeanalysis.ManifestValidation_InvalidFormat:
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeIntelMEPartitionInvalidFormat,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("ME partition '%s' has invalid format: %s", partition.Name, partition.ValidationDescription),
+				Params:      map[string]string{"partition": partition.Name},
+			})
+		}
+	}
+
+	if in.OriginalFirmware != nil {
+		originalInfo, err := GetMEInfo(in.OriginalFirmware.Bytes())
+		if err != nil {
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeIntelMEOriginalUnparsable,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("unable to parse the ME region of the original image: %v", err),
+			})
+		} else {
+			customReport.Original = originalInfo
+			customReport.Diagnosis, customReport.ChangedPartitions = Diagnose(originalInfo, actualInfo)
+			result.Issues = append(result.Issues, downgradeIssues(originalInfo, actualInfo)...)
+			if customReport.Diagnosis == intelmeanalysis.Diagnosis_Unexplained {
+				result.Issues = append(result.Issues, analysis.Issue{
+					Code:        analysis.IssueCodeIntelMEPartitionsChangedWithoutVersionChange,
+					Severity:    analysis.SeverityWarning,
+					Description: fmt.Sprintf("ME partitions changed without a firmware version change: %v", customReport.ChangedPartitions),
+					Params:      map[string]string{"partitions": strings.Join(customReport.ChangedPartitions, ",")},
+				})
+			}
+		}
+	}
+
+	result.Custom = customReport
+	return result, nil
+}
+
+// Diagnose explains the difference between the ME regions of the original and the actual images
+func Diagnose(original, actual *intelmeanalysis.MEInfo) (intelmeanalysis.Diagnosis, []string) {
+	originalPartitions, actualPartitions := partitionsByName(original.Partitions), partitionsByName(actual.Partitions)
+
+	var changed []string
+	if !bytes.Equal(original.FPTSHA256, actual.FPTSHA256) {
+		changed = append(changed, fptName)
+	}
+	for _, partition := range actual.Partitions {
+		originalPartition := originalPartitions[partition.Name]
+		if originalPartition == nil || !bytes.Equal(originalPartition.SHA256, partition.SHA256) {
+			changed = append(changed, partition.Name)
+		}
+	}
+	for _, partition := range original.Partitions {
+		if actualPartitions[partition.Name] == nil {
+			changed = append(changed, partition.Name)
+		}
+	}
+	if len(changed) == 0 {
+		return intelmeanalysis.Diagnosis_Match, nil
+	}
+
+	onlyDataChanged := true
+	for _, name := range changed {
+		partition := actualPartitions[name]
+		if partition == nil {
+			partition = originalPartitions[name]
+		}
+		if partition == nil || partition.Type == intelmeanalysis.PartitionType_Code {
+			onlyDataChanged = false
+		}
+		if partition == nil {
+			continue
+		}
+		switch partition.ManifestValidation {
+		case intelmeanalysis.ManifestValidation_HashMismatch, intelmeanalysis.ManifestValidation_InvalidFormat:
+			return intelmeanalysis.Diagnosis_PartitionCorrupted, changed
+		}
+	}
+
+	switch {
+	case !versionsEqual(original.Version, actual.Version) || !svnsEqual(original.SVN, actual.SVN):
+		return intelmeanalysis.Diagnosis_UpdatedOutOfBand, changed
+	case onlyDataChanged:
+		return intelmeanalysis.Diagnosis_DataPartitionsChanged, changed
+	}
+	return intelmeanalysis.Diagnosis_Unexplained, changed
+}
+
+func downgradeIssues(original, actual *intelmeanalysis.MEInfo) []analysis.Issue {
+	var result []analysis.Issue
+	if original.Version != nil && actual.Version != nil && compareVersions(*actual.Version, *original.Version) < 0 {
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeIntelMEVersionDowngrade,
+			Severity:    analysis.SeverityCritical,
+			Description: fmt.Sprintf("ME firmware downgrade: original version is %s, actual is %s", formatVersion(*original.Version), formatVersion(*actual.Version)),
+			Params:      map[string]string{"original_version": formatVersion(*original.Version), "actual_version": formatVersion(*actual.Version)},
+		})
+	}
+	if original.SVN != nil && actual.SVN != nil && *actual.SVN < *original.SVN {
+		result = append(result, analysis.Issue{
+			Code:        analysis.IssueCodeIntelMESVNDowngrade,
+			Severity:    analysis.SeverityCritical,
+			Description: fmt.Sprintf("ME firmware SVN downgrade: original SVN is %d, actual is %d", *original.SVN, *actual.SVN),
+			Params:      map[string]string{"original_svn": fmt.Sprint(*original.SVN), "actual_svn": fmt.Sprint(*actual.SVN)},
+		})
+	}
+	return result
+}
+
+func partitionsByName(partitions []*intelmeanalysis.Partition) map[string]*intelmeanalysis.Partition {
+	result := make(map[string]*intelmeanalysis.Partition, len(partitions))
+	for _, partition := range partitions {
+		result[partition.Name] = partition
+	}
+	return result
+}
+
+func compareVersions(a, b intelmeanalysis.Version) int {
+	for _, pair := range [][2]int16{
+		{a.Major, b.Major},
+		{a.Minor, b.Minor},
+		{a.Hotfix, b.Hotfix},
+		{a.Build, b.Build},
+	} {
+		switch {
+		case uint16(pair[0]) < uint16(pair[1]):
+			return -1
+		case uint16(pair[0]) > uint16(pair[1]):
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionsEqual(a, b *intelmeanalysis.Version) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return compareVersions(*a, *b) == 0
+}
+
+func svnsEqual(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func formatVersion(v intelmeanalysis.Version) string {
+	return fmt.Sprintf("%d.%d.%d.%d", uint16(v.Major), uint16(v.Minor), uint16(v.Hotfix), uint16(v.Build))
+}
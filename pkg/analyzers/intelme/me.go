@@ -0,0 +1,401 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package intelme
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/linuxboot/fiano/pkg/uefi"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd/report/generated/intelifdanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme/report/generated/intelmeanalysis"
+)
+
+// The layouts are described in http://me.bios.io/ME_blob_format and
+// in the sources of ME Analyzer (https://github.com/platomav/MEAnalyzer).
+const (
+	fptPartitionTypeMask = 0x7f
+
+	cpdHeaderMinLength   = 0x10
+	cpdHeaderLengthIndex = 0x0A
+	cpdEntryLength       = 0x18
+	cpdEntryNameLength   = 0x0C
+	cpdEntryOffsetMask   = 0x1ffffff
+
+	manifestHeaderLengthOffset = 0x04
+	manifestSizeOffset         = 0x18
+	manifestTagOffset          = 0x1C
+	manifestVersionOffset      = 0x24
+	manifestSVNOffset          = 0x2C
+	manifestMinLength          = 0x80
+
+	metadataExtensionHeaderLength     = 0x08
+	metadataModuleAttributesType      = 0x0A
+	metadataModuleAttributesHashIndex = 0x18
+	metadataCompressionIndex          = 0x08
+	metadataCompressionNone           = 0x00
+
+	// ftprPartitionName is the name of the partition containing the main ME firmware
+	ftprPartitionName = "FTPR"
+)
+
+var (
+	cpdSignature = []byte("$CPD")
+	manifestTag  = []byte("$MN2")
+)
+
+// GetMEInfo finds the ME region of a firmware image and parses it
+func GetMEInfo(image []byte) (*intelmeanalysis.MEInfo, error) {
+	region, err := getMERegion(image)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMERegion(region)
+}
+
+func getMERegion(image []byte) ([]byte, error) {
+	descriptor, err := intelifd.GetFlashDescriptorInfo(image)
+	if err != nil {
+		return nil, ErrNoMERegion{err: err}
+	}
+	for _, region := range descriptor.Regions {
+		if region.Type != intelifdanalysis.FlashRegionType_ME {
+			continue
+		}
+		if region.Offset+region.Length > int64(len(image)) {
+			return nil, ErrInvalidMERegion{err: fmt.Errorf("ME region 0x%X-0x%X is out of the image bounds (0x%X)",
+				region.Offset, region.Offset+region.Length, len(image))}
+		}
+		return image[region.Offset : region.Offset+region.Length], nil
+	}
+	return nil, ErrNoMERegion{err: fmt.Errorf("the flash descriptor has no ME region")}
+}
+
+// ParseMERegion parses the Flash Partition Table of the ME region and the manifests of its partitions
+func ParseMERegion(region []byte) (*intelmeanalysis.MEInfo, error) {
+	if len(region) < len(uefi.MEFTPSignature)+16 {
+		return nil, ErrInvalidMERegion{err: fmt.Errorf("ME region is too small: %d", len(region))}
+	}
+	fpt, err := uefi.NewMEFPT(region)
+	if err != nil {
+		return nil, ErrInvalidMERegion{err: fmt.Errorf("unable to parse the Flash Partition Table: %w", err)}
+	}
+
+	fptHash := sha256.Sum256(fpt.Buf())
+	result := &intelmeanalysis.MEInfo{
+		FPTSHA256: fptHash[:],
+	}
+	for _, entry := range fpt.Entries {
+		if !entry.OffsetIsValid() || entry.Length == 0 {
+			continue
+		}
+		partition := parsePartition(region, entry)
+		if partition.Name == ftprPartitionName {
+			result.Version = partition.Version
+			result.SVN = partition.SVN
+		}
+		result.Partitions = append(result.Partitions, partition)
+	}
+	return result, nil
+}
+
+func parsePartition(region []byte, entry uefi.MEPartitionEntry) *intelmeanalysis.Partition {
+	partition := &intelmeanalysis.Partition{
+		Name:   entry.Name.String(),
+		Type:   intelmeanalysis.PartitionType(entry.Flags & fptPartitionTypeMask),
+		Offset: int64(entry.Offset),
+		Length: int64(entry.Length),
+	}
+
+	end := uint64(entry.Offset) + uint64(entry.Length)
+	if end > uint64(len(region)) {
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_InvalidFormat
+		partition.ValidationDescription = fmt.Sprintf("the partition is out of the ME region bounds (0x%X > 0x%X)", end, len(region))
+		return partition
+	}
+	data := region[entry.Offset:end]
+	hash := sha256.Sum256(data)
+	partition.SHA256 = hash[:]
+
+	switch {
+	case bytes.HasPrefix(data, cpdSignature):
+		validateCodePartition(partition, data)
+	case isManifest(data):
+		// ME before version 11: a partition starts with a manifest, modules are not verified
+		m, err := parseManifest(data)
+		if err != nil {
+			partition.ManifestValidation = intelmeanalysis.ManifestValidation_InvalidFormat
+			partition.ValidationDescription = err.Error()
+			return partition
+		}
+		partition.Version = &m.Version
+		partition.SVN = &m.SVN
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_NotVerified
+	default:
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_NoManifest
+	}
+	return partition
+}
+
+// validateCodePartition checks that the hash of each metadata file
+// is listed in the partition manifest and that the hash of each module
+// matches the hash in its metadata file. Compressed modules are hashed
+// before compression, so they are reported as not verified.
+func validateCodePartition(partition *intelmeanalysis.Partition, data []byte) {
+	entries, err := parseCPD(data)
+	if err != nil {
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_InvalidFormat
+		partition.ValidationDescription = err.Error()
+		return
+	}
+
+	var manifestEntry *cpdEntry
+	for idx := range entries {
+		if strings.HasSuffix(entries[idx].Name, ".man") {
+			manifestEntry = &entries[idx]
+			break
+		}
+	}
+	if manifestEntry == nil {
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_NoManifest
+		return
+	}
+
+	m, err := parseManifest(manifestEntry.Data)
+	if err != nil {
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_InvalidFormat
+		partition.ValidationDescription = fmt.Sprintf("unable to parse manifest '%s': %v", manifestEntry.Name, err)
+		return
+	}
+	partition.Version = &m.Version
+	partition.SVN = &m.SVN
+
+	modules := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		modules[entry.Name] = entry.Data
+	}
+	var mismatchedModules []string
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name, ".met") {
+			continue
+		}
+		if !m.listsHashOf(entry.Data) {
+			partition.MismatchedEntries = append(partition.MismatchedEntries, entry.Name)
+			continue
+		}
+
+		moduleName := strings.TrimSuffix(entry.Name, ".met")
+		module, found := modules[moduleName]
+		attrs := parseModuleAttributes(entry.Data)
+		switch {
+		case !found || attrs == nil || attrs.Compression != metadataCompressionNone:
+			partition.UnverifiedEntries = append(partition.UnverifiedEntries, moduleName)
+		case !attrs.matches(module):
+			mismatchedModules = append(mismatchedModules, moduleName)
+		}
+	}
+	switch {
+	case len(partition.MismatchedEntries) > 0:
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_HashMismatch
+		partition.ValidationDescription = fmt.Sprintf("metadata files are not listed in the manifest: %s", strings.Join(partition.MismatchedEntries, ", "))
+		partition.MismatchedEntries = append(partition.MismatchedEntries, mismatchedModules...)
+	case len(mismatchedModules) > 0:
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_HashMismatch
+		partition.ValidationDescription = fmt.Sprintf("modules do not match their metadata files: %s", strings.Join(mismatchedModules, ", "))
+		partition.MismatchedEntries = mismatchedModules
+	case len(partition.UnverifiedEntries) > 0:
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_PartiallyVerified
+		partition.ValidationDescription = fmt.Sprintf("modules are not verified: %s", strings.Join(partition.UnverifiedEntries, ", "))
+	default:
+		partition.ManifestValidation = intelmeanalysis.ManifestValidation_Correct
+	}
+}
+
+// moduleAttributes is the Module Attributes extension of a metadata file
+type moduleAttributes struct {
+	Compression uint8
+	Hash        []byte
+}
+
+// parseModuleAttributes returns the Module Attributes extension of
+// a metadata file, or nil if there is none.
+func parseModuleAttributes(metadata []byte) *moduleAttributes {
+	for offset := uint64(0); offset+metadataExtensionHeaderLength <= uint64(len(metadata)); {
+		extType := binary.LittleEndian.Uint32(metadata[offset:])
+		extLength := uint64(binary.LittleEndian.Uint32(metadata[offset+4:]))
+		if extLength < metadataExtensionHeaderLength || offset+extLength > uint64(len(metadata)) {
+			return nil
+		}
+		ext := metadata[offset : offset+extLength]
+		if extType == metadataModuleAttributesType {
+			if extLength <= metadataModuleAttributesHashIndex {
+				return nil
+			}
+			return &moduleAttributes{
+				Compression: ext[metadataCompressionIndex],
+				Hash:        ext[metadataModuleAttributesHashIndex:],
+			}
+		}
+		offset += extLength
+	}
+	return nil
+}
+
+// matches returns true if the SHA256 or SHA384 hash (depending on the
+// hash length) of the module is the hash from the attributes, in either byte order.
+func (attrs *moduleAttributes) matches(module []byte) bool {
+	var hash []byte
+	switch len(attrs.Hash) {
+	case sha256.Size:
+		h := sha256.Sum256(module)
+		hash = h[:]
+	case sha512.Size384:
+		h := sha512.Sum384(module)
+		hash = h[:]
+	default:
+		return false
+	}
+	return bytes.Equal(attrs.Hash, hash) || bytes.Equal(attrs.Hash, reversed(hash))
+}
+
+type cpdEntry struct {
+	Name string
+	Data []byte
+}
+
+// parseCPD parses a Code Partition Directory
+func parseCPD(data []byte) ([]cpdEntry, error) {
+	if len(data) < cpdHeaderMinLength {
+		return nil, fmt.Errorf("code partition directory is too small: %d", len(data))
+	}
+	count := binary.LittleEndian.Uint32(data[len(cpdSignature):])
+	headerLength := uint64(data[cpdHeaderLengthIndex])
+	if headerLength > uint64(len(data)) {
+		return nil, fmt.Errorf("code partition directory header length %d is out of bounds (available: %d)", headerLength, len(data))
+	}
+	// the count is read from the image, so it is validated before allocating memory for the entries
+	if maxCount := (uint64(len(data)) - headerLength) / cpdEntryLength; uint64(count) > maxCount {
+		return nil, fmt.Errorf("code partition directory entries count %d exceeds the maximal possible %d", count, maxCount)
+	}
+
+	result := make([]cpdEntry, 0, count)
+	for idx := uint64(0); idx < uint64(count); idx++ {
+		entryOffset := headerLength + idx*cpdEntryLength
+		if entryOffset+cpdEntryLength > uint64(len(data)) {
+			return nil, fmt.Errorf("code partition directory entry #%d is out of bounds", idx)
+		}
+		entry := data[entryOffset : entryOffset+cpdEntryLength]
+		name := string(bytes.TrimRight(entry[:cpdEntryNameLength], "\x00"))
+		offset := uint64(binary.LittleEndian.Uint32(entry[cpdEntryNameLength:]) & cpdEntryOffsetMask)
+		length := uint64(binary.LittleEndian.Uint32(entry[cpdEntryNameLength+4:]))
+		if offset+length > uint64(len(data)) {
+			return nil, fmt.Errorf("code partition directory entry '%s' (0x%X-0x%X) is out of bounds", name, offset, offset+length)
+		}
+		result = append(result, cpdEntry{
+			Name: name,
+			Data: data[offset : offset+length],
+		})
+	}
+	return result, nil
+}
+
+type manifest struct {
+	Version    intelmeanalysis.Version
+	SVN        int32
+	Extensions []byte
+}
+
+func isManifest(data []byte) bool {
+	return len(data) >= manifestTagOffset+len(manifestTag) && bytes.Equal(data[manifestTagOffset:manifestTagOffset+len(manifestTag)], manifestTag)
+}
+
+func parseManifest(data []byte) (*manifest, error) {
+	if len(data) < manifestMinLength {
+		return nil, fmt.Errorf("manifest is too small: %d", len(data))
+	}
+	if !isManifest(data) {
+		return nil, fmt.Errorf("manifest tag '%s' is not found", manifestTag)
+	}
+	headerLength := uint64(binary.LittleEndian.Uint32(data[manifestHeaderLengthOffset:])) * 4
+	size := uint64(binary.LittleEndian.Uint32(data[manifestSizeOffset:])) * 4
+	if headerLength > size || size > uint64(len(data)) {
+		return nil, fmt.Errorf("invalid manifest header length 0x%X or size 0x%X (available: 0x%X)", headerLength, size, len(data))
+	}
+
+	return &manifest{
+		Version: intelmeanalysis.Version{
+			Major:  int16(binary.LittleEndian.Uint16(data[manifestVersionOffset:])),
+			Minor:  int16(binary.LittleEndian.Uint16(data[manifestVersionOffset+2:])),
+			Hotfix: int16(binary.LittleEndian.Uint16(data[manifestVersionOffset+4:])),
+			Build:  int16(binary.LittleEndian.Uint16(data[manifestVersionOffset+6:])),
+		},
+		SVN:        int32(binary.LittleEndian.Uint32(data[manifestSVNOffset:])),
+		Extensions: data[headerLength:size],
+	}, nil
+}
+
+// listsHashOf returns true if the SHA256 or SHA384 hash of the data is
+// found in the manifest extensions. Depending on the ME generation the
+// hashes are stored either as is or in the reversed byte order.
+func (m *manifest) listsHashOf(data []byte) bool {
+	sha256Hash := sha256.Sum256(data)
+	sha384Hash := sha512.Sum384(data)
+	for _, hash := range [][]byte{sha256Hash[:], sha384Hash[:]} {
+		if bytes.Contains(m.Extensions, hash) || bytes.Contains(m.Extensions, reversed(hash)) {
+			return true
+		}
+	}
+	return false
+}
+
+func reversed(b []byte) []byte {
+	result := make([]byte, len(b))
+	for idx := range b {
+		result[len(b)-1-idx] = b[idx]
+	}
+	return result
+}
+
+// ErrNoMERegion means that the image has no ME region
+type ErrNoMERegion struct {
+	err error
+}
+
+func (e ErrNoMERegion) Error() string {
+	return fmt.Sprintf("ME region is not found: %v", e.err)
+}
+
+// Unwrap returns the underlying error
+func (e ErrNoMERegion) Unwrap() error {
+	return e.err
+}
+
+// ErrInvalidMERegion means that the ME region was found, but could not be parsed
+type ErrInvalidMERegion struct {
+	err error
+}
+
+func (e ErrInvalidMERegion) Error() string {
+	return fmt.Sprintf("invalid ME region: %v", e.err)
+}
+
+// Unwrap returns the underlying error
+func (e ErrInvalidMERegion) Unwrap() error {
+	return e.err
+}
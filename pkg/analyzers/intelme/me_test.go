@@ -0,0 +1,204 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package intelme
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme/report/generated/intelmeanalysis"
+)
+
+type testMERegion struct {
+	Major       uint16
+	SVN         uint32
+	Module      []byte
+	MFSContent  byte
+	BreakHashes bool
+	BreakModule bool
+	Compressed  bool
+}
+
+// build constructs a minimal ME region: a Flash Partition Table with a code
+// partition "FTPR" (a CPD with a manifest, a metadata file and a module) and a data partition "MFS".
+func (r testMERegion) build() []byte {
+	region := make([]byte, 0x4000)
+	put := func(offset int, value uint32) {
+		binary.LittleEndian.PutUint32(region[offset:], value)
+	}
+
+	// Flash Partition Table
+	copy(region, "$FPT")
+	put(0x04, 2)
+	copy(region[0x20:], "FTPR")
+	put(0x28, 0x1000)
+	put(0x2C, 0x1000)
+	put(0x3C, uint32(intelmeanalysis.PartitionType_Code))
+	copy(region[0x40:], "MFS")
+	put(0x48, 0x2000)
+	put(0x4C, 0x1000)
+	put(0x5C, uint32(intelmeanalysis.PartitionType_Data))
+
+	// FTPR: Code Partition Directory
+	cpd := 0x1000
+	copy(region[cpd:], "$CPD")
+	put(cpd+0x04, 3)
+	region[cpd+0x0A] = 0x10
+	copy(region[cpd+0x0C:], "FTPR")
+	copy(region[cpd+0x10:], "FTPR.man")
+	put(cpd+0x1C, 0x100)
+	put(cpd+0x20, 0x300)
+	copy(region[cpd+0x28:], "bup.met")
+	put(cpd+0x34, 0x400)
+	put(cpd+0x38, 0x38)
+	copy(region[cpd+0x40:], "bup")
+	put(cpd+0x4C, 0x500)
+	put(cpd+0x50, uint32(len(r.Module)))
+
+	// FTPR: metadata (Module Attributes extension)
+	metadata := region[cpd+0x400 : cpd+0x438]
+	put(cpd+0x400, 0x0A)
+	put(cpd+0x404, 0x38)
+	if r.Compressed {
+		metadata[0x08] = 0x01
+	}
+	moduleHash := sha256.Sum256(r.Module)
+	copy(metadata[0x18:], moduleHash[:])
+
+	// FTPR: module
+	copy(region[cpd+0x500:], r.Module)
+	if r.BreakModule {
+		region[cpd+0x500] ^= 0x01
+	}
+
+	// FTPR: manifest
+	manifest := cpd + 0x100
+	put(manifest+0x04, 0x284/4)
+	put(manifest+0x18, 0x300/4)
+	copy(region[manifest+0x1C:], "$MN2")
+	binary.LittleEndian.PutUint16(region[manifest+0x24:], r.Major)
+	put(manifest+0x2C, r.SVN)
+	metadataHash := sha256.Sum256(metadata)
+	if r.BreakHashes {
+		metadataHash[0] ^= 0xff
+	}
+	copy(region[manifest+0x290:], metadataHash[:])
+
+	// MFS
+	region[0x2000] = r.MFSContent
+	return region
+}
+
+func TestParseMERegion(t *testing.T) {
+	info, err := ParseMERegion(testMERegion{Major: 15, SVN: 3, Module: []byte("module")}.build())
+	require.NoError(t, err)
+	require.Equal(t, &intelmeanalysis.Version{Major: 15}, info.Version)
+	require.Equal(t, int32(3), *info.SVN)
+	require.Len(t, info.Partitions, 2)
+	require.Equal(t, "FTPR", info.Partitions[0].Name)
+	require.Equal(t, intelmeanalysis.ManifestValidation_Correct, info.Partitions[0].ManifestValidation)
+	require.Equal(t, "MFS", info.Partitions[1].Name)
+	require.Equal(t, intelmeanalysis.PartitionType_Data, info.Partitions[1].Type)
+	require.Equal(t, intelmeanalysis.ManifestValidation_NoManifest, info.Partitions[1].ManifestValidation)
+
+	info, err = ParseMERegion(testMERegion{Major: 15, SVN: 3, Module: []byte("module"), BreakHashes: true}.build())
+	require.NoError(t, err)
+	require.Equal(t, intelmeanalysis.ManifestValidation_HashMismatch, info.Partitions[0].ManifestValidation)
+	require.Equal(t, []string{"bup.met"}, info.Partitions[0].MismatchedEntries)
+
+	// a module with a flipped byte does not match the hash in its metadata file
+	info, err = ParseMERegion(testMERegion{Major: 15, SVN: 3, Module: []byte("module"), BreakModule: true}.build())
+	require.NoError(t, err)
+	require.Equal(t, intelmeanalysis.ManifestValidation_HashMismatch, info.Partitions[0].ManifestValidation)
+	require.Equal(t, []string{"bup"}, info.Partitions[0].MismatchedEntries)
+
+	// a compressed module is hashed before compression, so it is not verified
+	info, err = ParseMERegion(testMERegion{Major: 15, SVN: 3, Module: []byte("module"), Compressed: true}.build())
+	require.NoError(t, err)
+	require.Equal(t, intelmeanalysis.ManifestValidation_PartiallyVerified, info.Partitions[0].ManifestValidation)
+	require.Equal(t, []string{"bup"}, info.Partitions[0].UnverifiedEntries)
+}
+
+func TestParseCPDHugeCount(t *testing.T) {
+	cpd := make([]byte, 0x100)
+	copy(cpd, cpdSignature)
+	binary.LittleEndian.PutUint32(cpd[len(cpdSignature):], 0xFFFFFFFF)
+	cpd[cpdHeaderLengthIndex] = 0x10
+
+	_, err := parseCPD(cpd)
+	require.Error(t, err)
+
+	// the maximal count which fits into the data is still parsed
+	binary.LittleEndian.PutUint32(cpd[len(cpdSignature):], (0x100-0x10)/cpdEntryLength)
+	entries, err := parseCPD(cpd)
+	require.NoError(t, err)
+	require.Len(t, entries, (0x100-0x10)/cpdEntryLength)
+
+	cpd[cpdHeaderLengthIndex] = 0xFF
+	binary.LittleEndian.PutUint32(cpd[len(cpdSignature):], 1)
+	_, err = parseCPD(cpd)
+	require.Error(t, err)
+}
+
+func TestDiagnose(t *testing.T) {
+	original := testMERegion{Major: 15, SVN: 3, Module: []byte("module")}
+	for name, testCase := range map[string]struct {
+		Actual            testMERegion
+		ExpectedDiagnosis intelmeanalysis.Diagnosis
+		ExpectedChanged   []string
+	}{
+		"match": {
+			Actual:            original,
+			ExpectedDiagnosis: intelmeanalysis.Diagnosis_Match,
+		},
+		"data_changed": {
+			Actual:            testMERegion{Major: 15, SVN: 3, Module: []byte("module"), MFSContent: 1},
+			ExpectedDiagnosis: intelmeanalysis.Diagnosis_DataPartitionsChanged,
+			ExpectedChanged:   []string{"MFS"},
+		},
+		"updated": {
+			Actual:            testMERegion{Major: 16, SVN: 4, Module: []byte("new module")},
+			ExpectedDiagnosis: intelmeanalysis.Diagnosis_UpdatedOutOfBand,
+			ExpectedChanged:   []string{"FTPR"},
+		},
+		"corrupted": {
+			Actual:            testMERegion{Major: 15, SVN: 3, Module: []byte("module"), BreakHashes: true},
+			ExpectedDiagnosis: intelmeanalysis.Diagnosis_PartitionCorrupted,
+			ExpectedChanged:   []string{"FTPR"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			originalInfo, err := ParseMERegion(original.build())
+			require.NoError(t, err)
+			actualInfo, err := ParseMERegion(testCase.Actual.build())
+			require.NoError(t, err)
+
+			diagnosis, changed := Diagnose(originalInfo, actualInfo)
+			require.Equal(t, testCase.ExpectedDiagnosis, diagnosis)
+			require.Equal(t, testCase.ExpectedChanged, changed)
+		})
+	}
+}
+
+func TestDowngradeIssues(t *testing.T) {
+	originalInfo, err := ParseMERegion(testMERegion{Major: 16, SVN: 4, Module: []byte("module")}.build())
+	require.NoError(t, err)
+	actualInfo, err := ParseMERegion(testMERegion{Major: 15, SVN: 3, Module: []byte("module")}.build())
+	require.NoError(t, err)
+
+	require.Len(t, downgradeIssues(originalInfo, actualInfo), 2)
+	require.Empty(t, downgradeIssues(actualInfo, originalInfo))
+}
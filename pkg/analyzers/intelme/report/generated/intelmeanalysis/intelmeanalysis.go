@@ -0,0 +1,1713 @@
+// Code generated by Thrift Compiler (0.14.0). DO NOT EDIT.
+
+package intelmeanalysis
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"time"
+)
+
+// (needed to ensure safety because of naive import list construction.)
+var _ = thrift.ZERO
+var _ = fmt.Printf
+var _ = context.Background
+var _ = time.Now
+var _ = bytes.Equal
+
+type PartitionType int64
+
+const (
+	PartitionType_Code    PartitionType = 0
+	PartitionType_Data    PartitionType = 1
+	PartitionType_NVRAM   PartitionType = 2
+	PartitionType_Generic PartitionType = 3
+	PartitionType_EFFS    PartitionType = 4
+	PartitionType_ROM     PartitionType = 5
+)
+
+func (p PartitionType) String() string {
+	switch p {
+	case PartitionType_Code:
+		return "Code"
+	case PartitionType_Data:
+		return "Data"
+	case PartitionType_NVRAM:
+		return "NVRAM"
+	case PartitionType_Generic:
+		return "Generic"
+	case PartitionType_EFFS:
+		return "EFFS"
+	case PartitionType_ROM:
+		return "ROM"
+	}
+	return "<UNSET>"
+}
+
+func PartitionTypeFromString(s string) (PartitionType, error) {
+	switch s {
+	case "Code":
+		return PartitionType_Code, nil
+	case "Data":
+		return PartitionType_Data, nil
+	case "NVRAM":
+		return PartitionType_NVRAM, nil
+	case "Generic":
+		return PartitionType_Generic, nil
+	case "EFFS":
+		return PartitionType_EFFS, nil
+	case "ROM":
+		return PartitionType_ROM, nil
+	}
+	return PartitionType(0), fmt.Errorf("not a valid PartitionType string")
+}
+
+func PartitionTypePtr(v PartitionType) *PartitionType { return &v }
+
+func (p PartitionType) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *PartitionType) UnmarshalText(text []byte) error {
+	q, err := PartitionTypeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *PartitionType) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = PartitionType(v)
+	return nil
+}
+
+func (p *PartitionType) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ManifestValidation int64
+
+const (
+	ManifestValidation_NoManifest        ManifestValidation = 0
+	ManifestValidation_Correct           ManifestValidation = 1
+	ManifestValidation_HashMismatch      ManifestValidation = 2
+	ManifestValidation_InvalidFormat     ManifestValidation = 3
+	ManifestValidation_NotVerified       ManifestValidation = 4
+	ManifestValidation_PartiallyVerified ManifestValidation = 5
+)
+
+func (p ManifestValidation) String() string {
+	switch p {
+	case ManifestValidation_NoManifest:
+		return "NoManifest"
+	case ManifestValidation_Correct:
+		return "Correct"
+	case ManifestValidation_HashMismatch:
+		return "HashMismatch"
+	case ManifestValidation_InvalidFormat:
+		return "InvalidFormat"
+	case ManifestValidation_NotVerified:
+		return "NotVerified"
+	case ManifestValidation_PartiallyVerified:
+		return "PartiallyVerified"
+	}
+	return "<UNSET>"
+}
+
+func ManifestValidationFromString(s string) (ManifestValidation, error) {
+	switch s {
+	case "NoManifest":
+		return ManifestValidation_NoManifest, nil
+	case "Correct":
+		return ManifestValidation_Correct, nil
+	case "HashMismatch":
+		return ManifestValidation_HashMismatch, nil
+	case "InvalidFormat":
+		return ManifestValidation_InvalidFormat, nil
+	case "NotVerified":
+		return ManifestValidation_NotVerified, nil
+	case "PartiallyVerified":
+		return ManifestValidation_PartiallyVerified, nil
+	}
+	return ManifestValidation(0), fmt.Errorf("not a valid ManifestValidation string")
+}
+
+func ManifestValidationPtr(v ManifestValidation) *ManifestValidation { return &v }
+
+func (p ManifestValidation) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *ManifestValidation) UnmarshalText(text []byte) error {
+	q, err := ManifestValidationFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *ManifestValidation) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = ManifestValidation(v)
+	return nil
+}
+
+func (p *ManifestValidation) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type Diagnosis int64
+
+const (
+	Diagnosis_Unknown               Diagnosis = 0
+	Diagnosis_Match                 Diagnosis = 1
+	Diagnosis_DataPartitionsChanged Diagnosis = 2
+	Diagnosis_UpdatedOutOfBand      Diagnosis = 3
+	Diagnosis_PartitionCorrupted    Diagnosis = 4
+	Diagnosis_Unexplained           Diagnosis = 5
+)
+
+func (p Diagnosis) String() string {
+	switch p {
+	case Diagnosis_Unknown:
+		return "Unknown"
+	case Diagnosis_Match:
+		return "Match"
+	case Diagnosis_DataPartitionsChanged:
+		return "DataPartitionsChanged"
+	case Diagnosis_UpdatedOutOfBand:
+		return "UpdatedOutOfBand"
+	case Diagnosis_PartitionCorrupted:
+		return "PartitionCorrupted"
+	case Diagnosis_Unexplained:
+		return "Unexplained"
+	}
+	return "<UNSET>"
+}
+
+func DiagnosisFromString(s string) (Diagnosis, error) {
+	switch s {
+	case "Unknown":
+		return Diagnosis_Unknown, nil
+	case "Match":
+		return Diagnosis_Match, nil
+	case "DataPartitionsChanged":
+		return Diagnosis_DataPartitionsChanged, nil
+	case "UpdatedOutOfBand":
+		return Diagnosis_UpdatedOutOfBand, nil
+	case "PartitionCorrupted":
+		return Diagnosis_PartitionCorrupted, nil
+	case "Unexplained":
+		return Diagnosis_Unexplained, nil
+	}
+	return Diagnosis(0), fmt.Errorf("not a valid Diagnosis string")
+}
+
+func DiagnosisPtr(v Diagnosis) *Diagnosis { return &v }
+
+func (p Diagnosis) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Diagnosis) UnmarshalText(text []byte) error {
+	q, err := DiagnosisFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+func (p *Diagnosis) Scan(value interface{}) error {
+	v, ok := value.(int64)
+	if !ok {
+		return errors.New("Scan value is not int64")
+	}
+	*p = Diagnosis(v)
+	return nil
+}
+
+func (p *Diagnosis) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+// Attributes:
+//   - Major
+//   - Minor
+//   - Hotfix
+//   - Build
+type Version struct {
+	Major  int16 `thrift:"Major,1" db:"Major" json:"Major"`
+	Minor  int16 `thrift:"Minor,2" db:"Minor" json:"Minor"`
+	Hotfix int16 `thrift:"Hotfix,3" db:"Hotfix" json:"Hotfix"`
+	Build  int16 `thrift:"Build,4" db:"Build" json:"Build"`
+}
+
+func NewVersion() *Version {
+	return &Version{}
+}
+
+func (p *Version) GetMajor() int16 {
+	return p.Major
+}
+
+func (p *Version) GetMinor() int16 {
+	return p.Minor
+}
+
+func (p *Version) GetHotfix() int16 {
+	return p.Hotfix
+}
+
+func (p *Version) GetBuild() int16 {
+	return p.Build
+}
+func (p *Version) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I16 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Version) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Major = v
+	}
+	return nil
+}
+
+func (p *Version) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Minor = v
+	}
+	return nil
+}
+
+func (p *Version) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Hotfix = v
+	}
+	return nil
+}
+
+func (p *Version) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI16(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Build = v
+	}
+	return nil
+}
+
+func (p *Version) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Version"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Version) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Major", thrift.I16, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Major: ", p), err)
+	}
+	if err := oprot.WriteI16(ctx, int16(p.Major)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Major (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Major: ", p), err)
+	}
+	return err
+}
+
+func (p *Version) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Minor", thrift.I16, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Minor: ", p), err)
+	}
+	if err := oprot.WriteI16(ctx, int16(p.Minor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Minor (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Minor: ", p), err)
+	}
+	return err
+}
+
+func (p *Version) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Hotfix", thrift.I16, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Hotfix: ", p), err)
+	}
+	if err := oprot.WriteI16(ctx, int16(p.Hotfix)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Hotfix (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Hotfix: ", p), err)
+	}
+	return err
+}
+
+func (p *Version) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Build", thrift.I16, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Build: ", p), err)
+	}
+	if err := oprot.WriteI16(ctx, int16(p.Build)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Build (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Build: ", p), err)
+	}
+	return err
+}
+
+func (p *Version) Equals(other *Version) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Major != other.Major {
+		return false
+	}
+	if p.Minor != other.Minor {
+		return false
+	}
+	if p.Hotfix != other.Hotfix {
+		return false
+	}
+	if p.Build != other.Build {
+		return false
+	}
+	return true
+}
+
+func (p *Version) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Version(%+v)", *p)
+}
+
+// Attributes:
+//   - Name
+//   - Type
+//   - Offset
+//   - Length
+//   - SHA256
+//   - Version
+//   - SVN
+//   - ManifestValidation
+//   - ValidationDescription
+//   - MismatchedEntries
+//   - UnverifiedEntries
+type Partition struct {
+	Name                  string             `thrift:"Name,1" db:"Name" json:"Name"`
+	Type                  PartitionType      `thrift:"Type,2" db:"Type" json:"Type"`
+	Offset                int64              `thrift:"Offset,3" db:"Offset" json:"Offset"`
+	Length                int64              `thrift:"Length,4" db:"Length" json:"Length"`
+	SHA256                []byte             `thrift:"SHA256,5" db:"SHA256" json:"SHA256"`
+	Version               *Version           `thrift:"Version,6" db:"Version" json:"Version,omitempty"`
+	SVN                   *int32             `thrift:"SVN,7" db:"SVN" json:"SVN,omitempty"`
+	ManifestValidation    ManifestValidation `thrift:"ManifestValidation,8" db:"ManifestValidation" json:"ManifestValidation"`
+	ValidationDescription string             `thrift:"ValidationDescription,9" db:"ValidationDescription" json:"ValidationDescription"`
+	MismatchedEntries     []string           `thrift:"MismatchedEntries,10" db:"MismatchedEntries" json:"MismatchedEntries"`
+	UnverifiedEntries     []string           `thrift:"UnverifiedEntries,11" db:"UnverifiedEntries" json:"UnverifiedEntries"`
+}
+
+func NewPartition() *Partition {
+	return &Partition{}
+}
+
+func (p *Partition) GetName() string {
+	return p.Name
+}
+
+func (p *Partition) GetType() PartitionType {
+	return p.Type
+}
+
+func (p *Partition) GetOffset() int64 {
+	return p.Offset
+}
+
+func (p *Partition) GetLength() int64 {
+	return p.Length
+}
+
+func (p *Partition) GetSHA256() []byte {
+	return p.SHA256
+}
+
+var Partition_Version_DEFAULT *Version
+
+func (p *Partition) GetVersion() *Version {
+	if !p.IsSetVersion() {
+		return Partition_Version_DEFAULT
+	}
+	return p.Version
+}
+
+var Partition_SVN_DEFAULT int32
+
+func (p *Partition) GetSVN() int32 {
+	if !p.IsSetSVN() {
+		return Partition_SVN_DEFAULT
+	}
+	return *p.SVN
+}
+
+func (p *Partition) GetManifestValidation() ManifestValidation {
+	return p.ManifestValidation
+}
+
+func (p *Partition) GetValidationDescription() string {
+	return p.ValidationDescription
+}
+
+func (p *Partition) GetMismatchedEntries() []string {
+	return p.MismatchedEntries
+}
+
+func (p *Partition) GetUnverifiedEntries() []string {
+	return p.UnverifiedEntries
+}
+func (p *Partition) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *Partition) IsSetSVN() bool {
+	return p.SVN != nil
+}
+
+func (p *Partition) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField5(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 6:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField6(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField7(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField8(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 9:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField9(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 10:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField10(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 11:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField11(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Partition) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *Partition) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := PartitionType(v)
+		p.Type = temp
+	}
+	return nil
+}
+
+func (p *Partition) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *Partition) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(ctx); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Length = v
+	}
+	return nil
+}
+
+func (p *Partition) ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.SHA256 = v
+	}
+	return nil
+}
+
+func (p *Partition) ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Version = &Version{}
+	if err := p.Version.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Version), err)
+	}
+	return nil
+}
+
+func (p *Partition) ReadField7(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.SVN = &v
+	}
+	return nil
+}
+
+func (p *Partition) ReadField8(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		temp := ManifestValidation(v)
+		p.ManifestValidation = temp
+	}
+	return nil
+}
+
+func (p *Partition) ReadField9(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(ctx); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.ValidationDescription = v
+	}
+	return nil
+}
+
+func (p *Partition) ReadField10(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.MismatchedEntries = tSlice
+	for i := 0; i < size; i++ {
+		var _elem0 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem0 = v
+		}
+		p.MismatchedEntries = append(p.MismatchedEntries, _elem0)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Partition) ReadField11(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.UnverifiedEntries = tSlice
+	for i := 0; i < size; i++ {
+		var _elem1 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem1 = v
+		}
+		p.UnverifiedEntries = append(p.UnverifiedEntries, _elem1)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Partition) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "Partition"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField5(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField6(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField7(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField8(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField9(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField10(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField11(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Partition) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Name", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Name: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Name (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Name: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Type", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Type: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Type (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Type: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Offset", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Offset: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Offset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Offset (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Offset: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Length", thrift.I64, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Length: ", p), err)
+	}
+	if err := oprot.WriteI64(ctx, int64(p.Length)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Length (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Length: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "SHA256", thrift.STRING, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:SHA256: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.SHA256); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.SHA256 (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:SHA256: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:Version: ", p), err)
+		}
+		if err := p.Version.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Version), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:Version: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Partition) writeField7(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSVN() {
+		if err := oprot.WriteFieldBegin(ctx, "SVN", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:SVN: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.SVN)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.SVN (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:SVN: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Partition) writeField8(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ManifestValidation", thrift.I32, 8); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:ManifestValidation: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.ManifestValidation)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ManifestValidation (8) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 8:ManifestValidation: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) writeField9(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ValidationDescription", thrift.STRING, 9); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:ValidationDescription: ", p), err)
+	}
+	if err := oprot.WriteString(ctx, string(p.ValidationDescription)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ValidationDescription (9) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 9:ValidationDescription: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) writeField10(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "MismatchedEntries", thrift.LIST, 10); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:MismatchedEntries: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.MismatchedEntries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MismatchedEntries {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 10:MismatchedEntries: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) writeField11(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "UnverifiedEntries", thrift.LIST, 11); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:UnverifiedEntries: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.UnverifiedEntries)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.UnverifiedEntries {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 11:UnverifiedEntries: ", p), err)
+	}
+	return err
+}
+
+func (p *Partition) Equals(other *Partition) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if p.Name != other.Name {
+		return false
+	}
+	if p.Type != other.Type {
+		return false
+	}
+	if p.Offset != other.Offset {
+		return false
+	}
+	if p.Length != other.Length {
+		return false
+	}
+	if bytes.Compare(p.SHA256, other.SHA256) != 0 {
+		return false
+	}
+	if !p.Version.Equals(other.Version) {
+		return false
+	}
+	if p.SVN != other.SVN {
+		if p.SVN == nil || other.SVN == nil {
+			return false
+		}
+		if (*p.SVN) != (*other.SVN) {
+			return false
+		}
+	}
+	if p.ManifestValidation != other.ManifestValidation {
+		return false
+	}
+	if p.ValidationDescription != other.ValidationDescription {
+		return false
+	}
+	if len(p.MismatchedEntries) != len(other.MismatchedEntries) {
+		return false
+	}
+	for i, _tgt := range p.MismatchedEntries {
+		_src2 := other.MismatchedEntries[i]
+		if _tgt != _src2 {
+			return false
+		}
+	}
+	if len(p.UnverifiedEntries) != len(other.UnverifiedEntries) {
+		return false
+	}
+	for i, _tgt := range p.UnverifiedEntries {
+		_src3 := other.UnverifiedEntries[i]
+		if _tgt != _src3 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Partition) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Partition(%+v)", *p)
+}
+
+// Attributes:
+//   - Version
+//   - SVN
+//   - FPTSHA256
+//   - Partitions
+type MEInfo struct {
+	Version    *Version     `thrift:"Version,1" db:"Version" json:"Version,omitempty"`
+	SVN        *int32       `thrift:"SVN,2" db:"SVN" json:"SVN,omitempty"`
+	FPTSHA256  []byte       `thrift:"FPTSHA256,3" db:"FPTSHA256" json:"FPTSHA256"`
+	Partitions []*Partition `thrift:"Partitions,4" db:"Partitions" json:"Partitions"`
+}
+
+func NewMEInfo() *MEInfo {
+	return &MEInfo{}
+}
+
+var MEInfo_Version_DEFAULT *Version
+
+func (p *MEInfo) GetVersion() *Version {
+	if !p.IsSetVersion() {
+		return MEInfo_Version_DEFAULT
+	}
+	return p.Version
+}
+
+var MEInfo_SVN_DEFAULT int32
+
+func (p *MEInfo) GetSVN() int32 {
+	if !p.IsSetSVN() {
+		return MEInfo_SVN_DEFAULT
+	}
+	return *p.SVN
+}
+
+func (p *MEInfo) GetFPTSHA256() []byte {
+	return p.FPTSHA256
+}
+
+func (p *MEInfo) GetPartitions() []*Partition {
+	return p.Partitions
+}
+func (p *MEInfo) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *MEInfo) IsSetSVN() bool {
+	return p.SVN != nil
+}
+
+func (p *MEInfo) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *MEInfo) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Version = &Version{}
+	if err := p.Version.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Version), err)
+	}
+	return nil
+}
+
+func (p *MEInfo) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.SVN = &v
+	}
+	return nil
+}
+
+func (p *MEInfo) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.FPTSHA256 = v
+	}
+	return nil
+}
+
+func (p *MEInfo) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*Partition, 0, size)
+	p.Partitions = tSlice
+	for i := 0; i < size; i++ {
+		_elem4 := &Partition{}
+		if err := _elem4.Read(ctx, iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		}
+		p.Partitions = append(p.Partitions, _elem4)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *MEInfo) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "MEInfo"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *MEInfo) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err := oprot.WriteFieldBegin(ctx, "Version", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Version: ", p), err)
+		}
+		if err := p.Version.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Version), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Version: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *MEInfo) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetSVN() {
+		if err := oprot.WriteFieldBegin(ctx, "SVN", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:SVN: ", p), err)
+		}
+		if err := oprot.WriteI32(ctx, int32(*p.SVN)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.SVN (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:SVN: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *MEInfo) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "FPTSHA256", thrift.STRING, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:FPTSHA256: ", p), err)
+	}
+	if err := oprot.WriteBinary(ctx, p.FPTSHA256); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.FPTSHA256 (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:FPTSHA256: ", p), err)
+	}
+	return err
+}
+
+func (p *MEInfo) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Partitions", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Partitions: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRUCT, len(p.Partitions)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Partitions {
+		if err := v.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Partitions: ", p), err)
+	}
+	return err
+}
+
+func (p *MEInfo) Equals(other *MEInfo) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Version.Equals(other.Version) {
+		return false
+	}
+	if p.SVN != other.SVN {
+		if p.SVN == nil || other.SVN == nil {
+			return false
+		}
+		if (*p.SVN) != (*other.SVN) {
+			return false
+		}
+	}
+	if bytes.Compare(p.FPTSHA256, other.FPTSHA256) != 0 {
+		return false
+	}
+	if len(p.Partitions) != len(other.Partitions) {
+		return false
+	}
+	for i, _tgt := range p.Partitions {
+		_src5 := other.Partitions[i]
+		if !_tgt.Equals(_src5) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *MEInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MEInfo(%+v)", *p)
+}
+
+// Attributes:
+//   - Actual
+//   - Original
+//   - Diagnosis
+//   - ChangedPartitions
+type CustomReport struct {
+	Actual            *MEInfo   `thrift:"Actual,1" db:"Actual" json:"Actual"`
+	Original          *MEInfo   `thrift:"Original,2" db:"Original" json:"Original,omitempty"`
+	Diagnosis         Diagnosis `thrift:"Diagnosis,3" db:"Diagnosis" json:"Diagnosis"`
+	ChangedPartitions []string  `thrift:"ChangedPartitions,4" db:"ChangedPartitions" json:"ChangedPartitions"`
+}
+
+func NewCustomReport() *CustomReport {
+	return &CustomReport{}
+}
+
+var CustomReport_Actual_DEFAULT *MEInfo
+
+func (p *CustomReport) GetActual() *MEInfo {
+	if !p.IsSetActual() {
+		return CustomReport_Actual_DEFAULT
+	}
+	return p.Actual
+}
+
+var CustomReport_Original_DEFAULT *MEInfo
+
+func (p *CustomReport) GetOriginal() *MEInfo {
+	if !p.IsSetOriginal() {
+		return CustomReport_Original_DEFAULT
+	}
+	return p.Original
+}
+
+func (p *CustomReport) GetDiagnosis() Diagnosis {
+	return p.Diagnosis
+}
+
+func (p *CustomReport) GetChangedPartitions() []string {
+	return p.ChangedPartitions
+}
+func (p *CustomReport) IsSetActual() bool {
+	return p.Actual != nil
+}
+
+func (p *CustomReport) IsSetOriginal() bool {
+	return p.Original != nil
+}
+
+func (p *CustomReport) Read(ctx context.Context, iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField1(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err := p.ReadField2(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err := p.ReadField3(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err := p.ReadField4(ctx, iprot); err != nil {
+					return err
+				}
+			} else {
+				if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+					return err
+				}
+			}
+		default:
+			if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(ctx); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Actual = &MEInfo{}
+	if err := p.Actual.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Actual), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+	p.Original = &MEInfo{}
+	if err := p.Original.Read(ctx, iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Original), err)
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(ctx); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := Diagnosis(v)
+		p.Diagnosis = temp
+	}
+	return nil
+}
+
+func (p *CustomReport) ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin(ctx)
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.ChangedPartitions = tSlice
+	for i := 0; i < size; i++ {
+		var _elem6 string
+		if v, err := iprot.ReadString(ctx); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem6 = v
+		}
+		p.ChangedPartitions = append(p.ChangedPartitions, _elem6)
+	}
+	if err := iprot.ReadListEnd(ctx); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) Write(ctx context.Context, oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin(ctx, "CustomReport"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if p != nil {
+		if err := p.writeField1(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField2(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField3(ctx, oprot); err != nil {
+			return err
+		}
+		if err := p.writeField4(ctx, oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteFieldStop(ctx); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(ctx); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CustomReport) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Actual", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Actual: ", p), err)
+	}
+	if err := p.Actual.Write(ctx, oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Actual), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Actual: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if p.IsSetOriginal() {
+		if err := oprot.WriteFieldBegin(ctx, "Original", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Original: ", p), err)
+		}
+		if err := p.Original.Write(ctx, oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Original), err)
+		}
+		if err := oprot.WriteFieldEnd(ctx); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Original: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CustomReport) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "Diagnosis", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Diagnosis: ", p), err)
+	}
+	if err := oprot.WriteI32(ctx, int32(p.Diagnosis)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.Diagnosis (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Diagnosis: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin(ctx, "ChangedPartitions", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ChangedPartitions: ", p), err)
+	}
+	if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.ChangedPartitions)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.ChangedPartitions {
+		if err := oprot.WriteString(ctx, string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(ctx); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(ctx); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ChangedPartitions: ", p), err)
+	}
+	return err
+}
+
+func (p *CustomReport) Equals(other *CustomReport) bool {
+	if p == other {
+		return true
+	} else if p == nil || other == nil {
+		return false
+	}
+	if !p.Actual.Equals(other.Actual) {
+		return false
+	}
+	if !p.Original.Equals(other.Original) {
+		return false
+	}
+	if p.Diagnosis != other.Diagnosis {
+		return false
+	}
+	if len(p.ChangedPartitions) != len(other.ChangedPartitions) {
+		return false
+	}
+	for i, _tgt := range p.ChangedPartitions {
+		_src7 := other.ChangedPartitions[i]
+		if _tgt != _src7 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *CustomReport) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CustomReport(%+v)", *p)
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.intelme.report.generated.intelmeanalysis
+
+const string IntelMEAnalyzerID = "IntelME";
+
+struct Version {
+  1: i16 Major;
+  2: i16 Minor;
+  3: i16 Hotfix;
+  4: i16 Build;
+}
+
+// PartitionType is the type of a partition as defined in the ME Flash Partition Table
+enum PartitionType {
+  Code = 0,
+  Data = 1,
+  NVRAM = 2,
+  Generic = 3,
+  EFFS = 4,
+  ROM = 5,
+}
+
+enum ManifestValidation {
+  NoManifest = 0, // the partition has no manifest (usually a data partition)
+  Correct = 1, // the hashes of all the metadata files are listed in the manifest and all the modules match their metadata files
+  HashMismatch = 2, // a metadata file does not match the manifest or a module does not match its metadata file
+  InvalidFormat = 3, // the partition or its manifest cannot be parsed
+  NotVerified = 4, // the manifest is found, but this manifest format is not verified (ME before version 11)
+  PartiallyVerified = 5, // the metadata files match the manifest, but some modules cannot be verified (for example compressed ones), see UnverifiedEntries
+}
+
+struct Partition {
+  1: string Name;
+  2: PartitionType Type;
+  3: i64 Offset; // relative to the beginning of the ME region
+  4: i64 Length;
+  5: binary SHA256;
+  6: optional Version Version;
+  7: optional i32 SVN;
+  8: ManifestValidation ManifestValidation;
+  9: string ValidationDescription;
+  10: list<string> MismatchedEntries; // names of the CPD entries which do not match the manifest or their metadata files
+  11: list<string> UnverifiedEntries; // names of the modules which hashes are not verified
+}
+
+struct MEInfo {
+  // Version and SVN of the ME firmware (taken from the FTPR manifest)
+  1: optional Version Version;
+  2: optional i32 SVN;
+  3: binary FPTSHA256; // the hash of the Flash Partition Table itself
+  4: list<Partition> Partitions;
+}
+
+// Diagnosis explains the difference between the ME regions of the original and actual images
+enum Diagnosis {
+  Unknown = 0, // the original image is not available
+  Match = 1,
+  DataPartitionsChanged = 2, // only data partitions changed, this happens during the normal ME operation
+  UpdatedOutOfBand = 3, // ME firmware version differs, but the partitions are consistent with their manifests
+  PartitionCorrupted = 4, // a changed partition does not match its manifest
+  Unexplained = 5, // code partitions or the partition table changed without a version change
+}
+
+struct CustomReport {
+  1: MEInfo Actual;
+  2: optional MEInfo Original;
+  3: Diagnosis Diagnosis;
+  4: list<string> ChangedPartitions;
+}
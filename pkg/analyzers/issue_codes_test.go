@@ -0,0 +1,194 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package analyzers
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+// issueCodeUsage is an issue literal found in the sources of analyzers.
+type issueCodeUsage struct {
+	Position token.Position
+
+	// HasCode is false if the literal has no Code field.
+	HasCode bool
+
+	// ConstName is the name of the IssueCode constant used as the Code,
+	// it is empty if the code is chosen through a variable.
+	ConstName string
+
+	Params []string
+}
+
+// TestIssueCodesCompleteness verifies that every issue reported by
+// built-in analyzers has a code from the catalog, that every code
+// of the catalog is used and that issues use only the catalogued params.
+func TestIssueCodesCompleteness(t *testing.T) {
+	fset := token.NewFileSet()
+	parse := func(path string) *ast.File {
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		require.NoError(t, err)
+		return file
+	}
+
+	codeByConstName := map[string]analysis.IssueCode{}
+	for _, decl := range parse("../analysis/issue_code_catalog.go").Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for idx, name := range valueSpec.Names {
+				value, err := strconv.Unquote(valueSpec.Values[idx].(*ast.BasicLit).Value)
+				require.NoError(t, err)
+				codeByConstName[name.Name] = analysis.IssueCode(value)
+			}
+		}
+	}
+	require.Len(t, codeByConstName, len(analysis.IssueCodes()))
+
+	files := []*ast.File{parse("../analysis/calculators.go")}
+	err := filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "generated" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		files = append(files, parse(path))
+		return nil
+	})
+	require.NoError(t, err)
+
+	var usages []issueCodeUsage
+	used := map[analysis.IssueCode]struct{}{}
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			if ident, ok := node.(*ast.Ident); ok {
+				if code, ok := codeByConstName[ident.Name]; ok {
+					used[code] = struct{}{}
+				}
+				return true
+			}
+			lit, ok := node.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+			if isIssueType(lit.Type) {
+				usages = append(usages, issueLiteralUsage(t, fset, lit))
+				return true
+			}
+			if arrayType, ok := lit.Type.(*ast.ArrayType); ok && isIssueType(arrayType.Elt) {
+				for _, elt := range lit.Elts {
+					if eltLit, ok := elt.(*ast.CompositeLit); ok && eltLit.Type == nil {
+						usages = append(usages, issueLiteralUsage(t, fset, eltLit))
+					}
+				}
+			}
+			return true
+		})
+	}
+	require.NotEmpty(t, usages)
+
+	for _, usage := range usages {
+		require.True(t, usage.HasCode, "%s: the issue has no code", usage.Position)
+		if usage.ConstName == "" {
+			continue
+		}
+		code, ok := codeByConstName[usage.ConstName]
+		require.True(t, ok, "%s: unknown issue code constant %s", usage.Position, usage.ConstName)
+
+		info := analysis.LookupIssueCode(code)
+		require.NotNil(t, info, code)
+		for _, param := range usage.Params {
+			require.Contains(t, info.Params, param, "%s: param '%s' is not listed in the catalog for %s", usage.Position, param, code)
+		}
+	}
+
+	registry, err := NewRegistryWithKnownAnalyzers(nil)
+	require.NoError(t, err)
+	registered := map[analysis.AnalyzerID]struct{}{}
+	for _, id := range registry.IDs() {
+		registered[id] = struct{}{}
+	}
+	for _, info := range analysis.IssueCodes() {
+		_, ok := used[info.Code]
+		require.True(t, ok, "issue code %s is in the catalog, but is never reported", info.Code)
+		if info.AnalyzerID != "" {
+			_, ok := registered[info.AnalyzerID]
+			require.True(t, ok, "issue code %s refers to unknown analyzer %s", info.Code, info.AnalyzerID)
+		}
+	}
+}
+
+func isIssueType(expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name == "Issue"
+	case *ast.SelectorExpr:
+		pkg, ok := expr.X.(*ast.Ident)
+		return ok && pkg.Name == "analysis" && expr.Sel.Name == "Issue"
+	}
+	return false
+}
+
+func issueLiteralUsage(t *testing.T, fset *token.FileSet, lit *ast.CompositeLit) issueCodeUsage {
+	usage := issueCodeUsage{
+		Position: fset.Position(lit.Pos()),
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		switch kv.Key.(*ast.Ident).Name {
+		case "Code":
+			usage.HasCode = true
+			switch value := kv.Value.(type) {
+			case *ast.Ident:
+				if strings.HasPrefix(value.Name, "IssueCode") {
+					usage.ConstName = value.Name
+				}
+			case *ast.SelectorExpr:
+				usage.ConstName = value.Sel.Name
+			}
+		case "Params":
+			params, ok := kv.Value.(*ast.CompositeLit)
+			require.True(t, ok, "%s: issue params are expected to be a map literal", usage.Position)
+			for _, param := range params.Elts {
+				key, err := strconv.Unquote(param.(*ast.KeyValueExpr).Key.(*ast.BasicLit).Value)
+				require.NoError(t, err)
+				usage.Params = append(usage.Params, key)
+			}
+		}
+	}
+	return usage
+}
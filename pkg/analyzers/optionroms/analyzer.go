@@ -0,0 +1,141 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package optionroms
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms/report/generated/optionromsanalysis"
+)
+
+func init() {
+	analysis.RegisterType((*Catalog)(nil))
+	analysis.RegisterType((*optionromsanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of OptionROMs analyzer
+const ID analysis.AnalyzerID = optionromsanalysis.OptionROMsAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
+// NewExecutorInput builds an analysis.Executor's input required for OptionROMs analyzer
+func NewExecutorInput(
+	eventLog *tpmeventlog.TPMEventLog,
+	catalog Catalog,
+) (analysis.Input, error) {
+	if eventLog == nil {
+		return nil, fmt.Errorf("the TPM EventLog should be specified")
+	}
+
+	result := analysis.NewInput()
+	result.AddTPMEventLog(
+		eventLog,
+	).AddCustomValue(
+		catalog,
+	)
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	TPMEventLog *tpmeventlog.TPMEventLog
+	Catalog     Catalog
+}
+
+// OptionROMs is analyzer that checks the option ROMs measured to PCR2
+// against a catalog of known option ROMs.
+type OptionROMs struct{}
+
+// New returns a new object of OptionROMs analyzer
+func New() analysis.Analyzer[Input] {
+	return &OptionROMs{}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *OptionROMs) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *OptionROMs) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze matches EV_EFI_BOOT_SERVICES_DRIVER events of PCR2 against the catalog
+func (analyzer *OptionROMs) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	customReport := optionromsanalysis.CustomReport{
+		CatalogEntries: int32(len(in.Catalog.OptionROMs)),
+	}
+	result := &analysis.Report{}
+	for _, event := range DriverEvents(in.TPMEventLog, logger.FromCtx(ctx)) {
+		optionROM := &optionromsanalysis.OptionROM{
+			DevicePath:  event.DevicePath,
+			ImageLength: int64(event.ImageLength),
+			Status:      optionromsanalysis.Status_Unknown,
+		}
+		var known *CatalogEntry
+		for _, digest := range event.Digests {
+			optionROM.Digests = append(optionROM.Digests, &optionromsanalysis.Digest{
+				HashAlgo: digest.HashAlgo.String(),
+				Value:    digest.Digest,
+			})
+			if known == nil {
+				known = in.Catalog.Lookup(digest.HashAlgo, digest.Digest)
+			}
+		}
+
+		expected := in.Catalog.ExpectedAt(event.DevicePath)
+		switch {
+		case known != nil:
+			optionROM.Status = optionromsanalysis.Status_Known
+			optionROM.Name, optionROM.Version = entryNameVersion(known)
+		case expected != nil:
+			optionROM.Status = optionromsanalysis.Status_Changed
+			optionROM.Name, optionROM.Version = entryNameVersion(expected)
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeOptionROMsModified,
+				Severity:    analysis.SeverityCritical,
+				Description: fmt.Sprintf("option ROM at %s differs from the expected '%s'", event.DevicePath, expected.Name),
+				Params:      map[string]string{"device_path": event.DevicePath, "expected": expected.Name},
+			})
+		default:
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeOptionROMsUnknown,
+				Severity:    analysis.SeverityWarning,
+				Description: fmt.Sprintf("unknown option ROM at %s", event.DevicePath),
+				Params:      map[string]string{"device_path": event.DevicePath},
+			})
+		}
+		customReport.OptionROMs = append(customReport.OptionROMs, optionROM)
+	}
+
+	result.Custom = customReport
+	return result, nil
+}
+
+func entryNameVersion(entry *CatalogEntry) (*string, *string) {
+	name := entry.Name
+	if entry.Version == "" {
+		return &name, nil
+	}
+	version := entry.Version
+	return &name, &version
+}
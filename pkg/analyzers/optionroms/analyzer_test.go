@@ -0,0 +1,143 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package optionroms
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms/report/generated/optionromsanalysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/firmwaredb"
+)
+
+func devicePathNode(nodeType, subType byte, data ...byte) []byte {
+	node := []byte{nodeType, subType, 0, 0}
+	binary.LittleEndian.PutUint16(node[2:], uint16(4+len(data)))
+	return append(node, data...)
+}
+
+// testImageLoadEvent builds UEFI_IMAGE_LOAD_EVENT for an option ROM of device 00:<device>.0
+func testImageLoadEvent(device byte) []byte {
+	var devicePath []byte
+	devicePath = append(devicePath, devicePathNode(0x02, 0x01, 0xD0, 0x41, 0x03, 0x0A, 0, 0, 0, 0)...)
+	devicePath = append(devicePath, devicePathNode(0x01, 0x01, 0, device)...)
+	offset := make([]byte, 20)
+	binary.LittleEndian.PutUint64(offset[4:], 0x200)
+	binary.LittleEndian.PutUint64(offset[12:], 0xFFFF)
+	devicePath = append(devicePath, devicePathNode(0x04, 0x08, offset...)...)
+	devicePath = append(devicePath, devicePathNode(0x7F, 0xFF)...)
+
+	data := make([]byte, 32)
+	binary.LittleEndian.PutUint64(data[8:], 0x1000)
+	binary.LittleEndian.PutUint64(data[24:], uint64(len(devicePath)))
+	return append(data, devicePath...)
+}
+
+// testDriverEvents returns the events of a driver measured to SHA1 and SHA256 banks
+func testDriverEvents(device byte, content string) []*tpmeventlog.Event {
+	data := testImageLoadEvent(device)
+	sha1Digest := sha1.Sum([]byte(content))
+	sha256Digest := sha256.Sum256([]byte(content))
+	return []*tpmeventlog.Event{
+		{PCRIndex: PCRIndex, Type: tpmeventlog.EV_EFI_BOOT_SERVICES_DRIVER, Data: data, Digest: &tpmeventlog.Digest{HashAlgo: tpm2.AlgSHA1, Digest: sha1Digest[:]}},
+		{PCRIndex: PCRIndex, Type: tpmeventlog.EV_EFI_BOOT_SERVICES_DRIVER, Data: data, Digest: &tpmeventlog.Digest{HashAlgo: tpm2.AlgSHA256, Digest: sha256Digest[:]}},
+	}
+}
+
+func TestOptionROMs(t *testing.T) {
+	knownDigest := sha256.Sum256([]byte("known"))
+	yaml := strings.ReplaceAll(`
+option_roms:
+  - name: known NIC
+    version: "1.0"
+    sha256: [%HASH%]
+  - name: pinned GPU
+    device_path: PciRoot(0x0)/Pci(0x2,0x0)/*
+    sha1: ["0000000000000000000000000000000000000000"]
+`, "%HASH%", hex.EncodeToString(knownDigest[:]))
+	catalog, err := ParseCatalog([]byte(yaml), true)
+	require.NoError(t, err)
+
+	var eventLog tpmeventlog.TPMEventLog
+	eventLog.Events = append(eventLog.Events, testDriverEvents(0x1, "known")...)
+	eventLog.Events = append(eventLog.Events, testDriverEvents(0x2, "replaced")...)
+	eventLog.Events = append(eventLog.Events, testDriverEvents(0x3, "unknown")...)
+	eventLog.Events = append(eventLog.Events, &tpmeventlog.Event{
+		PCRIndex: 0,
+		Type:     tpmeventlog.EV_EFI_BOOT_SERVICES_DRIVER,
+		Data:     testImageLoadEvent(0x4),
+		Digest:   &tpmeventlog.Digest{HashAlgo: tpm2.AlgSHA1, Digest: make([]byte, 20)},
+	})
+
+	report, err := New().Analyze(context.Background(), Input{
+		TPMEventLog: &eventLog,
+		Catalog:     *catalog,
+	})
+	require.NoError(t, err)
+	customReport := report.Custom.(optionromsanalysis.CustomReport)
+	require.Equal(t, int32(2), customReport.CatalogEntries)
+	require.Len(t, customReport.OptionROMs, 3)
+
+	known, changed, unknown := customReport.OptionROMs[0], customReport.OptionROMs[1], customReport.OptionROMs[2]
+	require.Equal(t, optionromsanalysis.Status_Known, known.Status)
+	require.Equal(t, "known NIC", *known.Name)
+	require.Equal(t, "1.0", *known.Version)
+	require.Len(t, known.Digests, 2)
+	require.Equal(t, int64(0x1000), known.ImageLength)
+
+	require.Equal(t, optionromsanalysis.Status_Changed, changed.Status)
+	require.Equal(t, "pinned GPU", *changed.Name)
+	require.Equal(t, optionromsanalysis.Status_Unknown, unknown.Status)
+	require.Nil(t, unknown.Name)
+
+	require.Len(t, report.Issues, 2)
+	require.Equal(t, analysis.SeverityCritical, report.Issues[0].Severity)
+	require.Equal(t, analysis.SeverityWarning, report.Issues[1].Severity)
+
+	_, err = ParseCatalog([]byte(`{"option_roms":[{"name":"no digests"}]}`), false)
+	require.Error(t, err)
+	_, err = ParseCatalog([]byte(`{"option_roms":[{"name":"invalid","sha1":["00"]}]}`), false)
+	require.Error(t, err)
+}
+
+func TestNewCatalogFromFirmwareDB(t *testing.T) {
+	digest := sha256.Sum256([]byte("known"))
+	catalog := NewCatalogFromFirmwareDB([]*firmwaredb.Firmware{
+		{
+			Type:     firmwaredb.FirmwareTypeOptionROM,
+			Version:  "2.1",
+			ImageURL: "https://example.com/roms/nic.rom",
+			Measurements: []*firmwaredb.FirmwareMeasurement{{
+				FirmwareMeasurementType: &firmwaredb.FirmwareMeasurementType{Name: MeasurementTypeAuthenticodeSHA256},
+				Value:                   digest[:],
+			}},
+		},
+		{
+			Type:    firmwaredb.FirmwareTypeBIOS,
+			Version: "F20",
+		},
+	})
+	require.Len(t, catalog.OptionROMs, 1)
+	require.Equal(t, "nic.rom", catalog.OptionROMs[0].Name)
+	require.NotNil(t, catalog.Lookup(tpm2.AlgSHA256, digest[:]))
+}
@@ -0,0 +1,208 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package optionroms
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-tpm/tpm2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/firmwaredb"
+)
+
+// Names of firmwaredb measurement types which contain Authenticode
+// digests of EFI drivers of firmwares of type OPTION_ROM.
+const (
+	MeasurementTypeAuthenticodeSHA1   = "AUTHENTICODE_SHA1"
+	MeasurementTypeAuthenticodeSHA256 = "AUTHENTICODE_SHA256"
+	MeasurementTypeAuthenticodeSHA384 = "AUTHENTICODE_SHA384"
+)
+
+// Catalog is a catalog of known option ROMs.
+//
+// It is serialized into the analyzer input, so a replayed report
+// uses the same catalog as the original analysis.
+type Catalog struct {
+	OptionROMs []CatalogEntry `json:"option_roms" yaml:"option_roms"`
+}
+
+// CatalogEntry describes a known option ROM by the Authenticode digests
+// of its EFI driver (as they are measured to PCR2).
+//
+// If DevicePath is set, then the option ROM is expected at the matching
+// device path (a path.Match pattern, e.g. "PciRoot(0x0)/Pci(0x1,0x0)/*"), and
+// any other option ROM found there is reported as changed.
+type CatalogEntry struct {
+	Name       string   `json:"name" yaml:"name"`
+	Version    string   `json:"version,omitempty" yaml:"version,omitempty"`
+	DevicePath string   `json:"device_path,omitempty" yaml:"device_path,omitempty"`
+	SHA1       []string `json:"sha1,omitempty" yaml:"sha1,omitempty"`
+	SHA256     []string `json:"sha256,omitempty" yaml:"sha256,omitempty"`
+	SHA384     []string `json:"sha384,omitempty" yaml:"sha384,omitempty"`
+}
+
+// ParseCatalog parses an option ROM catalog in JSON or YAML format.
+func ParseCatalog(b []byte, isYAML bool) (*Catalog, error) {
+	var catalog Catalog
+	var err error
+	if isYAML {
+		err = yaml.Unmarshal(b, &catalog)
+	} else {
+		err = json.Unmarshal(b, &catalog)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the option ROM catalog: %w", err)
+	}
+	for idx := range catalog.OptionROMs {
+		if err := catalog.OptionROMs[idx].normalize(); err != nil {
+			return nil, fmt.Errorf("invalid option ROM #%d (%s): %w", idx, catalog.OptionROMs[idx].Name, err)
+		}
+	}
+	return &catalog, nil
+}
+
+// LoadCatalog reads an option ROM catalog from a file. The format is
+// chosen by the file extension: ".yaml" and ".yml" are YAML, anything else is JSON.
+func LoadCatalog(path string) (*Catalog, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the option ROM catalog '%s': %w", path, err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseCatalog(b, true)
+	}
+	return ParseCatalog(b, false)
+}
+
+// NewCatalogFromFirmwareDB converts firmwaredb entries of type OPTION_ROM to a Catalog.
+//
+// The name of an entry is the file name of the image, and the digests are
+// taken from the measurements of types MeasurementTypeAuthenticode*.
+func NewCatalogFromFirmwareDB(firmwares []*firmwaredb.Firmware) Catalog {
+	var result Catalog
+	for _, fw := range firmwares {
+		if fw.Type != firmwaredb.FirmwareTypeOptionROM {
+			continue
+		}
+		entry := CatalogEntry{
+			Name:    path.Base(fw.ImageURL),
+			Version: fw.Version,
+		}
+		for _, measurement := range fw.Measurements {
+			if measurement.FirmwareMeasurementType == nil {
+				continue
+			}
+			digest := hex.EncodeToString(measurement.Value)
+			switch measurement.FirmwareMeasurementType.Name {
+			case MeasurementTypeAuthenticodeSHA1:
+				entry.SHA1 = append(entry.SHA1, digest)
+			case MeasurementTypeAuthenticodeSHA256:
+				entry.SHA256 = append(entry.SHA256, digest)
+			case MeasurementTypeAuthenticodeSHA384:
+				entry.SHA384 = append(entry.SHA384, digest)
+			}
+		}
+		if entry.normalize() != nil {
+			continue
+		}
+		result.OptionROMs = append(result.OptionROMs, entry)
+	}
+	return result
+}
+
+// Merge returns a catalog with entries of both catalogs.
+func (catalog Catalog) Merge(other Catalog) Catalog {
+	return Catalog{
+		OptionROMs: append(append([]CatalogEntry{}, catalog.OptionROMs...), other.OptionROMs...),
+	}
+}
+
+func (entry *CatalogEntry) normalize() error {
+	if entry.Name == "" {
+		return fmt.Errorf("name is not specified")
+	}
+	if entry.DevicePath != "" {
+		if _, err := path.Match(entry.DevicePath, ""); err != nil {
+			return fmt.Errorf("invalid device path pattern '%s': %w", entry.DevicePath, err)
+		}
+	}
+	count := 0
+	for _, digests := range []struct {
+		values []string
+		size   int
+	}{
+		{entry.SHA1, 20},
+		{entry.SHA256, 32},
+		{entry.SHA384, 48},
+	} {
+		for idx, digest := range digests.values {
+			b, err := hex.DecodeString(digest)
+			if err != nil || len(b) != digests.size {
+				return fmt.Errorf("invalid digest '%s'", digest)
+			}
+			digests.values[idx] = strings.ToLower(digest)
+			count++
+		}
+	}
+	if count == 0 {
+		return fmt.Errorf("no digests specified")
+	}
+	return nil
+}
+
+func (entry CatalogEntry) digests(hashAlgo tpm2.Algorithm) []string {
+	switch hashAlgo {
+	case tpm2.AlgSHA1:
+		return entry.SHA1
+	case tpm2.AlgSHA256:
+		return entry.SHA256
+	case tpm2.AlgSHA384:
+		return entry.SHA384
+	}
+	return nil
+}
+
+// Lookup returns the entry with the specified digest (if any).
+func (catalog Catalog) Lookup(hashAlgo tpm2.Algorithm, digest []byte) *CatalogEntry {
+	s := hex.EncodeToString(digest)
+	for idx := range catalog.OptionROMs {
+		for _, known := range catalog.OptionROMs[idx].digests(hashAlgo) {
+			if known == s {
+				return &catalog.OptionROMs[idx]
+			}
+		}
+	}
+	return nil
+}
+
+// ExpectedAt returns the entry pinned to the device path (if any).
+func (catalog Catalog) ExpectedAt(devicePath string) *CatalogEntry {
+	for idx := range catalog.OptionROMs {
+		pattern := catalog.OptionROMs[idx].DevicePath
+		if pattern == "" {
+			continue
+		}
+		if ok, _ := path.Match(pattern, devicePath); ok {
+			return &catalog.OptionROMs[idx]
+		}
+	}
+	return nil
+}
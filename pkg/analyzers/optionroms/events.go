@@ -0,0 +1,55 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package optionroms
+
+import (
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/pcr0eventlog"
+	"github.com/immune-gmbh/attestation-sdk/pkg/xtpmeventlog"
+)
+
+// PCRIndex is the PCR the firmware measures option ROMs to.
+const PCRIndex = 2
+
+// DriverEvent is an EFI driver loaded from an option ROM. A driver is measured
+// to each active PCR bank, so it may have multiple digests.
+type DriverEvent struct {
+	DevicePath  string
+	ImageLength uint64
+	Digests     []tpmeventlog.Digest
+}
+
+// DriverEvents returns the EV_EFI_BOOT_SERVICES_DRIVER events of PCR2.
+// The events of different PCR banks for the same driver are merged.
+func DriverEvents(eventLog *tpmeventlog.TPMEventLog, log logger.Logger) []DriverEvent {
+	var result []DriverEvent
+	for _, event := range xtpmeventlog.MergeBanks(pcr0eventlog.FilterPCR(eventLog, PCRIndex, log)) {
+		if event.Type != tpmeventlog.EV_EFI_BOOT_SERVICES_DRIVER {
+			continue
+		}
+		driverEvent := DriverEvent{
+			Digests: event.Digests,
+		}
+		imageLoadEvent, err := xtpmeventlog.ParseImageLoadEvent(event.Data)
+		if err != nil {
+			log.Errorf("%v", err)
+		} else {
+			driverEvent.ImageLength = imageLoadEvent.ImageLengthInMemory
+			driverEvent.DevicePath = xtpmeventlog.FormatDevicePath(imageLoadEvent.DevicePath)
+		}
+		result = append(result, driverEvent)
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.optionroms.report.generated.optionromsanalysis
+
+const string OptionROMsAnalyzerID = "OptionROMs";
+
+enum Status {
+  // Known means a digest of the option ROM is found in the catalog
+  Known = 0,
+  // Unknown means the option ROM is not found in the catalog
+  Unknown = 1,
+  // Changed means the device path is pinned to a catalog entry, but the digest does not match it
+  Changed = 2,
+}
+
+struct Digest {
+  1: string HashAlgo;
+  2: binary Value;
+}
+
+// OptionROM describes an EFI driver loaded from a PCI expansion ROM
+// (an EV_EFI_BOOT_SERVICES_DRIVER event of PCR2)
+struct OptionROM {
+  1: string DevicePath;
+  2: list<Digest> Digests;
+  3: i64 ImageLength;
+  4: Status Status;
+  // Name and Version are of the matched catalog entry (for status Known and Changed)
+  5: optional string Name;
+  6: optional string Version;
+}
+
+struct CustomReport {
+  1: list<OptionROM> OptionROMs;
+  // CatalogEntries is the amount of option ROMs known to the catalog
+  2: i32 CatalogEntries;
+}
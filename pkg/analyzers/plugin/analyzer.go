@@ -0,0 +1,263 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package plugin implements analyzers executed out of process by plugin
+// executables configured in afasd (see if/analyzer_plugin.thrift and
+// package pluginsdk).
+package plugin
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmdetection"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerplugin"
+	"github.com/immune-gmbh/attestation-sdk/if/typeconv"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/report/generated/pluginanalysis"
+)
+
+func init() {
+	analysis.RegisterType((*pluginanalysis.CustomReport)(nil))
+}
+
+// Input is an input structure required for analyzer
+//
+// All the fields are optional, Plugin verifies the ones required by the plugin.
+type Input struct {
+	ActualFirmware   *analysis.ActualFirmwareBlob   `exec:"optional"`
+	OriginalFirmware *analysis.OriginalFirmwareBlob `exec:"optional"`
+	ActualRegisters  *analysis.ActualRegisters      `exec:"optional"`
+	TPMDevice        *tpmdetection.Type             `exec:"optional"`
+	TPMEventLog      *tpmeventlog.TPMEventLog       `exec:"optional"`
+	ActualPCR0       *analysis.ActualPCR0           `exec:"optional"`
+	ACPITables       *analysis.ActualACPITables     `exec:"optional"`
+}
+
+// Plugin is analyzer which sends its input to a plugin executable and
+// converts the response to a report.
+type Plugin struct {
+	config      PluginConfig
+	description *analyzerplugin.Description
+}
+
+// NewPlugin starts the plugin executable to get its description.
+func NewPlugin(ctx context.Context, cfg PluginConfig) (*Plugin, error) {
+	if err := cfg.normalize(); err != nil {
+		return nil, err
+	}
+	response, err := cfg.call(ctx, &analyzerplugin.Request{
+		Describe: &analyzerplugin.DescribeRequest{
+			ProtocolVersion: analyzerplugin.ProtocolVersion,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the description of plugin '%s': %w", cfg.Executable, err)
+	}
+	if response.IsSetError() {
+		return nil, ErrPluginFailed{Executable: cfg.Executable, Err: fmt.Errorf("%s", response.GetError())}
+	}
+	description := response.GetDescription()
+	if description == nil {
+		return nil, ErrPluginFailed{Executable: cfg.Executable, Err: fmt.Errorf("invalid response: no description")}
+	}
+	if err := checkDescription(description); err != nil {
+		return nil, ErrPluginFailed{Executable: cfg.Executable, Err: fmt.Errorf("invalid description: %w", err)}
+	}
+	return &Plugin{
+		config:      cfg,
+		description: description,
+	}, nil
+}
+
+func checkDescription(description *analyzerplugin.Description) error {
+	if description.ProtocolVersion != analyzerplugin.ProtocolVersion {
+		return fmt.Errorf("unsupported protocol version %d, supported: %d", description.ProtocolVersion, analyzerplugin.ProtocolVersion)
+	}
+	if description.AnalyzerID == "" {
+		return fmt.Errorf("empty analyzer ID")
+	}
+	if _, err := analysis.ParseAnalyzerVersion(description.GetVersion()); err != nil {
+		return err
+	}
+	for _, inputType := range append(description.GetRequiredInputs(), description.GetOptionalInputs()...) {
+		if _, err := analyzerplugin.InputTypeFromString(inputType.String()); err != nil {
+			return fmt.Errorf("unknown input type %d", inputType)
+		}
+	}
+	return nil
+}
+
+// New returns the Plugin as analysis.Analyzer (to be used as analyzers.AnalyzerFactory)
+func (p *Plugin) New() analysis.Analyzer[Input] {
+	return p
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (p *Plugin) ID() analysis.AnalyzerID {
+	return analysis.AnalyzerID(p.description.AnalyzerID)
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (p *Plugin) Version() analysis.AnalyzerVersion {
+	return analysis.AnalyzerVersion(p.description.GetVersion())
+}
+
+// Executable returns the path to the executable of the plugin
+func (p *Plugin) Executable() string {
+	return p.config.Executable
+}
+
+// IsRequired returns true if the plugin cannot run without the input
+func (p *Plugin) IsRequired(inputType analyzerplugin.InputType) bool {
+	return containsInputType(p.description.GetRequiredInputs(), inputType)
+}
+
+// Accepts returns true if the plugin needs the input (either required or optional)
+func (p *Plugin) Accepts(inputType analyzerplugin.InputType) bool {
+	return p.IsRequired(inputType) || containsInputType(p.description.GetOptionalInputs(), inputType)
+}
+
+func containsInputType(inputTypes []analyzerplugin.InputType, inputType analyzerplugin.InputType) bool {
+	for _, item := range inputTypes {
+		if item == inputType {
+			return true
+		}
+	}
+	return false
+}
+
+// Analyze sends the inputs accepted by the plugin to the plugin executable
+func (p *Plugin) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	inputs, err := p.inputs(in)
+	if err != nil {
+		return nil, err
+	}
+	response, err := p.config.call(ctx, &analyzerplugin.Request{
+		Analyze: &analyzerplugin.AnalyzeRequest{
+			Inputs: inputs,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case response.IsSetNotApplicable():
+		return nil, analysis.NewErrNotApplicable(response.GetNotApplicable())
+	case response.IsSetError():
+		return nil, ErrPluginFailed{Executable: p.config.Executable, Err: fmt.Errorf("%s", response.GetError())}
+	case !response.IsSetReport():
+		return nil, ErrPluginFailed{Executable: p.config.Executable, Err: fmt.Errorf("invalid response: no report")}
+	}
+	return p.report(response.GetReport())
+}
+
+func (p *Plugin) inputs(in Input) (*analyzerplugin.Inputs, error) {
+	result := analyzerplugin.NewInputs()
+	var missing []analyzerplugin.InputType
+	provide := func(inputType analyzerplugin.InputType, isSet bool, fn func() error) error {
+		if !p.Accepts(inputType) {
+			return nil
+		}
+		if !isSet {
+			if p.IsRequired(inputType) {
+				missing = append(missing, inputType)
+			}
+			return nil
+		}
+		if err := fn(); err != nil {
+			return fmt.Errorf("unable to convert input %s: %w", inputType, err)
+		}
+		return nil
+	}
+
+	err := provide(analyzerplugin.InputType_ActualFirmware, in.ActualFirmware != nil, func() error {
+		result.ActualFirmware = in.ActualFirmware.Bytes()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = provide(analyzerplugin.InputType_OriginalFirmware, in.OriginalFirmware != nil, func() error {
+		result.OriginalFirmware = in.OriginalFirmware.Bytes()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = provide(analyzerplugin.InputType_StatusRegisters, in.ActualRegisters != nil, func() (err error) {
+		result.StatusRegisters, err = typeconv.ToThriftRegisters(in.ActualRegisters.GetRegisters())
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = provide(analyzerplugin.InputType_TPMDevice, in.TPMDevice != nil, func() error {
+		tpmType, err := typeconv.ToThriftTPMType(*in.TPMDevice)
+		result.TPMDevice = &tpmType
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = provide(analyzerplugin.InputType_TPMEventLog, in.TPMEventLog != nil, func() error {
+		result.TPMEventLog = typeconv.ToThriftTPMEventLog(in.TPMEventLog)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = provide(analyzerplugin.InputType_ActualPCR0, in.ActualPCR0 != nil, func() error {
+		result.ActualPCR0 = *in.ActualPCR0
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = provide(analyzerplugin.InputType_ACPITables, in.ACPITables != nil, func() error {
+		result.ACPITables = typeconv.ToThriftACPITables(*in.ACPITables)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("required inputs of plugin '%s' are not provided: %v", p.ID(), missing)
+	}
+	return result, nil
+}
+
+func (p *Plugin) report(pluginReport *analyzerplugin.Report) (*analysis.Report, error) {
+	result := &analysis.Report{
+		Custom: pluginanalysis.CustomReport{
+			Version: p.description.Version,
+			Data:    pluginReport.Data,
+		},
+		Comments: pluginReport.GetComments(),
+	}
+	for _, issue := range pluginReport.GetIssues() {
+		if issue == nil {
+			continue
+		}
+		analysisIssue, err := typeconv.FromThriftAnalysisIssue(issue)
+		if err != nil {
+			return nil, ErrPluginFailed{Executable: p.config.Executable, Err: fmt.Errorf("invalid issue: %w", err)}
+		}
+		result.Issues = append(result.Issues, analysisIssue)
+	}
+	return result, nil
+}
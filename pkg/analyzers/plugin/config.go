@@ -0,0 +1,121 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultTimeout is the timeout of a plugin execution if it is not configured.
+const DefaultTimeout = time.Minute
+
+// Config is the configuration of out-of-process analyzer plugins.
+type Config struct {
+	Plugins []PluginConfig `json:"plugins" yaml:"plugins"`
+}
+
+// PluginConfig defines how to execute a plugin.
+type PluginConfig struct {
+	// Executable is the path to the executable of the plugin.
+	Executable string `json:"executable" yaml:"executable"`
+
+	// Args are the command line arguments passed to the plugin.
+	Args []string `json:"args,omitempty" yaml:"args,omitempty"`
+
+	// Env are the environment variables ("KEY=value") of the plugin.
+	// The environment of afasd is not inherited.
+	Env []string `json:"env,omitempty" yaml:"env,omitempty"`
+
+	// Timeout is the maximal duration of a single execution of the plugin,
+	// for example "30s". DefaultTimeout is used if empty.
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	timeout time.Duration
+}
+
+// ParseConfig parses the configuration of plugins from JSON or YAML.
+func ParseConfig(b []byte, isYAML bool) (*Config, error) {
+	var cfg Config
+	var err error
+	if isYAML {
+		err = yaml.Unmarshal(b, &cfg)
+	} else {
+		err = json.Unmarshal(b, &cfg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the plugins configuration: %w", err)
+	}
+	for idx := range cfg.Plugins {
+		if err := cfg.Plugins[idx].normalize(); err != nil {
+			return nil, fmt.Errorf("invalid configuration of plugin #%d: %w", idx, err)
+		}
+	}
+	return &cfg, nil
+}
+
+// LoadConfig reads the configuration of plugins from a file. The format is chosen by
+// the file extension: ".yaml" and ".yml" are YAML, anything else is JSON.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the plugins configuration '%s': %w", path, err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseConfig(b, true)
+	}
+	return ParseConfig(b, false)
+}
+
+func (cfg *PluginConfig) normalize() error {
+	if cfg.Executable == "" {
+		return fmt.Errorf("the executable is not specified")
+	}
+	if cfg.Timeout == "" {
+		cfg.timeout = DefaultTimeout
+		return nil
+	}
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout '%s': %w", cfg.Timeout, err)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("timeout should be positive, but it is '%s'", cfg.Timeout)
+	}
+	cfg.timeout = timeout
+	return nil
+}
+
+// Load starts every configured plugin to get its description.
+func Load(ctx context.Context, cfg *Config) ([]*Plugin, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	result := make([]*Plugin, 0, len(cfg.Plugins))
+	for _, pluginCfg := range cfg.Plugins {
+		plugin, err := NewPlugin(ctx, pluginCfg)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, plugin)
+	}
+	return result, nil
+}
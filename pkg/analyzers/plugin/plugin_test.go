@@ -0,0 +1,178 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerplugin"
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerreport"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/pluginsdk"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/report/generated/pluginanalysis"
+)
+
+// testPluginModeEnv makes the test binary behave as a plugin (see TestMain).
+const testPluginModeEnv = "AFAS_TEST_PLUGIN_MODE"
+
+func TestMain(m *testing.M) {
+	mode := os.Getenv(testPluginModeEnv)
+	if mode == "" {
+		os.Exit(m.Run())
+	}
+
+	if mode == "garbage" {
+		os.Stdout.Write([]byte("not a thrift message"))
+		return
+	}
+	pluginsdk.Main(&analyzerplugin.Description{
+		AnalyzerID:     "Test" + mode,
+		RequiredInputs: []analyzerplugin.InputType{analyzerplugin.InputType_ActualFirmware},
+		OptionalInputs: []analyzerplugin.InputType{analyzerplugin.InputType_ActualPCR0},
+	}, func(ctx context.Context, inputs *analyzerplugin.Inputs) (*analyzerplugin.Report, error) {
+		switch mode {
+		case "crash":
+			panic("something went wrong")
+		case "hang":
+			time.Sleep(time.Hour)
+		case "notapplicable":
+			return nil, pluginsdk.ErrNotApplicable{Description: "not my kind of firmware"}
+		}
+		report := analyzerplugin.NewReport()
+		// the inputs which were not declared should not be sent
+		if inputs.IsSetOriginalFirmware() {
+			report.Issues = append(report.Issues, &analyzerreport.Issue{Severity: analyzerreport.Severity_SeverityCritical})
+		}
+		report.Comments = []string{string(inputs.GetActualFirmware()), string(inputs.GetActualPCR0())}
+		return report, nil
+	})
+}
+
+func newTestPlugin(t *testing.T, mode string, timeout string) *Plugin {
+	p, err := NewPlugin(context.Background(), PluginConfig{
+		Executable: os.Args[0],
+		Env:        []string{testPluginModeEnv + "=" + mode},
+		Timeout:    timeout,
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func TestPlugin(t *testing.T) {
+	p := newTestPlugin(t, "echo", "")
+	require.Equal(t, analysis.AnalyzerID("Testecho"), p.ID())
+	require.True(t, p.IsRequired(analyzerplugin.InputType_ActualFirmware))
+	require.True(t, p.Accepts(analyzerplugin.InputType_ActualPCR0))
+	require.False(t, p.Accepts(analyzerplugin.InputType_OriginalFirmware))
+
+	report, err := p.Analyze(context.Background(), Input{
+		ActualFirmware:   &[]analysis.ActualFirmwareBlob{analysis.NewActualFirmwareBlob(analysis.BytesBlob("actual"))}[0],
+		OriginalFirmware: &[]analysis.OriginalFirmwareBlob{analysis.NewOriginalFirmwareBlob(analysis.BytesBlob("original"))}[0],
+	})
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+	require.Equal(t, []string{"actual", ""}, report.Comments)
+	require.IsType(t, pluginanalysis.CustomReport{}, report.Custom)
+
+	_, err = p.Analyze(context.Background(), Input{})
+	require.Error(t, err)
+}
+
+func TestPluginFailures(t *testing.T) {
+	input := Input{
+		ActualFirmware: &[]analysis.ActualFirmwareBlob{analysis.NewActualFirmwareBlob(analysis.BytesBlob("actual"))}[0],
+	}
+
+	_, err := newTestPlugin(t, "crash", "").Analyze(context.Background(), input)
+	require.ErrorAs(t, err, &ErrPluginFailed{})
+	require.Contains(t, err.Error(), "something went wrong")
+
+	_, err = newTestPlugin(t, "hang", "500ms").Analyze(context.Background(), input)
+	require.ErrorAs(t, err, &analysis.ErrBudgetExceeded{})
+
+	_, err = newTestPlugin(t, "notapplicable", "").Analyze(context.Background(), input)
+	require.ErrorAs(t, err, &analysis.ErrNotApplicable{})
+
+	_, err = NewPlugin(context.Background(), PluginConfig{
+		Executable: os.Args[0],
+		Env:        []string{testPluginModeEnv + "=garbage"},
+	})
+	require.ErrorAs(t, err, &ErrPluginFailed{})
+
+	_, err = NewPlugin(context.Background(), PluginConfig{
+		Executable: filepath.Join(t.TempDir(), "nonexistent"),
+	})
+	require.Error(t, err)
+}
+
+// TestExamplePlugin builds tools/analyzerplugins/firmwaresanity and executes it
+// the same way as afasd does.
+func TestExamplePlugin(t *testing.T) {
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("the go toolchain is not available")
+	}
+	executable := filepath.Join(t.TempDir(), "firmwaresanity")
+	out, err := exec.Command(goBin, "build", "-o", executable, "github.com/immune-gmbh/attestation-sdk/tools/analyzerplugins/firmwaresanity").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	configPath := filepath.Join(t.TempDir(), "plugins.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("plugins:\n  - executable: "+executable+"\n    timeout: 30s\n"), 0o600))
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	plugins, err := Load(context.Background(), cfg)
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	require.Equal(t, analysis.AnalyzerID("FirmwareSanity"), plugins[0].ID())
+
+	dataCalculator, err := analysis.NewDataCalculator(100)
+	require.NoError(t, err)
+	report, err := analysis.ExecuteAnalyzer[Input](
+		context.Background(),
+		dataCalculator,
+		plugins[0],
+		analysis.NewInput().AddActualFirmware(analysis.BytesBlob(bytes.Repeat([]byte{0xff}, 1000))),
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 2)
+	require.Equal(t, analysis.SeverityCritical, report.Issues[0].Severity)
+	require.Equal(t, analysis.SeverityWarning, report.Issues[1].Severity)
+	custom := report.Custom.(pluginanalysis.CustomReport)
+	require.Equal(t, "1.0.0", custom.GetVersion())
+	require.Equal(t, analysis.AnalyzerVersion("1.0.0"), plugins[0].Version())
+	require.JSONEq(t, `{"Size":1000,"ErasedBytes":1000}`, custom.GetData())
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`{"plugins": [{"executable": "/bin/true", "timeout": "5s"}]}`), false)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, cfg.Plugins[0].timeout)
+
+	cfg, err = ParseConfig([]byte("plugins:\n  - executable: /bin/true\n"), true)
+	require.NoError(t, err)
+	require.Equal(t, DefaultTimeout, cfg.Plugins[0].timeout)
+
+	_, err = ParseConfig([]byte(`{"plugins": [{"timeout": "5s"}]}`), false)
+	require.Error(t, err)
+	_, err = ParseConfig([]byte(`{"plugins": [{"executable": "/bin/true", "timeout": "-1s"}]}`), false)
+	require.Error(t, err)
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package pluginsdk helps to implement out-of-process analyzer plugins of afasd.
+//
+// A plugin is an executable which reads a single analyzerplugin.Request from
+// stdin and writes a single analyzerplugin.Response to stdout (see
+// if/analyzer_plugin.thrift). A new process is started for every request, so
+// a plugin does not need to care about state, concurrency or cleanup:
+//
+//	func main() {
+//		pluginsdk.Main(&analyzerplugin.Description{
+//			AnalyzerID:     "MyAnalyzer",
+//			RequiredInputs: []analyzerplugin.InputType{analyzerplugin.InputType_ActualFirmware},
+//		}, analyze)
+//	}
+//
+// Anything written to stderr is included into the error if the plugin fails.
+package pluginsdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/apache/thrift/lib/go/thrift"
+
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerplugin"
+)
+
+// AnalyzeFunc analyzes the inputs declared in the description of the plugin.
+//
+// Return ErrNotApplicable to tell that the analyzer is not applicable to the inputs.
+type AnalyzeFunc func(ctx context.Context, inputs *analyzerplugin.Inputs) (*analyzerplugin.Report, error)
+
+// ErrNotApplicable should be returned by AnalyzeFunc to tell that the analyzer
+// is not applicable to the inputs.
+type ErrNotApplicable struct {
+	Description string
+}
+
+func (e ErrNotApplicable) Error() string {
+	return fmt.Sprintf("not applicable: %s", e.Description)
+}
+
+// Main serves the request from stdin and exits with a non-zero code on a failure.
+func Main(description *analyzerplugin.Description, analyze AnalyzeFunc) {
+	if err := Serve(context.Background(), os.Stdin, os.Stdout, description, analyze); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Serve reads a request from r and writes the response to w.
+func Serve(
+	ctx context.Context,
+	r io.Reader,
+	w io.Writer,
+	description *analyzerplugin.Description,
+	analyze AnalyzeFunc,
+) error {
+	request := analyzerplugin.NewRequest()
+	if err := ReadMessage(ctx, r, request); err != nil {
+		return fmt.Errorf("unable to read the request: %w", err)
+	}
+	if err := WriteMessage(ctx, w, handle(ctx, request, description, analyze)); err != nil {
+		return fmt.Errorf("unable to write the response: %w", err)
+	}
+	return nil
+}
+
+func handle(
+	ctx context.Context,
+	request *analyzerplugin.Request,
+	description *analyzerplugin.Description,
+	analyze AnalyzeFunc,
+) *analyzerplugin.Response {
+	response := analyzerplugin.NewResponse()
+	switch {
+	case request.IsSetDescribe():
+		if v := request.GetDescribe().GetProtocolVersion(); v != analyzerplugin.ProtocolVersion {
+			response.Error = &[]string{fmt.Sprintf("unsupported protocol version %d, supported: %d", v, analyzerplugin.ProtocolVersion)}[0]
+			return response
+		}
+		result := *description
+		result.ProtocolVersion = analyzerplugin.ProtocolVersion
+		response.Description = &result
+	case request.IsSetAnalyze():
+		inputs := request.GetAnalyze().GetInputs()
+		if inputs == nil {
+			inputs = analyzerplugin.NewInputs()
+		}
+		report, err := analyze(ctx, inputs)
+		var errNotApplicable ErrNotApplicable
+		switch {
+		case errors.As(err, &errNotApplicable):
+			response.NotApplicable = &errNotApplicable.Description
+		case err != nil:
+			response.Error = &[]string{err.Error()}[0]
+		case report == nil:
+			response.Report = analyzerplugin.NewReport()
+		default:
+			response.Report = report
+		}
+	default:
+		response.Error = &[]string{"empty request"}[0]
+	}
+	return response
+}
+
+// ReadMessage reads a Thrift structure encoded with the binary protocol.
+func ReadMessage(ctx context.Context, r io.Reader, out thrift.TStruct) error {
+	transport := thrift.NewStreamTransportR(r)
+	proto := thrift.NewTBinaryProtocolConf(transport, nil)
+	return out.Read(ctx, proto)
+}
+
+// WriteMessage writes a Thrift structure encoded with the binary protocol.
+func WriteMessage(ctx context.Context, w io.Writer, in thrift.TStruct) error {
+	var buf bytes.Buffer
+	transport := thrift.NewStreamTransportW(&buf)
+	proto := thrift.NewTBinaryProtocolConf(transport, nil)
+	if err := in.Write(ctx, proto); err != nil {
+		return err
+	}
+	if err := proto.Flush(ctx); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/tracer"
+
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerplugin"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/pluginsdk"
+)
+
+const (
+	// maxResponseSize limits the memory consumed by a response of a plugin.
+	maxResponseSize = 64 << 20
+
+	// maxStderrSize is the amount of the tail of stderr kept for diagnostics.
+	maxStderrSize = 4 << 10
+
+	// waitDelay is the time given to the plugin to close its outputs after it
+	// was killed (for example if it left a child process holding them).
+	waitDelay = time.Second
+)
+
+// call executes the plugin with the request and returns its response.
+//
+// Every call starts a new process, so a crash of the plugin affects only the request.
+func (cfg *PluginConfig) call(ctx context.Context, request *analyzerplugin.Request) (*analyzerplugin.Response, error) {
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, "plugin-call")
+	defer span.Finish()
+
+	var stdin bytes.Buffer
+	if err := pluginsdk.WriteMessage(ctx, &stdin, request); err != nil {
+		return nil, fmt.Errorf("unable to serialize the request: %w", err)
+	}
+
+	timeoutCtx, cancelFn := context.WithTimeout(ctx, cfg.timeout)
+	defer cancelFn()
+
+	stdout := &limitedBuffer{limit: maxResponseSize}
+	stderr := &tailBuffer{limit: maxStderrSize}
+	cmd := exec.CommandContext(timeoutCtx, cfg.Executable, cfg.Args...)
+	cmd.Env = cfg.Env
+	if cmd.Env == nil {
+		cmd.Env = []string{}
+	}
+	cmd.Stdin = &stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = waitDelay
+
+	err := cmd.Run()
+	if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return nil, analysis.ErrBudgetExceeded{Resource: "time", Limit: cfg.timeout.String()}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, ErrPluginFailed{Executable: cfg.Executable, Stderr: stderr.String(), Err: err}
+	}
+
+	response := analyzerplugin.NewResponse()
+	if err := pluginsdk.ReadMessage(ctx, bytes.NewReader(stdout.Bytes()), response); err != nil {
+		return nil, ErrPluginFailed{Executable: cfg.Executable, Stderr: stderr.String(), Err: fmt.Errorf("invalid response: %w", err)}
+	}
+	if response.CountSetFieldsResponse() != 1 {
+		return nil, ErrPluginFailed{Executable: cfg.Executable, Stderr: stderr.String(), Err: fmt.Errorf("invalid response: exactly one field should be set, found: %d", response.CountSetFieldsResponse())}
+	}
+	return response, nil
+}
+
+// limitedBuffer is a bytes.Buffer which fails to grow beyond the limit.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, fmt.Errorf("the response exceeds %d bytes", b.limit)
+	}
+	return b.Buffer.Write(p)
+}
+
+// tailBuffer keeps only the last written bytes.
+type tailBuffer struct {
+	buf   []byte
+	limit int
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.limit {
+		b.buf = b.buf[len(b.buf)-b.limit:]
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	return strings.TrimSpace(string(b.buf))
+}
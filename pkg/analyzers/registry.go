@@ -12,15 +12,31 @@
 package analyzers
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
 )
 
 // AnalyzerFactory represents a factory method for new analyzers
@@ -31,10 +47,36 @@ type Registry struct {
 	// TODO: use the input type as the key in the map (it is safer)
 	// TODO: also generalize the factory, instead of using `any`
 	analyzerFactories map[analysis.AnalyzerID]any
+
+	// executorFactories create executors of analyzers, see GetExecutor
+	executorFactories map[analysis.AnalyzerID]func() *Executor
+
+	// reportRegisterers register the reports of analyzers, see RegisterAnalyzerReports
+	reportRegisterers map[analysis.AnalyzerID]func(dc *analysis.DataCalculator) error
+}
+
+// Executor executes an analyzer without knowing its input type at compile time.
+type Executor struct {
+	ID        analysis.AnalyzerID
+	Version   analysis.AnalyzerVersion
+	InputType reflect.Type
+
+	// Execute is analysis.ExecuteAnalyzer applied to the analyzer.
+	Execute func(ctx context.Context, dc analysis.DataCalculatorInterface, in analysis.Input, cache analysis.DataCache) (*analysis.Report, error)
 }
 
 // Add registers provided analyzer
 func Add[inputType any](r *Registry, id analysis.AnalyzerID, analyzerFactory AnalyzerFactory[inputType]) error {
+	if err := add(r, id, analyzerFactory); err != nil {
+		return err
+	}
+	r.reportRegisterers[id] = func(dc *analysis.DataCalculator) error {
+		return analysis.RegisterAnalyzerReport(dc, analyzerFactory())
+	}
+	return nil
+}
+
+func add[inputType any](r *Registry, id analysis.AnalyzerID, analyzerFactory AnalyzerFactory[inputType]) error {
 	if analyzerFactory == nil {
 		return fmt.Errorf("analyzer should not be nil")
 	}
@@ -44,7 +86,21 @@ func Add[inputType any](r *Registry, id analysis.AnalyzerID, analyzerFactory Ana
 	if len(id) == 0 {
 		return fmt.Errorf("empty analyzer id")
 	}
+	if _, err := analysis.ParseAnalyzerVersion(string(analyzerFactory().Version())); err != nil {
+		return fmt.Errorf("analyzer '%s' has an invalid version: %w", id, err)
+	}
 	r.analyzerFactories[id] = analyzerFactory
+	r.executorFactories[id] = func() *Executor {
+		analyzer := analyzerFactory()
+		return &Executor{
+			ID:        id,
+			Version:   analyzer.Version(),
+			InputType: reflect.TypeOf((*inputType)(nil)).Elem(),
+			Execute: func(ctx context.Context, dc analysis.DataCalculatorInterface, in analysis.Input, cache analysis.DataCache) (*analysis.Report, error) {
+				return analysis.ExecuteAnalyzer(ctx, dc, analyzer, in, cache)
+			},
+		}
+	}
 	return nil
 }
 
@@ -59,6 +115,28 @@ func Get[inputType any](r *Registry, id analysis.AnalyzerID) analysis.Analyzer[i
 	return analyzerFactory.(AnalyzerFactory[inputType])()
 }
 
+// GetExecutor returns an executor of a new instance of required analyzer by id,
+// or nil if there is no such analyzer.
+func (r *Registry) GetExecutor(id analysis.AnalyzerID) *Executor {
+	executorFactory := r.executorFactories[id]
+	if executorFactory == nil {
+		return nil
+	}
+	return executorFactory()
+}
+
+// AddPlugins registers out-of-process analyzer plugins
+//
+// Reports of plugins cannot be consumed by other analyzers: all plugins have the same input type.
+func AddPlugins(r *Registry, plugins []*plugin.Plugin) error {
+	for _, p := range plugins {
+		if err := add(r, p.ID(), p.New); err != nil {
+			return fmt.Errorf("unable to register plugin '%s': %w", p.Executable(), err)
+		}
+	}
+	return nil
+}
+
 // IDs returns a list of IDs of all registered analyzers
 func (r *Registry) IDs() []analysis.AnalyzerID {
 	result := make([]analysis.AnalyzerID, 0, len(r.analyzerFactories))
@@ -68,20 +146,70 @@ func (r *Registry) IDs() []analysis.AnalyzerID {
 	return result
 }
 
+// RegisterAnalyzerReports allows analyzers to consume reports of the registered
+// analyzers (see analysis.AnalyzerReport) when using the DataCalculator.
+func (r *Registry) RegisterAnalyzerReports(dc *analysis.DataCalculator) error {
+	ids := make([]analysis.AnalyzerID, 0, len(r.reportRegisterers))
+	for id := range r.reportRegisterers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids {
+		if err := r.reportRegisterers[id](dc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewRegistry creates a new Registry instance
 func NewRegistry() *Registry {
 	return &Registry{
 		analyzerFactories: make(map[analysis.AnalyzerID]any),
+		executorFactories: make(map[analysis.AnalyzerID]func() *Executor),
+		reportRegisterers: make(map[analysis.AnalyzerID]func(dc *analysis.DataCalculator) error),
+	}
+}
+
+// ReferenceData contains the stores of reference data (databases, catalogs, allowlists)
+// which are injected into the known analyzers instead of being a part of their inputs,
+// see analysis.ReferenceDataStore.
+type ReferenceData struct {
+	AdvisoryDBs    *analysis.ReferenceDataStore[vulnerablemodules.AdvisoryDB]
+	BootAllowlists *analysis.ReferenceDataStore[bootchain.Allowlist]
+	TXTErrorCodes  *analysis.ReferenceDataStore[txterrors.ErrorCodes]
+	NVRAMRules     *analysis.ReferenceDataStore[diffmeasuredboot.NVRAMRules]
+	DiagnosisRules *analysis.ReferenceDataStore[diffmeasuredboot.DiagnosisRules]
+}
+
+// NewReferenceData returns empty stores of reference data of the known analyzers.
+func NewReferenceData() *ReferenceData {
+	return &ReferenceData{
+		// a few versions are kept to serve the requests in flight during a reload
+		AdvisoryDBs:    analysis.NewReferenceDataStore[vulnerablemodules.AdvisoryDB](4),
+		BootAllowlists: analysis.NewReferenceDataStore[bootchain.Allowlist](4),
+		TXTErrorCodes:  analysis.NewReferenceDataStore[txterrors.ErrorCodes](4),
+		NVRAMRules:     analysis.NewReferenceDataStore[diffmeasuredboot.NVRAMRules](4),
+		DiagnosisRules: analysis.NewReferenceDataStore[diffmeasuredboot.DiagnosisRules](4),
 	}
 }
 
 // NewRegistryWithKnownAnalyzers creates a new Registry instance and registers all analyzers from the analyzers subpackages
-func NewRegistryWithKnownAnalyzers() (*Registry, error) {
+//
+// referenceData provides the reference data to the analyzers which require it.
+func NewRegistryWithKnownAnalyzers(referenceData *ReferenceData) (*Registry, error) {
+	if referenceData == nil {
+		referenceData = NewReferenceData()
+	}
 	r := NewRegistry()
 	if err := Add(r, pspsignature.ID, pspsignature.New); err != nil {
 		return nil, err
 	}
-	if err := Add(r, diffmeasuredboot.ID, diffmeasuredboot.New); err != nil {
+	if err := Add(r, diffmeasuredboot.ID, func() analysis.Analyzer[diffmeasuredboot.Input] {
+		return diffmeasuredboot.New(referenceData.NVRAMRules, referenceData.DiagnosisRules)
+	}); err != nil {
 		return nil, err
 	}
 	if err := Add(r, intelacm.ID, intelacm.New); err != nil {
@@ -96,5 +224,47 @@ func NewRegistryWithKnownAnalyzers() (*Registry, error) {
 	if err := Add(r, apcbsectokens.ID, apcbsectokens.New); err != nil {
 		return nil, err
 	}
+	if err := Add(r, intelifd.ID, intelifd.New); err != nil {
+		return nil, err
+	}
+	if err := Add(r, intelme.ID, intelme.New); err != nil {
+		return nil, err
+	}
+	if err := Add(r, pspspl.ID, pspspl.New); err != nil {
+		return nil, err
+	}
+	if err := Add(r, psbfuses.ID, psbfuses.New); err != nil {
+		return nil, err
+	}
+	if err := Add(r, vulnerablemodules.ID, func() analysis.Analyzer[vulnerablemodules.Input] {
+		return vulnerablemodules.New(referenceData.AdvisoryDBs)
+	}); err != nil {
+		return nil, err
+	}
+	if err := Add(r, flashdegradation.ID, flashdegradation.New); err != nil {
+		return nil, err
+	}
+	if err := Add(r, firmwareprovenance.ID, firmwareprovenance.New); err != nil {
+		return nil, err
+	}
+	if err := Add(r, optionroms.ID, optionroms.New); err != nil {
+		return nil, err
+	}
+	if err := Add(r, bootchain.ID, func() analysis.Analyzer[bootchain.Input] {
+		return bootchain.New(referenceData.BootAllowlists)
+	}); err != nil {
+		return nil, err
+	}
+	if err := Add(r, acpitables.ID, acpitables.New); err != nil {
+		return nil, err
+	}
+	if err := Add(r, txterrors.ID, func() analysis.Analyzer[txterrors.Input] {
+		return txterrors.New(referenceData.TXTErrorCodes)
+	}); err != nil {
+		return nil, err
+	}
+	if err := Add(r, imagediff.ID, imagediff.New); err != nil {
+		return nil, err
+	}
 	return r, nil
 }
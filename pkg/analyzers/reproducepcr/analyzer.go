@@ -12,6 +12,8 @@
 
 package reproducepcr
 
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
 import (
 	"bytes"
 	"context"
@@ -54,6 +56,9 @@ type ExpectedPCR0 []byte
 // ID represents the unique id of DiffMeasuredBoot analyzer
 const ID analysis.AnalyzerID = reproducepcranalysis.ReproducePCRAnalyzerID
 
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.0.0"
+
 // NewExecutorInput builds an analysis.Executor's input required for ReproducePCR analyzer
 //
 // Optional arguments: tpm, eventlog and enforcedMeasurementsFlow
@@ -111,6 +116,24 @@ type Input struct {
 	ExpectedPCR0       ExpectedPCR0
 }
 
+// Reproduced returns true if the report of ReproducePCR says that the expected PCR0
+// value was reproduced (possibly with another flow) without disabling measurements.
+func Reproduced(report *analysis.Report) bool {
+	if report == nil {
+		return false
+	}
+	for _, issue := range report.Issues {
+		switch issue.Code {
+		case analysis.IssueCodeReproducePCRReproductionFailed,
+			analysis.IssueCodeReproducePCRMismatch,
+			analysis.IssueCodeReproducePCRMismatchLocality,
+			analysis.IssueCodeReproducePCRDisabledMeasurements:
+			return false
+		}
+	}
+	return true
+}
+
 // ReproducePCR is analyzer that tries to reproduce given PCR0 value
 type ReproducePCR struct{}
 
@@ -124,6 +147,11 @@ func (analyzer *ReproducePCR) ID() analysis.AnalyzerID {
 	return ID
 }
 
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *ReproducePCR) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
 // Analyze tries to reproduce ExpectedPCR0
 //
 // TODO: redesign this function, this is an intermediate code while migrating from `pcr` to `bootflow`.
@@ -152,14 +180,18 @@ func (analyzer *ReproducePCR) Analyze(ctx context.Context, in Input) (*analysis.
 		acmStatusActual, found := registers.FindACMPolicyStatus(in.ActualRegisters.GetRegisters())
 		if !found {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeReproducePCRACMPolicyStatusCorrected,
 				Severity:    analysis.SeverityInfo,
 				Description: fmt.Sprintf("Correct ACM_POLICY_STATUS register value: '0x%X'", acmStatusFixed),
+				Params:      map[string]string{"corrected": fmt.Sprintf("0x%X", acmStatusFixed)},
 			})
 		} else if acmStatusActual.Raw() != acmStatusFixed.Raw() {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:     analysis.IssueCodeReproducePCRACMPolicyStatusCorrected,
 				Severity: analysis.SeverityInfo,
 				Description: fmt.Sprintf("Correct ACM_POLICY_STATUS register value: '0x%X', initial: '0x%X'",
 					acmStatusFixed, acmStatusActual),
+				Params: map[string]string{"actual": fmt.Sprintf("0x%X", acmStatusActual), "corrected": fmt.Sprintf("0x%X", acmStatusFixed)},
 			})
 		}
 	}
@@ -207,11 +239,16 @@ func (analyzer *ReproducePCR) Analyze(ctx context.Context, in Input) (*analysis.
 		customReport.ExpectedFlow = resultFlow
 		customReport.ExpectedLocality = int8(tpmLocality)
 		report.Issues = append(report.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeReproducePCRMatchedFlow,
 			Severity:    analysis.SeverityInfo,
 			Description: fmt.Sprintf("Matched with flow: '%s'", flow.Name),
+			Params:      map[string]string{"flow": fmt.Sprint(flow.Name)},
 		})
 		return report, nil
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	settings := pcrbruteforcer.DefaultSettingsReproducePCR0()
 	if len(tpmInstance.CommandLog) <= 10 {
@@ -232,9 +269,14 @@ func (analyzer *ReproducePCR) Analyze(ctx context.Context, in Input) (*analysis.
 		settings,
 	)
 	log.Infof("reproduceExpectedPCR0 result is: %v %v", reproResult, reproErr)
+	if err := ctx.Err(); err != nil {
+		// the bruteforce was interrupted, its result is incomplete
+		return nil, err
+	}
 	if reproErr != nil {
 		log.Warnf("Failed to reproduce expected PCR0: %v", reproErr)
 		report.Issues = append(report.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeReproducePCRReproductionFailed,
 			Severity:    analysis.SeverityCritical,
 			Description: fmt.Sprintf("Failed to reproduce PCR0 value: %v", reproErr),
 		})
@@ -243,6 +285,7 @@ func (analyzer *ReproducePCR) Analyze(ctx context.Context, in Input) (*analysis.
 	if reproResult == nil {
 		log.Warnf("unable to reproduce expected PCR0")
 		report.Issues = append(report.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeReproducePCRMismatch,
 			Severity:    analysis.SeverityCritical,
 			Description: "Unable to reproduce PCR0 value",
 		})
@@ -253,32 +296,39 @@ func (analyzer *ReproducePCR) Analyze(ctx context.Context, in Input) (*analysis.
 
 		if customReport.ExpectedLocality != int8(reproResult.Locality) {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:     analysis.IssueCodeReproducePCRMismatchLocality,
 				Severity: analysis.SeverityCritical,
 				Description: fmt.Sprintf("Matched for locality: %d, instead of expected: %d",
 					reproResult.Locality, customReport.ExpectedLocality),
+				Params: map[string]string{"actual_locality": fmt.Sprint(reproResult.Locality), "expected_locality": fmt.Sprint(customReport.ExpectedLocality)},
 			})
 			customReport.ExpectedLocality = int8(reproResult.Locality)
 		}
 
 		if len(customReport.DisabledMeasurements) > 0 {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:     analysis.IssueCodeReproducePCRDisabledMeasurements,
 				Severity: analysis.SeverityCritical,
 				Description: fmt.Sprintf("Disabled measurements: '%s'",
 					strings.Join(customReport.DisabledMeasurements, ", ")),
+				Params: map[string]string{"measurements": strings.Join(customReport.DisabledMeasurements, ",")},
 			})
 		}
 
 		if reproResult.ACMPolicyStatus != nil {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:     analysis.IssueCodeReproducePCRACMPolicyStatusRecorrected,
 				Severity: analysis.SeverityInfo,
 				Description: fmt.Sprintf("Internal problem: ACM policy status was re-corrected from %X (found: %v) to %X",
 					acmStatusFixed, foundACMStatusFixed, *reproResult.ACMPolicyStatus),
+				Params: map[string]string{"corrected": fmt.Sprintf("0x%X", acmStatusFixed), "found": fmt.Sprint(foundACMStatusFixed), "final": fmt.Sprintf("0x%X", *reproResult.ACMPolicyStatus)},
 			})
 		}
 	}
 
 	if in.TPMEventLog == nil {
 		report.Issues = append(report.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeReproducePCRNoEventLog,
 			Severity:    analysis.SeverityWarning,
 			Description: "TPM EventLog is not provided",
 		})
@@ -294,18 +344,22 @@ func (analyzer *ReproducePCR) Analyze(ctx context.Context, in Input) (*analysis.
 		)
 		if correctedACMPolicyStatus != nil {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeReproducePCREventLogACMPolicyStatus,
 				Severity:    analysis.SeverityWarning,
 				Description: fmt.Sprintf("According to TPM EventLog ACM Policy Status is %v", *correctedACMPolicyStatus),
+				Params:      map[string]string{"status": fmt.Sprint(*correctedACMPolicyStatus)},
 			})
 		}
 		if err != nil {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeReproducePCREventLogReproductionError,
 				Severity:    analysis.SeverityWarning,
 				Description: fmt.Sprintf("An error occurred while reproducing TPM EventLog: %v", err),
 			})
 		}
 		for _, issue := range issues {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeReproducePCREventLogReproductionIssue,
 				Severity:    analysis.SeverityWarning,
 				Description: fmt.Sprintf("An issue occurred while reproducing TPM EventLog: %v", issue),
 			})
@@ -316,17 +370,20 @@ func (analyzer *ReproducePCR) Analyze(ctx context.Context, in Input) (*analysis.
 		if err == nil {
 			if bytes.Equal(replayedPCR0, in.ExpectedPCR0) {
 				report.Issues = append(report.Issues, analysis.Issue{
+					Code:        analysis.IssueCodeReproducePCREventLogReplayMatch,
 					Severity:    analysis.SeverityInfo,
 					Description: "Replayed PCR0 (using TPM EventLog) matches the provided PCR0",
 				})
 			} else {
 				report.Issues = append(report.Issues, analysis.Issue{
+					Code:        analysis.IssueCodeReproducePCREventLogReplayMismatch,
 					Severity:    analysis.SeverityWarning,
 					Description: "Replayed PCR0 (using TPM EventLog) does not match the provided PCR0",
 				})
 			}
 		} else {
 			report.Issues = append(report.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeReproducePCREventLogReplayFailed,
 				Severity:    analysis.SeverityWarning,
 				Description: fmt.Sprintf("Unable to replay PCR0 using TPM EventLog: %v", err.Error()),
 			})
@@ -359,6 +416,9 @@ func (analyzer *ReproducePCR) reproduceUsingKnownFlows(
 	}
 
 	for _, tryFlow := range allFlows {
+		if ctx.Err() != nil {
+			break
+		}
 		if flowscompat.ToOld(tryFlow) == pcr.FlowAuto {
 			// Try only those flows, which maps into something in the old design.
 			//
@@ -0,0 +1,209 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package txterrors
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors/report/generated/txterrorsanalysis"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+func init() {
+	analysis.RegisterType((*txterrorsanalysis.CustomReport)(nil))
+	analysis.RegisterType((*ErrorCodes)(nil))
+	analysis.RegisterType((ErrorCodesHash)(""))
+}
+
+// ID represents the unique id of TXTErrors analyzer
+const ID analysis.AnalyzerID = txterrorsanalysis.TXTErrorsAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.1.0"
+
+// ErrorCodesHash is the content hash of the error code table used by the analyzer,
+// the table itself is provided to the analyzer through New.
+type ErrorCodesHash analysis.ReferenceDataHash
+
+// NewExecutorInput builds an analysis.Executor's input required for TXTErrors analyzer
+//
+// errorCodes is put into errorCodeTables, which should be the store provided to New.
+//
+// Optional arguments: errorCodes
+func NewExecutorInput(
+	regs registers.Registers,
+	errorCodeTables *analysis.ReferenceDataStore[ErrorCodes],
+	errorCodes *ErrorCodes, // optional
+) (analysis.Input, error) {
+	if regs == nil {
+		return nil, fmt.Errorf("status registers should be specified")
+	}
+	actualRegisters, err := analysis.NewActualRegisters(regs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert registers: %w", err)
+	}
+
+	result := analysis.NewInput()
+	result.AddActualRegisters(
+		actualRegisters,
+	)
+	if errorCodes != nil {
+		errorCodesHash, err := errorCodeTables.Put(errorCodes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to store the error code table: %w", err)
+		}
+		result.AddCustomValue(ErrorCodesHash(errorCodesHash))
+	}
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	ActualRegisters analysis.ActualRegisters
+
+	// ErrorCodesHash identifies the table to interpret the errors, DefaultErrorCodes are used if not set.
+	ErrorCodesHash *ErrorCodesHash `exec:"optional"`
+}
+
+// TXTErrors is analyzer that decodes the errors reported by the processor
+// and the ACMs in TXT.ERRORCODE, ACM_STATUS and TXT.ESTS.
+type TXTErrors struct {
+	errorCodeTables *analysis.ReferenceDataStore[ErrorCodes]
+}
+
+// New returns a new object of TXTErrors analyzer
+//
+// errorCodeTables provides the error code tables referenced by the inputs, see NewExecutorInput.
+func New(errorCodeTables *analysis.ReferenceDataStore[ErrorCodes]) analysis.Analyzer[Input] {
+	return &TXTErrors{
+		errorCodeTables: errorCodeTables,
+	}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *TXTErrors) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *TXTErrors) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze decodes the TXT error registers
+func (analyzer *TXTErrors) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	log := logger.FromCtx(ctx)
+
+	errorCodes := DefaultErrorCodes()
+	if in.ErrorCodesHash != nil {
+		var err error
+		errorCodes, err = analyzer.errorCodeTables.Get(analysis.ReferenceDataHash(*in.ErrorCodesHash))
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the error code table: %w", err)
+		}
+	}
+
+	regs := in.ActualRegisters.GetRegisters()
+	var decoded []decodedRegister
+	if reg, found := registers.FindTXTErrorCode(regs); found {
+		err, valid := DecodeTXTErrorCode(reg)
+		decoded = append(decoded, decodedRegister{DecodedError: err, Valid: valid})
+	}
+	if reg, found := registers.FindACMStatus(regs); found {
+		err, valid := DecodeACMStatus(reg)
+		decoded = append(decoded, decodedRegister{DecodedError: err, Valid: valid})
+	}
+	errorStatus, errorStatusFound := registers.FindTXTErrorStatus(regs)
+	if len(decoded) == 0 && !errorStatusFound {
+		return nil, analysis.NewErrNotApplicable("no TXT error registers (TXT.ERRORCODE, ACM_STATUS, TXT.ESTS)")
+	}
+
+	result := &analysis.Report{}
+	customReport := txterrorsanalysis.CustomReport{
+		TXTReset: errorStatusFound && errorStatus.Reset(),
+	}
+	for _, reg := range decoded {
+		// ACM_STATUS is not required to have the valid bit set (unlike TXT.ERRORCODE),
+		// a non-zero error code is considered an error anyway.
+		if !reg.IsError() || (!reg.Valid && reg.Register != registers.ACMStatusRegisterID) {
+			log.Debugf("register %s reports no error: 0x%X", reg.Register, reg.Raw)
+			continue
+		}
+
+		reportError := &txterrorsanalysis.DecodedError{
+			Register:       string(reg.Register),
+			Raw:            int64(reg.Raw),
+			Valid:          reg.Valid,
+			Source:         string(reg.Source),
+			ModuleType:     int16(reg.ModuleType),
+			ModuleTypeName: reg.ModuleTypeName(),
+			Class:          int16(reg.Class),
+			Major:          int16(reg.Major),
+			Minor:          int32(reg.Minor),
+			Code:           reg.Code(),
+		}
+		customReport.Errors = append(customReport.Errors, reportError)
+
+		issue := analysis.Issue{
+			Code:     analysis.IssueCodeTXTErrorsUnknownError,
+			Severity: analysis.SeverityWarning,
+			Params: map[string]string{
+				"register": string(reg.Register),
+				"source":   string(reg.Source),
+				"code":     reg.Code(),
+			},
+		}
+		errorCode := errorCodes.Lookup(reg.DecodedError)
+		if errorCode == nil {
+			issue.Description = fmt.Sprintf("%s reports an unknown %s error %s", reg.Register, reg.Source, reg.Code())
+			result.Issues = append(result.Issues, issue)
+			continue
+		}
+		issue.Code = analysis.IssueCodeTXTErrorsError
+		issue.Params["name"] = errorCode.Name
+		reportError.Name = &errorCode.Name
+		reportError.Description = &errorCode.Description
+		issue.Description = fmt.Sprintf("%s reports %s error %s (%s): %s", reg.Register, reg.Source, reg.Code(), errorCode.Name, errorCode.Description)
+		if errorCode.Remediation != "" {
+			reportError.Remediation = &errorCode.Remediation
+			issue.Description += "; remediation: " + errorCode.Remediation
+			issue.Remediation = errorCode.Remediation
+		}
+		if errorCode.Critical {
+			issue.Severity = analysis.SeverityCritical
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+	if customReport.TXTReset {
+		result.Issues = append(result.Issues, analysis.Issue{
+			Code:        analysis.IssueCodeTXTErrorsReset,
+			Severity:    analysis.SeverityWarning,
+			Description: "TXT was reset because of an error (TXT_RESET.STS is set in TXT.ESTS)",
+		})
+	}
+
+	result.Custom = customReport
+	return result, nil
+}
+
+type decodedRegister struct {
+	DecodedError
+	Valid bool
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package txterrors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors/report/generated/txterrorsanalysis"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+// testACMStatus builds a valid ACM_STATUS with the error code
+func testACMStatus(class, major uint8, minor uint16) registers.ACMStatus {
+	return registers.ACMStatus(1<<31 | uint64(minor)<<16 | uint64(major)<<10 | uint64(class)<<4)
+}
+
+func testInput(t *testing.T, regs registers.Registers) Input {
+	actualRegisters, err := analysis.NewActualRegisters(regs)
+	require.NoError(t, err)
+	return Input{ActualRegisters: actualRegisters}
+}
+
+func TestLookup(t *testing.T) {
+	codes := DefaultErrorCodes()
+	for _, tc := range []struct {
+		reg          registers.ACMStatus
+		expectedName string
+	}{
+		{reg: testACMStatus(0x11, 0x05, 0x1C), expectedName: "BPMRevoked"},
+		{reg: testACMStatus(0x11, 0x05, 0x01), expectedName: "BPM"},
+		{reg: testACMStatus(0x11, 0x01, 0x1C), expectedName: "BPTIntegrity"},
+		{reg: testACMStatus(0x12, 0x05, 0x1C)},
+	} {
+		decoded, valid := DecodeACMStatus(tc.reg)
+		require.True(t, valid)
+		code := codes.Lookup(decoded)
+		if tc.expectedName == "" {
+			require.Nil(t, code, decoded.Code())
+			continue
+		}
+		require.NotNil(t, code, decoded.Code())
+		require.Equal(t, tc.expectedName, code.Name)
+	}
+
+	decoded, valid := DecodeTXTErrorCode(registers.TXTErrorCode(1<<31 | 7))
+	require.True(t, valid)
+	require.Equal(t, SourceProcessor, decoded.Source)
+	require.Equal(t, "ACMAuthenticationFailure", codes.Lookup(decoded).Name)
+}
+
+func TestAnalyze(t *testing.T) {
+	report, err := New(nil).Analyze(context.Background(), testInput(t, registers.Registers{
+		testACMStatus(0x11, 0x05, 0x1C),
+		registers.TXTErrorCode(0),
+		registers.TXTErrorStatus(1),
+	}))
+	require.NoError(t, err)
+
+	customReport := report.Custom.(txterrorsanalysis.CustomReport)
+	require.True(t, customReport.TXTReset)
+	require.Len(t, customReport.Errors, 1)
+	require.Equal(t, "ACM_STATUS", customReport.Errors[0].Register)
+	require.Equal(t, "1105001C", customReport.Errors[0].Code)
+	require.Equal(t, "BIOS ACM", customReport.Errors[0].ModuleTypeName)
+	require.Equal(t, "BPMRevoked", customReport.Errors[0].GetName())
+	require.NotEmpty(t, customReport.Errors[0].GetRemediation())
+
+	require.Len(t, report.Issues, 2)
+	require.Equal(t, analysis.SeverityCritical, report.Issues[0].Severity)
+	require.Equal(t, analysis.SeverityWarning, report.Issues[1].Severity)
+}
+
+func TestAnalyzeNoErrors(t *testing.T) {
+	report, err := New(nil).Analyze(context.Background(), testInput(t, registers.Registers{
+		registers.ACMStatus(0),
+		registers.TXTErrorCode(0),
+		registers.TXTErrorStatus(0),
+	}))
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+	require.Empty(t, report.Custom.(txterrorsanalysis.CustomReport).Errors)
+
+	_, err = New(nil).Analyze(context.Background(), testInput(t, registers.Registers{}))
+	require.ErrorAs(t, err, &analysis.ErrNotApplicable{})
+}
+
+func TestLoadErrorCodes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "codes.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+error_codes:
+  - name: CustomTPMError
+    source: acm
+    class: 0x12
+    major: 0x05
+    description: a TPM error of a custom ACM
+    remediation: check the TPM
+    critical: true
+`), 0o600))
+	codes, err := LoadErrorCodes(path)
+	require.NoError(t, err)
+
+	errorCodeTables := analysis.NewReferenceDataStore[ErrorCodes](1)
+	errorCodesHash, err := errorCodeTables.Put(codes)
+	require.NoError(t, err)
+	report, err := New(errorCodeTables).Analyze(context.Background(), Input{
+		ActualRegisters: testInput(t, registers.Registers{testACMStatus(0x12, 0x05, 0x1)}).ActualRegisters,
+		ErrorCodesHash:  (*ErrorCodesHash)(&errorCodesHash),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "CustomTPMError", report.Custom.(txterrorsanalysis.CustomReport).Errors[0].GetName())
+	require.Equal(t, analysis.SeverityCritical, report.Issues[0].Severity)
+
+	// the built-in table is still applied
+	decoded, _ := DecodeACMStatus(testACMStatus(0x11, 0x05, 0x1C))
+	require.Equal(t, "BPMRevoked", codes.Lookup(decoded).Name)
+
+	_, err = ParseErrorCodes([]byte(`{"error_codes": [{"name": "x", "source": "unknown"}]}`), false)
+	require.Error(t, err)
+}
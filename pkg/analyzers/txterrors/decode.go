@@ -0,0 +1,107 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package txterrors
+
+import (
+	"fmt"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+// DecodedError is an error decoded from TXT.ERRORCODE or ACM_STATUS.
+type DecodedError struct {
+	Register registers.RegisterID
+	Raw      uint64
+	Source   Source
+
+	// ProcessorCode is set only for errors reported by the processor.
+	ProcessorCode uint32
+
+	// ModuleType, Class, Major and Minor are set only for errors
+	// reported by software (an ACM or other software).
+	ModuleType uint8
+	Class      uint8
+	Major      uint8
+	Minor      uint16
+}
+
+// IsError returns false if the register reports no error.
+func (err DecodedError) IsError() bool {
+	switch err.Source {
+	case SourceProcessor:
+		return err.ProcessorCode != 0
+	default:
+		return err.Class != 0 || err.Major != 0 || err.Minor != 0
+	}
+}
+
+// Code returns the error code in the format used by Intel tools and
+// documentation: "CCMMmmmm" (class, major, minor) for software errors
+// and the plain hex value for processor errors.
+func (err DecodedError) Code() string {
+	if err.Source == SourceProcessor {
+		return fmt.Sprintf("%X", err.ProcessorCode)
+	}
+	return fmt.Sprintf("%02X%02X%04X", err.Class, err.Major, err.Minor)
+}
+
+// ModuleTypeName returns a human-readable name of ModuleType.
+func (err DecodedError) ModuleTypeName() string {
+	if err.Source == SourceProcessor {
+		return ""
+	}
+	switch err.ModuleType {
+	case 0:
+		return "BIOS ACM"
+	case 1:
+		return "SINIT ACM"
+	}
+	return fmt.Sprintf("reserved (0x%X)", err.ModuleType)
+}
+
+// DecodeTXTErrorCode decodes the TXT.ERRORCODE register. It returns false
+// if the register does not contain a valid error.
+func DecodeTXTErrorCode(reg registers.TXTErrorCode) (DecodedError, bool) {
+	result := DecodedError{
+		Register: reg.ID(),
+		Raw:      uint64(reg.Raw()),
+	}
+	if reg.ProcessorOrSoftwareReporter() == registers.ProcessorTXTErrorReporter {
+		result.Source = SourceProcessor
+		result.ProcessorCode = reg.Raw() & 0x3fffffff // 29:0
+	} else {
+		result.Source = SourceACM
+		if reg.SoftwareSource() {
+			result.Source = SourceSoftware
+		}
+		result.ModuleType = reg.ModuleType()
+		result.Class = reg.ClassCode()
+		result.Major = reg.MajorErrorCode()
+		result.Minor = reg.MinorErrorCode()
+	}
+	return result, reg.Valid()
+}
+
+// DecodeACMStatus decodes the ACM_STATUS register. It returns false
+// if the register does not contain a valid status.
+func DecodeACMStatus(reg registers.ACMStatus) (DecodedError, bool) {
+	return DecodedError{
+		Register:   reg.ID(),
+		Raw:        reg.Raw(),
+		Source:     SourceACM,
+		ModuleType: reg.ModuleType(),
+		Class:      reg.ClassCode(),
+		Major:      reg.MajorErrorCode(),
+		Minor:      reg.MinorErrorCode(),
+	}, reg.Valid()
+}
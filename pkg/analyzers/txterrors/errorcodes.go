@@ -0,0 +1,238 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package txterrors
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrorCodes is a table of documented TXT and ACM error codes.
+type ErrorCodes struct {
+	ErrorCodes []ErrorCode `json:"error_codes" yaml:"error_codes"`
+}
+
+// ErrorCode describes an error code (or a set of error codes). Fields which
+// are not specified match any value; if multiple entries match an error
+// the most specific one (with the most fields specified) is used.
+type ErrorCode struct {
+	Name string `json:"name" yaml:"name"`
+
+	// Source is "processor" for errors reported by the CPU (GETSEC),
+	// "acm" for errors reported by an authenticated code module or
+	// "software" for errors reported by other software (for example the MLE).
+	Source Source `json:"source" yaml:"source"`
+
+	// ProcessorCode matches bits 29:0 of TXT.ERRORCODE of processor errors.
+	ProcessorCode *uint32 `json:"processor_code,omitempty" yaml:"processor_code,omitempty"`
+
+	// ModuleType, Class, Major and Minor match the fields of ACM errors.
+	ModuleType *uint8  `json:"module_type,omitempty" yaml:"module_type,omitempty"`
+	Class      *uint8  `json:"class,omitempty" yaml:"class,omitempty"`
+	Major      *uint8  `json:"major,omitempty" yaml:"major,omitempty"`
+	Minor      *uint16 `json:"minor,omitempty" yaml:"minor,omitempty"`
+
+	Description string `json:"description" yaml:"description"`
+
+	// Remediation is a hint for the on-call how to fix the problem.
+	Remediation string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+
+	// Critical means the error indicates a security problem
+	// (rather than a misconfiguration or a hardware problem).
+	Critical bool `json:"critical,omitempty" yaml:"critical,omitempty"`
+}
+
+// Source is the reporter of an error.
+type Source string
+
+const (
+	SourceProcessor = Source("processor")
+	SourceACM       = Source("acm")
+	SourceSoftware  = Source("software")
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// DefaultErrorCodes returns the built-in table of error codes.
+//
+// The processor error codes are documented in Intel SDM ("GETSEC[ENTERACCS]",
+// TXT-shutdown conditions); ACM error codes are ACM-specific and only the
+// ones we have seen in the fleet are listed. More codes could be provided
+// through a table file, see LoadErrorCodes.
+func DefaultErrorCodes() *ErrorCodes {
+	authenticationHint := "check that the ACM in the FIT is not corrupted and is the one for this CPU family (see analyzer IntelACM)"
+	codes := &ErrorCodes{
+		ErrorCodes: []ErrorCode{
+			{Name: "LegacyShutdown", Source: SourceProcessor, ProcessorCode: ptr[uint32](0), Description: "legacy shutdown"},
+			{Name: "ACRAMMemoryType", Source: SourceProcessor, ProcessorCode: ptr[uint32](5), Description: "load memory type error in the authenticated code execution area"},
+			{Name: "UnrecognizedACMFormat", Source: SourceProcessor, ProcessorCode: ptr[uint32](6), Description: "unrecognized AC module format", Remediation: authenticationHint},
+			{Name: "ACMAuthenticationFailure", Source: SourceProcessor, ProcessorCode: ptr[uint32](7), Description: "failure to authenticate the AC module", Remediation: authenticationHint, Critical: true},
+			{Name: "InvalidACMFormat", Source: SourceProcessor, ProcessorCode: ptr[uint32](8), Description: "invalid AC module format", Remediation: authenticationHint},
+			{Name: "UnexpectedSnoopHit", Source: SourceProcessor, ProcessorCode: ptr[uint32](9), Description: "unexpected snoop hit detected"},
+			{Name: "InvalidEvent", Source: SourceProcessor, ProcessorCode: ptr[uint32](10), Description: "invalid event"},
+			{Name: "InvalidJoinFormat", Source: SourceProcessor, ProcessorCode: ptr[uint32](11), Description: "invalid MLE JOIN format"},
+			{Name: "UnrecoverableMachineCheck", Source: SourceProcessor, ProcessorCode: ptr[uint32](12), Description: "unrecoverable machine check condition", Remediation: "likely a hardware problem: check the machine check log of the host"},
+			{Name: "VMXAbort", Source: SourceProcessor, ProcessorCode: ptr[uint32](13), Description: "VMX abort"},
+			{Name: "ACRAMCorruption", Source: SourceProcessor, ProcessorCode: ptr[uint32](14), Description: "authenticated code execution area memory corruption", Remediation: "likely a hardware problem: check the memory of the host"},
+			{Name: "IllegalVoltageOrBusRatio", Source: SourceProcessor, ProcessorCode: ptr[uint32](15), Description: "illegal voltage/bus ratio"},
+
+			{
+				Name:        "BPTIntegrity",
+				Source:      SourceACM,
+				Class:       ptr[uint8](0x11),
+				Description: "Boot Policy integrity error (Key Manifest or Boot Policy Manifest verification failed)",
+				Remediation: "check the Key Manifest and the Boot Policy Manifest of the firmware (see analyzers DiffMeasuredBoot and IntelACM)",
+				Critical:    true,
+			},
+			{
+				Name:        "BPM",
+				Source:      SourceACM,
+				Class:       ptr[uint8](0x11),
+				Major:       ptr[uint8](0x05),
+				Description: "Boot Policy Manifest error",
+				Remediation: "check the Boot Policy Manifest of the firmware (see analyzer DiffMeasuredBoot)",
+				Critical:    true,
+			},
+			{
+				Name:        "BPMRevoked",
+				Source:      SourceACM,
+				Class:       ptr[uint8](0x11),
+				Major:       ptr[uint8](0x05),
+				Minor:       ptr[uint16](0x1C),
+				Description: "BPM is revoked: the BPM SVN is below the minimal SVN enforced by the ACM (the firmware was downgraded to an insecure version)",
+				Remediation: "flash a firmware version with the BPM SVN not below the ACM minimum (see analyzer FirmwareProvenance for the latest version)",
+				Critical:    true,
+			},
+		},
+	}
+	if err := codes.validate(); err != nil {
+		panic(err)
+	}
+	return codes
+}
+
+// ParseErrorCodes parses a table of error codes in JSON or YAML format.
+func ParseErrorCodes(b []byte, isYAML bool) (*ErrorCodes, error) {
+	var codes ErrorCodes
+	var err error
+	if isYAML {
+		err = yaml.Unmarshal(b, &codes)
+	} else {
+		err = json.Unmarshal(b, &codes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the error codes: %w", err)
+	}
+	if err := codes.validate(); err != nil {
+		return nil, err
+	}
+	return &codes, nil
+}
+
+// LoadErrorCodes reads a table of error codes from a file and appends
+// the built-in table to it (so the file could override or extend
+// the built-in entries). The format is chosen by the file extension:
+// ".yaml" and ".yml" are YAML, anything else is JSON.
+func LoadErrorCodes(path string) (*ErrorCodes, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the error codes '%s': %w", path, err)
+	}
+	var codes *ErrorCodes
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		codes, err = ParseErrorCodes(b, true)
+	default:
+		codes, err = ParseErrorCodes(b, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	codes.ErrorCodes = append(codes.ErrorCodes, DefaultErrorCodes().ErrorCodes...)
+	return codes, nil
+}
+
+func (codes *ErrorCodes) validate() error {
+	for idx, code := range codes.ErrorCodes {
+		switch code.Source {
+		case SourceProcessor:
+			if code.ModuleType != nil || code.Class != nil || code.Major != nil || code.Minor != nil {
+				return fmt.Errorf("error code #%d (%s): ACM fields are specified for a processor error", idx, code.Name)
+			}
+		case SourceACM, SourceSoftware:
+			if code.ProcessorCode != nil {
+				return fmt.Errorf("error code #%d (%s): processor_code is specified for a non-processor error", idx, code.Name)
+			}
+		default:
+			return fmt.Errorf("error code #%d (%s): unknown source '%s'", idx, code.Name, code.Source)
+		}
+	}
+	return nil
+}
+
+// Lookup returns the most specific entry matching the error (or nil).
+func (codes ErrorCodes) Lookup(err DecodedError) *ErrorCode {
+	var (
+		result      *ErrorCode
+		specificity int
+	)
+	for idx := range codes.ErrorCodes {
+		code := &codes.ErrorCodes[idx]
+		matches, fields := code.match(err)
+		if matches && (result == nil || fields > specificity) {
+			result, specificity = code, fields
+		}
+	}
+	return result
+}
+
+// match returns if the error matches the entry and the amount of matched fields
+func (code ErrorCode) match(err DecodedError) (bool, int) {
+	if code.Source != err.Source {
+		return false, 0
+	}
+	var fields int
+	for _, cond := range []struct {
+		expected *uint32
+		actual   uint32
+	}{
+		{u32(code.ProcessorCode), err.ProcessorCode},
+		{u32(code.ModuleType), uint32(err.ModuleType)},
+		{u32(code.Class), uint32(err.Class)},
+		{u32(code.Major), uint32(err.Major)},
+		{u32(code.Minor), uint32(err.Minor)},
+	} {
+		if cond.expected == nil {
+			continue
+		}
+		if *cond.expected != cond.actual {
+			return false, 0
+		}
+		fields++
+	}
+	return true, fields
+}
+
+func u32[T uint8 | uint16 | uint32](v *T) *uint32 {
+	if v == nil {
+		return nil
+	}
+	return ptr(uint32(*v))
+}
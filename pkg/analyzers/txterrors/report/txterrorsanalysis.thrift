@@ -0,0 +1,44 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.txterrors.report.generated.txterrorsanalysis
+
+const string TXTErrorsAnalyzerID = "TXTErrors";
+
+// DecodedError is an error reported by TXT.ERRORCODE or ACM_STATUS
+struct DecodedError {
+  // Register is the ID of the register, for example "TXT.ERRORCODE" or "ACM_STATUS"
+  1: string Register;
+  2: i64 Raw;
+  // Valid is the "valid" bit of the register
+  3: bool Valid;
+  // Source is "processor", "acm" or "software"
+  4: string Source;
+  5: i16 ModuleType;
+  // ModuleTypeName is empty for processor errors
+  6: string ModuleTypeName;
+  7: i16 Class;
+  8: i16 Major;
+  9: i32 Minor;
+  // Code is "CCMMmmmm" (class, major, minor) or the processor error code in hex
+  10: string Code;
+  // Name, Description and Remediation are set if the error code is known
+  11: optional string Name;
+  12: optional string Description;
+  13: optional string Remediation;
+}
+
+struct CustomReport {
+  1: list<DecodedError> Errors;
+  // TXTReset is TXT_RESET.STS of TXT.ESTS: TXT was reset because of an error
+  2: bool TXTReset;
+}
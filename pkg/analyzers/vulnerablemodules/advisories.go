@@ -0,0 +1,185 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package vulnerablemodules
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/linuxboot/fiano/pkg/guid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+// AdvisoryDB is a database of known-vulnerable UEFI modules.
+//
+// It is provided to the analyzer through New, the analyzer input contains
+// only its content hash (see AdvisoryDBHash).
+type AdvisoryDB struct {
+	Advisories []Advisory `json:"advisories" yaml:"advisories"`
+}
+
+// Advisory describes a single vulnerable module.
+//
+// A module matches the advisory if it has the same GUID and:
+// * one of its PE32/TE sections has one of the hashes in SHA256 (if any specified);
+// * its version is within [MinVersion, MaxVersion] (if any bound specified);
+// * any module with this GUID, if neither hashes nor versions are specified.
+type Advisory struct {
+	GUID        string   `json:"guid" yaml:"guid"`
+	SHA256      []string `json:"sha256,omitempty" yaml:"sha256,omitempty"`
+	MinVersion  string   `json:"min_version,omitempty" yaml:"min_version,omitempty"`
+	MaxVersion  string   `json:"max_version,omitempty" yaml:"max_version,omitempty"`
+	CVE         string   `json:"cve" yaml:"cve"`
+	Severity    string   `json:"severity" yaml:"severity"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// ParseAdvisoryDB parses an advisory database in JSON or YAML format.
+func ParseAdvisoryDB(b []byte, isYAML bool) (*AdvisoryDB, error) {
+	var db AdvisoryDB
+	var err error
+	if isYAML {
+		err = yaml.Unmarshal(b, &db)
+	} else {
+		err = json.Unmarshal(b, &db)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the advisory database: %w", err)
+	}
+	for idx := range db.Advisories {
+		if err := db.Advisories[idx].normalize(); err != nil {
+			return nil, fmt.Errorf("invalid advisory #%d (%s): %w", idx, db.Advisories[idx].CVE, err)
+		}
+	}
+	return &db, nil
+}
+
+// LoadAdvisoryDB reads an advisory database from a file. The format is
+// chosen by the file extension: ".yaml" and ".yml" are YAML, anything else is JSON.
+func LoadAdvisoryDB(path string) (*AdvisoryDB, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the advisory database '%s': %w", path, err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseAdvisoryDB(b, true)
+	}
+	return ParseAdvisoryDB(b, false)
+}
+
+func (advisory *Advisory) normalize() error {
+	if advisory.CVE == "" {
+		return fmt.Errorf("CVE is not specified")
+	}
+	moduleGUID, err := guid.Parse(advisory.GUID)
+	if err != nil {
+		return fmt.Errorf("invalid GUID '%s': %w", advisory.GUID, err)
+	}
+	advisory.GUID = moduleGUID.String()
+	for idx, hash := range advisory.SHA256 {
+		b, err := hex.DecodeString(hash)
+		if err != nil || len(b) != 32 {
+			return fmt.Errorf("invalid SHA256 '%s'", hash)
+		}
+		advisory.SHA256[idx] = strings.ToLower(hash)
+	}
+	if _, err := advisory.severity(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (advisory Advisory) severity() (analysis.Severity, error) {
+	switch strings.ToLower(advisory.Severity) {
+	case "info", "low":
+		return analysis.SeverityInfo, nil
+	case "warning", "medium":
+		return analysis.SeverityWarning, nil
+	case "critical", "high":
+		return analysis.SeverityCritical, nil
+	}
+	return 0, fmt.Errorf("unknown severity '%s'", advisory.Severity)
+}
+
+// Matches returns true if the module is affected by the advisory.
+func (advisory Advisory) Matches(module Module) bool {
+	if advisory.GUID != module.GUID.String() {
+		return false
+	}
+	if len(advisory.SHA256) > 0 {
+		for _, hash := range module.SHA256 {
+			for _, advisoryHash := range advisory.SHA256 {
+				if hex.EncodeToString(hash[:]) == advisoryHash {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if advisory.MinVersion == "" && advisory.MaxVersion == "" {
+		return true
+	}
+	if module.Version == nil {
+		return false
+	}
+	if advisory.MinVersion != "" && compareVersions(*module.Version, advisory.MinVersion) < 0 {
+		return false
+	}
+	if advisory.MaxVersion != "" && compareVersions(*module.Version, advisory.MaxVersion) > 0 {
+		return false
+	}
+	return true
+}
+
+// compareVersions compares version strings like "1.2.10" field by field:
+// numeric fields are compared as numbers, other fields are compared as strings.
+func compareVersions(a, b string) int {
+	isSeparator := func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}
+	aFields := strings.FieldsFunc(a, isSeparator)
+	bFields := strings.FieldsFunc(b, isSeparator)
+	for idx := 0; idx < len(aFields) || idx < len(bFields); idx++ {
+		var aField, bField string
+		if idx < len(aFields) {
+			aField = aFields[idx]
+		}
+		if idx < len(bFields) {
+			bField = bFields[idx]
+		}
+		aNum, aErr := strconv.ParseUint(aField, 10, 64)
+		bNum, bErr := strconv.ParseUint(bField, 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNum != bNum {
+				if aNum < bNum {
+					return -1
+				}
+				return 1
+			}
+		case aField != bField:
+			// a missing field is lower than any existing one
+			return strings.Compare(aField, bField)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package vulnerablemodules
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linuxboot/fiano/pkg/guid"
+	"github.com/stretchr/testify/require"
+)
+
+const testAdvisoriesYAML = `
+advisories:
+  - guid: 5C266089-E103-4D43-9AB5-12D7095BE2AF
+    sha256: [%HASH%]
+    cve: CVE-2022-0001
+    severity: critical
+    description: heap overflow in the image parser
+  - guid: 5c266089-e103-4d43-9ab5-12d7095be2af
+    min_version: "1.2"
+    max_version: "1.10"
+    cve: CVE-2022-0002
+    severity: medium
+`
+
+func testModule(body string, version *string) Module {
+	return Module{
+		GUID:    *guid.MustParse("5C266089-E103-4D43-9AB5-12D7095BE2AF"),
+		Version: version,
+		SHA256:  [][sha256.Size]byte{sha256.Sum256([]byte(body))},
+	}
+}
+
+func TestAdvisoryDB(t *testing.T) {
+	hash := sha256.Sum256([]byte("vulnerable"))
+	yaml := []byte(strings.ReplaceAll(testAdvisoriesYAML, "%HASH%", hex.EncodeToString(hash[:])))
+
+	db, err := ParseAdvisoryDB(yaml, true)
+	require.NoError(t, err)
+	require.Len(t, db.Advisories, 2)
+	require.Equal(t, db.Advisories[0].GUID, db.Advisories[1].GUID)
+
+	version := func(s string) *string { return &s }
+	byHash, byVersion := db.Advisories[0], db.Advisories[1]
+	require.True(t, byHash.Matches(testModule("vulnerable", nil)))
+	require.False(t, byHash.Matches(testModule("fixed", nil)))
+	require.False(t, byVersion.Matches(testModule("fixed", nil)))
+	require.False(t, byVersion.Matches(testModule("fixed", version("1.1"))))
+	require.True(t, byVersion.Matches(testModule("fixed", version("1.2"))))
+	require.True(t, byVersion.Matches(testModule("fixed", version("1.9.3"))))
+	require.True(t, byVersion.Matches(testModule("fixed", version("1.10"))))
+	require.False(t, byVersion.Matches(testModule("fixed", version("1.11"))))
+
+	_, err = ParseAdvisoryDB([]byte(`{"advisories":[{"guid":"invalid","cve":"CVE-2022-0003","severity":"high"}]}`), false)
+	require.Error(t, err)
+	_, err = ParseAdvisoryDB([]byte(`{"advisories":[{"guid":"5C266089-E103-4D43-9AB5-12D7095BE2AF","cve":"CVE-2022-0003","severity":"unknown"}]}`), false)
+	require.Error(t, err)
+}
+
+func TestAdvisoryDBWatcher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "advisories.json")
+	write := func(content string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	now := time.Now()
+	write(`{"advisories":[]}`, now)
+
+	w, err := NewAdvisoryDBWatcher(path, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, w.Get().Advisories)
+
+	reloaded, err := w.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+
+	write(`{"advisories":[{"guid":"5C266089-E103-4D43-9AB5-12D7095BE2AF","cve":"CVE-2022-0001","severity":"high"}]}`, now.Add(time.Second))
+	reloaded, err = w.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.Len(t, w.Get().Advisories, 1)
+
+	// an invalid database does not replace the valid one
+	write(`{"advisories":[{"guid":"invalid"}]}`, now.Add(2*time.Second))
+	_, err = w.Reload()
+	require.Error(t, err)
+	require.Len(t, w.Get().Advisories, 1)
+}
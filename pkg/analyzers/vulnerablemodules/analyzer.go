@@ -0,0 +1,170 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package vulnerablemodules
+
+//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules/report/generated/vulnmodulesanalysis"
+)
+
+func init() {
+	analysis.RegisterType((*AdvisoryDB)(nil))
+	analysis.RegisterType((AdvisoryDBHash)(""))
+	analysis.RegisterType((*vulnmodulesanalysis.CustomReport)(nil))
+}
+
+// ID represents the unique id of VulnerableModules analyzer
+const ID analysis.AnalyzerID = vulnmodulesanalysis.VulnerableModulesAnalyzerID
+
+// Version is the version of the logic of the analyzer, see analysis.AnalyzerVersion
+const Version analysis.AnalyzerVersion = "1.1.0"
+
+// AdvisoryDBHash is the content hash of the advisory database used by the analyzer,
+// the database itself is provided to the analyzer through New.
+type AdvisoryDBHash analysis.ReferenceDataHash
+
+// NewExecutorInput builds an analysis.Executor's input required for VulnerableModules analyzer
+//
+// advisoryDB is put into advisoryDBs, which should be the store provided to New.
+func NewExecutorInput(
+	actualFirmware analysis.Blob,
+	advisoryDBs *analysis.ReferenceDataStore[AdvisoryDB],
+	advisoryDB *AdvisoryDB,
+) (analysis.Input, error) {
+	if actualFirmware == nil {
+		return nil, fmt.Errorf("the actual firmware image should be specified")
+	}
+	if advisoryDB == nil {
+		return nil, fmt.Errorf("the advisory database should be specified")
+	}
+	advisoryDBHash, err := advisoryDBs.Put(advisoryDB)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store the advisory database: %w", err)
+	}
+
+	result := analysis.NewInput()
+	result.AddActualFirmware(
+		actualFirmware,
+	).AddCustomValue(
+		AdvisoryDBHash(advisoryDBHash),
+	)
+	return result, nil
+}
+
+// Input is an input structure required for analyzer
+type Input struct {
+	ActualFirmware analysis.ActualFirmwareBlob
+	AdvisoryDBHash AdvisoryDBHash
+}
+
+// VulnerableModules is analyzer that looks for UEFI modules
+// listed in the advisory database.
+type VulnerableModules struct {
+	advisoryDBs *analysis.ReferenceDataStore[AdvisoryDB]
+}
+
+// New returns a new object of VulnerableModules analyzer
+//
+// advisoryDBs provides the advisory databases referenced by the inputs, see NewExecutorInput.
+func New(advisoryDBs *analysis.ReferenceDataStore[AdvisoryDB]) analysis.Analyzer[Input] {
+	return &VulnerableModules{
+		advisoryDBs: advisoryDBs,
+	}
+}
+
+// ID implements the ID method required for analysis.Analyzer
+func (analyzer *VulnerableModules) ID() analysis.AnalyzerID {
+	return ID
+}
+
+// Version implements the Version method required for analysis.Analyzer
+func (analyzer *VulnerableModules) Version() analysis.AnalyzerVersion {
+	return Version
+}
+
+// Analyze hashes the executable sections of all FFS files of the image and checks them against the advisory database
+func (analyzer *VulnerableModules) Analyze(ctx context.Context, in Input) (*analysis.Report, error) {
+	advisoryDB, err := analyzer.advisoryDBs.Get(analysis.ReferenceDataHash(in.AdvisoryDBHash))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the advisory database: %w", err)
+	}
+
+	modules, err := ScanModules(in.ActualFirmware.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the UEFI structure of the actual image: %w", err)
+	}
+
+	customReport := vulnmodulesanalysis.CustomReport{
+		ScannedModules:  int32(len(modules)),
+		KnownAdvisories: int32(len(advisoryDB.Advisories)),
+	}
+	result := &analysis.Report{}
+	for _, module := range modules {
+		for _, advisory := range advisoryDB.Advisories {
+			if !advisory.Matches(module) {
+				continue
+			}
+			severity, err := advisory.severity()
+			if err != nil {
+				// ParseAdvisoryDB validates severities, but the database could be constructed manually
+				severity = analysis.SeverityCritical
+			}
+			reportModule := module.toThrift()
+			customReport.Matches = append(customReport.Matches, &vulnmodulesanalysis.Match{
+				Module: reportModule,
+				Advisory: &vulnmodulesanalysis.Advisory{
+					CVE:         advisory.CVE,
+					Severity:    advisory.Severity,
+					Description: advisory.Description,
+				},
+			})
+			result.Issues = append(result.Issues, analysis.Issue{
+				Code:        analysis.IssueCodeVulnerableModulesAffectedModule,
+				Severity:    severity,
+				Description: fmt.Sprintf("module %s is affected by %s: %s", moduleDescription(reportModule), advisory.CVE, advisory.Description),
+				Params:      map[string]string{"module": moduleDescription(reportModule), "cve": advisory.CVE},
+			})
+		}
+	}
+
+	result.Custom = customReport
+	return result, nil
+}
+
+func (module Module) toThrift() *vulnmodulesanalysis.Module {
+	result := &vulnmodulesanalysis.Module{
+		GUID:    module.GUID.String(),
+		Name:    module.Name,
+		Version: module.Version,
+	}
+	for _, hash := range module.SHA256 {
+		result.SHA256 = append(result.SHA256, append([]byte{}, hash[:]...))
+	}
+	return result
+}
+
+func moduleDescription(module *vulnmodulesanalysis.Module) string {
+	result := module.GUID
+	if module.Name != nil {
+		result = fmt.Sprintf("%s (%s)", *module.Name, module.GUID)
+	}
+	if module.Version != nil {
+		result += " version " + *module.Version
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package vulnerablemodules
+
+import (
+	"crypto/sha256"
+
+	"github.com/linuxboot/fiano/pkg/guid"
+	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/uefi"
+)
+
+// Module is an FFS file which contains an executable (PE32 or TE) image
+type Module struct {
+	GUID    guid.GUID
+	Name    *string
+	Version *string
+
+	// SHA256 contains hashes of bodies (without the section header)
+	// of all PE32/TE sections of the file.
+	SHA256 [][sha256.Size]byte
+}
+
+// ScanModules parses the image and returns all the modules found in it.
+//
+// DXE drivers are usually stored in compressed volumes, so the image is
+// parsed with decompression enabled.
+func ScanModules(image []byte) ([]Module, error) {
+	fw, err := uefi.Parse(image, true)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &moduleCollector{}
+	if err := collector.Run(fw.Firmware); err != nil {
+		return nil, err
+	}
+	return collector.Modules, nil
+}
+
+type moduleCollector struct {
+	Modules []Module
+	current *Module
+}
+
+// Run implements fianoUEFI.Visitor
+func (v *moduleCollector) Run(f fianoUEFI.Firmware) error {
+	return f.Apply(v)
+}
+
+// Visit implements fianoUEFI.Visitor
+func (v *moduleCollector) Visit(f fianoUEFI.Firmware) error {
+	switch f := f.(type) {
+	case *fianoUEFI.File:
+		parent := v.current
+		v.current = &Module{GUID: f.Header.GUID}
+		err := f.ApplyChildren(v)
+		if len(v.current.SHA256) > 0 {
+			v.Modules = append(v.Modules, *v.current)
+		}
+		v.current = parent
+		return err
+	case *fianoUEFI.Section:
+		if v.current == nil {
+			break
+		}
+		switch f.Header.Type {
+		case fianoUEFI.SectionTypePE32, fianoUEFI.SectionTypeTE:
+			v.current.SHA256 = append(v.current.SHA256, sha256.Sum256(uefi.SectionBody(f)))
+		case fianoUEFI.SectionTypeVersion:
+			version := f.Version
+			v.current.Version = &version
+		case fianoUEFI.SectionTypeUserInterface:
+			name := f.Name
+			v.current.Name = &name
+		}
+	}
+	return f.ApplyChildren(v)
+}
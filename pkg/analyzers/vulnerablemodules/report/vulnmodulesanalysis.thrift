@@ -0,0 +1,41 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+namespace go pkg.analyzers.vulnerablemodules.report.generated.vulnmodulesanalysis
+
+const string VulnerableModulesAnalyzerID = "VulnerableModules";
+
+// Module is an FFS file of the image which contains a PE32 or TE image
+struct Module {
+  1: string GUID;
+  2: optional string Name; // taken from EFI_SECTION_USER_INTERFACE
+  3: optional string Version; // taken from EFI_SECTION_VERSION
+  4: list<binary> SHA256; // hashes of the bodies of the PE32/TE sections
+}
+
+// Advisory is an entry of the advisory database which affected a module
+struct Advisory {
+  1: string CVE;
+  2: string Severity;
+  3: string Description;
+}
+
+struct Match {
+  1: Module Module;
+  2: Advisory Advisory;
+}
+
+struct CustomReport {
+  1: i32 ScannedModules;
+  2: i32 KnownAdvisories;
+  3: list<Match> Matches;
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package vulnerablemodules
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+// AdvisoryDBWatcher keeps an advisory database loaded from a file and
+// reloads it when the file is modified.
+type AdvisoryDBWatcher struct {
+	path           string
+	reloadInterval time.Duration
+	db             atomic.Pointer[AdvisoryDB]
+
+	locker  sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+// NewAdvisoryDBWatcher loads the advisory database from the file and returns
+// a watcher, which will check the file for modifications every reloadInterval
+// (after Watch is called).
+func NewAdvisoryDBWatcher(path string, reloadInterval time.Duration) (*AdvisoryDBWatcher, error) {
+	if reloadInterval <= 0 {
+		return nil, fmt.Errorf("reload interval should be positive, but it is %v", reloadInterval)
+	}
+	w := &AdvisoryDBWatcher{
+		path:           path,
+		reloadInterval: reloadInterval,
+	}
+	if _, err := w.Reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Get returns the latest successfully loaded advisory database.
+func (w *AdvisoryDBWatcher) Get() *AdvisoryDB {
+	return w.db.Load()
+}
+
+// Reload loads the advisory database if the file was modified since the last load.
+//
+// If the new version of the file is invalid, the previously loaded database is kept.
+func (w *AdvisoryDBWatcher) Reload() (bool, error) {
+	w.locker.Lock()
+	defer w.locker.Unlock()
+
+	stat, err := os.Stat(w.path)
+	if err != nil {
+		return false, fmt.Errorf("unable to stat the advisory database '%s': %w", w.path, err)
+	}
+	if w.db.Load() != nil && stat.ModTime().Equal(w.modTime) && stat.Size() == w.size {
+		return false, nil
+	}
+
+	db, err := LoadAdvisoryDB(w.path)
+	if err != nil {
+		return false, err
+	}
+	w.db.Store(db)
+	w.modTime = stat.ModTime()
+	w.size = stat.Size()
+	return true, nil
+}
+
+// Watch reloads the advisory database on modifications until the context is cancelled.
+func (w *AdvisoryDBWatcher) Watch(ctx context.Context) {
+	log := logger.FromCtx(ctx)
+	ticker := time.NewTicker(w.reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := w.Reload()
+			if err != nil {
+				log.Errorf("unable to reload the advisory database: %v", err)
+				continue
+			}
+			if reloaded {
+				log.Infof("reloaded the advisory database '%s': %d advisories", w.path, len(w.Get().Advisories))
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package authenticode calculates Authenticode digests of PE images,
+// which is the digest UEFI firmware extends into PCRs when it loads
+// an EFI image (EV_EFI_BOOT_SERVICES_DRIVER and similar events).
+package authenticode
+
+import (
+	"crypto"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+const (
+	peSignature    = "PE\x00\x00"
+	magicPE32      = 0x10b
+	magicPE32Plus  = 0x20b
+	coffHeaderSize = 20
+	sectionSize    = 40
+
+	// certificateTableIndex is the index of the data directory
+	// which points to the attribute certificate table
+	certificateTableIndex = 4
+)
+
+// Hash returns the Authenticode digest of a PE image.
+//
+// See "Calculating the PE Image Hash" in
+// "Windows Authenticode Portable Executable Signature Format".
+func Hash(image []byte, hashFunc crypto.Hash) ([]byte, error) {
+	if !hashFunc.Available() {
+		return nil, fmt.Errorf("hash function %v is not available", hashFunc)
+	}
+	if len(image) < 0x40 || image[0] != 'M' || image[1] != 'Z' {
+		return nil, fmt.Errorf("not a PE image: no MZ signature")
+	}
+	peOffset := int(binary.LittleEndian.Uint32(image[0x3c:]))
+	optOffset := peOffset + len(peSignature) + coffHeaderSize
+	if peOffset < 0 || optOffset+2 > len(image) || string(image[peOffset:peOffset+len(peSignature)]) != peSignature {
+		return nil, fmt.Errorf("not a PE image: no PE signature")
+	}
+	coffHeader := image[peOffset+len(peSignature):]
+	numberOfSections := int(binary.LittleEndian.Uint16(coffHeader[2:]))
+	sizeOfOptionalHeader := int(binary.LittleEndian.Uint16(coffHeader[16:]))
+
+	var dataDirsOffset int
+	switch magic := binary.LittleEndian.Uint16(image[optOffset:]); magic {
+	case magicPE32:
+		dataDirsOffset = optOffset + 96
+	case magicPE32Plus:
+		dataDirsOffset = optOffset + 112
+	default:
+		return nil, fmt.Errorf("unknown optional header magic 0x%X", magic)
+	}
+	if dataDirsOffset > len(image) || optOffset+sizeOfOptionalHeader > len(image) {
+		return nil, fmt.Errorf("the optional header is out of the image")
+	}
+	checksumOffset := optOffset + 64
+	sizeOfHeaders := int(binary.LittleEndian.Uint32(image[optOffset+60:]))
+	numberOfDataDirs := int(binary.LittleEndian.Uint32(image[dataDirsOffset-4:]))
+	if sizeOfHeaders > len(image) || sizeOfHeaders < dataDirsOffset {
+		return nil, fmt.Errorf("invalid SizeOfHeaders: %d", sizeOfHeaders)
+	}
+
+	h := hashFunc.New()
+	h.Write(image[:checksumOffset])
+
+	var certTableOffset, certTableSize int
+	if numberOfDataDirs > certificateTableIndex {
+		certDirOffset := dataDirsOffset + certificateTableIndex*8
+		if certDirOffset+8 > sizeOfHeaders {
+			return nil, fmt.Errorf("the certificate table directory is out of the headers")
+		}
+		h.Write(image[checksumOffset+4 : certDirOffset])
+		h.Write(image[certDirOffset+8 : sizeOfHeaders])
+		certTableOffset = int(binary.LittleEndian.Uint32(image[certDirOffset:]))
+		certTableSize = int(binary.LittleEndian.Uint32(image[certDirOffset+4:]))
+	} else {
+		h.Write(image[checksumOffset+4 : sizeOfHeaders])
+	}
+
+	type section struct {
+		offset int
+		size   int
+	}
+	sectionsOffset := optOffset + sizeOfOptionalHeader
+	if sectionsOffset+numberOfSections*sectionSize > len(image) {
+		return nil, fmt.Errorf("the section table is out of the image")
+	}
+	sections := make([]section, 0, numberOfSections)
+	for idx := 0; idx < numberOfSections; idx++ {
+		header := image[sectionsOffset+idx*sectionSize:]
+		s := section{
+			size:   int(binary.LittleEndian.Uint32(header[16:])),
+			offset: int(binary.LittleEndian.Uint32(header[20:])),
+		}
+		if s.size == 0 {
+			continue
+		}
+		if s.offset+s.size > len(image) {
+			return nil, fmt.Errorf("section #%d is out of the image", idx)
+		}
+		sections = append(sections, s)
+	}
+	sort.Slice(sections, func(i, j int) bool {
+		return sections[i].offset < sections[j].offset
+	})
+
+	sumOfBytesHashed := sizeOfHeaders
+	for _, s := range sections {
+		h.Write(image[s.offset : s.offset+s.size])
+		sumOfBytesHashed += s.size
+	}
+
+	extraDataEnd := len(image)
+	if certTableSize > 0 && certTableOffset >= sumOfBytesHashed && certTableOffset <= len(image) {
+		extraDataEnd = certTableOffset
+	}
+	if extraDataEnd > sumOfBytesHashed {
+		h.Write(image[sumOfBytesHashed:extraDataEnd])
+	}
+
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package authenticode
+
+import (
+	"crypto"
+	_ "crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testImage builds a minimal PE32+ image with two sections
+func testImage() []byte {
+	const (
+		peOffset      = 0x40
+		optOffset     = peOffset + 4 + coffHeaderSize
+		optHeaderSize = 112 + 16*8
+		headersSize   = 0x200
+	)
+	image := make([]byte, headersSize+0x400)
+	image[0], image[1] = 'M', 'Z'
+	binary.LittleEndian.PutUint32(image[0x3c:], peOffset)
+	copy(image[peOffset:], peSignature)
+	binary.LittleEndian.PutUint16(image[peOffset+4+2:], 2)
+	binary.LittleEndian.PutUint16(image[peOffset+4+16:], optHeaderSize)
+	binary.LittleEndian.PutUint16(image[optOffset:], magicPE32Plus)
+	binary.LittleEndian.PutUint32(image[optOffset+60:], headersSize)
+	binary.LittleEndian.PutUint32(image[optOffset+108:], 16)
+
+	sections := image[optOffset+optHeaderSize:]
+	// the section table is intentionally not sorted by PointerToRawData
+	binary.LittleEndian.PutUint32(sections[16:], 0x200)
+	binary.LittleEndian.PutUint32(sections[20:], headersSize+0x200)
+	binary.LittleEndian.PutUint32(sections[sectionSize+16:], 0x200)
+	binary.LittleEndian.PutUint32(sections[sectionSize+20:], headersSize)
+	for idx := headersSize; idx < len(image); idx++ {
+		image[idx] = byte(idx)
+	}
+	return image
+}
+
+func TestHash(t *testing.T) {
+	image := testImage()
+	reference, err := Hash(image, crypto.SHA256)
+	require.NoError(t, err)
+	require.Len(t, reference, 32)
+
+	t.Run("checksum_is_ignored", func(t *testing.T) {
+		modified := testImage()
+		binary.LittleEndian.PutUint32(modified[0x40+4+coffHeaderSize+64:], 0xdeadbeef)
+		h, err := Hash(modified, crypto.SHA256)
+		require.NoError(t, err)
+		require.Equal(t, reference, h)
+	})
+
+	t.Run("certificate_table_is_ignored", func(t *testing.T) {
+		modified := append(testImage(), make([]byte, 0x10)...)
+		certDirOffset := 0x40 + 4 + coffHeaderSize + 112 + certificateTableIndex*8
+		binary.LittleEndian.PutUint32(modified[certDirOffset:], uint32(len(image)))
+		binary.LittleEndian.PutUint32(modified[certDirOffset+4:], 0x10)
+		h, err := Hash(modified, crypto.SHA256)
+		require.NoError(t, err)
+		require.Equal(t, reference, h)
+	})
+
+	t.Run("section_data_is_hashed", func(t *testing.T) {
+		modified := testImage()
+		modified[len(modified)-1] ^= 1
+		h, err := Hash(modified, crypto.SHA256)
+		require.NoError(t, err)
+		require.NotEqual(t, reference, h)
+	})
+
+	t.Run("not_pe", func(t *testing.T) {
+		_, err := Hash(make([]byte, 0x100), crypto.SHA256)
+		require.Error(t, err)
+	})
+}
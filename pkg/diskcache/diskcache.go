@@ -0,0 +1,248 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package diskcache implements a size-bounded key-value cache stored on the local filesystem.
+// The content survives process restarts; the least recently used entries are evicted first.
+package diskcache
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/objhash"
+)
+
+const (
+	entryFileMode = 0640
+	dirMode       = 0750
+	tmpPattern    = ".tmp-*"
+
+	// fileNameKeySize is the amount of bytes of a key used as the file name.
+	// The whole key is too long for some filesystems, and the first 256 bits
+	// are still enough to avoid collisions.
+	fileNameKeySize = 32
+)
+
+type entry struct {
+	size     int64
+	lastUsed time.Time
+}
+
+// Cache is a persistent cache: each entry is stored as a separate file
+// "<dir>/<first two hex digits of key>/<the first fileNameKeySize bytes of key in hex>".
+//
+// Cache is safe for concurrent use from multiple goroutines, but not
+// from multiple processes sharing the same directory.
+type Cache struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	size    int64
+	entries map[string]*entry
+}
+
+// New opens (or creates) a cache in directory dir, limited to maxSize bytes in total.
+// Entries left from previous runs are reused.
+func New(dir string, maxSize int64) (*Cache, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("invalid cache size: %d", maxSize)
+	}
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return nil, fmt.Errorf("unable to create the cache directory '%s': %w", dir, err)
+	}
+	c := &Cache{
+		dir:     dir,
+		maxSize: maxSize,
+		entries: map[string]*entry{},
+	}
+	if err := c.load(); err != nil {
+		return nil, fmt.Errorf("unable to load the cache directory '%s': %w", dir, err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.evict(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Cache) load() error {
+	return filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if matched, _ := filepath.Match(tmpPattern, d.Name()); matched {
+			// a leftover of an interrupted Set
+			return os.Remove(path)
+		}
+		name := d.Name()
+		if !isValidName(name) || filepath.Join(c.dir, name[:2], name) != path {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		c.entries[name] = &entry{
+			size:     info.Size(),
+			lastUsed: info.ModTime(),
+		}
+		c.size += info.Size()
+		return nil
+	})
+}
+
+// Get returns the value stored with the key.
+func (c *Cache) Get(key objhash.ObjHash) ([]byte, bool) {
+	name := fileName(key)
+	c.mu.Lock()
+	e, ok := c.entries[name]
+	if ok {
+		e.lastUsed = time.Now()
+	}
+	c.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	path := c.path(name)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		c.mu.Lock()
+		// the entry could be replaced by a concurrent Set meanwhile, it is valid then
+		if c.entries[name] == e {
+			c.remove(name)
+		}
+		c.mu.Unlock()
+		return nil, false
+	}
+	// preserve the LRU order across restarts; errors are not important here
+	_ = os.Chtimes(path, time.Now(), time.Now())
+	return b, true
+}
+
+// Set stores the value with the key, evicting the least recently used
+// entries if the cache would exceed its size limit.
+//
+// Values larger than the whole cache are not stored.
+func (c *Cache) Set(key objhash.ObjHash, value []byte) error {
+	if int64(len(value)) > c.maxSize {
+		return nil
+	}
+
+	name := fileName(key)
+	dir := filepath.Join(c.dir, name[:2])
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return fmt.Errorf("unable to create directory '%s': %w", dir, err)
+	}
+	f, err := os.CreateTemp(dir, tmpPattern)
+	if err != nil {
+		return fmt.Errorf("unable to create a temporary file in '%s': %w", dir, err)
+	}
+	_, err = f.Write(value)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), entryFileMode)
+	}
+	if err == nil {
+		// rename is atomic, so a reader never gets a partially written value
+		err = os.Rename(f.Name(), c.path(name))
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return fmt.Errorf("unable to write cache entry %X: %w", key, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if old, ok := c.entries[name]; ok {
+		c.size -= old.size
+	}
+	c.entries[name] = &entry{
+		size:     int64(len(value)),
+		lastUsed: time.Now(),
+	}
+	c.size += int64(len(value))
+	return c.evict()
+}
+
+// Size returns the total size of the stored values.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// Len returns the amount of stored values.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+func (c *Cache) evict() error {
+	if c.size <= c.maxSize {
+		return nil
+	}
+
+	names := make([]string, 0, len(c.entries))
+	for name := range c.entries {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return c.entries[names[i]].lastUsed.Before(c.entries[names[j]].lastUsed)
+	})
+	for _, name := range names {
+		if c.size <= c.maxSize {
+			break
+		}
+		if err := os.Remove(c.path(name)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("unable to evict cache entry '%s': %w", name, err)
+		}
+		c.remove(name)
+	}
+	return nil
+}
+
+func (c *Cache) remove(name string) {
+	e, ok := c.entries[name]
+	if !ok {
+		return
+	}
+	c.size -= e.size
+	delete(c.entries, name)
+}
+
+func (c *Cache) path(name string) string {
+	return filepath.Join(c.dir, name[:2], name)
+}
+
+func fileName(key objhash.ObjHash) string {
+	return hex.EncodeToString(key[:fileNameKeySize])
+}
+
+func isValidName(name string) bool {
+	b, err := hex.DecodeString(name)
+	return err == nil && len(b) == fileNameKeySize && name == hex.EncodeToString(b)
+}
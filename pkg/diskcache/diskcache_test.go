@@ -0,0 +1,79 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package diskcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/objhash"
+)
+
+func TestCache(t *testing.T) {
+	dir := t.TempDir()
+	key0 := objhash.MustBuild("key0")
+	key1 := objhash.MustBuild("key1")
+	key2 := objhash.MustBuild("key2")
+
+	c, err := New(dir, 10)
+	require.NoError(t, err)
+
+	_, ok := c.Get(key0)
+	require.False(t, ok)
+
+	require.NoError(t, c.Set(key0, []byte("0000")))
+	require.NoError(t, c.Set(key1, []byte("1111")))
+	v, ok := c.Get(key0)
+	require.True(t, ok)
+	require.Equal(t, []byte("0000"), v)
+	require.Equal(t, int64(8), c.Size())
+
+	// key1 is the least recently used, so it is evicted
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, c.Set(key2, []byte("2222")))
+	require.Equal(t, 2, c.Len())
+	_, ok = c.Get(key1)
+	require.False(t, ok)
+
+	// too large
+	require.NoError(t, c.Set(key1, []byte("11111111111")))
+	_, ok = c.Get(key1)
+	require.False(t, ok)
+
+	t.Run("reopen", func(t *testing.T) {
+		// a leftover of an interrupted write
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".tmp-123"), []byte("garbage"), 0640))
+
+		c, err := New(dir, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, c.Len())
+		require.Equal(t, int64(8), c.Size())
+
+		v, ok := c.Get(key2)
+		require.True(t, ok)
+		require.Equal(t, []byte("2222"), v)
+
+		_, err = os.Stat(filepath.Join(dir, ".tmp-123"))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("reopen_smaller", func(t *testing.T) {
+		c, err := New(dir, 4)
+		require.NoError(t, err)
+		require.Equal(t, 1, c.Len())
+	})
+}
@@ -192,7 +192,7 @@ func (f FilterModelIDs) WhereCond() (string, []any) {
 	for _, id := range f {
 		s = append(s, strconv.FormatInt(id, 10))
 	}
-	return fmt.Sprintf("firmware_targets.model_id IN (%s)", strings.Join(s, ",")), nil
+	return fmt.Sprintf("firmware_target.model_id IN (%s)", strings.Join(s, ",")), nil
 }
 
 // Match implements Filter.
@@ -218,3 +218,39 @@ func (f FilterModelIDs) Match(fw *Firmware) bool {
 	}
 	return false
 }
+
+// FilterRevoked filters only the entries with the specified revocation status.
+type FilterRevoked bool
+
+// WhereCond implements Filter.
+func (f FilterRevoked) WhereCond() (string, []any) {
+	return "`revoked` = ?", []any{bool(f)}
+}
+
+// Match implements Filter.
+func (f FilterRevoked) Match(fw *Firmware) bool {
+	if fw == nil {
+		return false
+	}
+	return fw.Revoked == bool(f)
+}
+
+// FilterSuperseded filters only the entries which are (if true)
+// or are not (if false) superseded by another firmware.
+type FilterSuperseded bool
+
+// WhereCond implements Filter.
+func (f FilterSuperseded) WhereCond() (string, []any) {
+	if f {
+		return "`superseded_by` IS NOT NULL", nil
+	}
+	return "`superseded_by` IS NULL", nil
+}
+
+// Match implements Filter.
+func (f FilterSuperseded) Match(fw *Firmware) bool {
+	if fw == nil {
+		return false
+	}
+	return (fw.SupersededBy != nil) == bool(f)
+}
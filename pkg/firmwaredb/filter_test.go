@@ -41,6 +41,15 @@ func TestFilterWhereCond(t *testing.T) {
 	t.Run("FilterIDs", func(t *testing.T) {
 		assertQuery(FilterIDs{1, 2}, "id IN (1,2)")
 	})
+
+	t.Run("FilterRevoked", func(t *testing.T) {
+		assertQuery(FilterRevoked(true), "`revoked` = ?", true)
+	})
+
+	t.Run("FilterSuperseded", func(t *testing.T) {
+		assertQuery(FilterSuperseded(true), "`superseded_by` IS NOT NULL")
+		assertQuery(FilterSuperseded(false), "`superseded_by` IS NULL")
+	})
 }
 
 func TestFilterMatch(t *testing.T) {
@@ -78,6 +87,23 @@ func TestFilterMatch(t *testing.T) {
 			ID: 3,
 		}))
 	})
+
+	t.Run("FilterRevoked", func(t *testing.T) {
+		assert.True(t, FilterRevoked(true).Match(&Firmware{
+			Revoked: true,
+		}))
+		assert.False(t, FilterRevoked(true).Match(&Firmware{}))
+		assert.True(t, FilterRevoked(false).Match(&Firmware{}))
+	})
+
+	t.Run("FilterSuperseded", func(t *testing.T) {
+		supersededBy := int64(2)
+		assert.True(t, FilterSuperseded(true).Match(&Firmware{
+			SupersededBy: &supersededBy,
+		}))
+		assert.False(t, FilterSuperseded(true).Match(&Firmware{}))
+		assert.True(t, FilterSuperseded(false).Match(&Firmware{}))
+	})
 }
 
 type filterTrue struct{}
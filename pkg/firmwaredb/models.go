@@ -20,6 +20,18 @@ type FirmwareType = models.FirmwareType
 const (
 	// BiosFirmwareType represents BIOS
 	FirmwareTypeBIOS = models.FirmwareTypeBIOS
+
+	// FirmwareTypeOptionROM represents a PCI expansion ROM (of a NIC, GPU, etc)
+	FirmwareTypeOptionROM = models.FirmwareTypeOptionROM
+
+	// FirmwareTypeBMC represents a firmware of a baseboard management controller
+	FirmwareTypeBMC = models.FirmwareTypeBMC
+
+	// FirmwareTypeNIC represents a firmware of a network interface controller
+	FirmwareTypeNIC = models.FirmwareTypeNIC
+
+	// FirmwareTypeSSD represents a firmware of a solid-state drive
+	FirmwareTypeSSD = models.FirmwareTypeSSD
 )
 
 // Firmware represent a row of table containing metadata
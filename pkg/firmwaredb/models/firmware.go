@@ -1,5 +1,9 @@
 package models
 
+import (
+	"time"
+)
+
 // Firmware represent a row of table containing metadata
 // about firmware images.
 type Firmware struct {
@@ -8,6 +12,16 @@ type Firmware struct {
 	Version  string       `db:"version"`
 	ImageURL string       `db:"image_url"`
 
+	// ReleaseDate is the date the firmware was released by the vendor (if known).
+	ReleaseDate *time.Time `db:"release_date"`
+
+	// Revoked is true if the firmware must not be used anymore
+	// (for example, due to a known vulnerability).
+	Revoked bool `db:"revoked"`
+
+	// SupersededBy is the ID of the firmware which replaces this one (if any).
+	SupersededBy *int64 `db:"superseded_by"`
+
 	// loaded from other tables
 	Targets      []*FirmwareTarget      `db:"-"`
 	Measurements []*FirmwareMeasurement `db:"-"`
@@ -2,9 +2,12 @@
 -- this is not a real production-ready model, it is just a demonstration
 CREATE TABLE IF NOT EXISTS `firmware` (
     `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
-    `type` ENUM("BIOS", "BMC", "NIC", "SSD"),
+    `type` ENUM("BIOS", "BMC", "NIC", "SSD", "OPTION_ROM"),
     `version` VARCHAR(255),
 	`image_url` BLOB,
+	`release_date` DATE DEFAULT NULL,
+	`revoked` TINYINT(1) NOT NULL DEFAULT 0,
+	`superseded_by` BIGINT UNSIGNED DEFAULT NULL COMMENT 'reference to `firmware`.`id`',
     PRIMARY KEY (`id`),
     KEY `version` (`version`)
 ) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4;
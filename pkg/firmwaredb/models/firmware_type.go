@@ -10,6 +10,10 @@ type FirmwareType uint
 const (
 	FirmwareTypeUndefined = FirmwareType(iota)
 	FirmwareTypeBIOS
+	FirmwareTypeOptionROM
+	FirmwareTypeBMC
+	FirmwareTypeNIC
+	FirmwareTypeSSD
 
 	EndOfFirmwareType
 )
@@ -20,6 +24,14 @@ func (t FirmwareType) String() string {
 		return "NULL"
 	case FirmwareTypeBIOS:
 		return "BIOS"
+	case FirmwareTypeOptionROM:
+		return "OPTION_ROM"
+	case FirmwareTypeBMC:
+		return "BMC"
+	case FirmwareTypeNIC:
+		return "NIC"
+	case FirmwareTypeSSD:
+		return "SSD"
 	default:
 		return fmt.Sprintf("unknown_type_%d", uint(t))
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 	"github.com/immune-gmbh/attestation-sdk/if/generated/measurements"
 	"github.com/immune-gmbh/attestation-sdk/if/typeconv"
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
 	"github.com/immune-gmbh/attestation-sdk/pkg/flowscompat"
 	"github.com/immune-gmbh/attestation-sdk/pkg/objhash"
 
@@ -400,6 +401,544 @@ func (req *AnalyzeRequestBuilder) AddAPCBSecurityTokensInput(
 	return nil
 }
 
+// AddIntelFlashDescriptorInput populates AnalyzeRequest with input for IntelFlashDescriptor analyzer
+//
+// The original firmware image is optional: if neither firmwareVersion nor originalFirmwareImage
+// is provided, then the descriptor is not compared with the original one.
+func (req *AnalyzeRequestBuilder) AddIntelFlashDescriptorInput(
+	firmwareVersion string,
+	originalFirmwareImage *afas.FirmwareImage,
+	actualFirmwareImage afas.FirmwareImage,
+) error {
+	if originalFirmwareImage != nil {
+		if err := checkFirmwareImageIsCorrectEnum(*originalFirmwareImage, "originalFirmwareImage"); err != nil {
+			return err
+		}
+	}
+	if err := checkFirmwareImageIsCorrectEnum(actualFirmwareImage, "actualFirmwareImage"); err != nil {
+		return err
+	}
+
+	var input afas.IntelFlashDescriptorInput
+	switch {
+	case originalFirmwareImage != nil:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: originalFirmwareImage,
+		})
+		input.OriginalFirmwareImage = &idx
+	case len(firmwareVersion) > 0:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: &afas.FirmwareImage{
+				FirmwareVersion: &afas.FirmwareVersion{
+					Version: firmwareVersion,
+				},
+			},
+		})
+		input.OriginalFirmwareImage = &idx
+	}
+
+	input.ActualFirmwareImage = req.addArtifact(&afas.Artifact{
+		FwImage: &actualFirmwareImage,
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		IntelFlashDescriptor: &input,
+	})
+	return nil
+}
+
+// AddIntelMEInput populates AnalyzeRequest with input for IntelME analyzer
+//
+// The original firmware image is optional: if neither firmwareVersion nor originalFirmwareImage
+// is provided, then the ME region is not compared with the original one.
+func (req *AnalyzeRequestBuilder) AddIntelMEInput(
+	firmwareVersion string,
+	originalFirmwareImage *afas.FirmwareImage,
+	actualFirmwareImage afas.FirmwareImage,
+) error {
+	if originalFirmwareImage != nil {
+		if err := checkFirmwareImageIsCorrectEnum(*originalFirmwareImage, "originalFirmwareImage"); err != nil {
+			return err
+		}
+	}
+	if err := checkFirmwareImageIsCorrectEnum(actualFirmwareImage, "actualFirmwareImage"); err != nil {
+		return err
+	}
+
+	var input afas.IntelMEInput
+	switch {
+	case originalFirmwareImage != nil:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: originalFirmwareImage,
+		})
+		input.OriginalFirmwareImage = &idx
+	case len(firmwareVersion) > 0:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: &afas.FirmwareImage{
+				FirmwareVersion: &afas.FirmwareVersion{
+					Version: firmwareVersion,
+				},
+			},
+		})
+		input.OriginalFirmwareImage = &idx
+	}
+
+	input.ActualFirmwareImage = req.addArtifact(&afas.Artifact{
+		FwImage: &actualFirmwareImage,
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		IntelME: &input,
+	})
+	return nil
+}
+
+// AddPSPSecurityPatchLevelInput populates AnalyzeRequest with input for PSPSecurityPatchLevel analyzer
+//
+// The original firmware image and registers are optional: without the original firmware image
+// downgrades are not detected, without registers the fused anti-rollback state is not checked.
+func (req *AnalyzeRequestBuilder) AddPSPSecurityPatchLevelInput(
+	firmwareVersion string,
+	originalFirmwareImage *afas.FirmwareImage,
+	actualFirmwareImage afas.FirmwareImage,
+	actualRegisters registers.Registers,
+) error {
+	if originalFirmwareImage != nil {
+		if err := checkFirmwareImageIsCorrectEnum(*originalFirmwareImage, "originalFirmwareImage"); err != nil {
+			return err
+		}
+	}
+	if err := checkFirmwareImageIsCorrectEnum(actualFirmwareImage, "actualFirmwareImage"); err != nil {
+		return err
+	}
+
+	thriftRegisters, err := typeconv.ToThriftRegisters(actualRegisters)
+	if err != nil {
+		return fmt.Errorf("failed to convert registers to thrift format: %w", err)
+	}
+	sort.Slice(thriftRegisters, func(i, j int) bool {
+		return thriftRegisters[i].GetID() < thriftRegisters[j].GetID()
+	})
+
+	var input afas.PSPSecurityPatchLevelInput
+	switch {
+	case originalFirmwareImage != nil:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: originalFirmwareImage,
+		})
+		input.OriginalFirmwareImage = &idx
+	case len(firmwareVersion) > 0:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: &afas.FirmwareImage{
+				FirmwareVersion: &afas.FirmwareVersion{
+					Version: firmwareVersion,
+				},
+			},
+		})
+		input.OriginalFirmwareImage = &idx
+	}
+
+	input.ActualFirmwareImage = req.addArtifact(&afas.Artifact{
+		FwImage: &actualFirmwareImage,
+	})
+
+	if len(thriftRegisters) > 0 {
+		idx := req.addArtifact(&afas.Artifact{
+			StatusRegisters: thriftRegisters,
+		})
+		input.StatusRegisters = &idx
+	}
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		PSPSecurityPatchLevel: &input,
+	})
+	return nil
+}
+
+// AddPSBFusesInput populates AnalyzeRequest with input for PSBFuses analyzer
+//
+// fusedKeyHash is optional, see afas.PSBFusesInput.
+func (req *AnalyzeRequestBuilder) AddPSBFusesInput(
+	actualFirmwareImage afas.FirmwareImage,
+	actualRegisters registers.Registers,
+	fusedKeyHash []byte,
+) error {
+	if err := checkFirmwareImageIsCorrectEnum(actualFirmwareImage, "actualFirmwareImage"); err != nil {
+		return err
+	}
+
+	thriftRegisters, err := typeconv.ToThriftRegisters(actualRegisters)
+	if err != nil {
+		return fmt.Errorf("failed to convert registers to thrift format: %w", err)
+	}
+	if len(thriftRegisters) == 0 {
+		return fmt.Errorf("status registers should be provided")
+	}
+	sort.Slice(thriftRegisters, func(i, j int) bool {
+		return thriftRegisters[i].GetID() < thriftRegisters[j].GetID()
+	})
+
+	var input afas.PSBFusesInput
+	input.ActualFirmwareImage = req.addArtifact(&afas.Artifact{
+		FwImage: &actualFirmwareImage,
+	})
+	idx := req.addArtifact(&afas.Artifact{
+		StatusRegisters: thriftRegisters,
+	})
+	input.StatusRegisters = &idx
+	input.FusedKeyHash = fusedKeyHash
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		PSBFuses: &input,
+	})
+	return nil
+}
+
+// AddVulnerableModulesInput populates AnalyzeRequest with input for VulnerableModules analyzer
+func (req *AnalyzeRequestBuilder) AddVulnerableModulesInput(
+	actualFirmwareImage afas.FirmwareImage,
+) error {
+	if err := checkFirmwareImageIsCorrectEnum(actualFirmwareImage, "actualFirmwareImage"); err != nil {
+		return err
+	}
+
+	var input afas.VulnerableModulesInput
+	input.ActualFirmwareImage = req.addArtifact(&afas.Artifact{
+		FwImage: &actualFirmwareImage,
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		VulnerableModules: &input,
+	})
+	return nil
+}
+
+// AddFlashDegradationInput populates AnalyzeRequest with input for FlashDegradation analyzer
+//
+// If originalFirmwareImage is not provided, then the original image is looked up by firmwareVersion.
+func (req *AnalyzeRequestBuilder) AddFlashDegradationInput(
+	firmwareVersion string,
+	originalFirmwareImage *afas.FirmwareImage,
+	actualFirmwareImage afas.FirmwareImage,
+) error {
+	if originalFirmwareImage != nil {
+		if err := checkFirmwareImageIsCorrectEnum(*originalFirmwareImage, "originalFirmwareImage"); err != nil {
+			return err
+		}
+	}
+	if err := checkFirmwareImageIsCorrectEnum(actualFirmwareImage, "actualFirmwareImage"); err != nil {
+		return err
+	}
+
+	var input afas.FlashDegradationInput
+	switch {
+	case originalFirmwareImage != nil:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: originalFirmwareImage,
+		})
+		input.OriginalFirmwareImage = &idx
+	case len(firmwareVersion) > 0:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: &afas.FirmwareImage{
+				FirmwareVersion: &afas.FirmwareVersion{
+					Version: firmwareVersion,
+				},
+			},
+		})
+		input.OriginalFirmwareImage = &idx
+	}
+
+	input.ActualFirmwareImage = req.addArtifact(&afas.Artifact{
+		FwImage: &actualFirmwareImage,
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		FlashDegradation: &input,
+	})
+	return nil
+}
+
+// AddFirmwareProvenanceInput populates AnalyzeRequest with input for FirmwareProvenance analyzer
+func (req *AnalyzeRequestBuilder) AddFirmwareProvenanceInput(
+	actualFirmwareImage afas.FirmwareImage,
+) error {
+	if err := checkFirmwareImageIsCorrectEnum(actualFirmwareImage, "actualFirmwareImage"); err != nil {
+		return err
+	}
+
+	var input afas.FirmwareProvenanceInput
+	input.ActualFirmwareImage = req.addArtifact(&afas.Artifact{
+		FwImage: &actualFirmwareImage,
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		FirmwareProvenance: &input,
+	})
+	return nil
+}
+
+// AddOptionROMsInput populates AnalyzeRequest with input for OptionROMs analyzer
+func (req *AnalyzeRequestBuilder) AddOptionROMsInput(
+	eventLog *tpmeventlog.TPMEventLog,
+) error {
+	if eventLog == nil {
+		return fmt.Errorf("TPM EventLog should be provided")
+	}
+
+	var input afas.OptionROMsInput
+	input.TPMEventLog = req.addArtifact(&afas.Artifact{
+		TPMEventLog: typeconv.ToThriftTPMEventLog(eventLog),
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		OptionROMs: &input,
+	})
+	return nil
+}
+
+// AddBootChainInput populates AnalyzeRequest with input for BootChain analyzer
+func (req *AnalyzeRequestBuilder) AddBootChainInput(
+	eventLog *tpmeventlog.TPMEventLog,
+) error {
+	if eventLog == nil {
+		return fmt.Errorf("TPM EventLog should be provided")
+	}
+
+	var input afas.BootChainInput
+	input.TPMEventLog = req.addArtifact(&afas.Artifact{
+		TPMEventLog: typeconv.ToThriftTPMEventLog(eventLog),
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		BootChain: &input,
+	})
+	return nil
+}
+
+// AddACPITablesInput populates AnalyzeRequest with input for ACPITables analyzer
+//
+// The original firmware image is optional: if neither firmwareVersion nor originalFirmwareImage
+// is provided, then the ACPI tables are not compared with the ones of the original firmware.
+func (req *AnalyzeRequestBuilder) AddACPITablesInput(
+	firmwareVersion string,
+	originalFirmwareImage *afas.FirmwareImage,
+	tables []acpi.Table,
+) error {
+	if len(tables) == 0 {
+		return fmt.Errorf("ACPI tables should be provided")
+	}
+	if originalFirmwareImage != nil {
+		if err := checkFirmwareImageIsCorrectEnum(*originalFirmwareImage, "originalFirmwareImage"); err != nil {
+			return err
+		}
+	}
+
+	var input afas.ACPITablesInput
+	switch {
+	case originalFirmwareImage != nil:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: originalFirmwareImage,
+		})
+		input.OriginalFirmwareImage = &idx
+	case len(firmwareVersion) > 0:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: &afas.FirmwareImage{
+				FirmwareVersion: &afas.FirmwareVersion{
+					Version: firmwareVersion,
+				},
+			},
+		})
+		input.OriginalFirmwareImage = &idx
+	}
+
+	input.ACPITables = req.addArtifact(&afas.Artifact{
+		ACPITables: typeconv.ToThriftACPITables(tables),
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		ACPITables: &input,
+	})
+	return nil
+}
+
+// AddTXTErrorsInput populates AnalyzeRequest with input for TXTErrors analyzer
+func (req *AnalyzeRequestBuilder) AddTXTErrorsInput(
+	actualRegisters registers.Registers,
+) error {
+	thriftRegisters, err := typeconv.ToThriftRegisters(actualRegisters)
+	if err != nil {
+		return fmt.Errorf("failed to convert registers to thrift format: %w", err)
+	}
+	if len(thriftRegisters) == 0 {
+		return fmt.Errorf("status registers should be provided")
+	}
+	sort.Slice(thriftRegisters, func(i, j int) bool {
+		return thriftRegisters[i].GetID() < thriftRegisters[j].GetID()
+	})
+
+	var input afas.TXTErrorsInput
+	idx := req.addArtifact(&afas.Artifact{
+		StatusRegisters: thriftRegisters,
+	})
+	input.StatusRegisters = &idx
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		TXTErrors: &input,
+	})
+	return nil
+}
+
+// AddImageDiffInput populates AnalyzeRequest with input for ImageDiff analyzer
+//
+// The original image is either originalFirmwareImage or the image of firmwareVersion (if the former is not set).
+func (req *AnalyzeRequestBuilder) AddImageDiffInput(
+	firmwareVersion string,
+	originalFirmwareImage *afas.FirmwareImage,
+	actualFirmwareImage afas.FirmwareImage,
+) error {
+	if err := checkFirmwareImageIsCorrectEnum(actualFirmwareImage, "actualFirmwareImage"); err != nil {
+		return err
+	}
+
+	var input afas.ImageDiffInput
+	switch {
+	case originalFirmwareImage != nil:
+		if err := checkFirmwareImageIsCorrectEnum(*originalFirmwareImage, "originalFirmwareImage"); err != nil {
+			return err
+		}
+		input.OriginalFirmwareImage = req.addArtifact(&afas.Artifact{
+			FwImage: originalFirmwareImage,
+		})
+	case len(firmwareVersion) > 0:
+		input.OriginalFirmwareImage = req.addArtifact(&afas.Artifact{
+			FwImage: &afas.FirmwareImage{
+				FirmwareVersion: &afas.FirmwareVersion{
+					Version: firmwareVersion,
+				},
+			},
+		})
+	default:
+		return fmt.Errorf("the original firmware image or version should be specified")
+	}
+
+	input.ActualFirmwareImage = req.addArtifact(&afas.Artifact{
+		FwImage: &actualFirmwareImage,
+	})
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		ImageDiff: &input,
+	})
+	return nil
+}
+
+// AddPluginInput populates AnalyzeRequest with input for an out-of-process analyzer plugin
+//
+// All the provided artifacts are referenced by the input, the server passes to the plugin
+// only the ones it accepts. The original image is either originalFirmwareImage or the image
+// of firmwareVersion (if the former is not set).
+func (req *AnalyzeRequestBuilder) AddPluginInput(
+	analyzerID string,
+	firmwareVersion string,
+	originalFirmwareImage *afas.FirmwareImage,
+	actualFirmwareImage *afas.FirmwareImage,
+	actualRegisters registers.Registers,
+	tpmDevice tpmdetection.Type,
+	eventLog *tpmeventlog.TPMEventLog,
+	actualPCR0 []byte,
+	acpiTables []acpi.Table,
+) error {
+	if len(analyzerID) == 0 {
+		return fmt.Errorf("the analyzer ID of the plugin should be specified")
+	}
+	input := afas.PluginInput{
+		AnalyzerID: analyzerID,
+	}
+
+	switch {
+	case originalFirmwareImage != nil:
+		if err := checkFirmwareImageIsCorrectEnum(*originalFirmwareImage, "originalFirmwareImage"); err != nil {
+			return err
+		}
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: originalFirmwareImage,
+		})
+		input.OriginalFirmwareImage = &idx
+	case len(firmwareVersion) > 0:
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: &afas.FirmwareImage{
+				FirmwareVersion: &afas.FirmwareVersion{
+					Version: firmwareVersion,
+				},
+			},
+		})
+		input.OriginalFirmwareImage = &idx
+	}
+
+	if actualFirmwareImage != nil {
+		if err := checkFirmwareImageIsCorrectEnum(*actualFirmwareImage, "actualFirmwareImage"); err != nil {
+			return err
+		}
+		idx := req.addArtifact(&afas.Artifact{
+			FwImage: actualFirmwareImage,
+		})
+		input.ActualFirmwareImage = &idx
+	}
+
+	thriftRegisters, err := typeconv.ToThriftRegisters(actualRegisters)
+	if err != nil {
+		return fmt.Errorf("failed to convert registers to thrift format: %w", err)
+	}
+	if len(thriftRegisters) > 0 {
+		sort.Slice(thriftRegisters, func(i, j int) bool {
+			return thriftRegisters[i].GetID() < thriftRegisters[j].GetID()
+		})
+		idx := req.addArtifact(&afas.Artifact{
+			StatusRegisters: thriftRegisters,
+		})
+		input.StatusRegisters = &idx
+	}
+
+	thriftTPM, err := typeconv.ToThriftTPMType(tpmDevice)
+	if err != nil {
+		return fmt.Errorf("failed to convert TPM type to thrift format: %w", err)
+	}
+	if thriftTPM != afas.TPMType_UNKNOWN {
+		idx := req.addArtifact(&afas.Artifact{
+			TPMDevice: &thriftTPM,
+		})
+		input.TPMDevice = &idx
+	}
+
+	if thriftEventlog := typeconv.ToThriftTPMEventLog(eventLog); thriftEventlog != nil {
+		idx := req.addArtifact(&afas.Artifact{
+			TPMEventLog: thriftEventlog,
+		})
+		input.TPMEventLog = &idx
+	}
+
+	if len(actualPCR0) > 0 {
+		idx := req.addArtifact(&afas.Artifact{
+			Pcr: &afas.PCR{
+				Value: actualPCR0,
+				Index: 0,
+			},
+		})
+		input.ActualPCR0 = &idx
+	}
+
+	if len(acpiTables) > 0 {
+		idx := req.addArtifact(&afas.Artifact{
+			ACPITables: typeconv.ToThriftACPITables(acpiTables),
+		})
+		input.ACPITables = &idx
+	}
+
+	req.request.Analyzers = append(req.request.Analyzers, &afas.AnalyzerInput{
+		Plugin: &input,
+	})
+	return nil
+}
+
 func (req *AnalyzeRequestBuilder) addArtifact(art *afas.Artifact) int32 {
 	artifactHash := objhash.MustBuild(art)
 	idx, found := req.putArtifactsToPos[artifactHash]
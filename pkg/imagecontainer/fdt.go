@@ -0,0 +1,253 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagecontainer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	fdtMagic      = 0xd00dfeed
+	fdtHeaderSize = 40
+
+	fdtBeginNode = 1
+	fdtEndNode   = 2
+	fdtProp      = 3
+	fdtNop       = 4
+	fdtEnd       = 9
+)
+
+// fdtHeader is the header of a flattened device tree (see the Devicetree Specification).
+type fdtHeader struct {
+	Magic           uint32
+	TotalSize       uint32
+	OffDTStruct     uint32
+	OffDTStrings    uint32
+	OffMemRsvmap    uint32
+	Version         uint32
+	LastCompVersion uint32
+	BootCPUIDPhys   uint32
+	SizeDTStrings   uint32
+	SizeDTStruct    uint32
+}
+
+// fdtProperty is a property of a node of a flattened device tree.
+type fdtProperty struct {
+	NodePath string
+	Name     string
+	Value    []byte
+}
+
+// parseFDT parses a flattened device tree. If the tree contains node
+// "/images" it is considered to be a FIT (U-Boot Flattened Image Tree)
+// and each image is an entry.
+func parseFDT(image []byte, offset uint64) (*Container, bool) {
+	headerBytes, ok := clamp(image, offset, fdtHeaderSize)
+	if !ok || binary.BigEndian.Uint32(headerBytes) != fdtMagic {
+		return nil, false
+	}
+	var header fdtHeader
+	if err := binary.Read(bytes.NewReader(headerBytes), binary.BigEndian, &header); err != nil {
+		return nil, false
+	}
+	if header.Version < 16 || header.TotalSize < fdtHeaderSize ||
+		uint64(header.OffDTStruct)+uint64(header.SizeDTStruct) > uint64(header.TotalSize) ||
+		uint64(header.OffDTStrings)+uint64(header.SizeDTStrings) > uint64(header.TotalSize) {
+		return nil, false
+	}
+
+	prefix := containerPrefix(FormatFDT, offset)
+	container := &Container{
+		Format: FormatFDT,
+		Offset: offset,
+	}
+	blob, ok := clamp(image, offset, uint64(header.TotalSize))
+	container.Size = uint64(len(blob))
+	if !ok {
+		container.ParseError = ErrTruncated{Expected: uint64(header.TotalSize), Actual: container.Size}
+		container.Entries = []Entry{newEntry(prefix, blob)}
+		return container, true
+	}
+	properties, err := parseFDTProperties(blob, header)
+	if err != nil {
+		container.ParseError = err
+		container.Entries = []Entry{newEntry(prefix, blob)}
+		return container, true
+	}
+
+	isFIT := false
+	for _, prop := range properties {
+		if prop.NodePath == "/images" || strings.HasPrefix(prop.NodePath, "/images/") {
+			isFIT = true
+		}
+		if prop.NodePath == "/" && prop.Name == "description" {
+			container.Name = string(bytes.TrimRight(prop.Value, "\x00"))
+		}
+	}
+	if !isFIT {
+		container.Entries = []Entry{newEntry(prefix, blob)}
+		return container, true
+	}
+
+	container.Format = FormatFIT
+	prefix = containerPrefix(FormatFIT, offset)
+
+	// The data of images is either embedded (property "data") or
+	// external: "data-position" is relative to the beginning of the FIT
+	// and "data-offset" is relative to the end of the FDT (aligned to 4 bytes).
+	type imageData struct {
+		data                 []byte
+		offset, size         *uint64
+		relativeToFDTEnd     bool
+		externalDataProperty bool
+	}
+	images := map[string]*imageData{}
+	var imageNames []string
+	metadataHash := sha256.New()
+	for _, prop := range properties {
+		if path.Dir(prop.NodePath) != "/images" {
+			fmt.Fprintf(metadataHash, "%s:%s=%X;", prop.NodePath, prop.Name, prop.Value)
+			continue
+		}
+		name := path.Base(prop.NodePath)
+		img := images[name]
+		if img == nil {
+			img = &imageData{}
+			images[name] = img
+			imageNames = append(imageNames, name)
+		}
+		switch prop.Name {
+		case "data":
+			img.data = prop.Value
+			continue
+		case "data-position", "data-offset":
+			if len(prop.Value) == 4 {
+				v := uint64(binary.BigEndian.Uint32(prop.Value))
+				img.offset = &v
+				img.relativeToFDTEnd = prop.Name == "data-offset"
+				img.externalDataProperty = true
+			}
+		case "data-size":
+			if len(prop.Value) == 4 {
+				v := uint64(binary.BigEndian.Uint32(prop.Value))
+				img.size = &v
+			}
+		}
+		fmt.Fprintf(metadataHash, "%s:%s=%X;", prop.NodePath, prop.Name, prop.Value)
+	}
+	container.Entries = append(container.Entries, Entry{
+		Path:   prefix + "/metadata",
+		Size:   uint64(header.TotalSize),
+		SHA256: [sha256.Size]byte(metadataHash.Sum(nil)),
+	})
+
+	end := uint64(header.TotalSize)
+	for _, name := range imageNames {
+		img := images[name]
+		entryPath := prefix + "/images/" + name
+		if !img.externalDataProperty {
+			container.Entries = append(container.Entries, newEntry(entryPath, img.data))
+			continue
+		}
+		if img.offset == nil || img.size == nil {
+			container.ParseError = fmt.Errorf("image '%s' has external data without the size", name)
+			continue
+		}
+		dataOffset := *img.offset
+		if img.relativeToFDTEnd {
+			dataOffset += alignUp(uint64(header.TotalSize), 4)
+		}
+		data, ok := clamp(image, offset+dataOffset, *img.size)
+		container.Entries = append(container.Entries, newEntry(entryPath, data))
+		if !ok {
+			container.ParseError = ErrTruncated{Expected: dataOffset + *img.size, Actual: uint64(len(image)) - offset}
+		}
+		if dataEnd := dataOffset + uint64(len(data)); dataEnd > end {
+			end = dataEnd
+		}
+	}
+	container.Size = end
+	return container, true
+}
+
+func parseFDTProperties(blob []byte, header fdtHeader) ([]fdtProperty, error) {
+	structBlock := blob[header.OffDTStruct : header.OffDTStruct+header.SizeDTStruct]
+	stringsBlock := blob[header.OffDTStrings : header.OffDTStrings+header.SizeDTStrings]
+
+	var (
+		result   []fdtProperty
+		nodePath []string
+	)
+	for pos := uint64(0); ; {
+		if pos+4 > uint64(len(structBlock)) {
+			return nil, fmt.Errorf("unexpected end of the structure block")
+		}
+		token := binary.BigEndian.Uint32(structBlock[pos:])
+		pos += 4
+		switch token {
+		case fdtBeginNode:
+			end := bytes.IndexByte(structBlock[pos:], 0)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated node name at 0x%X", pos)
+			}
+			nodePath = append(nodePath, string(structBlock[pos:pos+uint64(end)]))
+			pos = alignUp(pos+uint64(end)+1, 4)
+		case fdtEndNode:
+			if len(nodePath) == 0 {
+				return nil, fmt.Errorf("unbalanced end of node at 0x%X", pos)
+			}
+			nodePath = nodePath[:len(nodePath)-1]
+		case fdtProp:
+			if pos+8 > uint64(len(structBlock)) {
+				return nil, fmt.Errorf("unexpected end of the structure block")
+			}
+			size := uint64(binary.BigEndian.Uint32(structBlock[pos:]))
+			nameOffset := uint64(binary.BigEndian.Uint32(structBlock[pos+4:]))
+			pos += 8
+			if pos+size > uint64(len(structBlock)) {
+				return nil, fmt.Errorf("property at 0x%X is out of range", pos)
+			}
+			if nameOffset >= uint64(len(stringsBlock)) {
+				return nil, fmt.Errorf("property name at 0x%X is out of range", nameOffset)
+			}
+			name := stringsBlock[nameOffset:]
+			if end := bytes.IndexByte(name, 0); end >= 0 {
+				name = name[:end]
+			}
+			result = append(result, fdtProperty{
+				NodePath: "/" + strings.Join(trimRoot(nodePath), "/"),
+				Name:     string(name),
+				Value:    structBlock[pos : pos+size],
+			})
+			pos = alignUp(pos+size, 4)
+		case fdtNop:
+		case fdtEnd:
+			return result, nil
+		default:
+			return nil, fmt.Errorf("unknown token 0x%X at 0x%X", token, pos-4)
+		}
+	}
+}
+
+// trimRoot removes the name of the root node (which is empty)
+func trimRoot(nodePath []string) []string {
+	if len(nodePath) > 0 && nodePath[0] == "" {
+		return nodePath[1:]
+	}
+	return nodePath
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package imagecontainer splits firmware images which are not UEFI (BMC,
+// NIC and SSD firmware) into files and partitions of well-known container
+// formats (uImage, FIT, squashfs, UBI), so that two images could be compared
+// entry by entry. The data which does not belong to any known container is
+// split into raw chunks.
+package imagecontainer
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+)
+
+// Format is a container format.
+type Format string
+
+const (
+	FormatUImage   = Format("uImage")
+	FormatFIT      = Format("FIT")
+	FormatFDT      = Format("FDT")
+	FormatSquashFS = Format("squashfs")
+	FormatUBI      = Format("UBI")
+	FormatRaw      = Format("raw")
+)
+
+const (
+	// ScanAlignment is the alignment containers are searched at.
+	ScanAlignment = 512
+
+	// RawChunkSize is the size of chunks the data which does not belong
+	// to any known container is split into. The chunks are aligned to
+	// the offset within the image, so that a change in one chunk does not
+	// affect the other ones.
+	RawChunkSize = 64 << 10
+)
+
+// Container is a region of an image in a known format.
+type Container struct {
+	Format Format
+	Offset uint64
+	Size   uint64
+
+	// Name is the image name stored in the container (if any).
+	Name string
+
+	// Entries are the files or partitions of the container.
+	Entries []Entry
+
+	// ParseError is set if the container is recognized, but malformed
+	// (for example truncated) or not supported. If the content could not
+	// be split at all, Entries contain a single entry for the whole container.
+	ParseError error
+}
+
+// Entry is a file or a partition of a container.
+type Entry struct {
+	// Path identifies the entry within the image, for example "squashfs@0x4A0000/usr/bin/ipmid".
+	Path string
+	Size uint64
+
+	// SHA256 is the hash of the content of the entry.
+	SHA256 [sha256.Size]byte
+
+	// Attributes describe the file attributes (like "mode=0755 uid=0 gid=0")
+	// for entries of filesystems, empty for other entries.
+	Attributes string
+}
+
+type parseFunc func(image []byte, offset uint64) (*Container, bool)
+
+var parsers = []parseFunc{
+	parseUImage,
+	parseFDT,
+	parseSquashFS,
+	parseUBI,
+}
+
+// Parse splits the image into containers. The containers are sorted by
+// offset and cover the whole image (gaps are covered by raw containers).
+func Parse(image []byte) []Container {
+	var result []Container
+	size := uint64(len(image))
+	rawStart := uint64(0)
+	for offset := uint64(0); offset < size; {
+		container := parseContainer(image, offset)
+		if container == nil {
+			offset += ScanAlignment
+			continue
+		}
+		if rawStart < offset {
+			result = append(result, newRawContainer(image, rawStart, offset))
+		}
+		result = append(result, *container)
+		rawStart = container.Offset + container.Size
+		offset = alignUp(rawStart, ScanAlignment)
+	}
+	if rawStart < size {
+		result = append(result, newRawContainer(image, rawStart, size))
+	}
+	return result
+}
+
+func parseContainer(image []byte, offset uint64) *Container {
+	for _, parse := range parsers {
+		container, ok := parse(image, offset)
+		if !ok {
+			continue
+		}
+		if container.Size == 0 {
+			// should never happen, but avoid an infinite loop anyway
+			container.Size = 1
+		}
+		sort.Slice(container.Entries, func(i, j int) bool {
+			return container.Entries[i].Path < container.Entries[j].Path
+		})
+		return container
+	}
+	return nil
+}
+
+func newRawContainer(image []byte, start, end uint64) Container {
+	container := Container{
+		Format: FormatRaw,
+		Offset: start,
+		Size:   end - start,
+	}
+	for chunkStart := start; chunkStart < end; {
+		chunkEnd := alignUp(chunkStart+1, RawChunkSize)
+		if chunkEnd > end {
+			chunkEnd = end
+		}
+		container.Entries = append(container.Entries, newEntry(fmt.Sprintf("%s@0x%X", FormatRaw, chunkStart), image[chunkStart:chunkEnd]))
+		chunkStart = chunkEnd
+	}
+	return container
+}
+
+func newEntry(path string, data []byte) Entry {
+	return Entry{
+		Path:   path,
+		Size:   uint64(len(data)),
+		SHA256: sha256.Sum256(data),
+	}
+}
+
+func containerPrefix(format Format, offset uint64) string {
+	return fmt.Sprintf("%s@0x%X", format, offset)
+}
+
+func alignUp(v, alignment uint64) uint64 {
+	return (v + alignment - 1) / alignment * alignment
+}
+
+// clamp returns the part of the image within [offset, offset+size) and
+// false if the region is truncated by the end of the image.
+func clamp(image []byte, offset, size uint64) ([]byte, bool) {
+	if offset >= uint64(len(image)) {
+		return nil, false
+	}
+	end := offset + size
+	if end < offset || end > uint64(len(image)) {
+		return image[offset:], false
+	}
+	return image[offset:end], true
+}
+
+// ErrTruncated means the container is cut off by the end of the image.
+type ErrTruncated struct {
+	Expected uint64
+	Actual   uint64
+}
+
+func (err ErrTruncated) Error() string {
+	return fmt.Sprintf("the container is truncated: expected %d bytes, but only %d are available", err.Expected, err.Actual)
+}
@@ -0,0 +1,334 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagecontainer
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testUImage(name string, data []byte) []byte {
+	header := make([]byte, uImageHeaderSize)
+	binary.BigEndian.PutUint32(header[0:], uImageMagic)
+	binary.BigEndian.PutUint32(header[12:], uint32(len(data)))
+	binary.BigEndian.PutUint32(header[24:], crc32.ChecksumIEEE(data))
+	header[30] = 2 // kernel
+	copy(header[32:], name)
+	binary.BigEndian.PutUint32(header[4:], crc32.ChecksumIEEE(header))
+	return append(header, data...)
+}
+
+// fdtBuilder builds a flattened device tree
+type fdtBuilder struct {
+	structBlock  bytes.Buffer
+	stringsBlock bytes.Buffer
+}
+
+func (b *fdtBuilder) u32(v uint32) {
+	binary.Write(&b.structBlock, binary.BigEndian, v)
+}
+
+func (b *fdtBuilder) pad() {
+	for b.structBlock.Len()%4 != 0 {
+		b.structBlock.WriteByte(0)
+	}
+}
+
+func (b *fdtBuilder) beginNode(name string) {
+	b.u32(fdtBeginNode)
+	b.structBlock.WriteString(name)
+	b.structBlock.WriteByte(0)
+	b.pad()
+}
+
+func (b *fdtBuilder) endNode() {
+	b.u32(fdtEndNode)
+}
+
+func (b *fdtBuilder) prop(name string, value []byte) {
+	b.u32(fdtProp)
+	b.u32(uint32(len(value)))
+	b.u32(uint32(b.stringsBlock.Len()))
+	b.stringsBlock.WriteString(name)
+	b.stringsBlock.WriteByte(0)
+	b.structBlock.Write(value)
+	b.pad()
+}
+
+func (b *fdtBuilder) bytes() []byte {
+	b.u32(fdtEnd)
+	offStruct := uint32(fdtHeaderSize + 16)
+	offStrings := offStruct + uint32(b.structBlock.Len())
+	totalSize := offStrings + uint32(b.stringsBlock.Len())
+	result := make([]byte, offStruct)
+	for idx, v := range []uint32{fdtMagic, totalSize, offStruct, offStrings, fdtHeaderSize, 17, 16, 0, uint32(b.stringsBlock.Len()), uint32(b.structBlock.Len())} {
+		binary.BigEndian.PutUint32(result[idx*4:], v)
+	}
+	result = append(result, b.structBlock.Bytes()...)
+	return append(result, b.stringsBlock.Bytes()...)
+}
+
+func testFIT(kernel, ramdisk []byte) []byte {
+	var b fdtBuilder
+	b.beginNode("")
+	b.prop("description", []byte("test FIT\x00"))
+	b.beginNode("images")
+	b.beginNode("kernel")
+	b.prop("type", []byte("kernel\x00"))
+	b.prop("data", kernel)
+	b.endNode()
+	b.beginNode("ramdisk")
+	b.prop("type", []byte("ramdisk\x00"))
+	b.prop("data", ramdisk)
+	b.endNode()
+	b.endNode()
+	b.endNode()
+	return b.bytes()
+}
+
+// metadataBlock returns a squashfs metadata block (compressed if specified)
+func metadataBlock(t *testing.T, data []byte, compress bool) []byte {
+	header := uint16(len(data)) | squashFSMetadataUncompressedFlag
+	if compress {
+		var buf bytes.Buffer
+		w := zlib.NewWriter(&buf)
+		_, err := w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		data = buf.Bytes()
+		header = uint16(len(data))
+	}
+	return append(binary.LittleEndian.AppendUint16(nil, header), data...)
+}
+
+func le(values ...any) []byte {
+	var buf bytes.Buffer
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			buf.WriteString(s)
+			continue
+		}
+		binary.Write(&buf, binary.LittleEndian, v)
+	}
+	return buf.Bytes()
+}
+
+// testSquashFS builds a squashfs with files "/a" (a data block),
+// "/d/b" (a fragment) and symlink "/l" -> "a".
+func testSquashFS(t *testing.T, fileA, fileB []byte, modeA uint16) []byte {
+	const blockSize = 4096
+	image := make([]byte, squashFSSuperblockSize)
+
+	dataA := uint64(len(image))
+	image = append(image, fileA...)
+	fragment := uint64(len(image))
+	image = append(image, fileB...)
+
+	inodeHeader := func(inodeType, mode uint16, number uint32) []byte {
+		return le(inodeType, mode, uint16(0), uint16(1), uint32(0), number)
+	}
+	var inodes []byte
+	inodeA := len(inodes)
+	inodes = append(inodes, inodeHeader(squashFSTypeFile, modeA, 1)...)
+	inodes = append(inodes, le(uint32(dataA), uint32(squashFSNoFragment), uint32(0), uint32(len(fileA)), uint32(len(fileA))|squashFSDataUncompressedFlag)...)
+	inodeL := len(inodes)
+	inodes = append(inodes, inodeHeader(squashFSTypeSymlink, 0777, 2)...)
+	inodes = append(inodes, le(uint32(1), uint32(1), "a")...)
+	inodeB := len(inodes)
+	inodes = append(inodes, inodeHeader(squashFSTypeFile, 0644, 3)...)
+	inodes = append(inodes, le(uint32(0), uint32(0), uint32(0), uint32(len(fileB)))...)
+
+	// directory listings: "d" at 0, root after it
+	inodeD := len(inodes)
+	dirD := le(uint32(0), uint32(0), uint32(3), uint16(inodeB), int16(0), uint16(squashFSTypeFile), uint16(0), "b")
+	dirRoot := le(uint32(2), uint32(0), uint32(1),
+		uint16(inodeA), int16(0), uint16(squashFSTypeFile), uint16(0), "a",
+		uint16(inodeD), int16(0), uint16(squashFSTypeDir), uint16(0), "d",
+		uint16(inodeL), int16(0), uint16(squashFSTypeSymlink), uint16(0), "l",
+	)
+	inodes = append(inodes, inodeHeader(squashFSTypeDir, 0755, 4)...)
+	inodes = append(inodes, le(uint32(0), uint32(2), uint16(len(dirD)+3), uint16(0), uint32(5))...)
+	inodeRoot := len(inodes)
+	inodes = append(inodes, inodeHeader(squashFSTypeDir, 0755, 5)...)
+	inodes = append(inodes, le(uint32(0), uint32(3), uint16(len(dirRoot)+3), uint16(len(dirD)), uint32(6))...)
+
+	inodeTable := uint64(len(image))
+	image = append(image, metadataBlock(t, inodes, true)...)
+	dirTable := uint64(len(image))
+	image = append(image, metadataBlock(t, append(dirD, dirRoot...), false)...)
+
+	fragmentEntries := uint64(len(image))
+	image = append(image, metadataBlock(t, le(fragment, uint32(len(fileB))|squashFSDataUncompressedFlag, uint32(0)), false)...)
+	fragmentTable := uint64(len(image))
+	image = append(image, le(fragmentEntries)...)
+
+	ids := uint64(len(image))
+	image = append(image, metadataBlock(t, le(uint32(0), uint32(1000)), false)...)
+	idTable := uint64(len(image))
+	image = append(image, le(ids)...)
+
+	superblock := le(
+		uint32(squashFSMagic), uint32(5), uint32(0), uint32(blockSize), uint32(1),
+		uint16(squashFSCompressionGZIP), uint16(12), uint16(0), uint16(2), uint16(4), uint16(0),
+		uint64(inodeRoot), uint64(len(image)), idTable, ^uint64(0), inodeTable, dirTable, fragmentTable, ^uint64(0),
+	)
+	copy(image, superblock)
+	return image
+}
+
+type testLEB struct {
+	volumeID uint32
+	lnum     uint32
+	data     []byte
+}
+
+// testUBI builds a UBI image with 8KiB eraseblocks, the volume table
+// is added automatically (volume 0 is named "rootfs").
+func testUBI(lebs []testLEB, freePEBs int) []byte {
+	const pebSize = 8192
+	const vidOffset, dataOffset = 64, 128
+	volumeTable := make([]byte, ubiVolumeTableRecSize)
+	binary.BigEndian.PutUint16(volumeTable[14:], uint16(len("rootfs")))
+	copy(volumeTable[16:], "rootfs")
+	lebs = append([]testLEB{{volumeID: ubiLayoutVolumeID, data: volumeTable}}, lebs...)
+
+	var image []byte
+	for idx := 0; idx < len(lebs)+freePEBs; idx++ {
+		peb := bytes.Repeat([]byte{0xFF}, pebSize)
+		ec := make([]byte, ubiHeaderSize)
+		copy(ec, ubiECHeaderMagic)
+		ec[4] = 1
+		binary.BigEndian.PutUint64(ec[8:], uint64(idx*7)) // erase counters differ between images
+		binary.BigEndian.PutUint32(ec[16:], vidOffset)
+		binary.BigEndian.PutUint32(ec[20:], dataOffset)
+		binary.BigEndian.PutUint32(ec[60:], ubiCRC(ec[:60]))
+		copy(peb, ec)
+		if idx < len(lebs) {
+			leb := lebs[idx]
+			vid := make([]byte, ubiHeaderSize)
+			copy(vid, ubiVIDHeaderMagic)
+			vid[4], vid[5] = 1, 1
+			binary.BigEndian.PutUint32(vid[8:], leb.volumeID)
+			binary.BigEndian.PutUint32(vid[12:], leb.lnum)
+			binary.BigEndian.PutUint64(vid[40:], uint64(idx))
+			binary.BigEndian.PutUint32(vid[60:], ubiCRC(vid[:60]))
+			copy(peb[vidOffset:], vid)
+			copy(peb[dataOffset:], leb.data)
+		}
+		image = append(image, peb...)
+	}
+	return image
+}
+
+func findEntry(t *testing.T, containers []Container, path string) Entry {
+	for _, container := range containers {
+		for _, entry := range container.Entries {
+			if entry.Path == path {
+				return entry
+			}
+		}
+	}
+	require.Failf(t, "entry not found", "%s", path)
+	return Entry{}
+}
+
+func TestParseUImage(t *testing.T) {
+	image := append(testUImage("Linux-5.15", []byte("kernel")), bytes.Repeat([]byte{0xFF}, 100)...)
+	containers := Parse(image)
+	require.Len(t, containers, 2)
+	require.Equal(t, FormatUImage, containers[0].Format)
+	require.Equal(t, "Linux-5.15", containers[0].Name)
+	require.NoError(t, containers[0].ParseError)
+	require.Equal(t, uint64(uImageHeaderSize+6), containers[0].Size)
+	require.Equal(t, sha256.Sum256([]byte("kernel")), findEntry(t, containers, "uImage@0x0/data").SHA256)
+	require.Equal(t, FormatRaw, containers[1].Format)
+	require.Equal(t, uint64(100), containers[1].Size)
+
+	truncated := Parse(image[:uImageHeaderSize+3])
+	require.Len(t, truncated, 1)
+	require.ErrorAs(t, truncated[0].ParseError, &ErrTruncated{})
+}
+
+func TestParseFIT(t *testing.T) {
+	containers := Parse(testFIT([]byte("kernel"), []byte("ramdisk")))
+	require.Len(t, containers, 1)
+	require.Equal(t, FormatFIT, containers[0].Format)
+	require.Equal(t, "test FIT", containers[0].Name)
+	require.NoError(t, containers[0].ParseError)
+	require.Equal(t, sha256.Sum256([]byte("kernel")), findEntry(t, containers, "FIT@0x0/images/kernel").SHA256)
+	require.Equal(t, sha256.Sum256([]byte("ramdisk")), findEntry(t, containers, "FIT@0x0/images/ramdisk").SHA256)
+
+	// the metadata does not depend on the content of the images
+	modified := Parse(testFIT([]byte("kernel"), []byte("RAMDISK")))
+	require.Equal(t, findEntry(t, containers, "FIT@0x0/metadata"), findEntry(t, modified, "FIT@0x0/metadata"))
+	require.NotEqual(t, findEntry(t, containers, "FIT@0x0/images/ramdisk"), findEntry(t, modified, "FIT@0x0/images/ramdisk"))
+}
+
+func TestParseSquashFS(t *testing.T) {
+	image := append(make([]byte, ScanAlignment), testSquashFS(t, []byte("hello world"), []byte("fragment"), 0755)...)
+	containers := Parse(image)
+	require.Len(t, containers, 2)
+	require.Equal(t, FormatSquashFS, containers[1].Format)
+	require.NoError(t, containers[1].ParseError)
+
+	a := findEntry(t, containers, "squashfs@0x200/a")
+	require.Equal(t, sha256.Sum256([]byte("hello world")), a.SHA256)
+	require.Equal(t, "mode=0755 uid=0 gid=1000", a.Attributes)
+	require.Equal(t, sha256.Sum256([]byte("fragment")), findEntry(t, containers, "squashfs@0x200/d/b").SHA256)
+	l := findEntry(t, containers, "squashfs@0x200/l")
+	require.Equal(t, sha256.Sum256([]byte("a")), l.SHA256)
+	require.Equal(t, "symlink mode=0777 uid=0 gid=1000", l.Attributes)
+
+	setuid := Parse(append(make([]byte, ScanAlignment), testSquashFS(t, []byte("hello world"), []byte("fragment"), 04755)...))
+	require.Equal(t, a.SHA256, findEntry(t, setuid, "squashfs@0x200/a").SHA256)
+	require.NotEqual(t, a.Attributes, findEntry(t, setuid, "squashfs@0x200/a").Attributes)
+}
+
+func TestParseUBI(t *testing.T) {
+	image := testUBI([]testLEB{
+		{volumeID: 0, lnum: 0, data: []byte("first")},
+		{volumeID: 0, lnum: 1, data: []byte("second")},
+		{volumeID: 1, lnum: 0, data: []byte("data")},
+	}, 2)
+	containers := Parse(image)
+	require.Len(t, containers, 1)
+	require.Equal(t, FormatUBI, containers[0].Format)
+	require.NoError(t, containers[0].ParseError)
+	require.Equal(t, uint64(len(image)), containers[0].Size)
+	require.Len(t, containers[0].Entries, 3)
+	rootfs := findEntry(t, containers, "UBI@0x0/rootfs")
+	findEntry(t, containers, "UBI@0x0/volume1")
+
+	// the same volumes in different eraseblocks
+	reordered := Parse(testUBI([]testLEB{
+		{volumeID: 1, lnum: 0, data: []byte("data")},
+		{volumeID: 0, lnum: 1, data: []byte("second")},
+		{volumeID: 0, lnum: 0, data: []byte("first")},
+	}, 0))
+	require.Equal(t, rootfs, findEntry(t, reordered, "UBI@0x0/rootfs"))
+}
+
+func TestParseRaw(t *testing.T) {
+	containers := Parse(make([]byte, RawChunkSize*2+10))
+	require.Len(t, containers, 1)
+	require.Equal(t, FormatRaw, containers[0].Format)
+	require.Len(t, containers[0].Entries, 3)
+	require.Equal(t, "raw@0x20000", containers[0].Entries[2].Path)
+	require.Equal(t, uint64(10), containers[0].Entries[2].Size)
+}
@@ -0,0 +1,584 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagecontainer
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ulikunitz/xz"
+	"github.com/ulikunitz/xz/lzma"
+)
+
+const (
+	squashFSMagic          = 0x73717368 // "hsqs"
+	squashFSSuperblockSize = 96
+
+	squashFSCompressionGZIP = 1
+	squashFSCompressionLZMA = 2
+	squashFSCompressionXZ   = 4
+
+	squashFSMetadataBlockSize        = 8192
+	squashFSMetadataUncompressedFlag = 0x8000
+	squashFSDataUncompressedFlag     = 1 << 24
+	squashFSNoFragment               = 0xFFFFFFFF
+
+	// squashFSMaxDepth limits the depth of the directory tree to protect
+	// from loops in malformed images
+	squashFSMaxDepth = 64
+)
+
+// squashFS inode types
+const (
+	squashFSTypeDir         = 1
+	squashFSTypeFile        = 2
+	squashFSTypeSymlink     = 3
+	squashFSTypeExtDir      = 8
+	squashFSTypeExtFile     = 9
+	squashFSTypeExtSymlink  = 10
+	squashFSInodeHeaderSize = 16
+)
+
+// squashFSSuperblock is the superblock of a squashfs 4.0 filesystem.
+type squashFSSuperblock struct {
+	Magic            uint32
+	InodeCount       uint32
+	ModificationTime uint32
+	BlockSize        uint32
+	FragmentCount    uint32
+	Compression      uint16
+	BlockLog         uint16
+	Flags            uint16
+	IDCount          uint16
+	VersionMajor     uint16
+	VersionMinor     uint16
+	RootInode        uint64
+	BytesUsed        uint64
+	IDTable          uint64
+	XattrTable       uint64
+	InodeTable       uint64
+	DirectoryTable   uint64
+	FragmentTable    uint64
+	ExportTable      uint64
+}
+
+type squashFS struct {
+	data       []byte
+	superblock squashFSSuperblock
+	decompress func([]byte) ([]byte, error)
+	metadata   map[uint64]squashFSMetadataBlock
+	fragments  map[uint32][]byte
+	ids        []uint32
+}
+
+type squashFSMetadataBlock struct {
+	Data []byte
+	Next uint64
+}
+
+type squashFSInode struct {
+	Type        uint16
+	Permissions uint16
+	UID         uint32
+	GID         uint32
+
+	// directories
+	DirBlock  uint32
+	DirOffset uint16
+	DirSize   uint32
+
+	// regular files
+	BlocksStart   uint64
+	FileSize      uint64
+	Fragment      uint32
+	FragmentStart uint32
+	BlockSizes    []uint32
+
+	// symlinks
+	Target []byte
+}
+
+func parseSquashFS(image []byte, offset uint64) (*Container, bool) {
+	superblockBytes, ok := clamp(image, offset, squashFSSuperblockSize)
+	if !ok || binary.LittleEndian.Uint32(superblockBytes) != squashFSMagic {
+		return nil, false
+	}
+	var superblock squashFSSuperblock
+	if err := binary.Read(bytes.NewReader(superblockBytes), binary.LittleEndian, &superblock); err != nil {
+		return nil, false
+	}
+	if superblock.VersionMajor != 4 || superblock.BytesUsed < squashFSSuperblockSize ||
+		superblock.BlockLog > 20 || superblock.BlockSize != 1<<superblock.BlockLog {
+		return nil, false
+	}
+
+	prefix := containerPrefix(FormatSquashFS, offset)
+	container := &Container{
+		Format: FormatSquashFS,
+		Offset: offset,
+	}
+	data, ok := clamp(image, offset, superblock.BytesUsed)
+	container.Size = uint64(len(data))
+	if !ok {
+		container.ParseError = ErrTruncated{Expected: superblock.BytesUsed, Actual: container.Size}
+		container.Entries = []Entry{newEntry(prefix, data)}
+		return container, true
+	}
+
+	fs := &squashFS{
+		data:       data,
+		superblock: superblock,
+		metadata:   map[uint64]squashFSMetadataBlock{},
+		fragments:  map[uint32][]byte{},
+	}
+	entries, err := fs.entries(prefix)
+	if err != nil {
+		container.ParseError = err
+		container.Entries = []Entry{newEntry(prefix, data)}
+		return container, true
+	}
+	container.Entries = entries
+	return container, true
+}
+
+func (fs *squashFS) entries(prefix string) ([]Entry, error) {
+	switch fs.superblock.Compression {
+	case squashFSCompressionGZIP:
+		fs.decompress = func(b []byte) ([]byte, error) {
+			r, err := zlib.NewReader(bytes.NewReader(b))
+			if err != nil {
+				return nil, err
+			}
+			return io.ReadAll(r)
+		}
+	case squashFSCompressionLZMA:
+		fs.decompress = func(b []byte) ([]byte, error) {
+			r, err := lzma.NewReader(bytes.NewReader(b))
+			if err != nil {
+				return nil, err
+			}
+			return io.ReadAll(r)
+		}
+	case squashFSCompressionXZ:
+		fs.decompress = func(b []byte) ([]byte, error) {
+			r, err := xz.NewReader(bytes.NewReader(b))
+			if err != nil {
+				return nil, err
+			}
+			return io.ReadAll(r)
+		}
+	default:
+		return nil, fmt.Errorf("squashfs compression %d is not supported", fs.superblock.Compression)
+	}
+
+	if err := fs.readIDs(); err != nil {
+		return nil, fmt.Errorf("unable to read the ID table: %w", err)
+	}
+	var result []Entry
+	if err := fs.walk(prefix, fs.superblock.RootInode, 0, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (fs *squashFS) readIDs() error {
+	count := uint64(fs.superblock.IDCount)
+	blocks := (count*4 + squashFSMetadataBlockSize - 1) / squashFSMetadataBlockSize
+	pointers, err := fs.slice(fs.superblock.IDTable, blocks*8)
+	if err != nil {
+		return err
+	}
+	for idx := uint64(0); idx < blocks; idx++ {
+		r := fs.metadataReader(binary.LittleEndian.Uint64(pointers[idx*8:]), 0)
+		n := count - uint64(len(fs.ids))
+		if n > squashFSMetadataBlockSize/4 {
+			n = squashFSMetadataBlockSize / 4
+		}
+		b, err := r.Read(n * 4)
+		if err != nil {
+			return err
+		}
+		for pos := uint64(0); pos < uint64(len(b)); pos += 4 {
+			fs.ids = append(fs.ids, binary.LittleEndian.Uint32(b[pos:]))
+		}
+	}
+	return nil
+}
+
+func (fs *squashFS) id(idx uint16) uint32 {
+	if int(idx) >= len(fs.ids) {
+		return uint32(idx)
+	}
+	return fs.ids[idx]
+}
+
+func (fs *squashFS) slice(offset, size uint64) ([]byte, error) {
+	b, ok := clamp(fs.data, offset, size)
+	if !ok {
+		return nil, fmt.Errorf("region 0x%X:0x%X is out of range", offset, offset+size)
+	}
+	return b, nil
+}
+
+func (fs *squashFS) metadataBlock(offset uint64) (squashFSMetadataBlock, error) {
+	if block, ok := fs.metadata[offset]; ok {
+		return block, nil
+	}
+	headerBytes, err := fs.slice(offset, 2)
+	if err != nil {
+		return squashFSMetadataBlock{}, err
+	}
+	header := binary.LittleEndian.Uint16(headerBytes)
+	size := uint64(header &^ squashFSMetadataUncompressedFlag)
+	data, err := fs.slice(offset+2, size)
+	if err != nil {
+		return squashFSMetadataBlock{}, err
+	}
+	if header&squashFSMetadataUncompressedFlag == 0 {
+		data, err = fs.decompress(data)
+		if err != nil {
+			return squashFSMetadataBlock{}, fmt.Errorf("unable to decompress the metadata block at 0x%X: %w", offset, err)
+		}
+	}
+	block := squashFSMetadataBlock{
+		Data: data,
+		Next: offset + 2 + size,
+	}
+	fs.metadata[offset] = block
+	return block, nil
+}
+
+// squashFSMetadataReader reads a stream of data stored in consecutive metadata blocks.
+type squashFSMetadataReader struct {
+	fs     *squashFS
+	block  uint64
+	offset uint64
+}
+
+func (fs *squashFS) metadataReader(block uint64, offset uint64) *squashFSMetadataReader {
+	return &squashFSMetadataReader{fs: fs, block: block, offset: offset}
+}
+
+func (r *squashFSMetadataReader) Read(n uint64) ([]byte, error) {
+	result := make([]byte, 0, n)
+	for uint64(len(result)) < n {
+		block, err := r.fs.metadataBlock(r.block)
+		if err != nil {
+			return nil, err
+		}
+		if r.offset >= uint64(len(block.Data)) {
+			if len(block.Data) == 0 {
+				return nil, fmt.Errorf("empty metadata block at 0x%X", r.block)
+			}
+			r.offset -= uint64(len(block.Data))
+			r.block = block.Next
+			continue
+		}
+		chunk := block.Data[r.offset:]
+		if remaining := n - uint64(len(result)); uint64(len(chunk)) > remaining {
+			chunk = chunk[:remaining]
+		}
+		result = append(result, chunk...)
+		r.offset += uint64(len(chunk))
+	}
+	return result, nil
+}
+
+func (r *squashFSMetadataReader) u16() (uint16, error) {
+	b, err := r.Read(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (r *squashFSMetadataReader) u32() (uint32, error) {
+	b, err := r.Read(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *squashFSMetadataReader) u64() (uint64, error) {
+	b, err := r.Read(8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+// inode reads an inode by a reference: the offset of the metadata block
+// (relative to the inode table) in the upper bits and the offset within
+// the block in the lower 16 bits.
+func (fs *squashFS) inode(ref uint64) (*squashFSInode, error) {
+	r := fs.metadataReader(fs.superblock.InodeTable+ref>>16, ref&0xFFFF)
+	header, err := r.Read(squashFSInodeHeaderSize)
+	if err != nil {
+		return nil, err
+	}
+	inode := &squashFSInode{
+		Type:        binary.LittleEndian.Uint16(header[0:]),
+		Permissions: binary.LittleEndian.Uint16(header[2:]),
+		UID:         fs.id(binary.LittleEndian.Uint16(header[4:])),
+		GID:         fs.id(binary.LittleEndian.Uint16(header[6:])),
+	}
+
+	var fields []any
+	switch inode.Type {
+	case squashFSTypeDir:
+		var linkCount, parent uint32
+		var size16 uint16
+		fields = []any{&inode.DirBlock, &linkCount, &size16, &inode.DirOffset, &parent}
+		if err := readFields(r, fields...); err != nil {
+			return nil, err
+		}
+		inode.DirSize = uint32(size16)
+		return inode, nil
+	case squashFSTypeExtDir:
+		var linkCount, parent, xattr uint32
+		var indexCount uint16
+		fields = []any{&linkCount, &inode.DirSize, &inode.DirBlock, &parent, &indexCount, &inode.DirOffset, &xattr}
+		return inode, readFields(r, fields...)
+	case squashFSTypeFile:
+		var blocksStart, fileSize uint32
+		if err := readFields(r, &blocksStart, &inode.Fragment, &inode.FragmentStart, &fileSize); err != nil {
+			return nil, err
+		}
+		inode.BlocksStart, inode.FileSize = uint64(blocksStart), uint64(fileSize)
+	case squashFSTypeExtFile:
+		var sparse uint64
+		var linkCount, xattr uint32
+		if err := readFields(r, &inode.BlocksStart, &inode.FileSize, &sparse, &linkCount, &inode.Fragment, &inode.FragmentStart, &xattr); err != nil {
+			return nil, err
+		}
+	case squashFSTypeSymlink, squashFSTypeExtSymlink:
+		var linkCount, targetSize uint32
+		if err := readFields(r, &linkCount, &targetSize); err != nil {
+			return nil, err
+		}
+		inode.Target, err = r.Read(uint64(targetSize))
+		return inode, err
+	default:
+		// devices, FIFOs and sockets have no content
+		return inode, nil
+	}
+
+	blockSize := uint64(fs.superblock.BlockSize)
+	blockCount := inode.FileSize / blockSize
+	if inode.Fragment == squashFSNoFragment && inode.FileSize%blockSize != 0 {
+		blockCount++
+	}
+	if blockCount > uint64(len(fs.data)) {
+		return nil, fmt.Errorf("invalid file size %d", inode.FileSize)
+	}
+	sizes, err := r.Read(blockCount * 4)
+	if err != nil {
+		return nil, err
+	}
+	for pos := 0; pos < len(sizes); pos += 4 {
+		inode.BlockSizes = append(inode.BlockSizes, binary.LittleEndian.Uint32(sizes[pos:]))
+	}
+	return inode, nil
+}
+
+func readFields(r *squashFSMetadataReader, fields ...any) error {
+	for _, field := range fields {
+		var err error
+		switch field := field.(type) {
+		case *uint16:
+			*field, err = r.u16()
+		case *uint32:
+			*field, err = r.u32()
+		case *uint64:
+			*field, err = r.u64()
+		default:
+			panic(fmt.Sprintf("unexpected type %T", field))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk appends the entries of the directory tree with the root at inode ref
+func (fs *squashFS) walk(dirPath string, ref uint64, depth int, result *[]Entry) error {
+	if depth > squashFSMaxDepth {
+		return fmt.Errorf("directory '%s' is too deep", dirPath)
+	}
+	dir, err := fs.inode(ref)
+	if err != nil {
+		return fmt.Errorf("unable to read the inode of directory '%s': %w", dirPath, err)
+	}
+	if dir.Type != squashFSTypeDir && dir.Type != squashFSTypeExtDir {
+		return fmt.Errorf("'%s' is not a directory", dirPath)
+	}
+	if dir.DirSize <= 3 {
+		// empty directory (the size includes "." and "..")
+		return nil
+	}
+
+	r := fs.metadataReader(fs.superblock.DirectoryTable+uint64(dir.DirBlock), uint64(dir.DirOffset))
+	remaining := int64(dir.DirSize) - 3
+	for remaining > 0 {
+		var count, start, inodeNumber uint32
+		if err := readFields(r, &count, &start, &inodeNumber); err != nil {
+			return fmt.Errorf("unable to read directory '%s': %w", dirPath, err)
+		}
+		remaining -= 12
+		for idx := uint32(0); idx <= count; idx++ {
+			var offset, inodeOffset, entryType, nameSize uint16
+			if err := readFields(r, &offset, &inodeOffset, &entryType, &nameSize); err != nil {
+				return fmt.Errorf("unable to read directory '%s': %w", dirPath, err)
+			}
+			name, err := r.Read(uint64(nameSize) + 1)
+			if err != nil {
+				return fmt.Errorf("unable to read directory '%s': %w", dirPath, err)
+			}
+			remaining -= 8 + int64(nameSize) + 1
+
+			entryPath := dirPath + "/" + string(name)
+			entryRef := uint64(start)<<16 | uint64(offset)
+			if entryType == squashFSTypeDir {
+				if err := fs.walk(entryPath, entryRef, depth+1, result); err != nil {
+					return err
+				}
+				continue
+			}
+			entry, err := fs.entry(entryPath, entryRef)
+			if err != nil {
+				return fmt.Errorf("unable to read '%s': %w", entryPath, err)
+			}
+			if entry != nil {
+				*result = append(*result, *entry)
+			}
+		}
+	}
+	return nil
+}
+
+// entry returns the entry of a file or a symlink, nil for other inode types.
+func (fs *squashFS) entry(entryPath string, ref uint64) (*Entry, error) {
+	inode, err := fs.inode(ref)
+	if err != nil {
+		return nil, err
+	}
+	attributes := fmt.Sprintf("mode=%04o uid=%d gid=%d", inode.Permissions, inode.UID, inode.GID)
+	switch inode.Type {
+	case squashFSTypeSymlink, squashFSTypeExtSymlink:
+		entry := newEntry(entryPath, inode.Target)
+		entry.Attributes = "symlink " + attributes
+		return &entry, nil
+	case squashFSTypeFile, squashFSTypeExtFile:
+	default:
+		return nil, nil
+	}
+
+	h := sha256.New()
+	blockSize := uint64(fs.superblock.BlockSize)
+	written := uint64(0)
+	pos := inode.BlocksStart
+	for _, size := range inode.BlockSizes {
+		length := blockSize
+		if remaining := inode.FileSize - written; remaining < length {
+			length = remaining
+		}
+		block, err := fs.dataBlock(pos, size)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			// sparse block
+			block = make([]byte, length)
+		}
+		if uint64(len(block)) > length {
+			block = block[:length]
+		}
+		h.Write(block)
+		written += uint64(len(block))
+		pos += uint64(size &^ squashFSDataUncompressedFlag)
+	}
+	if inode.Fragment != squashFSNoFragment {
+		fragment, err := fs.fragment(inode.Fragment)
+		if err != nil {
+			return nil, err
+		}
+		tail, ok := clamp(fragment, uint64(inode.FragmentStart), inode.FileSize-written)
+		if !ok {
+			return nil, fmt.Errorf("the tail is out of the fragment %d", inode.Fragment)
+		}
+		h.Write(tail)
+		written += uint64(len(tail))
+	}
+	if written != inode.FileSize {
+		return nil, fmt.Errorf("read %d bytes instead of %d", written, inode.FileSize)
+	}
+	return &Entry{
+		Path:       entryPath,
+		Size:       inode.FileSize,
+		SHA256:     [sha256.Size]byte(h.Sum(nil)),
+		Attributes: attributes,
+	}, nil
+}
+
+// dataBlock returns a decompressed data block, nil for sparse blocks.
+func (fs *squashFS) dataBlock(offset uint64, size uint32) ([]byte, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	data, err := fs.slice(offset, uint64(size&^squashFSDataUncompressedFlag))
+	if err != nil {
+		return nil, err
+	}
+	if size&squashFSDataUncompressedFlag != 0 {
+		return data, nil
+	}
+	data, err = fs.decompress(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress the data block at 0x%X: %w", offset, err)
+	}
+	return data, nil
+}
+
+// fragment returns the decompressed fragment block with the index.
+func (fs *squashFS) fragment(idx uint32) ([]byte, error) {
+	if block, ok := fs.fragments[idx]; ok {
+		return block, nil
+	}
+	if idx >= fs.superblock.FragmentCount {
+		return nil, fmt.Errorf("fragment %d is out of range", idx)
+	}
+	const entrySize = 16
+	const entriesPerBlock = squashFSMetadataBlockSize / entrySize
+	pointer, err := fs.slice(fs.superblock.FragmentTable+uint64(idx/entriesPerBlock)*8, 8)
+	if err != nil {
+		return nil, err
+	}
+	r := fs.metadataReader(binary.LittleEndian.Uint64(pointer), uint64(idx%entriesPerBlock)*entrySize)
+	var start uint64
+	var size, unused uint32
+	if err := readFields(r, &start, &size, &unused); err != nil {
+		return nil, err
+	}
+	block, err := fs.dataBlock(start, size)
+	if err != nil {
+		return nil, err
+	}
+	fs.fragments[idx] = block
+	return block, nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagecontainer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"sort"
+)
+
+const (
+	ubiECHeaderMagic  = "UBI#"
+	ubiVIDHeaderMagic = "UBI!"
+	ubiHeaderSize     = 64
+
+	ubiMinPEBSize = 4 << 10
+	ubiMaxPEBSize = 2 << 20
+
+	ubiLayoutVolumeID     = 0x7FFFEFFF
+	ubiVolumeTableRecSize = 172
+	ubiMaxVolumes         = 128
+	ubiStaticVolume       = 2
+)
+
+// ubiECHeader is the erase counter header of a UBI physical eraseblock (see "ubi-media.h" of Linux).
+type ubiECHeader struct {
+	Magic        [4]byte
+	Version      uint8
+	_            [3]byte
+	EraseCounter uint64
+	VIDHdrOffset uint32
+	DataOffset   uint32
+	ImageSeq     uint32
+	_            [32]byte
+	HeaderCRC    uint32
+}
+
+// ubiVIDHeader is the volume identifier header of a UBI physical eraseblock.
+type ubiVIDHeader struct {
+	Magic      [4]byte
+	Version    uint8
+	VolumeType uint8
+	CopyFlag   uint8
+	Compat     uint8
+	VolumeID   uint32
+	LNum       uint32
+	_          [4]byte
+	DataSize   uint32
+	UsedEBs    uint32
+	DataPad    uint32
+	DataCRC    uint32
+	_          [4]byte
+	SeqNum     uint64
+	_          [12]byte
+	HeaderCRC  uint32
+}
+
+type ubiLEB struct {
+	SeqNum uint64
+	Data   []byte
+}
+
+// ubiCRC is crc32 as used by UBI (initial value 0xFFFFFFFF, no final inversion)
+func ubiCRC(b []byte) uint32 {
+	return ^crc32.ChecksumIEEE(b)
+}
+
+func parseUBIECHeader(image []byte, offset uint64) (*ubiECHeader, bool) {
+	b, ok := clamp(image, offset, ubiHeaderSize)
+	if !ok || string(b[:4]) != ubiECHeaderMagic {
+		return nil, false
+	}
+	var header ubiECHeader
+	if err := binary.Read(bytes.NewReader(b), binary.BigEndian, &header); err != nil {
+		return nil, false
+	}
+	if ubiCRC(b[:ubiHeaderSize-4]) != header.HeaderCRC {
+		return nil, false
+	}
+	return &header, true
+}
+
+// parseUBI splits a UBI image into volumes. Each volume is an entry, the
+// content of a volume is assembled from its logical eraseblocks (so the
+// mapping to physical eraseblocks and the erase counters do not matter).
+func parseUBI(image []byte, offset uint64) (*Container, bool) {
+	header, ok := parseUBIECHeader(image, offset)
+	if !ok {
+		return nil, false
+	}
+	var pebSize uint64
+	for candidate := uint64(ubiMinPEBSize); candidate <= ubiMaxPEBSize; candidate *= 2 {
+		if _, ok := parseUBIECHeader(image, offset+candidate); ok {
+			pebSize = candidate
+			break
+		}
+	}
+	if pebSize == 0 || uint64(header.DataOffset) >= pebSize || uint64(header.VIDHdrOffset)+ubiHeaderSize > pebSize {
+		return nil, false
+	}
+
+	container := &Container{
+		Format: FormatUBI,
+		Offset: offset,
+	}
+	volumes := map[uint32]map[uint32]ubiLEB{}
+	pebOffset := offset
+	for ; pebOffset+pebSize <= uint64(len(image)); pebOffset += pebSize {
+		ecHeader, ok := parseUBIECHeader(image, pebOffset)
+		if !ok {
+			break
+		}
+		peb := image[pebOffset : pebOffset+pebSize]
+		if uint64(ecHeader.VIDHdrOffset)+ubiHeaderSize > pebSize {
+			container.ParseError = fmt.Errorf("invalid VID header offset of the eraseblock at 0x%X", pebOffset)
+			continue
+		}
+		vidBytes := peb[ecHeader.VIDHdrOffset : ecHeader.VIDHdrOffset+ubiHeaderSize]
+		if string(vidBytes[:4]) != ubiVIDHeaderMagic {
+			// a free eraseblock
+			continue
+		}
+		var vid ubiVIDHeader
+		if err := binary.Read(bytes.NewReader(vidBytes), binary.BigEndian, &vid); err != nil || ubiCRC(vidBytes[:ubiHeaderSize-4]) != vid.HeaderCRC {
+			container.ParseError = fmt.Errorf("corrupted VID header of the eraseblock at 0x%X", pebOffset)
+			continue
+		}
+		if uint64(ecHeader.DataOffset)+uint64(vid.DataPad) > pebSize {
+			container.ParseError = fmt.Errorf("invalid data offset of the eraseblock at 0x%X", pebOffset)
+			continue
+		}
+		data := peb[ecHeader.DataOffset : pebSize-uint64(vid.DataPad)]
+		if vid.VolumeType == ubiStaticVolume {
+			if uint64(vid.DataSize) <= uint64(len(data)) {
+				data = data[:vid.DataSize]
+			}
+		}
+		lebs := volumes[vid.VolumeID]
+		if lebs == nil {
+			lebs = map[uint32]ubiLEB{}
+			volumes[vid.VolumeID] = lebs
+		}
+		if prev, ok := lebs[vid.LNum]; ok && prev.SeqNum > vid.SeqNum {
+			// an older copy of the logical eraseblock (left after wear-leveling)
+			continue
+		}
+		lebs[vid.LNum] = ubiLEB{SeqNum: vid.SeqNum, Data: data}
+	}
+	container.Size = pebOffset - offset
+
+	prefix := containerPrefix(FormatUBI, offset)
+	names := map[uint32]string{}
+	if layout, ok := volumes[ubiLayoutVolumeID][0]; ok {
+		container.Entries = append(container.Entries, newEntry(prefix+"/volume-table", layout.Data))
+		for volumeID := uint32(0); volumeID < ubiMaxVolumes; volumeID++ {
+			record, ok := clamp(layout.Data, uint64(volumeID)*ubiVolumeTableRecSize, ubiVolumeTableRecSize)
+			if !ok {
+				break
+			}
+			nameLen := binary.BigEndian.Uint16(record[14:])
+			if nameLen == 0 || nameLen > 127 {
+				continue
+			}
+			names[volumeID] = string(record[16 : 16+nameLen])
+		}
+	}
+	for volumeID, lebs := range volumes {
+		if volumeID == ubiLayoutVolumeID {
+			continue
+		}
+		name := names[volumeID]
+		if name == "" {
+			name = fmt.Sprintf("volume%d", volumeID)
+		}
+		container.Entries = append(container.Entries, ubiVolumeEntry(prefix+"/"+name, lebs))
+	}
+	return container, true
+}
+
+func ubiVolumeEntry(entryPath string, lebs map[uint32]ubiLEB) Entry {
+	lnums := make([]uint32, 0, len(lebs))
+	for lnum := range lebs {
+		lnums = append(lnums, lnum)
+	}
+	sort.Slice(lnums, func(i, j int) bool {
+		return lnums[i] < lnums[j]
+	})
+
+	h := sha256.New()
+	entry := Entry{
+		Path: entryPath,
+	}
+	for _, lnum := range lnums {
+		data := lebs[lnum].Data
+		binary.Write(h, binary.BigEndian, lnum)
+		h.Write(data)
+		entry.Size += uint64(len(data))
+	}
+	entry.SHA256 = [sha256.Size]byte(h.Sum(nil))
+	return entry
+}
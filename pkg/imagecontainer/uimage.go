@@ -0,0 +1,110 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package imagecontainer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+const (
+	uImageMagic      = 0x27051956
+	uImageHeaderSize = 64
+	uImageTypeMulti  = 4
+)
+
+// uImageHeader is the header of a legacy U-Boot image (see "image.h" of U-Boot).
+type uImageHeader struct {
+	Magic       uint32
+	HeaderCRC   uint32
+	Time        uint32
+	Size        uint32
+	LoadAddress uint32
+	EntryPoint  uint32
+	DataCRC     uint32
+	OS          uint8
+	Arch        uint8
+	Type        uint8
+	Compression uint8
+	Name        [32]byte
+}
+
+func parseUImage(image []byte, offset uint64) (*Container, bool) {
+	headerBytes, ok := clamp(image, offset, uImageHeaderSize)
+	if !ok || binary.BigEndian.Uint32(headerBytes) != uImageMagic {
+		return nil, false
+	}
+	var header uImageHeader
+	if err := binary.Read(bytes.NewReader(headerBytes), binary.BigEndian, &header); err != nil {
+		return nil, false
+	}
+	crcInput := append([]byte{}, headerBytes...)
+	binary.BigEndian.PutUint32(crcInput[4:], 0)
+	if crc32.ChecksumIEEE(crcInput) != header.HeaderCRC {
+		return nil, false
+	}
+
+	prefix := containerPrefix(FormatUImage, offset)
+	container := &Container{
+		Format: FormatUImage,
+		Offset: offset,
+		Name:   string(bytes.TrimRight(header.Name[:], "\x00")),
+		Entries: []Entry{
+			newEntry(prefix+"/header", headerBytes),
+		},
+	}
+	data, ok := clamp(image, offset+uImageHeaderSize, uint64(header.Size))
+	container.Size = uImageHeaderSize + uint64(len(data))
+	if !ok {
+		container.ParseError = ErrTruncated{Expected: uImageHeaderSize + uint64(header.Size), Actual: container.Size}
+		container.Entries = append(container.Entries, newEntry(prefix+"/data", data))
+		return container, true
+	}
+	if crc32.ChecksumIEEE(data) != header.DataCRC {
+		container.ParseError = fmt.Errorf("data CRC mismatch")
+	}
+
+	if header.Type != uImageTypeMulti {
+		container.Entries = append(container.Entries, newEntry(prefix+"/data", data))
+		return container, true
+	}
+
+	// multi-file image: a zero-terminated list of sizes followed by the images (aligned to 4 bytes)
+	var sizes []uint32
+	pos := uint64(0)
+	for {
+		if pos+4 > uint64(len(data)) {
+			container.ParseError = fmt.Errorf("invalid multi-file image: the list of sizes is not terminated")
+			container.Entries = append(container.Entries, newEntry(prefix+"/data", data))
+			return container, true
+		}
+		size := binary.BigEndian.Uint32(data[pos:])
+		pos += 4
+		if size == 0 {
+			break
+		}
+		sizes = append(sizes, size)
+	}
+	for idx, size := range sizes {
+		part, ok := clamp(data, pos, uint64(size))
+		container.Entries = append(container.Entries, newEntry(fmt.Sprintf("%s/data%d", prefix, idx), part))
+		if !ok {
+			container.ParseError = fmt.Errorf("invalid multi-file image: image #%d is out of range", idx)
+			break
+		}
+		pos = alignUp(pos+uint64(size), 4)
+	}
+	return container, true
+}
@@ -214,6 +214,9 @@ func CalculateCBnT0TACMPolicyStatus(
 		issues = append(issues, fmt.Errorf("no EventLog provided"))
 	} else {
 		for _, tpmAlg := range []tpm2.Algorithm{tpm2.AlgSHA1, tpm2.AlgSHA256} {
+			if err := ctx.Err(); err != nil {
+				return nil, issues, err
+			}
 			result, updatedACMPolicyStatus, rIssues, err := pcrbruteforcer.ReproduceEventLog(
 				ctx,
 				actualProcess,
@@ -264,6 +267,9 @@ func CalculateCBnT0TACMPolicyStatus(
 			}
 		}
 
+		if err := ctx.Err(); err != nil {
+			return nil, issues, err
+		}
 		reproduceResult, err := pcrbruteforcer.ReproduceExpectedPCR0(
 			ctx,
 			tpmInstance.CommandLog,
@@ -0,0 +1,287 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package nvram parses UEFI variable stores (AMI NVAR and EDK2 VSS) of a firmware image.
+package nvram
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"unicode/utf16"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/linuxboot/fiano/pkg/guid"
+	fianoUEFI "github.com/linuxboot/fiano/pkg/uefi"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/uefi"
+)
+
+// Format is the format of a variable store
+type Format string
+
+const (
+	// FormatNVAR is the AMI NVAR variable store format
+	FormatNVAR = Format("NVAR")
+
+	// FormatVSS is the EDK2 variable store format (including the authenticated variables one)
+	FormatVSS = Format("VSS")
+)
+
+// VariableKey identifies a variable
+type VariableKey struct {
+	GUID guid.GUID
+	Name string
+}
+
+// String implements fmt.Stringer
+func (key VariableKey) String() string {
+	return fmt.Sprintf("%s:%s", key.GUID, key.Name)
+}
+
+// Entry is a single record of a variable store. A variable may have multiple
+// entries: the current value and deleted/overwritten old values.
+type Entry struct {
+	VariableKey
+	Data []byte
+
+	// Range is the absolute range of the whole entry (including headers) in the image
+	Range pkgbytes.Range
+
+	// Active is false for deleted or overwritten values
+	Active bool
+}
+
+// Store is a parsed variable store
+type Store struct {
+	Format Format
+
+	// Range is the absolute range of the store in the image
+	Range pkgbytes.Range
+
+	// FreeSpace is the absolute range of the unused space in the store
+	FreeSpace pkgbytes.Range
+
+	Entries []Entry
+}
+
+// Variables returns the current values of all variables of the store
+func (store *Store) Variables() map[VariableKey][]byte {
+	result := map[VariableKey][]byte{}
+	for _, entry := range store.Entries {
+		if entry.Active {
+			result[entry.VariableKey] = entry.Data
+		}
+	}
+	return result
+}
+
+// Parse finds and parses all variable stores of the image.
+func Parse(image []byte) ([]*Store, error) {
+	var result []*Store
+	nvarStores, err := parseNVARStores(image)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse NVAR stores: %w", err)
+	}
+	result = append(result, nvarStores...)
+	result = append(result, parseVSSStores(image)...)
+	return result, nil
+}
+
+func parseNVARStores(image []byte) ([]*Store, error) {
+	fw, err := uefi.Parse(image, false)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := fw.GetByGUID(*fianoUEFI.NVAR)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Store
+	for _, node := range nodes {
+		file, ok := node.Firmware.(*fianoUEFI.File)
+		if !ok || file.NVarStore == nil {
+			continue
+		}
+		nvarStore := file.NVarStore
+		base := node.Offset + file.DataOffset
+		store := &Store{
+			Format: FormatNVAR,
+			Range: pkgbytes.Range{
+				Offset: base,
+				Length: nvarStore.Length,
+			},
+			FreeSpace: pkgbytes.Range{
+				Offset: base + nvarStore.FreeSpaceOffset,
+				Length: nvarStore.GUIDStoreOffset - nvarStore.FreeSpaceOffset,
+			},
+		}
+		for _, nvar := range nvarStore.Entries {
+			entry := Entry{
+				Range: pkgbytes.Range{
+					Offset: base + nvar.Offset,
+					Length: uint64(nvar.Header.Size),
+				},
+			}
+			switch nvar.Type {
+			case fianoUEFI.FullNVarEntry, fianoUEFI.DataNVarEntry, fianoUEFI.LinkNVarEntry:
+				entry.VariableKey = VariableKey{GUID: nvar.GUID, Name: nvar.Name}
+				entry.Data = nvarData(nvar)
+				// Link entries are the old values, the current value is in the end of the chain.
+				entry.Active = nvar.Type != fianoUEFI.LinkNVarEntry
+			}
+			store.Entries = append(store.Entries, entry)
+		}
+		result = append(result, store)
+	}
+	return result, nil
+}
+
+func nvarData(nvar *fianoUEFI.NVar) []byte {
+	buf := nvar.Buf()
+	end := int64(len(buf))
+	if nvar.ExtOffset > 0 && nvar.ExtOffset < end {
+		end = nvar.ExtOffset
+	}
+	if nvar.DataOffset > end {
+		return nil
+	}
+	return buf[nvar.DataOffset:end]
+}
+
+var (
+	// gEfiVariableGuid
+	vssSignature = guid.MustParse("DDCF3616-3275-4164-98B6-FE85707FFE7D")
+	// gEfiAuthenticatedVariableGuid
+	vssAuthSignature = guid.MustParse("AAF32C78-947B-439A-A180-2E144EC37792")
+)
+
+const (
+	vssStoreHeaderSize      = 28
+	vssStoreFormatted       = 0x5A
+	vssStoreHealthy         = 0xFE
+	vssVariableStartID      = 0x55AA
+	vssVariableHeaderSize   = 32
+	vssAuthVarHeaderSize    = 60
+	vssVarAdded             = 0x3F
+	vssVarInDeletedTransit  = 0xFE
+	vssVarAddedInTransition = vssVarAdded & vssVarInDeletedTransit
+)
+
+func parseVSSStores(image []byte) []*Store {
+	var result []*Store
+	for _, signature := range []*guid.GUID{vssSignature, vssAuthSignature} {
+		isAuth := signature == vssAuthSignature
+		for offset := 0; ; {
+			idx := bytes.Index(image[offset:], signature[:])
+			if idx < 0 {
+				break
+			}
+			storeOffset := offset + idx
+			offset = storeOffset + 1
+			if store := parseVSSStore(image, storeOffset, isAuth); store != nil {
+				result = append(result, store)
+				offset = int(store.Range.End())
+			}
+		}
+	}
+	return result
+}
+
+// parseVSSStore parses a VARIABLE_STORE_HEADER and the variables after it
+//
+// Returns nil if there is no valid store at the offset.
+func parseVSSStore(image []byte, storeOffset int, isAuth bool) *Store {
+	if storeOffset+vssStoreHeaderSize > len(image) {
+		return nil
+	}
+	header := image[storeOffset:]
+	size := int(binary.LittleEndian.Uint32(header[16:]))
+	format, state := header[20], header[21]
+	if format != vssStoreFormatted || state != vssStoreHealthy {
+		return nil
+	}
+	if size <= vssStoreHeaderSize || storeOffset+size > len(image) {
+		return nil
+	}
+	storeEnd := storeOffset + size
+
+	store := &Store{
+		Format: FormatVSS,
+		Range: pkgbytes.Range{
+			Offset: uint64(storeOffset),
+			Length: uint64(size),
+		},
+	}
+
+	varHeaderSize := vssVariableHeaderSize
+	if isAuth {
+		varHeaderSize = vssAuthVarHeaderSize
+	}
+	offset := storeOffset + vssStoreHeaderSize
+	for offset+varHeaderSize <= storeEnd {
+		varHeader := image[offset : offset+varHeaderSize]
+		if binary.LittleEndian.Uint16(varHeader[0:]) != vssVariableStartID {
+			break
+		}
+		varState := varHeader[2]
+		// NameSize, DataSize and VendorGuid are in the end of the header in both formats
+		nameSize := int(binary.LittleEndian.Uint32(varHeader[varHeaderSize-24:]))
+		dataSize := int(binary.LittleEndian.Uint32(varHeader[varHeaderSize-20:]))
+		var vendorGUID guid.GUID
+		copy(vendorGUID[:], varHeader[varHeaderSize-16:])
+
+		nameOffset := offset + varHeaderSize
+		dataOffset := nameOffset + nameSize
+		end := dataOffset + dataSize
+		if nameSize < 0 || dataSize < 0 || end > storeEnd || end < offset {
+			break
+		}
+		store.Entries = append(store.Entries, Entry{
+			VariableKey: VariableKey{
+				GUID: vendorGUID,
+				Name: decodeUCS2(image[nameOffset:dataOffset]),
+			},
+			Data: image[dataOffset:end],
+			Range: pkgbytes.Range{
+				Offset: uint64(offset),
+				Length: uint64(end - offset),
+			},
+			Active: varState == vssVarAdded || varState == vssVarAddedInTransition,
+		})
+		offset = alignUp(end, 4)
+	}
+	if offset < storeEnd {
+		store.FreeSpace = pkgbytes.Range{
+			Offset: uint64(offset),
+			Length: uint64(storeEnd - offset),
+		}
+	}
+	return store
+}
+
+func decodeUCS2(b []byte) string {
+	chars := make([]uint16, 0, len(b)/2)
+	for idx := 0; idx+1 < len(b); idx += 2 {
+		char := binary.LittleEndian.Uint16(b[idx:])
+		if char == 0 {
+			break
+		}
+		chars = append(chars, char)
+	}
+	return string(utf16.Decode(chars))
+}
+
+func alignUp(v, alignment int) int {
+	return (v + alignment - 1) / alignment * alignment
+}
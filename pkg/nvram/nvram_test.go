@@ -0,0 +1,90 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package nvram
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+
+	pkgbytes "github.com/linuxboot/fiano/pkg/bytes"
+	"github.com/linuxboot/fiano/pkg/guid"
+	"github.com/stretchr/testify/require"
+)
+
+type testVariable struct {
+	GUID  guid.GUID
+	Name  string
+	Data  []byte
+	State byte
+}
+
+func newTestVSSStore(size int, variables ...testVariable) []byte {
+	var buf bytes.Buffer
+	buf.Write(vssSignature[:])
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(size))
+	buf.Write([]byte{vssStoreFormatted, vssStoreHealthy, 0, 0, 0, 0, 0, 0})
+	for _, v := range variables {
+		name := utf16.Encode([]rune(v.Name + "\x00"))
+		_ = binary.Write(&buf, binary.LittleEndian, uint16(vssVariableStartID))
+		buf.Write([]byte{v.State, 0})
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(7))           // attributes
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(len(name)*2)) // name size
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(len(v.Data))) // data size
+		buf.Write(v.GUID[:])
+		_ = binary.Write(&buf, binary.LittleEndian, name)
+		buf.Write(v.Data)
+		for buf.Len()%4 != 0 {
+			buf.WriteByte(0xff)
+		}
+	}
+	for buf.Len() < size {
+		buf.WriteByte(0xff)
+	}
+	return buf.Bytes()
+}
+
+func TestParseVSS(t *testing.T) {
+	vendorGUID := *guid.MustParse("8BE4DF61-93CA-11D2-AA0D-00E098032B8C")
+	store := newTestVSSStore(0x200,
+		testVariable{GUID: vendorGUID, Name: "BootOrder", Data: []byte{1, 0}, State: vssVarAdded & 0xFD}, // deleted
+		testVariable{GUID: vendorGUID, Name: "BootOrder", Data: []byte{0, 0, 1, 0}, State: vssVarAdded},
+		testVariable{GUID: vendorGUID, Name: "Lang", Data: []byte("eng"), State: vssVarAddedInTransition},
+	)
+	image := append(bytes.Repeat([]byte{0xff}, 0x100), store...)
+
+	stores, err := Parse(image)
+	require.NoError(t, err)
+	require.Len(t, stores, 1)
+	require.Equal(t, FormatVSS, stores[0].Format)
+	require.Equal(t, pkgbytes.Range{Offset: 0x100, Length: 0x200}, stores[0].Range)
+	require.Len(t, stores[0].Entries, 3)
+	require.False(t, stores[0].Entries[0].Active)
+	require.Equal(t, uint64(0x100+vssStoreHeaderSize), stores[0].Entries[0].Range.Offset)
+	require.Equal(t, stores[0].Range.End(), stores[0].FreeSpace.End())
+	require.Equal(t, alignUp(int(stores[0].Entries[2].Range.End()), 4), int(stores[0].FreeSpace.Offset))
+
+	require.Equal(t, map[VariableKey][]byte{
+		{GUID: vendorGUID, Name: "BootOrder"}: {0, 0, 1, 0},
+		{GUID: vendorGUID, Name: "Lang"}:      []byte("eng"),
+	}, stores[0].Variables())
+}
+
+func TestParseVSSInvalidHeader(t *testing.T) {
+	store := newTestVSSStore(0x100)
+	store[20] = 0 // not formatted
+	stores, err := Parse(store)
+	require.NoError(t, err)
+	require.Empty(t, stores)
+}
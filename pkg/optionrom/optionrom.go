@@ -0,0 +1,167 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package optionrom parses PCI expansion ROMs (option ROMs) of add-in
+// cards like NICs and GPUs.
+package optionrom
+
+import (
+	"crypto"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/authenticode"
+)
+
+const (
+	romSignature  = 0xAA55
+	pcirSignature = "PCIR"
+	efiSignature  = 0x0EF1
+	blockSize     = 512
+
+	// indicatorLastImage is the bit of the PCIR "Indicator" field
+	// which marks the last image in the ROM
+	indicatorLastImage = 0x80
+)
+
+// CodeType is the type of code contained in an option ROM image.
+type CodeType uint8
+
+const (
+	CodeTypeX86          = CodeType(0)
+	CodeTypeOpenFirmware = CodeType(1)
+	CodeTypeHPPA         = CodeType(2)
+	CodeTypeEFI          = CodeType(3)
+)
+
+// String implements fmt.Stringer
+func (t CodeType) String() string {
+	switch t {
+	case CodeTypeX86:
+		return "x86"
+	case CodeTypeOpenFirmware:
+		return "OpenFirmware"
+	case CodeTypeHPPA:
+		return "HP PA RISC"
+	case CodeTypeEFI:
+		return "EFI"
+	}
+	return fmt.Sprintf("unknown_code_type_%d", uint8(t))
+}
+
+// Image is a single image of a PCI expansion ROM. A ROM may contain
+// multiple images, for example a legacy x86 one and an EFI one.
+type Image struct {
+	Offset   int
+	VendorID uint16
+	DeviceID uint16
+	Revision uint16
+	CodeType CodeType
+	Data     []byte
+
+	// Last is true if the image is marked as the last one in the ROM
+	Last bool
+
+	// EFI is set only for images of CodeTypeEFI
+	EFI *EFIImage
+}
+
+// EFIImage is the EFI-specific part of an option ROM image.
+type EFIImage struct {
+	Subsystem       uint16
+	MachineType     uint16
+	CompressionType uint16
+
+	// PE is the (possibly compressed) EFI driver as it is loaded by
+	// the firmware, including the padding up to InitializationSize.
+	PE []byte
+}
+
+// IsCompressed returns true if the EFI driver is compressed with
+// the EFI compression algorithm.
+func (img EFIImage) IsCompressed() bool {
+	return img.CompressionType != 0
+}
+
+// AuthenticodeHash returns the digest which the firmware extends to PCR2
+// when it loads the EFI driver (EV_EFI_BOOT_SERVICES_DRIVER).
+func (img EFIImage) AuthenticodeHash(hashFunc crypto.Hash) ([]byte, error) {
+	if img.IsCompressed() {
+		return nil, fmt.Errorf("compressed EFI drivers are not supported")
+	}
+	return authenticode.Hash(img.PE, hashFunc)
+}
+
+// Parse parses all images of a PCI expansion ROM.
+func Parse(rom []byte) ([]Image, error) {
+	var result []Image
+	for offset := 0; offset < len(rom); {
+		img, err := parseImage(rom, offset)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the image at offset 0x%X: %w", offset, err)
+		}
+		result = append(result, *img)
+		if img.Last {
+			break
+		}
+		offset += len(img.Data)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no images found")
+	}
+	return result, nil
+}
+
+func parseImage(rom []byte, offset int) (*Image, error) {
+	data := rom[offset:]
+	if len(data) < 0x1A || binary.LittleEndian.Uint16(data) != romSignature {
+		return nil, fmt.Errorf("no ROM signature")
+	}
+	pcirOffset := int(binary.LittleEndian.Uint16(data[0x18:]))
+	if pcirOffset+0x18 > len(data) || string(data[pcirOffset:pcirOffset+4]) != pcirSignature {
+		return nil, fmt.Errorf("no PCI data structure")
+	}
+	pcir := data[pcirOffset:]
+	imageLength := int(binary.LittleEndian.Uint16(pcir[0x10:])) * blockSize
+	if imageLength == 0 || imageLength > len(data) {
+		return nil, fmt.Errorf("invalid image length %d", imageLength)
+	}
+
+	img := &Image{
+		Offset:   offset,
+		VendorID: binary.LittleEndian.Uint16(pcir[0x04:]),
+		DeviceID: binary.LittleEndian.Uint16(pcir[0x06:]),
+		Revision: binary.LittleEndian.Uint16(pcir[0x12:]),
+		CodeType: CodeType(pcir[0x14]),
+		Data:     data[:imageLength],
+		Last:     pcir[0x15]&indicatorLastImage != 0,
+	}
+	if img.CodeType != CodeTypeEFI {
+		return img, nil
+	}
+
+	if binary.LittleEndian.Uint32(data[0x04:]) != efiSignature {
+		return nil, fmt.Errorf("no EFI signature")
+	}
+	initializationSize := int(binary.LittleEndian.Uint16(data[0x02:])) * blockSize
+	peOffset := int(binary.LittleEndian.Uint16(data[0x16:]))
+	if initializationSize > imageLength || peOffset >= initializationSize {
+		return nil, fmt.Errorf("invalid EFI image bounds: [0x%X:0x%X]", peOffset, initializationSize)
+	}
+	img.EFI = &EFIImage{
+		Subsystem:       binary.LittleEndian.Uint16(data[0x08:]),
+		MachineType:     binary.LittleEndian.Uint16(data[0x0A:]),
+		CompressionType: binary.LittleEndian.Uint16(data[0x0C:]),
+		PE:              data[peOffset:initializationSize],
+	}
+	return img, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package optionrom
+
+import (
+	"crypto"
+	_ "crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testROMImage builds an option ROM image of the specified code type with
+// the PCI data structure at offset 0x1C and the payload at offset 0x40.
+func testROMImage(codeType CodeType, payload []byte, last bool) []byte {
+	size := (0x40 + len(payload) + blockSize - 1) / blockSize * blockSize
+	image := make([]byte, size)
+	binary.LittleEndian.PutUint16(image, romSignature)
+	binary.LittleEndian.PutUint16(image[0x18:], 0x1C)
+	pcir := image[0x1C:]
+	copy(pcir, pcirSignature)
+	binary.LittleEndian.PutUint16(pcir[0x04:], 0x8086)
+	binary.LittleEndian.PutUint16(pcir[0x06:], 0x1572)
+	binary.LittleEndian.PutUint16(pcir[0x10:], uint16(size/blockSize))
+	pcir[0x14] = byte(codeType)
+	if last {
+		pcir[0x15] = indicatorLastImage
+	}
+	if codeType == CodeTypeEFI {
+		binary.LittleEndian.PutUint16(image[0x02:], uint16(size/blockSize))
+		binary.LittleEndian.PutUint32(image[0x04:], efiSignature)
+		binary.LittleEndian.PutUint16(image[0x16:], 0x40)
+	}
+	copy(image[0x40:], payload)
+	return image
+}
+
+func TestParse(t *testing.T) {
+	legacy := testROMImage(CodeTypeX86, []byte{0xCB}, false)
+	efi := testROMImage(CodeTypeEFI, []byte("MZ"), true)
+	rom := append(append(append([]byte{}, legacy...), efi...), 0xFF, 0xFF)
+
+	images, err := Parse(rom)
+	require.NoError(t, err)
+	require.Len(t, images, 2)
+
+	require.Equal(t, CodeTypeX86, images[0].CodeType)
+	require.Nil(t, images[0].EFI)
+	require.False(t, images[0].Last)
+
+	require.Equal(t, CodeTypeEFI, images[1].CodeType)
+	require.Equal(t, len(legacy), images[1].Offset)
+	require.Equal(t, uint16(0x8086), images[1].VendorID)
+	require.Equal(t, uint16(0x1572), images[1].DeviceID)
+	require.True(t, images[1].Last)
+	require.NotNil(t, images[1].EFI)
+	require.False(t, images[1].EFI.IsCompressed())
+	require.Equal(t, len(efi)-0x40, len(images[1].EFI.PE))
+	require.Equal(t, "MZ", string(images[1].EFI.PE[:2]))
+
+	// the payload is not a valid PE image
+	_, err = images[1].EFI.AuthenticodeHash(crypto.SHA256)
+	require.Error(t, err)
+
+	_, err = Parse([]byte{0xFF, 0xFF})
+	require.Error(t, err)
+}
@@ -12,6 +12,7 @@
 package pcr0eventlog
 
 import (
+	"github.com/9elements/converged-security-suite/v2/pkg/pcr"
 	tpmeventlog "github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
 	"github.com/facebookincubator/go-belt/tool/logger"
 )
@@ -26,9 +27,31 @@ const (
 // CheckTPMEventLog checks the TPM Event Log
 func CheckTPMEventLog(eventLog *tpmeventlog.TPMEventLog, logger logger.Logger) {
 	eventLogSizePCR0 := uint(0)
-	var filteredEventsPCR0 []*tpmeventlog.Event
+	filteredEventsPCR0 := FilterPCR(eventLog, 0, logger)
+	for _, event := range filteredEventsPCR0 {
+		// minimalSerializedEventInScuba is the minimal representation of an EventLog entry in the Scuba field.
+		// EventLog is being serialized to JSON (see also package scubareport).
+		minimalSerializedEventInScuba := `{"Index":0,"Type":0,"Digest":{"PCRBank":0,"Digest":""},"Data":""}`
+
+		size := uint(len(event.Digest.Digest)) + uint(len(event.Data)) + uint(len(minimalSerializedEventInScuba))
+		eventLogSizePCR0 += size
+	}
+	if eventLogSizePCR0 < maxSizeEventData {
+		eventLog.Events = filteredEventsPCR0
+	} else {
+		logger.Errorf("EventLog is too large (size:%d)", eventLogSizePCR0)
+		eventLog.Events = nil
+	}
+
+	logger.Debugf("filtered EventLog is %v", *eventLog)
+}
+
+// FilterPCR returns the events of the specified PCR, which have
+// a digest of a known hash algorithm and of the correct size.
+func FilterPCR(eventLog *tpmeventlog.TPMEventLog, pcrIndex pcr.ID, logger logger.Logger) []*tpmeventlog.Event {
+	var result []*tpmeventlog.Event
 	for _, event := range eventLog.Events {
-		if event.PCRIndex != 0 {
+		if event.PCRIndex != pcrIndex {
 			continue
 		}
 		if event.Digest == nil {
@@ -50,21 +73,7 @@ func CheckTPMEventLog(eventLog *tpmeventlog.TPMEventLog, logger logger.Logger) {
 			continue
 		}
 
-		// minimalSerializedEventInScuba is the minimal representation of an EventLog entry in the Scuba field.
-		// EventLog is being serialized to JSON (see also package scubareport).
-		minimalSerializedEventInScuba := `{"Index":0,"Type":0,"Digest":{"PCRBank":0,"Digest":""},"Data":""}`
-
-		size := uint(len(event.Digest.Digest)) + uint(len(event.Data)) + uint(len(minimalSerializedEventInScuba))
-
-		filteredEventsPCR0 = append(filteredEventsPCR0, event)
-		eventLogSizePCR0 += size
+		result = append(result, event)
 	}
-	if eventLogSizePCR0 < maxSizeEventData {
-		eventLog.Events = filteredEventsPCR0
-	} else {
-		logger.Errorf("EventLog is too large (size:%d)", eventLogSizePCR0)
-		eventLog.Events = nil
-	}
-
-	logger.Debugf("filtered EventLog is %v", *eventLog)
+	return result
 }
@@ -0,0 +1,145 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package registers
+
+import (
+	"fmt"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+// PSBStatus is the Platform Secure Boot fuse state of an AMD CPU as it is
+// reported by PSP in MP0_C2P_MSG_37 register.
+type PSBStatus struct {
+	PlatformVendorID           uint8
+	PlatformModelID            uint8
+	BIOSKeyRevisionID          uint8
+	RootKeySelect              uint8
+	PlatformSecureBootEnabled  bool
+	DisableBIOSKeyAntiRollback bool
+	DisableAMDBIOSKeyUse       bool
+	DisableSecureDebugUnlock   bool
+	CustomerKeyLock            bool
+}
+
+// ParsePSBStatus decodes fuse fields of MP0_C2P_MSG_37 register:
+//
+//	[7:0]   Platform vendor ID
+//	[11:8]  Platform model ID
+//	[15:12] BIOS key revision ID
+//	[19:16] Root key select
+//	[24]    Platform secure boot enable
+//	[25]    Disable BIOS key anti-rollback
+//	[26]    Disable AMD BIOS key use
+//	[27]    Disable secure debug unlock
+//	[28]    Customer key lock
+func ParsePSBStatus(reg registers.MP0C2PMsg37) PSBStatus {
+	raw := reg.Raw()
+	return PSBStatus{
+		PlatformVendorID:           uint8(raw),
+		PlatformModelID:            uint8(raw>>8) & 0xf,
+		BIOSKeyRevisionID:          uint8(raw>>12) & 0xf,
+		RootKeySelect:              uint8(raw>>16) & 0xf,
+		PlatformSecureBootEnabled:  reg.IsPlatformSecureBootEnabled(),
+		DisableBIOSKeyAntiRollback: (raw>>25)&1 == 1,
+		DisableAMDBIOSKeyUse:       (raw>>26)&1 == 1,
+		DisableSecureDebugUnlock:   (raw>>27)&1 == 1,
+		CustomerKeyLock:            (raw>>28)&1 == 1,
+	}
+}
+
+// FindPSBStatus returns the decoded PSB fuse state if MP0_C2P_MSG_37 is present in regs
+func FindPSBStatus(regs registers.Registers) (PSBStatus, bool) {
+	reg, found := registers.FindMP0C2PMsg37(regs)
+	if !found {
+		return PSBStatus{}, false
+	}
+	return ParsePSBStatus(reg), true
+}
+
+// PSBTestStatus is the result of the Platform Secure Boot check performed by PSP during the boot
+type PSBTestStatus uint8
+
+// See "PSB test status" in MP0_C2P_MSG_38 register
+const (
+	PSBTestStatusPass                  PSBTestStatus = 0x00
+	PSBTestStatusFuseReadError         PSBTestStatus = 0x3E
+	PSBTestStatusBIOSKeyBadUsage       PSBTestStatus = 0x81
+	PSBTestStatusBIOSRTMSignatureNoEnt PSBTestStatus = 0x82
+	PSBTestStatusBIOSRTMCopyError      PSBTestStatus = 0x83
+	PSBTestStatusBIOSRTMBadSignature   PSBTestStatus = 0x84
+	PSBTestStatusOEMKeyInvalid         PSBTestStatus = 0x85
+	PSBTestStatusPlatformBadID         PSBTestStatus = 0x86
+	PSBTestStatusBIOSCopyBitUnset      PSBTestStatus = 0x87
+	PSBTestStatusBIOSCABadSignature    PSBTestStatus = 0x8A
+	PSBTestStatusBIOSCABadUsage        PSBTestStatus = 0x8B
+	PSBTestStatusBIOSKeyBadRevision    PSBTestStatus = 0x8C
+)
+
+// String implements fmt.Stringer
+func (s PSBTestStatus) String() string {
+	switch s {
+	case PSBTestStatusPass:
+		return "PASS"
+	case PSBTestStatusFuseReadError:
+		return "FUSE_READ_ERROR"
+	case PSBTestStatusBIOSKeyBadUsage:
+		return "BIOS_KEY_BAD_USAGE"
+	case PSBTestStatusBIOSRTMSignatureNoEnt:
+		return "BIOS_RTM_SIG_NOENT"
+	case PSBTestStatusBIOSRTMCopyError:
+		return "BIOS_RTM_COPY_ERROR"
+	case PSBTestStatusBIOSRTMBadSignature:
+		return "BIOS_RTM_BAD_SIG"
+	case PSBTestStatusOEMKeyInvalid:
+		return "OEM_KEY_INVALID"
+	case PSBTestStatusPlatformBadID:
+		return "PLATFORM_BAD_ID"
+	case PSBTestStatusBIOSCopyBitUnset:
+		return "BIOS_COPY_BIT_UNSET"
+	case PSBTestStatusBIOSCABadSignature:
+		return "BIOS_CA_BAD_SIG"
+	case PSBTestStatusBIOSCABadUsage:
+		return "BIOS_CA_BAD_USAGE"
+	case PSBTestStatusBIOSKeyBadRevision:
+		return "BIOS_KEY_BAD_REVISION"
+	}
+	return fmt.Sprintf("UNKNOWN_0x%02X", uint8(s))
+}
+
+// PSBHSTIStatus is the Platform Secure Boot state reported by PSP in MP0_C2P_MSG_38 register
+type PSBHSTIStatus struct {
+	TestStatus  PSBTestStatus
+	FusingReady bool
+}
+
+// ParsePSBHSTIStatus decodes fields of MP0_C2P_MSG_38 register:
+//
+//	[7:0] PSB test status
+//	[8]   PSB fusing readiness
+func ParsePSBHSTIStatus(reg registers.MP0C2PMsg38) PSBHSTIStatus {
+	raw := reg.Raw()
+	return PSBHSTIStatus{
+		TestStatus:  PSBTestStatus(raw),
+		FusingReady: (raw>>8)&1 == 1,
+	}
+}
+
+// FindPSBHSTIStatus returns the decoded PSB status if MP0_C2P_MSG_38 is present in regs
+func FindPSBHSTIStatus(regs registers.Registers) (PSBHSTIStatus, bool) {
+	reg, found := registers.FindMP0C2PMsg38(regs)
+	if !found {
+		return PSBHSTIStatus{}, false
+	}
+	return ParsePSBHSTIStatus(reg), true
+}
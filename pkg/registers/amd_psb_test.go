@@ -0,0 +1,57 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package registers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/registers"
+)
+
+func TestFindPSBStatus(t *testing.T) {
+	regs := registers.Registers{
+		registers.ParseMP0C2PMsg37Register(0x1100008D),
+		registers.ParseMP0C2PMsg38Register(0x0000018C),
+	}
+
+	status, found := FindPSBStatus(regs)
+	require.True(t, found)
+	require.Equal(t, uint8(0x8D), status.PlatformVendorID)
+	require.True(t, status.PlatformSecureBootEnabled)
+	require.True(t, status.CustomerKeyLock)
+	require.False(t, status.DisableBIOSKeyAntiRollback)
+
+	hstiStatus, found := FindPSBHSTIStatus(regs)
+	require.True(t, found)
+	require.Equal(t, PSBTestStatusBIOSKeyBadRevision, hstiStatus.TestStatus)
+	require.True(t, hstiStatus.FusingReady)
+
+	_, found = FindPSBStatus(nil)
+	require.False(t, found)
+}
+
+func TestParseDMIDecodeKeyHash(t *testing.T) {
+	keyHash, err := parseDMIDecodeKeyHash("0x" + strings.Repeat("ab", 32))
+	require.NoError(t, err)
+	require.Len(t, keyHash, 32)
+	require.Equal(t, uint8(0xAB), keyHash[0])
+
+	_, err = parseDMIDecodeKeyHash("0xnothex")
+	require.Error(t, err)
+
+	_, err = parseDMIDecodeKeyHash("0xabcd")
+	require.Error(t, err)
+}
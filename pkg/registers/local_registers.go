@@ -12,6 +12,8 @@
 package registers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -26,9 +28,10 @@ import (
 )
 
 const (
-	odmSMBIOSType    = 11
-	mpoC2PMSG37Index = 13
-	mpoC2PMSG38Index = 14
+	odmSMBIOSType        = 11
+	mpoC2PMSG37Index     = 13
+	mpoC2PMSG38Index     = 14
+	psbFusedKeyHashIndex = 15
 )
 
 func amdLocalRegisters() (registers.Registers, error) {
@@ -51,17 +54,10 @@ func amdLocalRegisters() (registers.Registers, error) {
 	// String 14: 0x1100008D # MP0_C2P_MSG_37
 	// String 15: 0x50000000 # MP0_C2P_MSG_38
 
-	localDMI, err := dmidecode.LocalDMITable()
+	odmSMBIOS, err := localODMSMBIOS()
 	if err != nil {
 		return nil, err
 	}
-
-	var odmSMBIOS *smbios.Structure
-	for _, smbios := range localDMI.SMBIOSStructs {
-		if smbios.Header.Type == odmSMBIOSType {
-			odmSMBIOS = smbios
-		}
-	}
 	if odmSMBIOS == nil {
 		return nil, nil
 	}
@@ -85,6 +81,44 @@ func amdLocalRegisters() (registers.Registers, error) {
 	return result, nil
 }
 
+// LocalPSBFusedKeyHash returns the SHA256 hash of the OEM root key fused into the local AMD CPU.
+//
+// PSP does not expose the hash through a register, so it is provided only by some BIOSes
+// in the OEM string 16 (after the MP0_C2P_MSG_37 and MP0_C2P_MSG_38 values, see amdLocalRegisters):
+//
+//	String 16: 0x<64 hex digits>
+//
+// Returns nil if the hash is not provided.
+func LocalPSBFusedKeyHash() ([]byte, error) {
+	if cpuid.CPU.VendorID != cpuid.AMD {
+		return nil, nil
+	}
+	odmSMBIOS, err := localODMSMBIOS()
+	if err != nil {
+		return nil, err
+	}
+	// the other BIOSes leave the default OEM string
+	if odmSMBIOS == nil || len(odmSMBIOS.Strings) <= psbFusedKeyHashIndex || !strings.HasPrefix(odmSMBIOS.Strings[psbFusedKeyHashIndex], "0x") {
+		return nil, nil
+	}
+	return parseDMIDecodeKeyHash(odmSMBIOS.Strings[psbFusedKeyHashIndex])
+}
+
+func localODMSMBIOS() (*smbios.Structure, error) {
+	localDMI, err := dmidecode.LocalDMITable()
+	if err != nil {
+		return nil, err
+	}
+
+	var odmSMBIOS *smbios.Structure
+	for _, smbios := range localDMI.SMBIOSStructs {
+		if smbios.Header.Type == odmSMBIOSType {
+			odmSMBIOS = smbios
+		}
+	}
+	return odmSMBIOS, nil
+}
+
 func intelLocalRegisters() (registers.Registers, error) {
 	txtAPI := hwapi.GetAPI()
 
@@ -123,6 +157,17 @@ func parseDMIDecodeRegisterValue(value string) (uint32, error) {
 	return uint32(v), nil
 }
 
+func parseDMIDecodeKeyHash(value string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PSB fused key hash '%s' from SMBIOS: %w", value, err)
+	}
+	if len(b) != sha256.Size {
+		return nil, fmt.Errorf("invalid length of PSB fused key hash '%s' from SMBIOS: %d != %d", value, len(b), sha256.Size)
+	}
+	return b, nil
+}
+
 // LocalRegisters dumps status registers of the local machine.
 func LocalRegisters() (registers.Registers, error) {
 	if cpuid.CPU.VendorID == cpuid.AMD {
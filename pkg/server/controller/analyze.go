@@ -25,25 +25,44 @@ import (
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 	"github.com/immune-gmbh/attestation-sdk/if/typeconv"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
-	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
 	"github.com/immune-gmbh/attestation-sdk/pkg/server/controller/analyzerinput"
 	controllererrors "github.com/immune-gmbh/attestation-sdk/pkg/server/controller/errors"
 	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
+	"github.com/immune-gmbh/attestation-sdk/pkg/verdict"
 )
 
-// Analyze provides firmware analysis by specified algorithms
+// Analyze provides firmware analysis by specified algorithms.
+//
+// The execution profile (see analysis.Profile) is always stored with the report,
+// but is returned only if withProfile is true. The input dependency graphs
+// of the analyzers (see analysis.DependencyGraph) are not stored and are
+// returned only if withDependencyGraphs is true.
 func (ctrl *Controller) Analyze(
 	ctx context.Context,
 	hostInfo *afas.HostInfo,
 	artifacts []afas.Artifact,
 	analyzers []afas.AnalyzerInput,
+	withProfile bool,
+	withDependencyGraphs bool,
 ) (*afas.AnalyzeResult_, error) {
 	jobID := types.NewJobID()
 	ctx = beltctx.WithField(ctx, "jobID", jobID)
@@ -67,7 +86,14 @@ func (ctrl *Controller) Analyze(
 		}
 	}()
 
-	return typeconv.ToThriftAnalyzeReport(report), nil
+	result := typeconv.ToThriftAnalyzeReport(report)
+	if withProfile && report.Profile != nil {
+		result.Profile = typeconv.ToThriftAnalyzeProfile(report.Profile)
+	}
+	if withDependencyGraphs {
+		ctrl.addDependencyGraphs(ctx, report.AnalyzerReports, result.Results)
+	}
+	return result, nil
 }
 
 func (ctrl *Controller) getAnalyzeReport(
@@ -86,7 +112,9 @@ func (ctrl *Controller) getAnalyzeReport(
 		Timestamp:       time.Now(),
 		JobID:           jobID,
 		AnalyzerReports: make([]models.AnalyzerReport, len(analyzerInputs)),
+		Profile:         analysis.NewProfile(),
 	}
+	ctx = analysis.WithProfile(ctx, report.Profile)
 	if hostInfo != nil {
 		report.AssetID = hostInfo.AssetID
 	}
@@ -102,114 +130,310 @@ func (ctrl *Controller) getAnalyzeReport(
 		return nil, fmt.Errorf("failed to create artifacts accessor: %w", err)
 	}
 
-	// scopeCache helps to share all calculated results between all analyzers without putting restrictions of consuming identical set of artifacts
-	scopeCache := analysis.NewDataCache()
-	var (
-		wg          sync.WaitGroup
-		resultMutex sync.Mutex
-	)
+	requestedJobs := make([]*analyzerJob, len(analyzerInputs))
+	var wg sync.WaitGroup
 	for idx, analyzerThriftInput := range analyzerInputs {
 		wg.Add(1)
 		go func(idx int, analyzerThriftInput afas.AnalyzerInput) {
 			defer wg.Done()
+			requestedJobs[idx] = ctrl.newAnalyzerJob(ctx, artifactsAccessor, hostInfo, analyzerThriftInput)
+		}(idx, analyzerThriftInput)
+	}
+	wg.Wait()
 
-			var (
-				analyzerInput  analysis.Input
-				inputErr       error
-				analyzerID     analysis.AnalyzerID
-				analyzerReport *analysis.Report
-				analyzerErr    error
-			)
-
-			// TODO: Generalize input data conversion, do not require to list each input type in package `controller`.
-			//       The set of analyzers should be injected, not hardcoded. The implementation of `controller` should
-			//       be agnostic of specific analyzer implementations.
-			switch {
-			case analyzerThriftInput.IsSetDiffMeasuredBoot():
-				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", diffmeasuredboot.ID))
-				defer span.Finish()
-				analyzerInput, inputErr = analyzerinput.NewDiffMeasuredBootInput(ctx, artifactsAccessor, *analyzerThriftInput.GetDiffMeasuredBoot())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[diffmeasuredboot.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, diffmeasuredboot.ID)
-			case analyzerThriftInput.IsSetReproducePCR():
-				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", reproducepcr.ID))
-				defer span.Finish()
-				analyzerInput, inputErr = analyzerinput.NewReproducePCRInput(ctx, artifactsAccessor, *analyzerThriftInput.GetReproducePCR())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[reproducepcr.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, reproducepcr.ID)
-			case analyzerThriftInput.IsSetIntelACM():
-				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", intelacm.ID))
-				defer span.Finish()
-				analyzerInput, inputErr = analyzerinput.NewIntelACMInput(ctx, artifactsAccessor, *analyzerThriftInput.GetIntelACM())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[intelacm.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, intelacm.ID)
-			case analyzerThriftInput.IsSetPSPSignature():
-				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", pspsignature.ID))
-				defer span.Finish()
-				analyzerInput, inputErr = analyzerinput.NewPSPSignatureInput(ctx, artifactsAccessor, *analyzerThriftInput.GetPSPSignature())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[pspsignature.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, pspsignature.ID)
-			case analyzerThriftInput.IsSetBIOSRTMVolume():
-				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", biosrtmvolume.ID))
-				defer span.Finish()
-				analyzerInput, inputErr = analyzerinput.NewBIOSRTMVolumeInput(ctx, artifactsAccessor, *analyzerThriftInput.GetBIOSRTMVolume())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[biosrtmvolume.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, biosrtmvolume.ID)
-			case analyzerThriftInput.IsSetAPCBSecurityTokens():
-				span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", apcbsectokens.ID))
-				defer span.Finish()
-				analyzerInput, inputErr = analyzerinput.NewAPCBSecurityTokensInput(ctx, artifactsAccessor, *analyzerThriftInput.GetAPCBSecurityTokens())
-				analyzerID, analyzerReport, analyzerErr = executeAnalyzer[apcbsectokens.Input](ctx, ctrl, hostInfo, scopeCache, analyzerInput, apcbsectokens.ID)
-			default:
-				log.Errorf("Not supported analyzer: %s", &analyzerThriftInput)
-				resultMutex.Lock()
-				report.AnalyzerReports[idx] = models.AnalyzerReport{
-					ExecError: models.SQLErrorWrapper{Err: controllererrors.ErrUnknownAnalyzer{AnalyzerInput: analyzerThriftInput}},
+	// Analyzers may consume reports of other analyzers (see analysis.AnalyzerReport),
+	// so the prerequisites are executed first and their reports are provided to
+	// the dependent analyzers. Reports of not requested prerequisites are not
+	// included into the result.
+	reportProvider, _ := ctrl.analysisDataCalculator.(analysis.AnalyzerReportProvider)
+	var prerequisitesOf func(analysis.AnalyzerID) []analysis.AnalyzerID
+	if reportProvider != nil {
+		prerequisitesOf = reportProvider.AnalyzerPrerequisites
+	}
+	jobs, prerequisites := scheduleAnalyzerJobs(ctx, requestedJobs, prerequisitesOf, newPrerequisiteJob)
+	stages := analyzerExecutionStages(prerequisites)
+	analyzerReports := make([]models.AnalyzerReport, len(jobs))
+
+	// scopeCache helps to share all calculated results between all analyzers without putting restrictions of consuming identical set of artifacts
+	scopeCache := analysis.NewDataCache()
+	var resultMutex sync.Mutex
+	for _, stage := range stages {
+		for _, idx := range stage {
+			wg.Add(1)
+			go func(idx int) {
+				defer wg.Done()
+				job := jobs[idx]
+				if reportProvider != nil && job.input != nil && job.inputErr == nil {
+					resultMutex.Lock()
+					addPrerequisiteReports(ctx, job.input, reportProvider, analyzerReports, prerequisites[idx])
+					resultMutex.Unlock()
 				}
+				analyzerReport := ctrl.runAnalyzerJob(ctx, hostInfo, scopeCache, job)
+
+				// Lock isn't really needed, because each goroutine assigns only its own item and
+				// items of previous stages are read only after wg.Wait(), but just for semantic
+				// cleanness keeping it.
+				resultMutex.Lock()
+				analyzerReports[idx] = analyzerReport
 				resultMutex.Unlock()
-				return
-			}
-
-			if inputErr != nil {
-				log.Errorf("Failed to construct input for analyzer: '%s': '%v'", analyzerID, inputErr)
-				analyzerErr = controllererrors.ErrInvalidInput{Err: inputErr}
-			}
-			resultMutex.Lock()
-			// Lock isn't really needed, because we assign values by aligned words and there could
-			// not be any problem with concurrency, but just for semantic cleanness keeping them.
-			report.AnalyzerReports[idx] = models.AnalyzerReport{
-				AnalyzerID: analyzerID,
-				Input:      analyzerInput,
-				Report:     analyzerReport,
-				ExecError:  models.SQLErrorWrapper{Err: analyzerErr},
-			}
-			resultMutex.Unlock()
-		}(idx, analyzerThriftInput)
+			}(idx)
+		}
+		wg.Wait()
 	}
-	wg.Wait()
+	copy(report.AnalyzerReports, analyzerReports)
 
+	report.Verdict = ctrl.hostVerdict(ctx, report.AnalyzerReports)
 	return report, nil
 }
 
-func executeAnalyzer[analyzerInputType any](
+// hostVerdict combines the reports of all analyzers into a verdict about the host, see verdict.Policy.
+func (ctrl *Controller) hostVerdict(ctx context.Context, analyzerReports []models.AnalyzerReport) *verdict.Verdict {
+	span, _ := tracer.StartChildSpanFromCtx(ctx, "hostVerdict")
+	defer span.Finish()
+
+	outcomes := make([]verdict.AnalyzerOutcome, 0, len(analyzerReports))
+	for _, analyzerReport := range analyzerReports {
+		outcomes = append(outcomes, verdict.AnalyzerOutcome{
+			AnalyzerID: analyzerReport.AnalyzerID,
+			Report:     analyzerReport.Report,
+			Err:        analyzerReport.ExecError.Err,
+		})
+	}
+	result := ctrl.verdictPolicy.Evaluate(outcomes)
+	logger.FromCtx(ctx).Infof("host verdict: %s (confidence: %.2f, evidence: %d)", result.Class, result.Confidence, len(result.Evidence))
+	return &result
+}
+
+// analyzerExecutor executes the analyzer, see executeAnalyzer.
+type analyzerExecutor func(
 	ctx context.Context,
 	ctrl *Controller,
 	hostInfo *afas.HostInfo,
 	scopeCache analysis.DataCache,
 	analyzerInput analysis.Input,
 	analyzerID analysis.AnalyzerID,
-) (analysis.AnalyzerID, *analysis.Report, error) {
+) (analysis.AnalyzerID, analysis.AnalyzerVersion, *analysis.Report, error)
+
+// analyzerJob is an analyzer requested in an Analyze call together with its input.
+type analyzerJob struct {
+	thriftInput afas.AnalyzerInput
+	analyzerID  analysis.AnalyzerID
+	input       analysis.Input
+	inputErr    error
+
+	// execute is nil if the analyzer is not supported.
+	execute analyzerExecutor
+}
+
+// newPrerequisiteJob creates a job for a not requested prerequisite of an analyzer,
+// see scheduleAnalyzerJobs.
+func newPrerequisiteJob(analyzerID analysis.AnalyzerID, dependentInput analysis.Input) *analyzerJob {
+	input := make(analysis.Input, len(dependentInput))
+	for typeID, value := range dependentInput {
+		input[typeID] = value
+	}
+	return &analyzerJob{
+		analyzerID: analyzerID,
+		input:      input,
+		execute:    executeAnalyzer,
+	}
+}
+
+func (ctrl *Controller) newAnalyzerJob(
+	ctx context.Context,
+	artifactsAccessor analyzerinput.ArtifactsAccessor,
+	hostInfo *afas.HostInfo,
+	analyzerThriftInput afas.AnalyzerInput,
+) *analyzerJob {
+	job := &analyzerJob{
+		thriftInput: analyzerThriftInput,
+	}
+	var newInput func(ctx context.Context) (analysis.Input, error)
+
+	// TODO: Generalize input data conversion, do not require to list each input type in package `controller`.
+	//       The set of analyzers should be injected, not hardcoded. The implementation of `controller` should
+	//       be agnostic of specific analyzer implementations.
+	switch {
+	case analyzerThriftInput.IsSetDiffMeasuredBoot():
+		job.analyzerID, job.execute = diffmeasuredboot.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewDiffMeasuredBootInput(ctx, artifactsAccessor, *analyzerThriftInput.GetDiffMeasuredBoot(), ctrl.referenceData.NVRAMRules, ctrl.nvramRules, ctrl.referenceData.DiagnosisRules, ctrl.diagnosisRules)
+		}
+	case analyzerThriftInput.IsSetReproducePCR():
+		job.analyzerID, job.execute = reproducepcr.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewReproducePCRInput(ctx, artifactsAccessor, *analyzerThriftInput.GetReproducePCR())
+		}
+	case analyzerThriftInput.IsSetIntelACM():
+		job.analyzerID, job.execute = intelacm.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewIntelACMInput(ctx, artifactsAccessor, *analyzerThriftInput.GetIntelACM())
+		}
+	case analyzerThriftInput.IsSetPSPSignature():
+		job.analyzerID, job.execute = pspsignature.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewPSPSignatureInput(ctx, artifactsAccessor, *analyzerThriftInput.GetPSPSignature())
+		}
+	case analyzerThriftInput.IsSetBIOSRTMVolume():
+		job.analyzerID, job.execute = biosrtmvolume.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewBIOSRTMVolumeInput(ctx, artifactsAccessor, *analyzerThriftInput.GetBIOSRTMVolume())
+		}
+	case analyzerThriftInput.IsSetAPCBSecurityTokens():
+		job.analyzerID, job.execute = apcbsectokens.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewAPCBSecurityTokensInput(ctx, artifactsAccessor, *analyzerThriftInput.GetAPCBSecurityTokens())
+		}
+	case analyzerThriftInput.IsSetIntelFlashDescriptor():
+		job.analyzerID, job.execute = intelifd.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewIntelFlashDescriptorInput(ctx, artifactsAccessor, *analyzerThriftInput.GetIntelFlashDescriptor())
+		}
+	case analyzerThriftInput.IsSetIntelME():
+		job.analyzerID, job.execute = intelme.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewIntelMEInput(ctx, artifactsAccessor, *analyzerThriftInput.GetIntelME())
+		}
+	case analyzerThriftInput.IsSetPSPSecurityPatchLevel():
+		job.analyzerID, job.execute = pspspl.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewPSPSecurityPatchLevelInput(ctx, artifactsAccessor, *analyzerThriftInput.GetPSPSecurityPatchLevel())
+		}
+	case analyzerThriftInput.IsSetPSBFuses():
+		job.analyzerID, job.execute = psbfuses.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewPSBFusesInput(ctx, artifactsAccessor, *analyzerThriftInput.GetPSBFuses())
+		}
+	case analyzerThriftInput.IsSetVulnerableModules():
+		job.analyzerID, job.execute = vulnerablemodules.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewVulnerableModulesInput(ctx, artifactsAccessor, *analyzerThriftInput.GetVulnerableModules(), ctrl.referenceData.AdvisoryDBs, ctrl.getAdvisoryDB())
+		}
+	case analyzerThriftInput.IsSetFlashDegradation():
+		job.analyzerID, job.execute = flashdegradation.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewFlashDegradationInput(ctx, artifactsAccessor, *analyzerThriftInput.GetFlashDegradation())
+		}
+	case analyzerThriftInput.IsSetFirmwareProvenance():
+		job.analyzerID, job.execute = firmwareprovenance.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewFirmwareProvenanceInput(ctx, artifactsAccessor, *analyzerThriftInput.GetFirmwareProvenance(), ctrl.OriginalFWDB, hostInfo.ModelID)
+		}
+	case analyzerThriftInput.IsSetOptionROMs():
+		job.analyzerID, job.execute = optionroms.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewOptionROMsInput(ctx, artifactsAccessor, *analyzerThriftInput.GetOptionROMs(), ctrl.optionROMCatalog, ctrl.OriginalFWDB)
+		}
+	case analyzerThriftInput.IsSetBootChain():
+		job.analyzerID, job.execute = bootchain.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewBootChainInput(ctx, artifactsAccessor, *analyzerThriftInput.GetBootChain(), ctrl.referenceData.BootAllowlists, ctrl.bootAllowlist)
+		}
+	case analyzerThriftInput.IsSetACPITables():
+		job.analyzerID, job.execute = acpitables.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewACPITablesInput(ctx, artifactsAccessor, *analyzerThriftInput.GetACPITables())
+		}
+	case analyzerThriftInput.IsSetTXTErrors():
+		job.analyzerID, job.execute = txterrors.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewTXTErrorsInput(ctx, artifactsAccessor, *analyzerThriftInput.GetTXTErrors(), ctrl.referenceData.TXTErrorCodes, ctrl.txtErrorCodes)
+		}
+	case analyzerThriftInput.IsSetImageDiff():
+		job.analyzerID, job.execute = imagediff.ID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewImageDiffInput(ctx, artifactsAccessor, *analyzerThriftInput.GetImageDiff())
+		}
+	case analyzerThriftInput.IsSetPlugin():
+		pluginID := analysis.AnalyzerID(analyzerThriftInput.GetPlugin().AnalyzerID)
+		job.analyzerID, job.execute = pluginID, executeAnalyzer
+		newInput = func(ctx context.Context) (analysis.Input, error) {
+			return analyzerinput.NewPluginInput(ctx, artifactsAccessor, *analyzerThriftInput.GetPlugin(), ctrl.plugins[pluginID])
+		}
+	default:
+		return job
+	}
+
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerInput-%s", job.analyzerID))
+	defer span.Finish()
+	job.input, job.inputErr = newInput(ctx)
+	return job
+}
+
+func (ctrl *Controller) runAnalyzerJob(
+	ctx context.Context,
+	hostInfo *afas.HostInfo,
+	scopeCache analysis.DataCache,
+	job *analyzerJob,
+) models.AnalyzerReport {
+	log := logger.FromCtx(ctx)
+	if job.execute == nil {
+		log.Errorf("Not supported analyzer: %s", &job.thriftInput)
+		return models.AnalyzerReport{
+			ExecError: models.SQLErrorWrapper{Err: controllererrors.ErrUnknownAnalyzer{AnalyzerInput: job.thriftInput}},
+		}
+	}
+
+	span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("AnalyzerWithInput-%s", job.analyzerID))
+	defer span.Finish()
+
+	analyzerID, analyzerVersion, analyzerReport, analyzerErr := job.execute(ctx, ctrl, hostInfo, scopeCache, job.input, job.analyzerID)
+	if job.inputErr != nil {
+		log.Errorf("Failed to construct input for analyzer: '%s': '%v'", analyzerID, job.inputErr)
+		analyzerErr = controllererrors.ErrInvalidInput{Err: job.inputErr}
+	}
+	var inputHash []byte
+	if job.input != nil {
+		var err error
+		inputHash, err = job.input.Hash()
+		if err != nil {
+			log.Errorf("unable to calculate the hash of the input of analyzer '%s': %v", analyzerID, err)
+		}
+	}
+	return models.AnalyzerReport{
+		AnalyzerID:      analyzerID,
+		AnalyzerVersion: analyzerVersion,
+		InputHash:       inputHash,
+		Input:           job.input,
+		Report:          analyzerReport,
+		ExecError:       models.SQLErrorWrapper{Err: analyzerErr},
+	}
+}
+
+func executeAnalyzer(
+	ctx context.Context,
+	ctrl *Controller,
+	hostInfo *afas.HostInfo,
+	scopeCache analysis.DataCache,
+	analyzerInput analysis.Input,
+	analyzerID analysis.AnalyzerID,
+) (analysis.AnalyzerID, analysis.AnalyzerVersion, *analysis.Report, error) {
 	if analyzerInput == nil {
-		return analyzerID, nil, fmt.Errorf("no valid input provided")
+		return analyzerID, "", nil, fmt.Errorf("no valid input provided")
 	}
 	if hostInfo != nil && hostInfo.AssetID != nil {
 		// TODO: Our analyzers are not AssetID-agnostic? Fix this. Analyzers
 		//       should know nothing about our infra (and should be opensourcable).
 		analyzerInput.AddAssetID(*hostInfo.AssetID)
 	}
+	if hostInfo != nil && hostInfo.ModelID != nil {
+		analyzerInput.AddModelID(*hostInfo.ModelID)
+	}
 
-	analyzer := analyzers.Get[analyzerInputType](ctrl.analyzersRegistry, analyzerID)
+	analyzer := ctrl.analyzersRegistry.GetExecutor(analyzerID)
 	if analyzer == nil {
-		return analyzerID, nil, fmt.Errorf("analyzer with id '%s' is not found", analyzerID)
+		return analyzerID, "", nil, fmt.Errorf("analyzer with id '%s' is not found", analyzerID)
 	}
+	analyzerVersion := analyzer.Version
 
 	span, ctx := tracer.StartChildSpanFromCtx(ctx, fmt.Sprintf("Analyzer-%s", analyzerID))
 	defer span.Finish()
-	report, err := analysis.ExecuteAnalyzer(ctx, ctrl.analysisDataCalculator, analyzer, analyzerInput, scopeCache)
-	return analyzerID, report, err
+	if cached := ctrl.findCachedAnalyzerReport(ctx, analyzerID, analyzerVersion, analyzerInput); cached != nil {
+		// The report is attributed to the version which actually produced it.
+		return analyzerID, cached.AnalyzerVersion, cached.Report, nil
+	}
+	report, err := executeWithLimit(ctx, ctrl.analyzerLimits.For(analyzerID), func(ctx context.Context) (*analysis.Report, error) {
+		return analyzer.Execute(ctx, ctrl.analysisDataCalculator, analyzerInput, scopeCache)
+	})
+	return analyzerID, analyzerVersion, report, err
 }
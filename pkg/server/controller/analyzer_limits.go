@@ -0,0 +1,204 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/metrics"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+const (
+	heapObjectsMetric        = "/memory/classes/heap/objects:bytes"
+	memoryBudgetPollInterval = 100 * time.Millisecond
+)
+
+// AnalyzerLimit is the execution budget of an analyzer.
+type AnalyzerLimit struct {
+	// Timeout is the maximal duration of the analysis, for example "30s". Empty means no limit.
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// MemoryBudgetMiB is a soft limit of the heap growth (in MiB) while the analyzer runs. Zero means no limit.
+	//
+	// Go does not account memory per goroutine, so this is the growth of the whole process heap,
+	// including allocations of other analyzers and requests running at the same time.
+	// The budget should be considered as a protection against runaway analyzers, not as
+	// a precise limit, and should be set with the concurrency of afasd in mind.
+	MemoryBudgetMiB uint64 `json:"memory_budget_mib,omitempty" yaml:"memory_budget_mib,omitempty"`
+
+	timeout time.Duration
+}
+
+// AnalyzerLimits defines execution budgets of analyzers.
+type AnalyzerLimits struct {
+	// Default is applied to analyzers without an own entry in Analyzers.
+	Default AnalyzerLimit `json:"default" yaml:"default"`
+
+	// Analyzers contains limits by analyzer name (for example "ReproducePCR").
+	// Zero fields are inherited from Default.
+	Analyzers map[analysis.AnalyzerID]AnalyzerLimit `json:"analyzers" yaml:"analyzers"`
+}
+
+// ParseAnalyzerLimits parses analyzer limits from JSON or YAML.
+func ParseAnalyzerLimits(b []byte, isYAML bool) (*AnalyzerLimits, error) {
+	var limits AnalyzerLimits
+	var err error
+	if isYAML {
+		err = yaml.Unmarshal(b, &limits)
+	} else {
+		err = json.Unmarshal(b, &limits)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the analyzer limits: %w", err)
+	}
+	if err := limits.Default.normalize(); err != nil {
+		return nil, fmt.Errorf("invalid default limit: %w", err)
+	}
+	for analyzerID, limit := range limits.Analyzers {
+		if err := limit.normalize(); err != nil {
+			return nil, fmt.Errorf("invalid limit of analyzer '%s': %w", analyzerID, err)
+		}
+		limits.Analyzers[analyzerID] = limit
+	}
+	return &limits, nil
+}
+
+// LoadAnalyzerLimits reads analyzer limits from a file. The format is chosen by
+// the file extension: ".yaml" and ".yml" are YAML, anything else is JSON.
+func LoadAnalyzerLimits(path string) (*AnalyzerLimits, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the analyzer limits '%s': %w", path, err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseAnalyzerLimits(b, true)
+	}
+	return ParseAnalyzerLimits(b, false)
+}
+
+func (limit *AnalyzerLimit) normalize() error {
+	if limit.Timeout == "" {
+		return nil
+	}
+	timeout, err := time.ParseDuration(limit.Timeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout '%s': %w", limit.Timeout, err)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("timeout should be positive, but it is '%s'", limit.Timeout)
+	}
+	limit.timeout = timeout
+	return nil
+}
+
+// For returns the effective limit of the analyzer.
+func (limits *AnalyzerLimits) For(analyzerID analysis.AnalyzerID) AnalyzerLimit {
+	if limits == nil {
+		return AnalyzerLimit{}
+	}
+	result := limits.Default
+	limit, ok := limits.Analyzers[analyzerID]
+	if !ok {
+		return result
+	}
+	if limit.timeout != 0 {
+		result.Timeout, result.timeout = limit.Timeout, limit.timeout
+	}
+	if limit.MemoryBudgetMiB != 0 {
+		result.MemoryBudgetMiB = limit.MemoryBudgetMiB
+	}
+	return result
+}
+
+func (limit AnalyzerLimit) isUnlimited() bool {
+	return limit.timeout == 0 && limit.MemoryBudgetMiB == 0
+}
+
+// executeWithLimit runs fn and cancels its context if it exceeds the limit.
+//
+// Go does not allow to terminate a goroutine, so after the limit is exceeded
+// the result of fn is ignored and ErrBudgetExceeded is returned immediately;
+// fn itself is expected to stop soon after its context is cancelled.
+func executeWithLimit(
+	ctx context.Context,
+	limit AnalyzerLimit,
+	fn func(ctx context.Context) (*analysis.Report, error),
+) (*analysis.Report, error) {
+	if limit.isUnlimited() {
+		return fn(ctx)
+	}
+
+	memoryBudget := limit.MemoryBudgetMiB << 20
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	var memoryCheckCh <-chan time.Time
+	var heapBaseline uint64
+	if memoryBudget != 0 {
+		heapBaseline = heapObjectsBytes()
+		ticker := time.NewTicker(memoryBudgetPollInterval)
+		defer ticker.Stop()
+		memoryCheckCh = ticker.C
+	}
+
+	type result struct {
+		report *analysis.Report
+		err    error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		report, err := fn(ctx)
+		resultCh <- result{report: report, err: err}
+	}()
+
+	var timeoutCh <-chan time.Time
+	if limit.timeout != 0 {
+		timer := time.NewTimer(limit.timeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	for {
+		select {
+		case r := <-resultCh:
+			return r.report, r.err
+		case <-timeoutCh:
+			return nil, analysis.ErrBudgetExceeded{Resource: "time", Limit: limit.Timeout}
+		case <-memoryCheckCh:
+			if heap := heapObjectsBytes(); heap > heapBaseline && heap-heapBaseline > memoryBudget {
+				return nil, analysis.ErrBudgetExceeded{Resource: "memory", Limit: fmt.Sprintf("%dMiB", limit.MemoryBudgetMiB)}
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func heapObjectsBytes() uint64 {
+	sample := []metrics.Sample{{Name: heapObjectsMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
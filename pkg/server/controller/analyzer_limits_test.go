@@ -0,0 +1,109 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+func TestAnalyzerLimits(t *testing.T) {
+	limits, err := ParseAnalyzerLimits([]byte(`
+default:
+  timeout: 5m
+  memory_budget_mib: 4096
+analyzers:
+  ReproducePCR:
+    timeout: 30s
+  IntelACM:
+    memory_budget_mib: 128
+`), true)
+	require.NoError(t, err)
+
+	limit := limits.For("ReproducePCR")
+	require.Equal(t, 30*time.Second, limit.timeout)
+	require.Equal(t, uint64(4096), limit.MemoryBudgetMiB)
+
+	limit = limits.For("IntelACM")
+	require.Equal(t, 5*time.Minute, limit.timeout)
+	require.Equal(t, uint64(128), limit.MemoryBudgetMiB)
+
+	require.Equal(t, limits.Default, limits.For("DiffMeasuredBoot"))
+	require.True(t, (*AnalyzerLimits)(nil).For("DiffMeasuredBoot").isUnlimited())
+
+	_, err = ParseAnalyzerLimits([]byte(`{"analyzers": {"ReproducePCR": {"timeout": "soon"}}}`), false)
+	require.Error(t, err)
+}
+
+func TestExecuteWithLimit(t *testing.T) {
+	t.Run("timeout", func(t *testing.T) {
+		var cancelled bool
+		done := make(chan struct{})
+		_, err := executeWithLimit(context.Background(), AnalyzerLimit{Timeout: "10ms", timeout: 10 * time.Millisecond}, func(ctx context.Context) (*analysis.Report, error) {
+			defer close(done)
+			<-ctx.Done()
+			cancelled = true
+			return nil, ctx.Err()
+		})
+		require.ErrorAs(t, err, &analysis.ErrBudgetExceeded{})
+		<-done
+		require.True(t, cancelled)
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		var garbage [][]byte
+		done := make(chan struct{})
+		_, err := executeWithLimit(context.Background(), AnalyzerLimit{MemoryBudgetMiB: 1}, func(ctx context.Context) (*analysis.Report, error) {
+			defer close(done)
+			for ctx.Err() == nil {
+				garbage = append(garbage, make([]byte, 1<<20))
+				time.Sleep(time.Millisecond)
+			}
+			return nil, ctx.Err()
+		})
+		require.ErrorAs(t, err, &analysis.ErrBudgetExceeded{})
+		<-done
+		require.NotEmpty(t, garbage)
+	})
+
+	t.Run("budgeted_concurrently", func(t *testing.T) {
+		// an analyzer ignoring its context keeps running after its budget is exceeded,
+		// but it does not block other analyzers with a budget
+		release := make(chan struct{})
+		defer close(release)
+		_, err := executeWithLimit(context.Background(), AnalyzerLimit{Timeout: "10ms", timeout: 10 * time.Millisecond, MemoryBudgetMiB: 1024}, func(ctx context.Context) (*analysis.Report, error) {
+			<-release
+			return &analysis.Report{}, nil
+		})
+		require.ErrorAs(t, err, &analysis.ErrBudgetExceeded{})
+
+		report, err := executeWithLimit(context.Background(), AnalyzerLimit{Timeout: "1m", timeout: time.Minute, MemoryBudgetMiB: 1024}, func(ctx context.Context) (*analysis.Report, error) {
+			return &analysis.Report{Comments: []string{"ok"}}, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"ok"}, report.Comments)
+	})
+
+	t.Run("within_limits", func(t *testing.T) {
+		report, err := executeWithLimit(context.Background(), AnalyzerLimit{Timeout: "1m", timeout: time.Minute, MemoryBudgetMiB: 1024}, func(ctx context.Context) (*analysis.Report, error) {
+			return &analysis.Report{Comments: []string{"ok"}}, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"ok"}, report.Comments)
+	})
+}
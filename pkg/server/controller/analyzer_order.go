@@ -0,0 +1,162 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
+)
+
+// scheduleAnalyzerJobs adds jobs for the prerequisites (see analysis.AnalyzerReport)
+// of the requested analyzers, which are not requested themselves, so that all the
+// prerequisites are executed as separate jobs (and not calculated inside the
+// dependent analyzers).
+//
+// The returned jobs start with the requested jobs in the same order. A not requested
+// prerequisite is executed with the input of the dependent analyzer, once per distinct
+// input. prerequisites[idx] lists the indexes (in the returned jobs) of the prerequisites
+// of the job jobs[idx]. prerequisitesOf may be nil, then no prerequisites are scheduled.
+func scheduleAnalyzerJobs(
+	ctx context.Context,
+	requestedJobs []*analyzerJob,
+	prerequisitesOf func(analysis.AnalyzerID) []analysis.AnalyzerID,
+	newPrerequisiteJob func(analyzerID analysis.AnalyzerID, input analysis.Input) *analyzerJob,
+) (jobs []*analyzerJob, prerequisites [][]int) {
+	jobs = append(jobs, requestedJobs...)
+	if prerequisitesOf == nil {
+		return jobs, make([][]int, len(jobs))
+	}
+
+	requestedIndexes := map[analysis.AnalyzerID][]int{}
+	for idx, job := range requestedJobs {
+		requestedIndexes[job.analyzerID] = append(requestedIndexes[job.analyzerID], idx)
+	}
+	type prerequisiteKey struct {
+		analyzerID analysis.AnalyzerID
+		inputHash  string
+	}
+	scheduled := map[prerequisiteKey]int{}
+
+	// jobs of prerequisites are appended while iterating, so their prerequisites are scheduled as well
+	for idx := 0; idx < len(jobs); idx++ {
+		job := jobs[idx]
+		var jobPrerequisites []int
+		if job.analyzerID != "" {
+			var inputHash string
+			for _, prerequisiteID := range prerequisitesOf(job.analyzerID) {
+				if prerequisiteID == job.analyzerID {
+					continue
+				}
+				if indexes, ok := requestedIndexes[prerequisiteID]; ok {
+					jobPrerequisites = append(jobPrerequisites, indexes...)
+					continue
+				}
+				if job.input == nil || job.inputErr != nil {
+					continue
+				}
+				if inputHash == "" {
+					hash, err := job.input.Hash()
+					if err != nil {
+						logger.FromCtx(ctx).Errorf("unable to calculate the hash of the input of analyzer '%s': %v", job.analyzerID, err)
+						hash = []byte(fmt.Sprintf("job-%d", idx))
+					}
+					inputHash = string(hash)
+				}
+				key := prerequisiteKey{analyzerID: prerequisiteID, inputHash: inputHash}
+				prerequisiteIdx, ok := scheduled[key]
+				if !ok {
+					prerequisiteIdx = len(jobs)
+					jobs = append(jobs, newPrerequisiteJob(prerequisiteID, job.input))
+					scheduled[key] = prerequisiteIdx
+				}
+				jobPrerequisites = append(jobPrerequisites, prerequisiteIdx)
+			}
+			sort.Ints(jobPrerequisites)
+		}
+		prerequisites = append(prerequisites, jobPrerequisites)
+	}
+	return jobs, prerequisites
+}
+
+// analyzerExecutionStages splits the jobs into stages: the jobs of one stage may
+// be executed concurrently, but only after all the jobs of the previous stages
+// are finished. A job is placed to a stage after all its prerequisites, so their
+// reports could be provided to it.
+//
+// prerequisites[idx] lists the indexes of the prerequisites of the job idx
+// (see scheduleAnalyzerJobs), returned stages contain the indexes of the jobs.
+func analyzerExecutionStages(prerequisites [][]int) (stages [][]int) {
+	// Analyzer dependencies are acyclic (analysis.DataCalculator checks it on registration),
+	// but the inProgress guard keeps this function terminating on any input.
+	stageOf := make([]int, len(prerequisites))
+	resolved := make([]bool, len(prerequisites))
+	inProgress := make([]bool, len(prerequisites))
+	var resolve func(idx int) int
+	resolve = func(idx int) int {
+		if resolved[idx] || inProgress[idx] {
+			return stageOf[idx]
+		}
+		inProgress[idx] = true
+		stage := 0
+		for _, prerequisiteIdx := range prerequisites[idx] {
+			if prerequisiteStage := resolve(prerequisiteIdx) + 1; prerequisiteStage > stage {
+				stage = prerequisiteStage
+			}
+		}
+		inProgress[idx] = false
+		resolved[idx] = true
+		stageOf[idx] = stage
+		return stage
+	}
+
+	for idx := range prerequisites {
+		stage := resolve(idx)
+		for len(stages) <= stage {
+			stages = append(stages, nil)
+		}
+		stages[stage] = append(stages[stage], idx)
+	}
+	return stages
+}
+
+// addPrerequisiteReports adds the reports of the prerequisites executed in
+// the same call to the input of a dependent analyzer. Failed prerequisites
+// are not added, except the not applicable ones.
+func addPrerequisiteReports(
+	ctx context.Context,
+	input analysis.Input,
+	reportProvider analysis.AnalyzerReportProvider,
+	analyzerReports []models.AnalyzerReport,
+	prerequisites []int,
+) {
+	for _, idx := range prerequisites {
+		prerequisiteReport := analyzerReports[idx]
+		value, ok := reportProvider.NewAnalyzerReportValue(
+			prerequisiteReport.AnalyzerID,
+			prerequisiteReport.AnalyzerVersion,
+			prerequisiteReport.Report,
+			prerequisiteReport.ExecError.Err,
+		)
+		if !ok {
+			continue
+		}
+		logger.FromCtx(ctx).Debugf("providing the report of analyzer '%s' as an input", prerequisiteReport.AnalyzerID)
+		input.AddCustomValue(value)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+func testPrerequisitesOf(analyzerID analysis.AnalyzerID) []analysis.AnalyzerID {
+	switch analyzerID {
+	case "DiffMeasuredBoot":
+		return []analysis.AnalyzerID{"ReproducePCR"}
+	case "Verdict":
+		return []analysis.AnalyzerID{"DiffMeasuredBoot", "IntelACM", "ReproducePCR"}
+	}
+	return nil
+}
+
+func newTestJobs(analyzerIDs []analysis.AnalyzerID, inputs ...analysis.Input) []*analyzerJob {
+	jobs := make([]*analyzerJob, len(analyzerIDs))
+	for idx, analyzerID := range analyzerIDs {
+		jobs[idx] = &analyzerJob{analyzerID: analyzerID}
+		if idx < len(inputs) {
+			jobs[idx].input = inputs[idx]
+		}
+	}
+	return jobs
+}
+
+func jobIDs(jobs []*analyzerJob) []analysis.AnalyzerID {
+	result := make([]analysis.AnalyzerID, len(jobs))
+	for idx, job := range jobs {
+		result[idx] = job.analyzerID
+	}
+	return result
+}
+
+func TestScheduleAnalyzerJobs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("prerequisites_requested", func(t *testing.T) {
+		requested := newTestJobs([]analysis.AnalyzerID{"Verdict", "DiffMeasuredBoot", "IntelACM", "ReproducePCR", "", "ReproducePCR"})
+		jobs, prerequisites := scheduleAnalyzerJobs(ctx, requested, testPrerequisitesOf, newPrerequisiteJob)
+		require.Equal(t, requested, jobs)
+		require.Equal(t, [][]int{{1, 2, 3, 5}, {3, 5}, nil, nil, nil, nil}, prerequisites)
+	})
+
+	t.Run("prerequisites_not_requested", func(t *testing.T) {
+		inputA := analysis.NewInput().AddAssetID(1)
+		inputB := analysis.NewInput().AddAssetID(2)
+		requested := newTestJobs(
+			[]analysis.AnalyzerID{"DiffMeasuredBoot", "DiffMeasuredBoot", "DiffMeasuredBoot", "DiffMeasuredBoot"},
+			inputA, analysis.NewInput().AddAssetID(1), inputB, nil,
+		)
+		jobs, prerequisites := scheduleAnalyzerJobs(ctx, requested, testPrerequisitesOf, newPrerequisiteJob)
+		require.Equal(t, []analysis.AnalyzerID{"DiffMeasuredBoot", "DiffMeasuredBoot", "DiffMeasuredBoot", "DiffMeasuredBoot", "ReproducePCR", "ReproducePCR"}, jobIDs(jobs))
+		require.Equal(t, [][]int{{4}, {4}, {5}, nil, nil, nil}, prerequisites)
+		require.Equal(t, inputA, jobs[4].input)
+		require.Equal(t, inputB, jobs[5].input)
+
+		// the input of the prerequisite is a copy, so reports added to the dependent input do not leak there
+		inputA.AddCustomValue(analysis.ActualPCR0([]byte{1}))
+		require.NotEqual(t, inputA, jobs[4].input)
+	})
+
+	t.Run("transitive", func(t *testing.T) {
+		requested := newTestJobs([]analysis.AnalyzerID{"Verdict"}, analysis.NewInput().AddAssetID(1))
+		jobs, prerequisites := scheduleAnalyzerJobs(ctx, requested, testPrerequisitesOf, newPrerequisiteJob)
+		require.Equal(t, []analysis.AnalyzerID{"Verdict", "DiffMeasuredBoot", "IntelACM", "ReproducePCR"}, jobIDs(jobs))
+		require.Equal(t, [][]int{{1, 2, 3}, {3}, nil, nil}, prerequisites)
+		require.Equal(t, [][]int{{2, 3}, {1}, {0}}, analyzerExecutionStages(prerequisites))
+	})
+
+	t.Run("no_prerequisites", func(t *testing.T) {
+		requested := newTestJobs([]analysis.AnalyzerID{"Verdict", "DiffMeasuredBoot"}, analysis.NewInput())
+		jobs, prerequisites := scheduleAnalyzerJobs(ctx, requested, nil, newPrerequisiteJob)
+		require.Equal(t, requested, jobs)
+		require.Equal(t, [][]int{{0, 1}}, analyzerExecutionStages(prerequisites))
+	})
+}
+
+func TestAnalyzerExecutionStages(t *testing.T) {
+	stages := analyzerExecutionStages([][]int{{1, 2, 3, 5}, {3, 5}, nil, nil, nil, nil})
+	require.Equal(t, [][]int{{2, 3, 4, 5}, {1}, {0}}, stages)
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/storage"
+	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
+)
+
+// AnalyzerReportCachePolicy defines if previously stored analyzer reports
+// are reused for an analyzer input which was already analyzed.
+type AnalyzerReportCachePolicy int
+
+const (
+	// AnalyzerReportCacheDisabled means every analyzer is always executed.
+	AnalyzerReportCacheDisabled = AnalyzerReportCachePolicy(iota)
+
+	// AnalyzerReportCacheAnyVersion reuses a stored report regardless of
+	// the version of the analyzer which produced it.
+	AnalyzerReportCacheAnyVersion
+
+	// AnalyzerReportCacheUpToDate reuses only reports produced by the current
+	// (or a newer) version of the analyzer, reports of older versions are misses.
+	AnalyzerReportCacheUpToDate
+)
+
+// String implements fmt.Stringer.
+func (policy AnalyzerReportCachePolicy) String() string {
+	switch policy {
+	case AnalyzerReportCacheDisabled:
+		return "disabled"
+	case AnalyzerReportCacheAnyVersion:
+		return "any-version"
+	case AnalyzerReportCacheUpToDate:
+		return "up-to-date"
+	}
+	return fmt.Sprintf("unknown_policy_%d", int(policy))
+}
+
+// ParseAnalyzerReportCachePolicy is the reverse function of AnalyzerReportCachePolicy.String.
+func ParseAnalyzerReportCachePolicy(s string) (AnalyzerReportCachePolicy, error) {
+	for _, policy := range []AnalyzerReportCachePolicy{
+		AnalyzerReportCacheDisabled,
+		AnalyzerReportCacheAnyVersion,
+		AnalyzerReportCacheUpToDate,
+	} {
+		if policy.String() == s {
+			return policy, nil
+		}
+	}
+	return AnalyzerReportCacheDisabled, fmt.Errorf("unknown analyzer report cache policy '%s' (expected: disabled, any-version or up-to-date)", s)
+}
+
+// findCachedAnalyzerReport returns a previously stored successful report of the analyzer
+// for the same input, or nil if there is no such report (or it is not allowed by the policy).
+func (ctrl *Controller) findCachedAnalyzerReport(
+	ctx context.Context,
+	analyzerID analysis.AnalyzerID,
+	analyzerVersion analysis.AnalyzerVersion,
+	analyzerInput analysis.Input,
+) *models.AnalyzerReport {
+	if ctrl.analyzerReportCache == AnalyzerReportCacheDisabled {
+		return nil
+	}
+	log := logger.FromCtx(ctx)
+
+	inputHash, err := analyzerInput.Hash()
+	if err != nil {
+		log.Errorf("unable to calculate the hash of the input of analyzer '%s': %v", analyzerID, err)
+		return nil
+	}
+	succeeded := true
+	filter := storage.AnalyzerReportFindFilter{
+		AnalyzerID: &analyzerID,
+		InputHash:  inputHash,
+		Succeeded:  &succeeded,
+	}
+	if ctrl.analyzerReportCache == AnalyzerReportCacheUpToDate {
+		filter.MinAnalyzerVersion = &analyzerVersion
+	}
+
+	reports, err := ctrl.FirmwareStorage.FindAnalyzerReports(ctx, filter, nil, 1)
+	if err != nil {
+		log.Errorf("unable to find cached reports of analyzer '%s': %v", analyzerID, err)
+		return nil
+	}
+	if len(reports) == 0 {
+		log.Debugf("no cached report of analyzer '%s' (version %s)", analyzerID, analyzerVersion)
+		return nil
+	}
+	log.Debugf("reusing the cached report #%d of analyzer '%s' (version %s)", reports[0].ID, analyzerID, reports[0].AnalyzerVersion)
+	return reports[0]
+}
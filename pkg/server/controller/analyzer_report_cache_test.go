@@ -0,0 +1,89 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/storage"
+	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
+)
+
+type analyzerReportsStorageMock struct {
+	Storage
+	filters []storage.AnalyzerReportFindFilter
+	reports []*models.AnalyzerReport
+}
+
+func (stor *analyzerReportsStorageMock) FindAnalyzerReports(
+	ctx context.Context,
+	filter storage.AnalyzerReportFindFilter,
+	tx *sqlx.Tx,
+	limit uint,
+) ([]*models.AnalyzerReport, error) {
+	stor.filters = append(stor.filters, filter)
+	return stor.reports, nil
+}
+
+func TestParseAnalyzerReportCachePolicy(t *testing.T) {
+	for _, policy := range []AnalyzerReportCachePolicy{
+		AnalyzerReportCacheDisabled,
+		AnalyzerReportCacheAnyVersion,
+		AnalyzerReportCacheUpToDate,
+	} {
+		parsed, err := ParseAnalyzerReportCachePolicy(policy.String())
+		require.NoError(t, err)
+		require.Equal(t, policy, parsed)
+	}
+	_, err := ParseAnalyzerReportCachePolicy("always")
+	require.Error(t, err)
+}
+
+func TestFindCachedAnalyzerReport(t *testing.T) {
+	ctx := context.Background()
+	input := analysis.NewInput().AddActualPCR0([]byte{1, 2, 3})
+	inputHash, err := input.Hash()
+	require.NoError(t, err)
+	cached := &models.AnalyzerReport{ID: 1, AnalyzerVersion: "1.0.0", Report: &analysis.Report{}}
+
+	t.Run("disabled", func(t *testing.T) {
+		stor := &analyzerReportsStorageMock{reports: []*models.AnalyzerReport{cached}}
+		ctrl := &Controller{FirmwareStorage: stor, analyzerReportCache: AnalyzerReportCacheDisabled}
+		require.Nil(t, ctrl.findCachedAnalyzerReport(ctx, "ReproducePCR", "1.1.0", input))
+		require.Empty(t, stor.filters)
+	})
+
+	t.Run("any-version", func(t *testing.T) {
+		stor := &analyzerReportsStorageMock{reports: []*models.AnalyzerReport{cached}}
+		ctrl := &Controller{FirmwareStorage: stor, analyzerReportCache: AnalyzerReportCacheAnyVersion}
+		require.Equal(t, cached, ctrl.findCachedAnalyzerReport(ctx, "ReproducePCR", "1.1.0", input))
+		require.Len(t, stor.filters, 1)
+		require.Equal(t, analysis.AnalyzerID("ReproducePCR"), *stor.filters[0].AnalyzerID)
+		require.Equal(t, inputHash, stor.filters[0].InputHash)
+		require.True(t, *stor.filters[0].Succeeded)
+		require.Nil(t, stor.filters[0].MinAnalyzerVersion)
+	})
+
+	t.Run("up-to-date", func(t *testing.T) {
+		stor := &analyzerReportsStorageMock{}
+		ctrl := &Controller{FirmwareStorage: stor, analyzerReportCache: AnalyzerReportCacheUpToDate}
+		require.Nil(t, ctrl.findCachedAnalyzerReport(ctx, "ReproducePCR", "1.1.0", input))
+		require.Len(t, stor.filters, 1)
+		require.Equal(t, analysis.AnalyzerVersion("1.1.0"), *stor.filters[0].MinAnalyzerVersion)
+	})
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 	"github.com/immune-gmbh/attestation-sdk/if/typeconv"
+	"github.com/immune-gmbh/attestation-sdk/pkg/acpi"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/lockmap"
 	"github.com/immune-gmbh/attestation-sdk/pkg/objhash"
@@ -42,6 +43,7 @@ type ArtifactsAccessor interface {
 	GetTPMEventLog(ctx context.Context, artIdx int) (*tpmeventlog.TPMEventLog, error)
 	GetPCR(ctx context.Context, artIdx int) ([]byte, uint32, error)
 	GetMeasurementsFlow(ctx context.Context, inputIdx int) (types.BootFlow, error)
+	GetACPITables(ctx context.Context, artIdx int) ([]acpi.Table, error)
 }
 
 // FirmwareImage combines firmware image metadata and data together.
@@ -235,3 +237,14 @@ func (a *artifactsAccessor) GetMeasurementsFlow(ctx context.Context, inputIdx in
 	flow, err := typeconv.FromThriftFlow(artifact.GetMeasurementsFlow())
 	return types.BootFlow(flow), err
 }
+
+func (a *artifactsAccessor) GetACPITables(ctx context.Context, inputIdx int) ([]acpi.Table, error) {
+	if err := a.checkIndex(inputIdx); err != nil {
+		return nil, err
+	}
+	artifact := a.artifacts[inputIdx]
+	if !artifact.IsSetACPITables() {
+		return nil, fmt.Errorf("unexpected artifact's '%d' type for obtaining ACPI tables", inputIdx)
+	}
+	return typeconv.FromThriftACPITables(artifact.GetACPITables()), nil
+}
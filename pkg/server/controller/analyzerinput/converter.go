@@ -19,13 +19,29 @@ import (
 	"github.com/9elements/converged-security-suite/v2/pkg/bootflow/flows"
 
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerplugin"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
+	"github.com/immune-gmbh/attestation-sdk/pkg/dmidecode"
+	"github.com/immune-gmbh/attestation-sdk/pkg/firmwaredb"
 	"github.com/immune-gmbh/attestation-sdk/pkg/flowscompat"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
 
@@ -42,6 +58,10 @@ func NewDiffMeasuredBootInput(
 	ctx context.Context,
 	artifacts ArtifactsAccessor,
 	input afas.DiffMeasuredBootInput,
+	nvramRuleSets *analysis.ReferenceDataStore[diffmeasuredboot.NVRAMRules],
+	nvramRules *diffmeasuredboot.NVRAMRules,
+	diagnosisRuleSets *analysis.ReferenceDataStore[diffmeasuredboot.DiagnosisRules],
+	diagnosisRules *diffmeasuredboot.DiagnosisRules,
 ) (analysis.Input, error) {
 	log := logger.FromCtx(ctx)
 	actualFirmware, originalFirmware, err := getFirmwarePair(ctx, artifacts, input.ActualFirmwareImage, input.OriginalFirmwareImage)
@@ -84,6 +104,10 @@ func NewDiffMeasuredBootInput(
 		eventlog,
 		actualPCR0,
 		nil,
+		nvramRuleSets,
+		nvramRules,
+		diagnosisRuleSets,
+		diagnosisRules,
 	)
 	if err != nil {
 		return nil, err
@@ -268,6 +292,429 @@ func NewAPCBSecurityTokensInput(
 	return result, nil
 }
 
+// NewIntelFlashDescriptorInput constructs input needed for IntelFlashDescriptor analyzer
+func NewIntelFlashDescriptorInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.IntelFlashDescriptorInput,
+) (analysis.Input, error) {
+	actualFirmware, originalFirmware, err := getFirmwarePair(ctx, artifacts, input.ActualFirmwareImage, input.OriginalFirmwareImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the firmware pair: %w", err)
+	}
+	result, err := intelifd.NewExecutorInput(
+		originalFirmware,
+		actualFirmware,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NewIntelMEInput constructs input needed for IntelME analyzer
+func NewIntelMEInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.IntelMEInput,
+) (analysis.Input, error) {
+	actualFirmware, originalFirmware, err := getFirmwarePair(ctx, artifacts, input.ActualFirmwareImage, input.OriginalFirmwareImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the firmware pair: %w", err)
+	}
+	result, err := intelme.NewExecutorInput(
+		originalFirmware,
+		actualFirmware,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NewPSPSecurityPatchLevelInput constructs input needed for PSPSecurityPatchLevel analyzer
+func NewPSPSecurityPatchLevelInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.PSPSecurityPatchLevelInput,
+) (analysis.Input, error) {
+	actualFirmware, originalFirmware, err := getFirmwarePair(ctx, artifacts, input.ActualFirmwareImage, input.OriginalFirmwareImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the firmware pair: %w", err)
+	}
+	regs, err := getStatusRegisters(ctx, false, &input, artifacts)
+	if err != nil {
+		return nil, err
+	}
+	result, err := pspspl.NewExecutorInput(
+		originalFirmware,
+		actualFirmware,
+		regs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NewPSBFusesInput constructs input needed for PSBFuses analyzer
+func NewPSBFusesInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.PSBFusesInput,
+) (analysis.Input, error) {
+	actualFirmware, err := artifacts.GetFirmware(ctx, int(input.ActualFirmwareImage))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the actual firmware: %w", err)
+	}
+	regs, err := getStatusRegisters(ctx, true, &input, artifacts)
+	if err != nil {
+		return nil, err
+	}
+	result, err := psbfuses.NewExecutorInput(
+		actualFirmware,
+		regs,
+		input.GetFusedKeyHash(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NewVulnerableModulesInput constructs input needed for VulnerableModules analyzer
+func NewVulnerableModulesInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.VulnerableModulesInput,
+	advisoryDBs *analysis.ReferenceDataStore[vulnerablemodules.AdvisoryDB],
+	advisoryDB *vulnerablemodules.AdvisoryDB,
+) (analysis.Input, error) {
+	if advisoryDB == nil {
+		return nil, fmt.Errorf("the advisory database is not configured")
+	}
+	actualFirmware, err := artifacts.GetFirmware(ctx, int(input.ActualFirmwareImage))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the actual firmware: %w", err)
+	}
+	result, err := vulnerablemodules.NewExecutorInput(
+		actualFirmware,
+		advisoryDBs,
+		advisoryDB,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NewFlashDegradationInput constructs input needed for FlashDegradation analyzer
+func NewFlashDegradationInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.FlashDegradationInput,
+) (analysis.Input, error) {
+	actualFirmware, originalFirmware, err := getFirmwarePair(ctx, artifacts, input.ActualFirmwareImage, input.OriginalFirmwareImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the firmware pair: %w", err)
+	}
+	result, err := flashdegradation.NewExecutorInput(
+		originalFirmware,
+		actualFirmware,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NewFirmwareProvenanceInput constructs input needed for FirmwareProvenance analyzer
+//
+// The relevant firmware database entries are fetched here (instead of accessing
+// the database from the analyzer) to keep the analysis deterministic and replayable.
+func NewFirmwareProvenanceInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.FirmwareProvenanceInput,
+	firmwareDB firmwaredb.DB,
+	modelID *int64,
+) (analysis.Input, error) {
+	if firmwareDB == nil {
+		return nil, fmt.Errorf("the firmware database is not configured")
+	}
+	actualFirmware, err := artifacts.GetFirmware(ctx, int(input.ActualFirmwareImage))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the actual firmware: %w", err)
+	}
+	firmwares, err := getModelFirmwares(ctx, firmwareDB, actualFirmware, modelID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the firmwares of the model: %w", err)
+	}
+	result, err := firmwareprovenance.NewExecutorInput(
+		actualFirmware,
+		firmwareprovenance.NewCatalog(firmwares),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getModelFirmwares returns all BIOS firmwares of the model. If the model is not known,
+// then the models are determined by targets of the version found in SMBIOS of the image.
+func getModelFirmwares(
+	ctx context.Context,
+	firmwareDB firmwaredb.DB,
+	actualFirmware analysis.Blob,
+	modelID *int64,
+) ([]*firmwaredb.Firmware, error) {
+	var modelIDs firmwaredb.FilterModelIDs
+	if modelID != nil {
+		modelIDs = append(modelIDs, *modelID)
+	} else {
+		dmiTable, err := dmidecode.DMITableFromFirmwareImage(actualFirmware.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse SMBIOS of the actual firmware: %w", err)
+		}
+		sameVersion, err := firmwareDB.Get(ctx,
+			firmwaredb.FilterTypes{firmwaredb.FirmwareTypeBIOS},
+			firmwaredb.FilterVersion(dmiTable.BIOSInfo().Version),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, fw := range sameVersion {
+			for _, target := range fw.Targets {
+				if target.ModelID != nil {
+					modelIDs = append(modelIDs, *target.ModelID)
+				}
+			}
+		}
+		if len(modelIDs) == 0 {
+			return sameVersion, nil
+		}
+	}
+
+	return firmwareDB.Get(ctx,
+		firmwaredb.FilterTypes{firmwaredb.FirmwareTypeBIOS},
+		modelIDs,
+	)
+}
+
+// NewOptionROMsInput constructs input needed for OptionROMs analyzer
+//
+// The catalog is the configured one plus OPTION_ROM entries of the firmware database.
+func NewOptionROMsInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.OptionROMsInput,
+	catalog *optionroms.Catalog,
+	firmwareDB firmwaredb.DB,
+) (analysis.Input, error) {
+	eventLog, err := artifacts.GetTPMEventLog(ctx, int(input.TPMEventLog))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TPM eventlog using artifact '%d': '%w'", input.TPMEventLog, err)
+	}
+
+	var result optionroms.Catalog
+	if catalog != nil {
+		result = *catalog
+	}
+	if firmwareDB != nil {
+		firmwares, err := firmwareDB.Get(ctx, firmwaredb.FilterTypes{firmwaredb.FirmwareTypeOptionROM})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get option ROMs from the firmware database: %w", err)
+		}
+		result = result.Merge(optionroms.NewCatalogFromFirmwareDB(firmwares))
+	}
+
+	return optionroms.NewExecutorInput(eventLog, result)
+}
+
+// NewBootChainInput constructs input needed for BootChain analyzer
+func NewBootChainInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.BootChainInput,
+	allowlists *analysis.ReferenceDataStore[bootchain.Allowlist],
+	allowlist *bootchain.Allowlist,
+) (analysis.Input, error) {
+	if allowlist == nil {
+		return nil, fmt.Errorf("the boot allowlist is not configured")
+	}
+	eventLog, err := artifacts.GetTPMEventLog(ctx, int(input.TPMEventLog))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TPM eventlog using artifact '%d': '%w'", input.TPMEventLog, err)
+	}
+	return bootchain.NewExecutorInput(eventLog, allowlists, allowlist)
+}
+
+// NewACPITablesInput constructs input needed for ACPITables analyzer
+func NewACPITablesInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.ACPITablesInput,
+) (analysis.Input, error) {
+	tables, err := artifacts.GetACPITables(ctx, int(input.ACPITables))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ACPI tables using artifact '%d': '%w'", input.ACPITables, err)
+	}
+
+	var originalFirmware analysis.Blob
+	if input.OriginalFirmwareImage != nil {
+		originalFirmware, err = artifacts.GetFirmware(ctx, int(*input.OriginalFirmwareImage))
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the original firmware image: %w", err)
+		}
+	}
+	return acpitables.NewExecutorInput(tables, originalFirmware)
+}
+
+// NewTXTErrorsInput constructs input needed for TXTErrors analyzer
+func NewTXTErrorsInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.TXTErrorsInput,
+	errorCodeTables *analysis.ReferenceDataStore[txterrors.ErrorCodes],
+	errorCodes *txterrors.ErrorCodes,
+) (analysis.Input, error) {
+	regs, err := getStatusRegisters(ctx, true, &input, artifacts)
+	if err != nil {
+		return nil, err
+	}
+	return txterrors.NewExecutorInput(regs, errorCodeTables, errorCodes)
+}
+
+// NewImageDiffInput constructs input needed for ImageDiff analyzer
+func NewImageDiffInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.ImageDiffInput,
+) (analysis.Input, error) {
+	actualFirmware, originalFirmware, err := getFirmwarePair(ctx, artifacts, input.ActualFirmwareImage, &input.OriginalFirmwareImage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the firmware pair: %w", err)
+	}
+	return imagediff.NewExecutorInput(originalFirmware, actualFirmware)
+}
+
+// NewPluginInput constructs input needed for an out-of-process analyzer plugin
+//
+// Only the artifacts accepted by the plugin are added to the input.
+func NewPluginInput(
+	ctx context.Context,
+	artifacts ArtifactsAccessor,
+	input afas.PluginInput,
+	analyzerPlugin *plugin.Plugin,
+) (analysis.Input, error) {
+	if analyzerPlugin == nil {
+		return nil, fmt.Errorf("analyzer plugin '%s' is not configured", input.AnalyzerID)
+	}
+
+	result := analysis.NewInput()
+	addInput := func(inputType analyzerplugin.InputType, artIdx *int32, add func(artIdx int) error) error {
+		if !analyzerPlugin.Accepts(inputType) {
+			return nil
+		}
+		if artIdx == nil {
+			if analyzerPlugin.IsRequired(inputType) {
+				return fmt.Errorf("no artifact describes %s required by plugin '%s'", inputType, input.AnalyzerID)
+			}
+			return nil
+		}
+		if err := add(int(*artIdx)); err != nil {
+			return fmt.Errorf("failed to get %s using artifact '%d': %w", inputType, *artIdx, err)
+		}
+		return nil
+	}
+
+	err := addInput(analyzerplugin.InputType_ActualFirmware, input.ActualFirmwareImage, func(artIdx int) error {
+		image, err := artifacts.GetFirmware(ctx, artIdx)
+		if err != nil {
+			return err
+		}
+		result.AddActualFirmware(image)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = addInput(analyzerplugin.InputType_OriginalFirmware, input.OriginalFirmwareImage, func(artIdx int) error {
+		image, err := artifacts.GetFirmware(ctx, artIdx)
+		if err != nil {
+			return err
+		}
+		result.AddOriginalFirmware(image)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = addInput(analyzerplugin.InputType_StatusRegisters, input.StatusRegisters, func(artIdx int) error {
+		regs, err := artifacts.GetRegisters(ctx, artIdx)
+		if err != nil {
+			return err
+		}
+		actualRegisters, err := analysis.NewActualRegisters(regs)
+		if err != nil {
+			return err
+		}
+		result.AddActualRegisters(actualRegisters)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = addInput(analyzerplugin.InputType_TPMDevice, input.TPMDevice, func(artIdx int) error {
+		tpm, err := artifacts.GetTPMDevice(ctx, artIdx)
+		if err != nil {
+			return err
+		}
+		result.AddTPMDevice(tpm)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = addInput(analyzerplugin.InputType_TPMEventLog, input.TPMEventLog, func(artIdx int) error {
+		eventlog, err := artifacts.GetTPMEventLog(ctx, artIdx)
+		if err != nil {
+			return err
+		}
+		result.AddTPMEventLog(eventlog)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = addInput(analyzerplugin.InputType_ActualPCR0, input.ActualPCR0, func(artIdx int) error {
+		pcr, pcrIdx, err := artifacts.GetPCR(ctx, artIdx)
+		if err != nil {
+			return err
+		}
+		if pcrIdx != 0 {
+			return fmt.Errorf("unexpected PCR index: %d != 0", pcrIdx)
+		}
+		result.AddActualPCR0(pcr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = addInput(analyzerplugin.InputType_ACPITables, input.ACPITables, func(artIdx int) error {
+		tables, err := artifacts.GetACPITables(ctx, artIdx)
+		if err != nil {
+			return err
+		}
+		result.AddActualACPITables(tables)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 type registersArtifactsIndicies interface {
 	IsSetStatusRegisters() bool
 	GetStatusRegisters() int32
@@ -24,8 +24,16 @@ import (
 
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 	"github.com/immune-gmbh/attestation-sdk/if/generated/device"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
 	"github.com/immune-gmbh/attestation-sdk/pkg/firmwaredb"
+	"github.com/immune-gmbh/attestation-sdk/pkg/verdict"
 )
 
 func init() {
@@ -53,12 +61,55 @@ type Controller struct {
 	OriginalFWDB              firmwaredb.DB
 	OriginalFWImageRepository originalFWImageRepository
 	analyzersRegistry         *analyzers.Registry
+	referenceData             *analyzers.ReferenceData
 	analysisDataCalculator    analysisDataCalculatorInterface
+	advisoryDB                *vulnerablemodules.AdvisoryDBWatcher
+	nvramRules                *diffmeasuredboot.NVRAMRules
+	diagnosisRules            *diffmeasuredboot.DiagnosisRules
+	optionROMCatalog          *optionroms.Catalog
+	bootAllowlist             *bootchain.Allowlist
+	txtErrorCodes             *txterrors.ErrorCodes
+	analyzerLimits            *AnalyzerLimits
+	plugins                   map[analysis.AnalyzerID]*plugin.Plugin
+	analyzerReportCache       AnalyzerReportCachePolicy
+	verdictPolicy             *verdict.Policy
 
 	closedSignal       chan struct{}
 	activeGoroutinesWG sync.WaitGroup
 }
 
+// Options contains the optional settings of the Controller. The zero value of
+// each field disables the corresponding feature (or selects the default).
+type Options struct {
+	// AdvisoryDB is the database of vulnerable UEFI modules used by analyzer VulnerableModules.
+	AdvisoryDB *vulnerablemodules.AdvisoryDBWatcher
+
+	// NVRAMRules and DiagnosisRules are used by analyzer DiffMeasuredBoot.
+	NVRAMRules     *diffmeasuredboot.NVRAMRules
+	DiagnosisRules *diffmeasuredboot.DiagnosisRules
+
+	// OptionROMCatalog is the catalog of known option ROMs used by analyzer OptionROMs.
+	OptionROMCatalog *optionroms.Catalog
+
+	// BootAllowlist is the allowlist of boot components used by analyzer BootChain.
+	BootAllowlist *bootchain.Allowlist
+
+	// TXTErrorCodes is the database of TXT error codes used by analyzer TXTErrors.
+	TXTErrorCodes *txterrors.ErrorCodes
+
+	// AnalyzerLimits defines the execution budgets of analyzers.
+	AnalyzerLimits *AnalyzerLimits
+
+	// Plugins are out-of-process analyzers.
+	Plugins []*plugin.Plugin
+
+	// AnalyzerReportCache defines if stored analyzer reports are reused.
+	AnalyzerReportCache AnalyzerReportCachePolicy
+
+	// VerdictPolicy defines how the host verdict is derived, verdict.DefaultPolicy is used if nil.
+	VerdictPolicy *verdict.Policy
+}
+
 func New(
 	ctx context.Context,
 	firmwareStorage Storage,
@@ -67,13 +118,31 @@ func New(
 	analysisDataCalculator analysisDataCalculatorInterface,
 	deviceGetter DeviceGetter,
 	apiCachePurgeTimeout time.Duration,
+	opts Options,
 ) (*Controller, error) {
 	ctx = beltctx.WithField(ctx, "module", "controller")
 
-	analyzersRegistry, err := analyzers.NewRegistryWithKnownAnalyzers()
+	referenceData := analyzers.NewReferenceData()
+	analyzersRegistry, err := analyzers.NewRegistryWithKnownAnalyzers(referenceData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create analyzers registry: %w", err)
 	}
+	if err := analyzers.AddPlugins(analyzersRegistry, opts.Plugins); err != nil {
+		return nil, fmt.Errorf("failed to register analyzer plugins: %w", err)
+	}
+	if dc, ok := analysisDataCalculator.(*analysis.DataCalculator); ok {
+		if err := analyzersRegistry.RegisterAnalyzerReports(dc); err != nil {
+			return nil, fmt.Errorf("failed to provide analyzer reports to dependent analyzers: %w", err)
+		}
+	}
+	verdictPolicy := opts.VerdictPolicy
+	if verdictPolicy == nil {
+		verdictPolicy = verdict.DefaultPolicy()
+	}
+	pluginsMap := make(map[analysis.AnalyzerID]*plugin.Plugin, len(opts.Plugins))
+	for _, p := range opts.Plugins {
+		pluginsMap[p.ID()] = p
+	}
 
 	ctrl := &Controller{
 		FirmwareStorage:           firmwareStorage,
@@ -81,7 +150,18 @@ func New(
 		OriginalFWDB:              origFirmwareDB,
 		OriginalFWImageRepository: origFirmwareRepo,
 		analyzersRegistry:         analyzersRegistry,
+		referenceData:             referenceData,
 		analysisDataCalculator:    analysisDataCalculator,
+		advisoryDB:                opts.AdvisoryDB,
+		nvramRules:                opts.NVRAMRules,
+		diagnosisRules:            opts.DiagnosisRules,
+		optionROMCatalog:          opts.OptionROMCatalog,
+		bootAllowlist:             opts.BootAllowlist,
+		txtErrorCodes:             opts.TXTErrorCodes,
+		analyzerLimits:            opts.AnalyzerLimits,
+		plugins:                   pluginsMap,
+		analyzerReportCache:       opts.AnalyzerReportCache,
+		verdictPolicy:             verdictPolicy,
 
 		closedSignal: make(chan struct{}),
 	}
@@ -90,6 +170,9 @@ func New(
 	ctrl.launchAsync(ctrl.Context, func(ctx context.Context) {
 		ctrl.updateCacheLoop(ctx, apiCachePurgeTimeout)
 	})
+	if opts.AdvisoryDB != nil {
+		ctrl.launchAsync(ctrl.Context, opts.AdvisoryDB.Watch)
+	}
 	return ctrl, nil
 }
 
@@ -117,6 +200,15 @@ func (ctrl *Controller) purgeAPICache() {
 	// TODO: purge any cache
 }
 
+// getAdvisoryDB returns the current version of the advisory database
+// of vulnerable UEFI modules (or nil if it is not configured).
+func (ctrl *Controller) getAdvisoryDB() *vulnerablemodules.AdvisoryDB {
+	if ctrl.advisoryDB == nil {
+		return nil
+	}
+	return ctrl.advisoryDB.Get()
+}
+
 // getHostInfo tries to get full information about the host being analyzed.
 // If request is being made from the host that is being analyzed, a host can provide information about itself in thrift input structure.
 // But that information may not be full
@@ -0,0 +1,64 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
+)
+
+// addDependencyGraphs sets the input dependency graphs of the analyzers to
+// the results (which are in the same order as the analyzer reports), so that
+// it is possible to find out why an analyzer did not run. Errors are only logged.
+func (ctrl *Controller) addDependencyGraphs(
+	ctx context.Context,
+	analyzerReports []models.AnalyzerReport,
+	results []*afas.AnalyzerResult_,
+) {
+	log := logger.FromCtx(ctx)
+
+	graphBuilder, ok := ctrl.analysisDataCalculator.(analysis.DependencyGraphBuilder)
+	if !ok {
+		log.Debugf("data calculator %T is not able to build dependency graphs", ctrl.analysisDataCalculator)
+		return
+	}
+	for idx, analyzerReport := range analyzerReports {
+		if analyzerReport.Input == nil || idx >= len(results) {
+			continue
+		}
+		analyzerID := analyzerReport.AnalyzerID
+		analyzer := ctrl.analyzersRegistry.GetExecutor(analyzerID)
+		if analyzer == nil {
+			continue
+		}
+		graph, err := graphBuilder.DependencyGraph(analyzerID, analyzer.InputType, analyzerReport.Input)
+		if err != nil {
+			log.Errorf("unable to build the dependency graph for analyzer '%s': %v", analyzerID, err)
+			continue
+		}
+		if missing := graph.MissingInputs(); len(missing) > 0 {
+			log.Infof("analyzer '%s' misses inputs: %v", analyzerID, missing)
+		}
+		jsonGraph, err := graph.JSON()
+		if err != nil {
+			log.Errorf("unable to serialize the dependency graph for analyzer '%s': %v", analyzerID, err)
+			continue
+		}
+		results[idx].DependencyGraphJSON = &[]string{string(jsonGraph)}[0]
+	}
+}
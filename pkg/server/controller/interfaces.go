@@ -40,6 +40,7 @@ type Storage interface {
 	// AnalyzeReport
 	InsertAnalyzeReport(ctx context.Context, report *models.AnalyzeReport) error
 	FindAnalyzeReports(ctx context.Context, filterInput storage.AnalyzeReportFindFilter, tx *sqlx.Tx, limit uint) ([]*models.AnalyzeReport, error)
+	FindAnalyzerReports(ctx context.Context, filter storage.AnalyzerReportFindFilter, tx *sqlx.Tx, limit uint) ([]*models.AnalyzerReport, error)
 }
 
 type DeviceGetter interface {
@@ -20,6 +20,7 @@ import (
 
 	"github.com/immune-gmbh/attestation-sdk/if/generated/afas"
 	"github.com/immune-gmbh/attestation-sdk/if/typeconv"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/storage"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
 )
@@ -67,6 +68,38 @@ func (ctrl *Controller) SearchReport(
 		findFilter.ActualFirmware.Filename = requestFilter.ActualFirmware.Filename
 		findFilter.ActualFirmware.HashStable = requestFilter.ActualFirmware.HashStable
 	}
+	if requestFilter.AnalyzerID == nil && (requestFilter.MinAnalyzerVersion != nil || requestFilter.MaxAnalyzerVersion != nil) {
+		return nil, fmt.Errorf("analyzer version bounds require an analyzer ID")
+	}
+	if requestFilter.AnalyzerID != nil {
+		analyzerID := analysis.AnalyzerID(*requestFilter.AnalyzerID)
+		findFilter.AnalyzerReport.AnalyzerID = &analyzerID
+	}
+	if requestFilter.MinAnalyzerVersion != nil {
+		version, err := analysis.ParseAnalyzerVersion(*requestFilter.MinAnalyzerVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid min analyzer version: %w", err)
+		}
+		findFilter.AnalyzerReport.MinAnalyzerVersion = &version
+	}
+	if requestFilter.MaxAnalyzerVersion != nil {
+		version, err := analysis.ParseAnalyzerVersion(*requestFilter.MaxAnalyzerVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max analyzer version: %w", err)
+		}
+		findFilter.AnalyzerReport.MaxAnalyzerVersion = &version
+	}
+
+	if requestFilter.VerdictClass != nil {
+		class, err := typeconv.FromThriftHostVerdictClass(*requestFilter.VerdictClass)
+		if err != nil {
+			return nil, fmt.Errorf("invalid verdict class: %w", err)
+		}
+		findFilter.VerdictClass = &class
+	}
+	if requestFilter.MinVerdictConfidence != nil {
+		findFilter.MinVerdictConfidence = requestFilter.MinVerdictConfidence
+	}
 
 	reports, err := ctrl.FirmwareStorage.FindAnalyzeReports(ctx, findFilter, nil, uint(limit))
 	if err != nil {
@@ -27,11 +27,20 @@ import (
 // This is moved out of `analysis` package, because this calculators contains
 // optional knowledge `analysis` should be agnostic about.
 // Currently these calculators just enable more caching to improve the performance.
-func OverrideValueCalculators(dc *analysis.DataCalculator) {
-	analysis.SetValueCalculator(dc, getActualFirmware)
-	analysis.SetValueCalculator(dc, getOriginalFirmware)
-	analysis.SetValueCalculator(dc, getActualBIOSInfo)
-	analysis.SetValueCalculator(dc, getOriginalBIOSInfo)
+func OverrideValueCalculators(dc *analysis.DataCalculator) error {
+	if err := analysis.SetValueCalculator(dc, getActualFirmware); err != nil {
+		return err
+	}
+	if err := analysis.SetValueCalculator(dc, getOriginalFirmware); err != nil {
+		return err
+	}
+	if err := analysis.SetValueCalculator(dc, getActualBIOSInfo); err != nil {
+		return err
+	}
+	if err := analysis.SetValueCalculator(dc, getOriginalBIOSInfo); err != nil {
+		return err
+	}
+	return nil
 }
 
 // biosInfoCacheInterface defines an interface where there BIOSInfo cache could be extracted from
@@ -113,6 +113,8 @@ func (svc *service) Analyze(
 		request.GetHostInfo(),
 		artifacts,
 		analyzers,
+		request.GetProfile(),
+		request.GetDependencyGraphs(),
 	)
 	if err != nil {
 		return nil, unwrapException(err)
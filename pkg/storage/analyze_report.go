@@ -21,9 +21,11 @@ import (
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/jmoiron/sqlx"
 
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/storage/helpers"
 	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
+	"github.com/immune-gmbh/attestation-sdk/pkg/verdict"
 )
 
 // InsertAnalyzeReport adds information about performed analysis.
@@ -138,15 +140,92 @@ type AnalyzeReportFindFilter struct {
 
 	// Firmware image referenced in the report.
 	ActualFirmware FindFirmwareFilter
+
+	// At least one of analyzer reports of the report should match this filter.
+	AnalyzerReport AnalyzerReportFindFilter
+
+	// VerdictClass is the class of the verdict about the host, see models.AnalyzeReport.Verdict.
+	VerdictClass *verdict.Class
+
+	// MinVerdictConfidence is the inclusive lower bound of the confidence of the verdict.
+	MinVerdictConfidence *float64
 }
 
 type analyzeReportFindFilter struct {
-	ID          *uint64
-	JobID       *types.JobID
-	AssetID     *int32
-	ProcessedAt *sql.NullTime
+	ID                   *uint64
+	JobID                *types.JobID
+	AssetID              *int32
+	ProcessedAt          *sql.NullTime
+	VerdictClass         *verdict.Class
+	MinVerdictConfidence *float64
 
 	ActualFirmwareImageIDs []types.ImageID
+	AnalyzerReport         AnalyzerReportFindFilter
+}
+
+// AnalyzerReportFindFilter is a set of values to look for in analyzer
+// reports (concatenated through "AND"-s).
+//
+// If a field has a nil-value then it is not included to filter conditions.
+type AnalyzerReportFindFilter struct {
+	AnalyzerID *analysis.AnalyzerID
+
+	// MinAnalyzerVersion is the inclusive lower bound of the analyzer version.
+	MinAnalyzerVersion *analysis.AnalyzerVersion
+
+	// MaxAnalyzerVersion is the exclusive upper bound of the analyzer version.
+	MaxAnalyzerVersion *analysis.AnalyzerVersion
+
+	// InputHash is the hash of the analyzer input, see analysis.Input.Hash.
+	InputHash []byte
+
+	// Succeeded selects reports without (if true) or with (if false) an execution error.
+	Succeeded *bool
+}
+
+// IsEmpty returns true if the filter has no conditions.
+func (filter AnalyzerReportFindFilter) IsEmpty() bool {
+	return filter.AnalyzerID == nil &&
+		filter.MinAnalyzerVersion == nil &&
+		filter.MaxAnalyzerVersion == nil &&
+		filter.InputHash == nil &&
+		filter.Succeeded == nil
+}
+
+func (filter AnalyzerReportFindFilter) whereConds() ([]string, []any, error) {
+	var whereConds []string
+	var whereArgs []any
+
+	if filter.AnalyzerID != nil {
+		whereConds = append(whereConds, "`analyzer_report`.`analyzer_id` = ?")
+		whereArgs = append(whereArgs, *filter.AnalyzerID)
+	}
+	if filter.MinAnalyzerVersion != nil {
+		if _, err := analysis.ParseAnalyzerVersion(string(*filter.MinAnalyzerVersion)); err != nil {
+			return nil, nil, fmt.Errorf("invalid min analyzer version: %w", err)
+		}
+		whereConds = append(whereConds, "`analyzer_report`.`analyzer_version_ordinal` >= ?")
+		whereArgs = append(whereArgs, filter.MinAnalyzerVersion.Ordinal())
+	}
+	if filter.MaxAnalyzerVersion != nil {
+		if _, err := analysis.ParseAnalyzerVersion(string(*filter.MaxAnalyzerVersion)); err != nil {
+			return nil, nil, fmt.Errorf("invalid max analyzer version: %w", err)
+		}
+		whereConds = append(whereConds, "`analyzer_report`.`analyzer_version_ordinal` < ?")
+		whereArgs = append(whereArgs, filter.MaxAnalyzerVersion.Ordinal())
+	}
+	if filter.InputHash != nil {
+		whereConds = append(whereConds, "`analyzer_report`.`input_hash` = ?")
+		whereArgs = append(whereArgs, filter.InputHash)
+	}
+	if filter.Succeeded != nil {
+		if *filter.Succeeded {
+			whereConds = append(whereConds, "`analyzer_report`.`exec_error_code` = 'OK'")
+		} else {
+			whereConds = append(whereConds, "`analyzer_report`.`exec_error_code` != 'OK'")
+		}
+	}
+	return whereConds, whereArgs, nil
 }
 
 // FindAnalyzeReports finds and locks existing AnalyzeReports including the related AnalyzerReports.
@@ -173,6 +252,11 @@ func (stor *Storage) FindAnalyzeReports(
 		JobID:       filterInput.JobID,
 		AssetID:     filterInput.AssetID,
 		ProcessedAt: filterInput.ProcessedAt,
+
+		VerdictClass:         filterInput.VerdictClass,
+		MinVerdictConfidence: filterInput.MinVerdictConfidence,
+
+		AnalyzerReport: filterInput.AnalyzerReport,
 	}
 
 	if filterInput.ActualFirmware.ImageID != nil {
@@ -282,6 +366,27 @@ func (stor *Storage) findAnalyzeReports(
 			whereArgs = append(whereArgs, "0000-00-00 00:00:00")
 		}
 	}
+	if filter.VerdictClass != nil {
+		whereConds = append(whereConds, "`analyze_report`.`verdict_class` = ?")
+		whereArgs = append(whereArgs, string(*filter.VerdictClass))
+	}
+	if filter.MinVerdictConfidence != nil {
+		whereConds = append(whereConds, "`analyze_report`.`verdict_confidence` >= ?")
+		whereArgs = append(whereArgs, *filter.MinVerdictConfidence)
+	}
+	if !filter.AnalyzerReport.IsEmpty() {
+		// A subquery instead of JOIN to avoid duplicates if multiple
+		// analyzer reports of the same analyze report match.
+		analyzerConds, analyzerArgs, err := filter.AnalyzerReport.whereConds()
+		if err != nil {
+			return nil, err
+		}
+		whereConds = append(whereConds, fmt.Sprintf(
+			"`analyze_report`.`id` IN (SELECT `analyzer_report`.`analyze_report_id` FROM `analyzer_report` WHERE (%s))",
+			strings.Join(analyzerConds, ") AND ("),
+		))
+		whereArgs = append(whereArgs, analyzerArgs...)
+	}
 	_, columns, err := helpers.GetValuesAndColumns(&models.AnalyzeReport{}, nil)
 	if err != nil {
 		return nil, fmt.Errorf("unable to gather column names: %w", err)
@@ -386,6 +491,58 @@ func (stor *Storage) FindAnalyzerReport(
 	return &report, nil
 }
 
+// FindAnalyzerReports finds and locks AnalyzerReports, the newest reports go first.
+//
+// The rows are write-locks as part of `tx` transaction. To unlock the rows either Commit or Rollback
+// the transaction.
+//
+// TODO: Remove these functions from `Storage`. See the TODO of FindAnalyzerReport.
+func (stor *Storage) FindAnalyzerReports(
+	ctx context.Context,
+	filter AnalyzerReportFindFilter,
+	tx *sqlx.Tx,
+	limit uint, // 0 -- no limit
+) ([]*models.AnalyzerReport, error) {
+	whereConds, whereArgs, err := filter.whereConds()
+	if err != nil {
+		return nil, err
+	}
+	_, columns, err := helpers.GetValuesAndColumns(&models.AnalyzerReport{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to gather column names: %w", err)
+	}
+	var whereTotal string
+	if len(whereConds) > 0 {
+		whereTotal = "WHERE (" + strings.Join(whereConds, ") AND (") + ")"
+	}
+	query := fmt.Sprintf(
+		"SELECT %s FROM `analyzer_report` %s ORDER BY `analyzer_report`.`id` DESC",
+		constructColumns("analyzer_report", columns),
+		whereTotal,
+	)
+	if limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	if tx != nil {
+		query += " FOR UPDATE"
+	}
+
+	logger.FromCtx(ctx).Debugf("query: <%s>; args: %v", query, whereArgs)
+	var _reports []models.AnalyzerReport
+	if err := sqlx.Select(stor.querier(tx), &_reports, query, whereArgs...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to query analyzer reports using query '%s' with args '%s': %w", query, whereArgs, err)
+	}
+
+	reports := make([]*models.AnalyzerReport, 0, len(_reports))
+	for idx := range _reports {
+		reports = append(reports, &_reports[idx])
+	}
+	return reports, nil
+}
+
 func uint64SliceToSQLList(in []uint64) string {
 	if len(in) == 0 {
 		return "()"
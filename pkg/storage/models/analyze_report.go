@@ -4,7 +4,9 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
+	"github.com/immune-gmbh/attestation-sdk/pkg/verdict"
 )
 
 // AnalyzeReport represents a full report for a single analysis
@@ -34,6 +36,13 @@ type AnalyzeReport struct {
 	// currently it is firmware-alerter.
 	GroupKey *AnalyzeReportGroupKey `db:"group_key"`
 
+	// Verdict is the overall assessment of the host combined from the reports of all analyzers.
+	Verdict *verdict.Verdict `db:"verdict"`
+
+	// Profile contains timings, cache hits/misses and allocations of the analyzers
+	// and of the values calculated for them.
+	Profile *analysis.Profile `db:"profile"`
+
 	// == Connected data (stored in other tables) ==
 
 	// AnalyzerReports is a list of succeeded analysis reports
@@ -6,10 +6,15 @@ CREATE TABLE IF NOT EXISTS `analyze_report` (
     `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     `processed_at` TIMESTAMP DEFAULT NULL,
     `group_key` BINARY(128) NULL,
+    `verdict` JSON DEFAULT NULL,
+    `verdict_class` ENUM('Inconclusive', 'Healthy', 'DegradedHardware', 'Misconfigured', 'Suspicious', 'Compromised') GENERATED ALWAYS AS (verdict ->> '$.Class'),
+    `verdict_confidence` DOUBLE GENERATED ALWAYS AS (verdict ->> '$.Confidence'),
+    `profile` JSON DEFAULT NULL,
     PRIMARY KEY (`id`),
     KEY `job_id` (`job_id`),
     KEY `asset_id` (`asset_id`),
     KEY `timestamp` (`timestamp`),
     KEY `processed_at` (`processed_at`),
-    KEY `group_key` (`group_key`)
+    KEY `group_key` (`group_key`),
+    KEY `verdict` (`verdict_class`, `verdict_confidence`)
 ) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4;
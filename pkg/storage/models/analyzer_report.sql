@@ -3,17 +3,22 @@ CREATE TABLE IF NOT EXISTS `analyzer_report` (
     `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
     `analyze_report_id` BIGINT UNSIGNED NOT NULL,
     `analyzer_id` VARCHAR(64) NOT NULL,
+    `analyzer_version` VARCHAR(32) NOT NULL DEFAULT '',
+    `input_hash` BINARY(32) NULL,
     `exec_error` JSON DEFAULT NULL,
     `input` JSON DEFAULT NULL,
     `report` JSON DEFAULT NULL,
     `diagnosis_code` VARCHAR(255) NULL,
     `input_actual_firmware_image_id` BINARY(128) GENERATED ALWAYS AS (UNHEX(input ->> '$.ActualFirmwareBlob.Blob."./server/controller/types.AnalyzerFirmwareAccessor".ImageID')),
     `input_original_firmware_image_id` BINARY(128) GENERATED ALWAYS AS (UNHEX(input ->> '$.OriginalFirmwareBlob.Blob."./server/controller/types.AnalyzerFirmwareAccessor".ImageID')),
-    `exec_error_code` ENUM('OK', 'ErrNotApplicable', 'ErrOther') GENERATED ALWAYS AS (IF(exec_error IS NULL, 'OK',IF(JSON_CONTAINS_PATH(exec_error, 'one', '$**.ErrNotApplicable'), 'ErrNotApplicable', 'ErrOther'))),
+    `analyzer_version_ordinal` BIGINT UNSIGNED GENERATED ALWAYS AS (IF(analyzer_version = '', 0, (CAST(SUBSTRING_INDEX(analyzer_version, '.', 1) AS UNSIGNED) * 1000000 + CAST(SUBSTRING_INDEX(SUBSTRING_INDEX(analyzer_version, '.', 2), '.', -1) AS UNSIGNED)) * 1000000 + CAST(SUBSTRING_INDEX(analyzer_version, '.', -1) AS UNSIGNED))),
+    `exec_error_code` ENUM('OK', 'ErrNotApplicable', 'ErrOther', 'ErrBudgetExceeded') GENERATED ALWAYS AS (IF(exec_error IS NULL, 'OK',IF(JSON_CONTAINS_PATH(exec_error, 'one', '$**.ErrNotApplicable'), 'ErrNotApplicable', IF(JSON_CONTAINS_PATH(exec_error, 'one', '$**.ErrBudgetExceeded'), 'ErrBudgetExceeded', 'ErrOther')))),
     PRIMARY KEY (`id`),
     KEY `analyze_report_id` (`analyze_report_id`),
     KEY `analyzer_diagnosis` (`analyzer_id`, `diagnosis_code`),
     KEY `input_actual_firmware_image_id` (`input_actual_firmware_image_id`),
     KEY `input_original_firmware_image_id` (`input_original_firmware_image_id`),
-    KEY `exec_error_code` (`exec_error_code`)
+    KEY `exec_error_code` (`exec_error_code`),
+    KEY `analyzer_version` (`analyzer_id`, `analyzer_version_ordinal`),
+    KEY `analyzer_input_hash` (`analyzer_id`, `input_hash`)
 ) ENGINE=InnoDB DEFAULT CHARSET=UTF8MB4;
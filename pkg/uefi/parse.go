@@ -43,3 +43,16 @@ func Parse(imageBytes []byte, decompress bool) (*UEFI, error) {
 
 	return uefi.ParseUEFIFirmwareBytes(imageBytes)
 }
+
+// SectionBody returns the content of the section without the section header.
+func SectionBody(section *fianoUEFI.Section) []byte {
+	buf := section.Buf()
+	headerSize := 4 // EFI_COMMON_SECTION_HEADER
+	if section.Header.Size == [3]uint8{0xFF, 0xFF, 0xFF} {
+		headerSize = 8 // EFI_COMMON_SECTION_HEADER2
+	}
+	if len(buf) < headerSize {
+		return nil
+	}
+	return buf[headerSize:]
+}
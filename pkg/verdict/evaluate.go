@@ -0,0 +1,123 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package verdict
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+// AnalyzerOutcome is the result of an analyzer: either Report or Err is set.
+type AnalyzerOutcome struct {
+	AnalyzerID analysis.AnalyzerID
+	Report     *analysis.Report
+	Err        error
+}
+
+// Evaluate combines the outcomes of analyzers into a verdict.
+//
+// The class of the verdict is the most severe class of the evidence. Confidences
+// of the evidence of that class are combined as independent events:
+// 1 - (1-c1)*(1-c2)*... If there is no evidence the verdict is ClassHealthy
+// with the share of applicable analyzers which succeeded as the confidence,
+// unless the share is below Policy.MinCoverage or no analyzer succeeded at all
+// (then the verdict is ClassInconclusive).
+func (policy *Policy) Evaluate(outcomes []AnalyzerOutcome) Verdict {
+	var (
+		evidence   []Evidence
+		applicable int
+		succeeded  int
+	)
+	for _, outcome := range outcomes {
+		if outcome.Err != nil {
+			if !errors.As(outcome.Err, &analysis.ErrNotApplicable{}) {
+				applicable++
+			}
+			continue
+		}
+		if outcome.Report == nil {
+			continue
+		}
+		applicable++
+		succeeded++
+		for _, issue := range outcome.Report.Issues {
+			rule := policy.match(outcome.AnalyzerID, issue)
+			if rule == nil || rule.class == ClassHealthy {
+				continue
+			}
+			evidence = append(evidence, Evidence{
+				AnalyzerID:  outcome.AnalyzerID,
+				IssueCode:   issue.Code,
+				Severity:    issue.Severity,
+				Description: issue.Description,
+				Rule:        rule.Name,
+				Class:       rule.class,
+				Confidence:  rule.Confidence,
+			})
+		}
+	}
+
+	if len(evidence) == 0 {
+		var coverage float64
+		if applicable > 0 {
+			coverage = float64(succeeded) / float64(applicable)
+		}
+		result := Verdict{
+			Class:      ClassHealthy,
+			Confidence: coverage,
+		}
+		if succeeded == 0 || coverage < policy.minCoverage() {
+			result.Class = ClassInconclusive
+		}
+		return result
+	}
+
+	sort.SliceStable(evidence, func(i, j int) bool {
+		return evidence[i].Class.Rank() > evidence[j].Class.Rank()
+	})
+	result := Verdict{
+		Class:    evidence[0].Class,
+		Evidence: evidence,
+	}
+	doubt := 1.0
+	for _, item := range evidence {
+		if item.Class != result.Class {
+			break
+		}
+		doubt *= 1 - item.Confidence
+	}
+	result.Confidence = 1 - doubt
+	return result
+}
+
+func (policy *Policy) minCoverage() float64 {
+	if policy == nil {
+		return 0
+	}
+	return policy.MinCoverage
+}
+
+func (policy *Policy) match(analyzerID analysis.AnalyzerID, issue analysis.Issue) *Rule {
+	if policy == nil {
+		return nil
+	}
+	for idx := range policy.Rules {
+		rule := &policy.Rules[idx]
+		if rule.Match.matches(analyzerID, issue) {
+			return rule
+		}
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package verdict
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+// Policy is an ordered set of rules classifying the issues reported by analyzers.
+// Each issue is classified by the first matching rule; issues which match no rule
+// do not contribute to the verdict.
+type Policy struct {
+	Rules []Rule `json:"rules" yaml:"rules"`
+
+	// MinCoverage is the minimal share of applicable analyzers which should succeed
+	// to consider a host without evidence as healthy, in range [0, 1]. Otherwise
+	// (and always if no analyzer succeeded) the verdict is "Inconclusive".
+	MinCoverage float64 `json:"min_coverage,omitempty" yaml:"min_coverage,omitempty"`
+}
+
+// Rule classifies an issue as evidence of Verdict if all the conditions specified in Match are satisfied.
+type Rule struct {
+	Name string `json:"name" yaml:"name"`
+
+	// Verdict is the name of a Class, for example "Compromised". Issues classified
+	// as "Healthy" are ignored, such rules could be used to exclude issues from
+	// more generic rules below. "Inconclusive" is not allowed.
+	Verdict string `json:"verdict" yaml:"verdict"`
+
+	// Confidence is how confident the rule is in the verdict, in range (0, 1].
+	Confidence float64 `json:"confidence" yaml:"confidence"`
+
+	Match Match `json:"match" yaml:"match"`
+
+	class Class
+}
+
+// Match is the set of conditions of a Rule. Conditions which are not
+// specified are ignored, an empty Match matches every issue.
+type Match struct {
+	// Analyzers requires the issue to be reported by one of the analyzers.
+	Analyzers []analysis.AnalyzerID `json:"analyzers,omitempty" yaml:"analyzers,omitempty"`
+
+	// IssueCodes requires the code of the issue to match one of the patterns
+	// (in the syntax of path.Match), for example "PSB_FUSES.*".
+	IssueCodes []string `json:"issue_codes,omitempty" yaml:"issue_codes,omitempty"`
+
+	// MinSeverity requires the severity of the issue to be at least the
+	// specified one: "INFO", "WARNING" or "CRITICAL".
+	MinSeverity string `json:"min_severity,omitempty" yaml:"min_severity,omitempty"`
+
+	minSeverity analysis.Severity
+}
+
+// DefaultPolicy returns the policy used if no policy is provided.
+func DefaultPolicy() *Policy {
+	policy := &Policy{
+		MinCoverage: 0.5,
+		Rules: []Rule{
+			{
+				Name:       "measured-boot-tampered",
+				Verdict:    string(ClassCompromised),
+				Confidence: 0.8,
+				Match: Match{
+					IssueCodes: []string{
+						string(analysis.IssueCodeDiffMeasuredBootSuspiciousDamage),
+						string(analysis.IssueCodeReproducePCRDisabledMeasurements),
+					},
+					MinSeverity: analysis.SeverityCritical.String(),
+				},
+			},
+			{
+				Name:       "invalid-signature",
+				Verdict:    string(ClassCompromised),
+				Confidence: 0.9,
+				Match: Match{
+					IssueCodes: []string{
+						string(analysis.IssueCodePSPSignatureIncorrectSignature),
+						string(analysis.IssueCodeBIOSRTMVolumeIncorrectSignature),
+					},
+				},
+			},
+			{
+				Name:       "firmware-modified",
+				Verdict:    string(ClassCompromised),
+				Confidence: 0.6,
+				Match: Match{
+					IssueCodes: []string{
+						string(analysis.IssueCodeOptionROMsModified),
+						string(analysis.IssueCodeIntelMEPartitionsChangedWithoutVersionChange),
+						string(analysis.IssueCodeFlashDegradationIntentionalWrite),
+					},
+				},
+			},
+			{
+				Name:       "measurements-unexplained",
+				Verdict:    string(ClassSuspicious),
+				Confidence: 0.5,
+				Match: Match{
+					IssueCodes: []string{
+						"REPRO_PCR.MISMATCH*",
+						string(analysis.IssueCodeReproducePCRReproductionFailed),
+						string(analysis.IssueCodeDiffMeasuredBootSuspiciousDamage),
+						string(analysis.IssueCodeDiffMeasuredBootSignificantNVRAMChange),
+						string(analysis.IssueCodeBootChainNotAllowlisted),
+						string(analysis.IssueCodeOptionROMsUnknown),
+					},
+				},
+			},
+			{
+				Name:       "rollback",
+				Verdict:    string(ClassSuspicious),
+				Confidence: 0.6,
+				Match: Match{
+					IssueCodes: []string{
+						"*_DOWNGRADE",
+						string(analysis.IssueCodeFirmwareProvenanceRevoked),
+					},
+				},
+			},
+			{
+				Name:       "unexpected-changes",
+				Verdict:    string(ClassSuspicious),
+				Confidence: 0.4,
+				Match: Match{
+					IssueCodes: []string{
+						string(analysis.IssueCodeIntelFlashDescriptorChanged),
+						string(analysis.IssueCodeACPITablesTableChanged),
+						"IMAGE_DIFF.*",
+					},
+					MinSeverity: analysis.SeverityWarning.String(),
+				},
+			},
+			{
+				Name:       "hardware-degradation",
+				Verdict:    string(ClassDegradedHardware),
+				Confidence: 0.7,
+				Match: Match{
+					IssueCodes: []string{
+						string(analysis.IssueCodeFlashDegradationHardwareDegradation),
+						string(analysis.IssueCodeDiffMeasuredBootNotSuspiciousDamage),
+					},
+				},
+			},
+			{
+				Name:       "weak-configuration",
+				Verdict:    string(ClassMisconfigured),
+				Confidence: 0.8,
+				Match: Match{
+					IssueCodes: []string{
+						string(analysis.IssueCodeIntelFlashDescriptorBIOSRegionWritable),
+						string(analysis.IssueCodeIntelFlashDescriptorUnlocked),
+						"PSB_FUSES.*",
+						"APCB_TOKENS.*",
+						"BIOS_RTM.*_DISABLED",
+						string(analysis.IssueCodePSPSecurityPatchLevelAntiRollbackNotEnforced),
+						"ACPI_TABLES.*DMA*",
+						"TXT_ERRORS.*",
+					},
+					MinSeverity: analysis.SeverityWarning.String(),
+				},
+			},
+			{
+				Name:       "outdated-firmware",
+				Verdict:    string(ClassMisconfigured),
+				Confidence: 0.6,
+				Match: Match{
+					IssueCodes: []string{
+						string(analysis.IssueCodeVulnerableModulesAffectedModule),
+						string(analysis.IssueCodeFirmwareProvenanceSuperseded),
+						string(analysis.IssueCodeFirmwareProvenanceOutdated),
+					},
+				},
+			},
+			{
+				Name:       "other-critical",
+				Verdict:    string(ClassSuspicious),
+				Confidence: 0.3,
+				Match: Match{
+					MinSeverity: analysis.SeverityCritical.String(),
+				},
+			},
+		},
+	}
+	if err := policy.normalize(); err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+// ParsePolicy parses a verdict policy in JSON or YAML format.
+func ParsePolicy(b []byte, isYAML bool) (*Policy, error) {
+	var policy Policy
+	var err error
+	if isYAML {
+		err = yaml.Unmarshal(b, &policy)
+	} else {
+		err = json.Unmarshal(b, &policy)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the verdict policy: %w", err)
+	}
+	if err := policy.normalize(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// LoadPolicy reads a verdict policy from a file. The format is chosen
+// by the file extension: ".yaml" and ".yml" are YAML, anything else is JSON.
+func LoadPolicy(path string) (*Policy, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the verdict policy '%s': %w", path, err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParsePolicy(b, true)
+	}
+	return ParsePolicy(b, false)
+}
+
+func (policy *Policy) normalize() error {
+	if policy.MinCoverage < 0 || policy.MinCoverage > 1 {
+		return fmt.Errorf("min coverage %v is out of range [0, 1]", policy.MinCoverage)
+	}
+	for idx := range policy.Rules {
+		if err := policy.Rules[idx].normalize(); err != nil {
+			return fmt.Errorf("invalid verdict rule #%d (%s): %w", idx, policy.Rules[idx].Name, err)
+		}
+	}
+	return nil
+}
+
+func (rule *Rule) normalize() error {
+	class, err := ParseClass(rule.Verdict)
+	if err != nil {
+		return err
+	}
+	if class == ClassInconclusive {
+		return fmt.Errorf("verdict '%s' could not be assigned by a rule", class)
+	}
+	rule.class = class
+	if rule.Confidence <= 0 || rule.Confidence > 1 {
+		return fmt.Errorf("confidence %v is out of range (0, 1]", rule.Confidence)
+	}
+	return rule.Match.normalize()
+}
+
+func (match *Match) normalize() error {
+	for _, pattern := range match.IssueCodes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid issue code pattern '%s': %w", pattern, err)
+		}
+	}
+	if match.MinSeverity != "" {
+		severity, err := parseSeverity(match.MinSeverity)
+		if err != nil {
+			return err
+		}
+		match.minSeverity = severity
+	}
+	return nil
+}
+
+func parseSeverity(s string) (analysis.Severity, error) {
+	for _, severity := range []analysis.Severity{analysis.SeverityInfo, analysis.SeverityWarning, analysis.SeverityCritical} {
+		if strings.EqualFold(s, severity.String()) {
+			return severity, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown severity '%s'", s)
+}
+
+func (match *Match) matches(analyzerID analysis.AnalyzerID, issue analysis.Issue) bool {
+	if len(match.Analyzers) > 0 && !contains(match.Analyzers, analyzerID) {
+		return false
+	}
+	if len(match.IssueCodes) > 0 {
+		matched := false
+		for _, pattern := range match.IssueCodes {
+			if ok, _ := path.Match(pattern, string(issue.Code)); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return issue.Severity >= match.minSeverity
+}
+
+func contains[T comparable](s []T, v T) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
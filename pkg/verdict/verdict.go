@@ -0,0 +1,128 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package verdict combines the reports of all analyzers of an Analyze request
+// into a single verdict about the host, according to a configurable Policy.
+package verdict
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+// Class is the overall assessment of a host.
+type Class string
+
+// The classes are listed in the ascending order of severity, see Class.Rank.
+const (
+	// ClassInconclusive means the host could not be assessed: no problems were found,
+	// but too few analyzers succeeded (see Policy.MinCoverage). It is not a class of
+	// evidence, so it has the lowest rank.
+	ClassInconclusive Class = "Inconclusive"
+
+	// ClassHealthy means no problems were found.
+	ClassHealthy Class = "Healthy"
+
+	// ClassDegradedHardware means the found problems are most likely caused by faulty hardware.
+	ClassDegradedHardware Class = "DegradedHardware"
+
+	// ClassMisconfigured means the host has a weak security configuration (or outdated/vulnerable firmware).
+	ClassMisconfigured Class = "Misconfigured"
+
+	// ClassSuspicious means there are changes which could be caused by an attack, but not necessarily are.
+	ClassSuspicious Class = "Suspicious"
+
+	// ClassCompromised means there is a strong evidence of an attack.
+	ClassCompromised Class = "Compromised"
+)
+
+// Classes returns all the classes in the ascending order of severity.
+func Classes() []Class {
+	return []Class{
+		ClassInconclusive,
+		ClassHealthy,
+		ClassDegradedHardware,
+		ClassMisconfigured,
+		ClassSuspicious,
+		ClassCompromised,
+	}
+}
+
+// Rank returns the severity of the class: the higher the more severe. Unknown classes have rank -1.
+func (c Class) Rank() int {
+	for idx, class := range Classes() {
+		if class == c {
+			return idx
+		}
+	}
+	return -1
+}
+
+// ParseClass parses the name of a class, for example "Compromised".
+func ParseClass(s string) (Class, error) {
+	c := Class(s)
+	if c.Rank() < 0 {
+		return "", fmt.Errorf("unknown verdict class '%s', known classes: %v", s, Classes())
+	}
+	return c, nil
+}
+
+// Evidence is an issue reported by an analyzer which contributed to the verdict.
+type Evidence struct {
+	AnalyzerID  analysis.AnalyzerID
+	IssueCode   analysis.IssueCode `json:",omitempty"`
+	Severity    analysis.Severity
+	Description string `json:",omitempty"`
+
+	// Rule is the name of the policy rule which classified the issue.
+	Rule       string
+	Class      Class
+	Confidence float64
+}
+
+// Verdict is the overall assessment of a host based on the reports of all analyzers.
+type Verdict struct {
+	Class Class
+
+	// Confidence is a value in range [0, 1]. For ClassHealthy and ClassInconclusive
+	// it is the share of applicable analyzers which succeeded, otherwise it combines
+	// the confidences of the evidence of the Class.
+	Confidence float64
+
+	// Evidence is sorted by the severity of the class (the most severe first).
+	Evidence []Evidence `json:",omitempty"`
+}
+
+// Scan implements database/sql.Scanner
+func (v *Verdict) Scan(src any) error {
+	var b []byte
+	switch src := src.(type) {
+	case string:
+		b = []byte(src)
+	case []byte:
+		b = src
+	default:
+		return fmt.Errorf("expected []byte or string, but received %T", src)
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Value implements database/sql/driver.Valuer
+func (v *Verdict) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
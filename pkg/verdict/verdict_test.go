@@ -0,0 +1,193 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package verdict
+
+import (
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+)
+
+func TestEvaluate(t *testing.T) {
+	policy := DefaultPolicy()
+
+	t.Run("healthy", func(t *testing.T) {
+		verdict := policy.Evaluate([]AnalyzerOutcome{
+			{AnalyzerID: "ReproducePCR", Report: &analysis.Report{Issues: []analysis.Issue{{
+				Code:     analysis.IssueCodeReproducePCRMatchedFlow,
+				Severity: analysis.SeverityInfo,
+			}}}},
+			{AnalyzerID: "IntelACM", Err: fmt.Errorf("unable to parse")},
+			{AnalyzerID: "PSBFuses", Err: analysis.NewErrNotApplicable("not AMD")},
+		})
+		require.Equal(t, Verdict{Class: ClassHealthy, Confidence: 0.5}, verdict)
+	})
+
+	t.Run("no_outcomes", func(t *testing.T) {
+		require.Equal(t, Verdict{Class: ClassInconclusive}, policy.Evaluate(nil))
+	})
+
+	t.Run("all_failed", func(t *testing.T) {
+		verdict := policy.Evaluate([]AnalyzerOutcome{
+			{AnalyzerID: "IntelACM", Err: fmt.Errorf("unable to parse")},
+			{AnalyzerID: "ReproducePCR", Err: analysis.ErrBudgetExceeded{Resource: "time", Limit: "1m"}},
+			{AnalyzerID: "PSBFuses", Err: analysis.NewErrNotApplicable("not AMD")},
+		})
+		require.Equal(t, Verdict{Class: ClassInconclusive}, verdict)
+	})
+
+	t.Run("nothing_applicable", func(t *testing.T) {
+		verdict := policy.Evaluate([]AnalyzerOutcome{
+			{AnalyzerID: "PSBFuses", Err: analysis.NewErrNotApplicable("not AMD")},
+		})
+		require.Equal(t, Verdict{Class: ClassInconclusive}, verdict)
+	})
+
+	t.Run("low_coverage", func(t *testing.T) {
+		verdict := policy.Evaluate([]AnalyzerOutcome{
+			{AnalyzerID: "ReproducePCR", Report: &analysis.Report{}},
+			{AnalyzerID: "IntelACM", Err: fmt.Errorf("unable to parse")},
+			{AnalyzerID: "IntelME", Err: fmt.Errorf("unable to parse")},
+		})
+		require.Equal(t, ClassInconclusive, verdict.Class)
+		require.InDelta(t, 1.0/3, verdict.Confidence, 1e-9)
+	})
+
+	t.Run("most_severe_class", func(t *testing.T) {
+		verdict := policy.Evaluate([]AnalyzerOutcome{
+			{AnalyzerID: "FlashDegradation", Report: &analysis.Report{Issues: []analysis.Issue{{
+				Code:     analysis.IssueCodeFlashDegradationHardwareDegradation,
+				Severity: analysis.SeverityWarning,
+			}}}},
+			{AnalyzerID: "DiffMeasuredBoot", Report: &analysis.Report{Issues: []analysis.Issue{{
+				Code:        analysis.IssueCodeDiffMeasuredBootSuspiciousDamage,
+				Severity:    analysis.SeverityWarning,
+				Description: "Suspicious damage",
+			}}}},
+			{AnalyzerID: "ReproducePCR", Report: &analysis.Report{Issues: []analysis.Issue{{
+				Code:     analysis.IssueCodeReproducePCRMismatch,
+				Severity: analysis.SeverityCritical,
+			}}}},
+		})
+		require.Equal(t, ClassSuspicious, verdict.Class)
+		require.InDelta(t, 0.75, verdict.Confidence, 1e-9)
+		require.Len(t, verdict.Evidence, 3)
+		require.Equal(t, Evidence{
+			AnalyzerID:  "DiffMeasuredBoot",
+			IssueCode:   analysis.IssueCodeDiffMeasuredBootSuspiciousDamage,
+			Severity:    analysis.SeverityWarning,
+			Description: "Suspicious damage",
+			Rule:        "measurements-unexplained",
+			Class:       ClassSuspicious,
+			Confidence:  0.5,
+		}, verdict.Evidence[0])
+		require.Equal(t, ClassDegradedHardware, verdict.Evidence[2].Class)
+	})
+
+	t.Run("critical_damage", func(t *testing.T) {
+		verdict := policy.Evaluate([]AnalyzerOutcome{
+			{AnalyzerID: "DiffMeasuredBoot", Report: &analysis.Report{Issues: []analysis.Issue{{
+				Code:     analysis.IssueCodeDiffMeasuredBootSuspiciousDamage,
+				Severity: analysis.SeverityCritical,
+			}}}},
+		})
+		require.Equal(t, ClassCompromised, verdict.Class)
+		require.InDelta(t, 0.8, verdict.Confidence, 1e-9)
+	})
+}
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`
+rules:
+  - name: ignore-txt
+    verdict: Healthy
+    confidence: 1
+    match:
+      issue_codes: ["TXT_ERRORS.*"]
+  - name: plugin-critical
+    verdict: Compromised
+    confidence: 0.9
+    match:
+      analyzers: [FirmwareSanity]
+      min_severity: critical
+`), true)
+	require.NoError(t, err)
+
+	verdict := policy.Evaluate([]AnalyzerOutcome{
+		{AnalyzerID: "TXTErrors", Report: &analysis.Report{Issues: []analysis.Issue{{
+			Code:     analysis.IssueCodeTXTErrorsError,
+			Severity: analysis.SeverityCritical,
+		}}}},
+		{AnalyzerID: "FirmwareSanity", Report: &analysis.Report{Issues: []analysis.Issue{{
+			Code:     "FIRMWARE_SANITY.ERASED",
+			Severity: analysis.SeverityWarning,
+		}}}},
+	})
+	require.Equal(t, Verdict{Class: ClassHealthy, Confidence: 1}, verdict)
+
+	for _, policy := range []string{
+		`{"rules": [{"name": "a", "verdict": "Hacked", "confidence": 1}]}`,
+		`{"rules": [{"name": "a", "verdict": "Inconclusive", "confidence": 1}]}`,
+		`{"rules": [], "min_coverage": 2}`,
+		`{"rules": [{"name": "a", "verdict": "Suspicious", "confidence": 0}]}`,
+		`{"rules": [{"name": "a", "verdict": "Suspicious", "confidence": 1, "match": {"min_severity": "fatal"}}]}`,
+		`{"rules": [{"name": "a", "verdict": "Suspicious", "confidence": 1, "match": {"issue_codes": ["["]}}]}`,
+	} {
+		_, err := ParsePolicy([]byte(policy), false)
+		require.Error(t, err, policy)
+	}
+}
+
+func TestDefaultPolicyIssueCodes(t *testing.T) {
+	for _, rule := range DefaultPolicy().Rules {
+		for _, pattern := range rule.Match.IssueCodes {
+			var matched bool
+			for _, info := range analysis.IssueCodes() {
+				if ok, _ := path.Match(pattern, string(info.Code)); ok {
+					matched = true
+					break
+				}
+			}
+			require.True(t, matched, "pattern '%s' of rule '%s' matches no issue code", pattern, rule.Name)
+		}
+	}
+}
+
+func TestVerdictSQL(t *testing.T) {
+	verdict := &Verdict{
+		Class:      ClassMisconfigured,
+		Confidence: 0.8,
+		Evidence: []Evidence{{
+			AnalyzerID: "PSBFuses",
+			IssueCode:  analysis.IssueCodePSBFusesPSBDisabled,
+			Severity:   analysis.SeverityCritical,
+			Rule:       "weak-configuration",
+			Class:      ClassMisconfigured,
+			Confidence: 0.8,
+		}},
+	}
+	value, err := verdict.Value()
+	require.NoError(t, err)
+
+	var scanned Verdict
+	require.NoError(t, scanned.Scan(value))
+	require.Equal(t, *verdict, scanned)
+
+	value, err = (*Verdict)(nil).Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
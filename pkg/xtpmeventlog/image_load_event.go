@@ -0,0 +1,125 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package xtpmeventlog
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/linuxboot/fiano/pkg/guid"
+)
+
+// imageLoadEventHeaderSize is the size of UEFI_IMAGE_LOAD_EVENT without the device path
+const imageLoadEventHeaderSize = 32
+
+// ImageLoadEvent is UEFI_IMAGE_LOAD_EVENT, the data of events
+// EV_EFI_BOOT_SERVICES_APPLICATION, EV_EFI_BOOT_SERVICES_DRIVER
+// and EV_EFI_RUNTIME_SERVICES_DRIVER.
+type ImageLoadEvent struct {
+	ImageLocationInMemory uint64
+	ImageLengthInMemory   uint64
+	ImageLinkTimeAddress  uint64
+	DevicePath            []byte
+}
+
+// ParseImageLoadEvent parses UEFI_IMAGE_LOAD_EVENT.
+func ParseImageLoadEvent(data []byte) (*ImageLoadEvent, error) {
+	if len(data) < imageLoadEventHeaderSize {
+		return nil, fmt.Errorf("too short UEFI_IMAGE_LOAD_EVENT: %d bytes", len(data))
+	}
+	result := &ImageLoadEvent{
+		ImageLocationInMemory: binary.LittleEndian.Uint64(data[0:]),
+		ImageLengthInMemory:   binary.LittleEndian.Uint64(data[8:]),
+		ImageLinkTimeAddress:  binary.LittleEndian.Uint64(data[16:]),
+		DevicePath:            data[imageLoadEventHeaderSize:],
+	}
+	devicePathLength := binary.LittleEndian.Uint64(data[24:])
+	if devicePathLength < uint64(len(result.DevicePath)) {
+		result.DevicePath = result.DevicePath[:devicePathLength]
+	}
+	return result, nil
+}
+
+// FormatDevicePath returns a text representation of a binary UEFI device path,
+// similar to the one used by the UEFI shell. Only the nodes relevant for
+// the boot flow are supported, other nodes are printed as "Path(type,subtype)".
+func FormatDevicePath(b []byte) string {
+	var nodes []string
+	for len(b) >= 4 {
+		nodeType, subType := b[0], b[1]
+		length := int(binary.LittleEndian.Uint16(b[2:]))
+		if length < 4 || length > len(b) {
+			nodes = append(nodes, "Invalid")
+			break
+		}
+		data := b[4:length]
+		b = b[length:]
+
+		switch {
+		case nodeType == 0x7F:
+			// end of the device path (instance)
+			return strings.Join(nodes, "/")
+		case nodeType == 0x01 && subType == 0x01 && len(data) >= 2:
+			nodes = append(nodes, fmt.Sprintf("Pci(0x%X,0x%X)", data[1], data[0]))
+		case nodeType == 0x02 && subType == 0x01 && len(data) >= 8:
+			hid := binary.LittleEndian.Uint32(data)
+			uid := binary.LittleEndian.Uint32(data[4:])
+			switch hid {
+			case 0x0A0341D0, 0x0A0841D0:
+				// PNP0A03 (PCI) and PNP0A08 (PCI Express) root bridges
+				nodes = append(nodes, fmt.Sprintf("PciRoot(0x%X)", uid))
+			default:
+				nodes = append(nodes, fmt.Sprintf("Acpi(0x%X,0x%X)", hid, uid))
+			}
+		case nodeType == 0x04 && subType == 0x01 && len(data) >= 38:
+			partition := binary.LittleEndian.Uint32(data)
+			switch signatureType := data[37]; signatureType {
+			case 0x02:
+				var signature guid.GUID
+				copy(signature[:], data[20:36])
+				nodes = append(nodes, fmt.Sprintf("HD(%d,GPT,%s)", partition, signature.String()))
+			case 0x01:
+				nodes = append(nodes, fmt.Sprintf("HD(%d,MBR,0x%08X)", partition, binary.LittleEndian.Uint32(data[20:])))
+			default:
+				nodes = append(nodes, fmt.Sprintf("HD(%d)", partition))
+			}
+		case nodeType == 0x04 && subType == 0x04:
+			nodes = append(nodes, decodeUTF16(data))
+		case nodeType == 0x04 && subType == 0x06 && len(data) >= 16:
+			var fileGUID guid.GUID
+			copy(fileGUID[:], data)
+			nodes = append(nodes, fmt.Sprintf("FvFile(%s)", fileGUID.String()))
+		case nodeType == 0x04 && subType == 0x08 && len(data) >= 20:
+			nodes = append(nodes, fmt.Sprintf("Offset(0x%X,0x%X)",
+				binary.LittleEndian.Uint64(data[4:]), binary.LittleEndian.Uint64(data[12:])))
+		default:
+			nodes = append(nodes, fmt.Sprintf("Path(%d,%d)", nodeType, subType))
+		}
+	}
+	return strings.Join(nodes, "/")
+}
+
+// decodeUTF16 decodes a NUL-terminated UTF-16LE string
+func decodeUTF16(b []byte) string {
+	s := make([]uint16, 0, len(b)/2)
+	for idx := 0; idx+1 < len(b); idx += 2 {
+		c := binary.LittleEndian.Uint16(b[idx:])
+		if c == 0 {
+			break
+		}
+		s = append(s, c)
+	}
+	return string(utf16.Decode(s))
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package xtpmeventlog
+
+import (
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/pcr"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/linuxboot/fiano/pkg/guid"
+	"github.com/stretchr/testify/require"
+)
+
+func devicePathNode(nodeType, subType byte, data ...byte) []byte {
+	node := []byte{nodeType, subType, 0, 0}
+	binary.LittleEndian.PutUint16(node[2:], uint16(4+len(data)))
+	return append(node, data...)
+}
+
+func TestFormatDevicePath(t *testing.T) {
+	t.Run("option_rom", func(t *testing.T) {
+		offset := make([]byte, 20)
+		binary.LittleEndian.PutUint64(offset[4:], 0x200)
+		binary.LittleEndian.PutUint64(offset[12:], 0xFFFF)
+		var devicePath []byte
+		devicePath = append(devicePath, devicePathNode(0x02, 0x01, 0xD0, 0x41, 0x03, 0x0A, 0, 0, 0, 0)...)
+		devicePath = append(devicePath, devicePathNode(0x01, 0x01, 0, 0x1C)...)
+		devicePath = append(devicePath, devicePathNode(0x04, 0x08, offset...)...)
+		devicePath = append(devicePath, devicePathNode(0x7F, 0xFF)...)
+		require.Equal(t, "PciRoot(0x0)/Pci(0x1C,0x0)/Offset(0x200,0xFFFF)", FormatDevicePath(devicePath))
+	})
+
+	t.Run("boot_application", func(t *testing.T) {
+		partitionGUID := guid.MustParse("A1B2C3D4-0000-1111-2222-333344445555")
+		hd := make([]byte, 38)
+		binary.LittleEndian.PutUint32(hd, 1)
+		copy(hd[20:], partitionGUID[:])
+		hd[36], hd[37] = 0x02, 0x02
+		var file []byte
+		for _, c := range utf16.Encode([]rune(`\EFI\BOOT\BOOTX64.EFI`)) {
+			file = binary.LittleEndian.AppendUint16(file, c)
+		}
+		file = append(file, 0, 0)
+
+		var devicePath []byte
+		devicePath = append(devicePath, devicePathNode(0x04, 0x01, hd...)...)
+		devicePath = append(devicePath, devicePathNode(0x04, 0x04, file...)...)
+		devicePath = append(devicePath, devicePathNode(0x7F, 0xFF)...)
+		require.Equal(t, `HD(1,GPT,A1B2C3D4-0000-1111-2222-333344445555)/\EFI\BOOT\BOOTX64.EFI`, FormatDevicePath(devicePath))
+
+		data := make([]byte, imageLoadEventHeaderSize)
+		binary.LittleEndian.PutUint64(data[8:], 0x1000)
+		binary.LittleEndian.PutUint64(data[24:], uint64(len(devicePath)))
+		imageLoadEvent, err := ParseImageLoadEvent(append(data, devicePath...))
+		require.NoError(t, err)
+		require.Equal(t, uint64(0x1000), imageLoadEvent.ImageLengthInMemory)
+		require.Equal(t, devicePath, imageLoadEvent.DevicePath)
+	})
+}
+
+func TestMergeBanks(t *testing.T) {
+	event := func(pcrIndex pcr.ID, data string, hashAlgo tpm2.Algorithm) *tpmeventlog.Event {
+		return &tpmeventlog.Event{
+			PCRIndex: pcrIndex,
+			Type:     tpmeventlog.EV_IPL,
+			Data:     []byte(data),
+			Digest:   &tpmeventlog.Digest{HashAlgo: hashAlgo},
+		}
+	}
+
+	events := MergeBanks([]*tpmeventlog.Event{
+		event(8, "grub_cmd: a", tpm2.AlgSHA1),
+		event(8, "grub_cmd: a", tpm2.AlgSHA256),
+		// the same command executed twice
+		event(8, "grub_cmd: a", tpm2.AlgSHA1),
+		event(8, "grub_cmd: a", tpm2.AlgSHA256),
+		event(9, "/boot/vmlinuz", tpm2.AlgSHA1),
+		{PCRIndex: 9, Type: tpmeventlog.EV_IPL},
+	})
+	require.Len(t, events, 3)
+	require.Len(t, events[0].Digests, 2)
+	require.Len(t, events[1].Digests, 2)
+	require.Len(t, events[2].Digests, 1)
+	require.Nil(t, events[2].Digest(tpm2.AlgSHA256))
+}
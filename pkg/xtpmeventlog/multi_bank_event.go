@@ -0,0 +1,74 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package xtpmeventlog
+
+import (
+	"bytes"
+
+	"github.com/9elements/converged-security-suite/v2/pkg/pcr"
+	"github.com/9elements/converged-security-suite/v2/pkg/tpmeventlog"
+)
+
+// MultiBankEvent is a single measurement extended to multiple PCR banks.
+type MultiBankEvent struct {
+	PCRIndex pcr.ID
+	Type     tpmeventlog.EventType
+	Data     []byte
+	Digests  []tpmeventlog.Digest
+}
+
+// Digest returns the digest of the specified hash algorithm (if any).
+func (ev MultiBankEvent) Digest(hashAlgo tpmeventlog.TPMAlgorithm) []byte {
+	for _, digest := range ev.Digests {
+		if digest.HashAlgo == hashAlgo {
+			return digest.Digest
+		}
+	}
+	return nil
+}
+
+func (ev MultiBankEvent) hasBank(hashAlgo tpmeventlog.TPMAlgorithm) bool {
+	for _, digest := range ev.Digests {
+		if digest.HashAlgo == hashAlgo {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeBanks merges the events of a crypto-agile EventLog which describe
+// the same measurement (but for different PCR banks). Events without
+// a digest are skipped.
+func MergeBanks(events []*tpmeventlog.Event) []MultiBankEvent {
+	var result []MultiBankEvent
+	for _, event := range events {
+		if event.Digest == nil {
+			continue
+		}
+		if len(result) > 0 {
+			last := &result[len(result)-1]
+			if last.PCRIndex == event.PCRIndex && last.Type == event.Type &&
+				bytes.Equal(last.Data, event.Data) && !last.hasBank(event.Digest.HashAlgo) {
+				last.Digests = append(last.Digests, *event.Digest)
+				continue
+			}
+		}
+		result = append(result, MultiBankEvent{
+			PCRIndex: event.PCRIndex,
+			Type:     event.Type,
+			Data:     event.Data,
+			Digests:  []tpmeventlog.Digest{*event.Digest},
+		})
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"text/template"
+)
+
+const analysisImportPath = "github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+
+var fileTemplate = template.Must(template.New("file").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+	"lowerFirst": func(s string) string {
+		return string(bytes.ToLower([]byte(s[:1]))) + s[1:]
+	},
+}).Parse(`// Code generated by analyzerinputgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+	"reflect"
+{{ range .Imports }}
+	{{ . }}{{ end }}
+)
+{{ range .Inputs }}{{ $input := . }}{{ $prefix := lowerFirst .TypeName }}
+// If this fails to compile, then {{ .TypeName }} was changed: run "go generate".
+var _ = {{ .TypeName }}(struct {
+{{ range .Fields }}	{{ .Name }} {{ .TypeExpr }}{{ if .Tag }} ` + "`{{ .Tag }}`" + `{{ end }}
+{{ end }}}{})
+
+var {{ $prefix }}Description = analysis.InputDescription{
+	Fields: []analysis.InputField{
+{{ range .Fields }}		{Name: {{ quote .Name }}, Type: reflect.TypeOf((*{{ .TypeExpr }})(nil)).Elem(){{ if .Optional }}, Optional: true{{ end }}},
+{{ end }}	},
+}
+
+func resolve{{ .TypeName }}(
+	ctx context.Context,
+	dc analysis.DataCalculatorInterface,
+	in analysis.Input,
+	cache analysis.DataCache,
+) ({{ .TypeName }}, []analysis.Issue, error) {
+	var (
+		result      {{ .TypeName }}
+		issues      []analysis.Issue
+		fieldIssues []analysis.Issue
+		err         error
+	)
+{{ range .Fields }}
+	fieldIssues, err = analysis.ResolveInputField(ctx, dc, in, cache, {{ quote .Name }}, {{ .Optional }}, &result.{{ .Name }})
+	if err != nil {
+		return {{ $input.TypeName }}{}, nil, err
+	}
+	issues = append(issues, fieldIssues...)
+{{ end }}
+	return result, issues, nil
+}
+
+func init() {
+	analysis.RegisterInputResolver[{{ .TypeName }}]({{ $prefix }}Description, resolve{{ .TypeName }})
+}
+{{ end }}`))
+
+func generate(pkg *parsedPackage) ([]byte, error) {
+	imports := map[string]string{}
+	for name, importPath := range pkg.imports {
+		imports[name] = importPath
+	}
+	if pkg.name != "analysis" {
+		imports["analysis"] = analysisImportPath
+	}
+
+	var importLines []string
+	for name, importPath := range imports {
+		if guessPackageName(importPath) == name {
+			importLines = append(importLines, strconv.Quote(importPath))
+		} else {
+			importLines = append(importLines, name+" "+strconv.Quote(importPath))
+		}
+	}
+	sort.Strings(importLines)
+
+	var buf bytes.Buffer
+	err := fileTemplate.Execute(&buf, map[string]any{
+		"Package": pkg.name,
+		"Imports": importLines,
+		"Inputs":  pkg.inputs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format the generated code: %w\n%s", err, buf.Bytes())
+	}
+	return b, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Command analyzerinputgen generates strongly-typed input resolvers for analyzers.
+//
+// It is supposed to be invoked through `go generate` from a package with analyzers:
+//
+//	//go:generate go run github.com/immune-gmbh/attestation-sdk/tools/analyzerinputgen
+//
+// For each type of the package implementing analysis.Analyzer[T] (where T is
+// a structure defined in the same package) it generates:
+//   - a compile-time check that T was not changed since the generation;
+//   - an analysis.InputDescription of the required and optional inputs;
+//   - an analysis.InputResolver[T] which resolves the fields without reflecting over T;
+//
+// and registers them with analysis.RegisterInputResolver.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/pflag"
+)
+
+func main() {
+	output := pflag.String("output", "analyzer_input_gen.go", "the name of the generated file (in the package directory)")
+	pflag.Parse()
+
+	dir := "."
+	switch pflag.NArg() {
+	case 0:
+	case 1:
+		dir = pflag.Arg(0)
+	default:
+		pflag.Usage()
+		os.Exit(2) // The default Go's exitcode on flag.Parse() problems
+	}
+
+	pkg, err := parsePackage(dir, *output)
+	if err != nil {
+		log.Fatalf("unable to parse package in '%s': %v", dir, err)
+	}
+	if len(pkg.inputs) == 0 {
+		log.Fatalf("no analyzers found in '%s'", dir)
+	}
+
+	b, err := generate(pkg)
+	if err != nil {
+		log.Fatalf("unable to generate the code: %v", err)
+	}
+
+	outputPath := filepath.Join(dir, *output)
+	if err := os.WriteFile(outputPath, b, 0644); err != nil {
+		log.Fatalf("unable to write '%s': %v", outputPath, err)
+	}
+	fmt.Printf("generated %s\n", outputPath)
+}
@@ -0,0 +1,271 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type inputField struct {
+	Name     string
+	TypeExpr string
+	Tag      string
+	Optional bool
+}
+
+type inputStruct struct {
+	TypeName string
+	Fields   []inputField
+}
+
+type parsedPackage struct {
+	name    string
+	inputs  []inputStruct
+	imports map[string]string // package name as used in the code -> import path
+}
+
+// parsePackage finds analyzers in the package in dir, skipping test files and
+// the previously generated file.
+func parsePackage(dir string, generatedFileName string) (*parsedPackage, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != generatedFileName
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected exactly one package, but found %d", len(pkgs))
+	}
+
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+
+	structs := map[string]*ast.StructType{}
+	structFiles := map[string]*ast.File{}
+	methods := map[string]map[string]*ast.FuncDecl{}
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				if decl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok && typeSpec.TypeParams == nil {
+						structs[typeSpec.Name.Name] = structType
+						structFiles[typeSpec.Name.Name] = file
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil || len(decl.Recv.List) != 1 {
+					continue
+				}
+				recvType := decl.Recv.List[0].Type
+				if star, ok := recvType.(*ast.StarExpr); ok {
+					recvType = star.X
+				}
+				recvIdent, ok := recvType.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				if methods[recvIdent.Name] == nil {
+					methods[recvIdent.Name] = map[string]*ast.FuncDecl{}
+				}
+				methods[recvIdent.Name][decl.Name.Name] = decl
+			}
+		}
+	}
+
+	result := &parsedPackage{
+		name:    pkg.Name,
+		imports: map[string]string{},
+	}
+	seen := map[string]bool{}
+	var analyzerNames []string
+	for analyzerName := range methods {
+		analyzerNames = append(analyzerNames, analyzerName)
+	}
+	sort.Strings(analyzerNames)
+	for _, analyzerName := range analyzerNames {
+		inputTypeName := analyzerInputTypeName(methods[analyzerName])
+		if inputTypeName == "" || seen[inputTypeName] {
+			continue
+		}
+		structType, ok := structs[inputTypeName]
+		if !ok {
+			continue
+		}
+		seen[inputTypeName] = true
+
+		input, err := parseInputStruct(fset, inputTypeName, structType, structFiles[inputTypeName], result.imports)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse input '%s' of analyzer '%s': %w", inputTypeName, analyzerName, err)
+		}
+		result.inputs = append(result.inputs, *input)
+	}
+	return result, nil
+}
+
+// analyzerInputTypeName returns the name of T if the methods implement analysis.Analyzer[T]:
+//
+//	ID() analysis.AnalyzerID
+//	Version() analysis.AnalyzerVersion
+//	Analyze(context.Context, T) (*analysis.Report, error)
+func analyzerInputTypeName(methods map[string]*ast.FuncDecl) string {
+	id, version, analyze := methods["ID"], methods["Version"], methods["Analyze"]
+	if id == nil || version == nil || analyze == nil {
+		return ""
+	}
+	if id.Type.Params.NumFields() != 0 || id.Type.Results.NumFields() != 1 {
+		return ""
+	}
+	if version.Type.Params.NumFields() != 0 || version.Type.Results.NumFields() != 1 {
+		return ""
+	}
+	if analyze.Type.Params.NumFields() != 2 || analyze.Type.Results.NumFields() != 2 {
+		return ""
+	}
+	var paramTypes []ast.Expr
+	for _, field := range analyze.Type.Params.List {
+		names := len(field.Names)
+		if names == 0 {
+			names = 1
+		}
+		for i := 0; i < names; i++ {
+			paramTypes = append(paramTypes, field.Type)
+		}
+	}
+	inputIdent, ok := paramTypes[1].(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return inputIdent.Name
+}
+
+func parseInputStruct(
+	fset *token.FileSet,
+	typeName string,
+	structType *ast.StructType,
+	file *ast.File,
+	imports map[string]string,
+) (*inputStruct, error) {
+	fileImports := map[string]string{}
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name := guessPackageName(importPath)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		fileImports[name] = importPath
+	}
+
+	result := &inputStruct{TypeName: typeName}
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			return nil, fmt.Errorf("embedded fields are not supported")
+		}
+
+		var typeExpr bytes.Buffer
+		if err := printer.Fprint(&typeExpr, fset, field.Type); err != nil {
+			return nil, err
+		}
+
+		var resolveErr error
+		ast.Inspect(field.Type, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkgIdent, ok := sel.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			importPath, ok := fileImports[pkgIdent.Name]
+			if !ok {
+				resolveErr = fmt.Errorf("unable to find the import of package '%s'", pkgIdent.Name)
+				return false
+			}
+			if prevPath, ok := imports[pkgIdent.Name]; ok && prevPath != importPath {
+				resolveErr = fmt.Errorf("package name '%s' is used for both '%s' and '%s'", pkgIdent.Name, prevPath, importPath)
+				return false
+			}
+			imports[pkgIdent.Name] = importPath
+			return false
+		})
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+
+		var tag string
+		if field.Tag != nil {
+			var err error
+			tag, err = strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				return nil, fmt.Errorf("field '%s' is not exported", name.Name)
+			}
+			result.Fields = append(result.Fields, inputField{
+				Name:     name.Name,
+				TypeExpr: typeExpr.String(),
+				Tag:      tag,
+				Optional: isOptional(reflect.StructTag(tag).Get("exec")),
+			})
+		}
+	}
+	return result, nil
+}
+
+// isOptional is the same logic as in package "analysis".
+func isOptional(execTag string) bool {
+	for _, tag := range strings.Split(execTag, ",") {
+		if tag == "optional" {
+			return true
+		}
+	}
+	return false
+}
+
+// guessPackageName returns the conventional name of a package given its import path
+// (the last element, ignoring major version suffixes like "/v2").
+func guessPackageName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' {
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			name = path.Base(path.Dir(importPath))
+		}
+	}
+	return strings.ReplaceAll(name, "-", "_")
+}
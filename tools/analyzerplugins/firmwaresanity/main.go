@@ -0,0 +1,108 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Command firmwaresanity is an example of an out-of-process analyzer plugin
+// of afasd (see package pluginsdk).
+//
+// It checks that the actual firmware image is not erased and that its size
+// looks like the size of a flash chip (and matches the original image, if provided).
+//
+// To enable it in afasd add it to the plugins configuration (--analyzer-plugins):
+//
+//	plugins:
+//	  - executable: /usr/local/bin/firmwaresanity
+//	    timeout: 10s
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerplugin"
+	"github.com/immune-gmbh/attestation-sdk/if/generated/analyzerreport"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin/pluginsdk"
+)
+
+// AnalyzerID is the ID of the analyzer provided by the plugin.
+const AnalyzerID = "FirmwareSanity"
+
+// Data is the plugin-specific part of the report.
+type Data struct {
+	Size         int
+	ErasedBytes  int
+	OriginalSize *int `json:",omitempty"`
+}
+
+func main() {
+	pluginsdk.Main(&analyzerplugin.Description{
+		AnalyzerID:     AnalyzerID,
+		Version:        &[]string{"1.0.0"}[0],
+		RequiredInputs: []analyzerplugin.InputType{analyzerplugin.InputType_ActualFirmware},
+		OptionalInputs: []analyzerplugin.InputType{analyzerplugin.InputType_OriginalFirmware},
+	}, analyze)
+}
+
+func analyze(ctx context.Context, inputs *analyzerplugin.Inputs) (*analyzerplugin.Report, error) {
+	image := inputs.GetActualFirmware()
+	if len(image) == 0 {
+		return nil, pluginsdk.ErrNotApplicable{Description: "empty firmware image"}
+	}
+
+	data := Data{
+		Size: len(image),
+	}
+	var zeroBytes int
+	for _, b := range image {
+		switch b {
+		case 0xff:
+			data.ErasedBytes++
+		case 0x00:
+			zeroBytes++
+		}
+	}
+
+	report := analyzerplugin.NewReport()
+	if data.ErasedBytes == len(image) || zeroBytes == len(image) {
+		report.Issues = append(report.Issues, newIssue(analyzerreport.Severity_SeverityCritical, "FIRMWARE_SANITY.ERASED",
+			"the firmware image is erased (all bytes are 0x%02X)", image[0]))
+	}
+	if bits.OnesCount(uint(len(image))) != 1 {
+		report.Issues = append(report.Issues, newIssue(analyzerreport.Severity_SeverityWarning, "FIRMWARE_SANITY.SIZE_NOT_POWER_OF_TWO",
+			"the size of the firmware image (%d) is not a power of two, the image is probably truncated", len(image)))
+	}
+	if inputs.IsSetOriginalFirmware() {
+		originalSize := len(inputs.GetOriginalFirmware())
+		data.OriginalSize = &originalSize
+		if originalSize != len(image) {
+			report.Issues = append(report.Issues, newIssue(analyzerreport.Severity_SeverityWarning, "FIRMWARE_SANITY.SIZE_MISMATCH",
+				"the size of the firmware image (%d) differs from the size of the original image (%d)", len(image), originalSize))
+		}
+	}
+	report.Comments = append(report.Comments, fmt.Sprintf("%d%% of the image is erased (0xFF)", data.ErasedBytes*100/len(image)))
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize the data: %w", err)
+	}
+	report.Data = &[]string{string(b)}[0]
+	return report, nil
+}
+
+func newIssue(severity analyzerreport.Severity, code string, format string, args ...any) *analyzerreport.Issue {
+	return &analyzerreport.Issue{
+		Severity:    severity,
+		Code:        &code,
+		Description: &[]string{fmt.Sprintf(format, args...)}[0],
+	}
+}
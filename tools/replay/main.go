@@ -14,12 +14,17 @@ package main
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"io"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/immune-gmbh/attestation-sdk/cmd/afascli/commands/analyze/format"
 	"github.com/immune-gmbh/attestation-sdk/if/typeconv"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin"
 	"github.com/immune-gmbh/attestation-sdk/pkg/observability"
 	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
 	"github.com/immune-gmbh/attestation-sdk/pkg/types"
@@ -57,7 +62,16 @@ func main() {
 	rdbmsDriver := pflag.String("rdbms-driver", "mysql", "")
 	rdbmsDSN := pflag.String("rdbms-dsn", defaultDSN, "")
 	blobstorageURL := pflag.String("object-storage-url", `fs:///srv/afasd`, "URL to an object storage where the firmware images are stored")
-	analyzerReportID := pflag.Int64("analyzer-report-id", 0, "")
+	analyzerReportID := pflag.Int64("analyzer-report-id", 0, "the ID of the analyzer report to replay")
+	analyzerID := pflag.String("analyzer-id", "", "replay all reports of this analyzer produced by versions lower than --below-version (instead of --analyzer-report-id)")
+	belowVersion := pflag.String("below-version", "", "the exclusive upper bound (MAJOR.MINOR.PATCH) of the versions of the analyzer reports to replay with --analyzer-id")
+	limit := pflag.Uint("limit", 0, "the maximal amount of reports to replay with --analyzer-id (the newest go first), 0 means no limit")
+	analyzerPluginsPath := pflag.String("analyzer-plugins", "", "path to the JSON/YAML file with the configuration of out-of-process analyzer plugins (to replay reports of plugins)")
+	advisoriesPath := pflag.String("uefi-advisories", "", "path to the JSON/YAML database of vulnerable UEFI modules (to replay reports of analyzer VulnerableModules)")
+	txtErrorCodesPath := pflag.String("txt-error-codes", "", "path to the JSON/YAML table of TXT/ACM error codes (to replay reports of analyzer TXTErrors)")
+	nvramRulesPath := pflag.String("nvram-rules", "", "path to the JSON/YAML rules classifying NVRAM variable changes (to replay reports of analyzer DiffMeasuredBoot)")
+	diagnosisRulesPath := pflag.String("diff-diagnosis-rules", "", "path to the JSON/YAML rules diagnosing firmware differences (to replay reports of analyzer DiffMeasuredBoot)")
+	bootAllowlistDir := pflag.String("boot-allowlist-dir", "", "path to the directory with allowed EFI binaries, kernels and initrds (to replay reports of analyzer BootChain)")
 	pflag.Parse()
 
 	ctx := observability.WithBelt(
@@ -82,13 +96,36 @@ func main() {
 	if pflag.NArg() != 0 {
 		usageExit()
 	}
-	if *analyzerReportID == 0 {
-		logger.FromCtx(ctx).Fatalf("-analyzer-report-id is required")
+	if (*analyzerReportID == 0) == (*analyzerID == "") {
+		logger.FromCtx(ctx).Fatalf("exactly one of --analyzer-report-id and --analyzer-id is required")
+	}
+	if (*analyzerID == "") != (*belowVersion == "") {
+		logger.FromCtx(ctx).Fatalf("--analyzer-id and --below-version should be used together")
 	}
 
 	fianoLog.DefaultLogger = newFianoLogger(logger.FromCtx(ctx).WithField("module", "fiano"))
 
-	report, err := replay.AnalyzerReport(ctx, *blobstorageURL, *rdbmsDriver, *rdbmsDSN, *analyzerReportID)
+	var analyzerPlugins []*plugin.Plugin
+	if *analyzerPluginsPath != "" {
+		pluginsConfig, err := plugin.LoadConfig(*analyzerPluginsPath)
+		assertNoError(ctx, err)
+		analyzerPlugins, err = plugin.Load(ctx, pluginsConfig)
+		assertNoError(ctx, err)
+	}
+
+	referenceData, err := loadReferenceData(*advisoriesPath, *txtErrorCodesPath, *bootAllowlistDir, *nvramRulesPath, *diagnosisRulesPath)
+	assertNoError(ctx, err)
+
+	if *analyzerID != "" {
+		version, err := analysis.ParseAnalyzerVersion(*belowVersion)
+		assertNoError(ctx, err)
+		summary, err := replay.OutdatedAnalyzerReports(ctx, *blobstorageURL, *rdbmsDriver, *rdbmsDSN, analysis.AnalyzerID(*analyzerID), version, *limit, analyzerPlugins, referenceData)
+		assertNoError(ctx, err)
+		printReplaySummary(os.Stdout, summary)
+		return
+	}
+
+	report, err := replay.AnalyzerReport(ctx, *blobstorageURL, *rdbmsDriver, *rdbmsDSN, *analyzerReportID, analyzerPlugins, referenceData)
 	assertNoError(ctx, err)
 
 	format.HumanReadable(os.Stdout, *typeconv.ToThriftAnalyzeReport(&models.AnalyzeReport{
@@ -101,3 +138,23 @@ func main() {
 		AnalyzerReports: []models.AnalyzerReport{*report},
 	}), true, false)
 }
+
+func printReplaySummary(w io.Writer, summary *replay.ReplaySummary) {
+	fmt.Fprintf(w, "replayed: %d, unchanged verdicts: %d, changed verdicts: %d, failed to replay: %d\n",
+		summary.Replayed, summary.Unchanged, len(summary.Changed), len(summary.Failed))
+	for _, change := range summary.Changed {
+		fmt.Fprintf(w, "\nanalyzer report %d (analyze report %d): version %s -> %s\n",
+			change.AnalyzerReportID, change.AnalyzeReportID, change.OldVersion, change.NewVersion)
+		fmt.Fprintf(w, "\tbefore: %s\n", change.OldVerdict)
+		fmt.Fprintf(w, "\tafter:  %s\n", change.NewVerdict)
+	}
+
+	failedIDs := make([]uint64, 0, len(summary.Failed))
+	for id := range summary.Failed {
+		failedIDs = append(failedIDs, id)
+	}
+	sort.Slice(failedIDs, func(i, j int) bool { return failedIDs[i] < failedIDs[j] })
+	for _, id := range failedIDs {
+		fmt.Fprintf(w, "\nanalyzer report %d: unable to replay: %v\n", id, summary.Failed[id])
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
+)
+
+// loadReferenceData loads the reference data of analyzers from the given paths
+// (empty paths are skipped). The inputs of the replayed reports reference the data
+// by content hash, so the data should be the same as used by afasd to produce the reports.
+func loadReferenceData(
+	advisoriesPath string,
+	txtErrorCodesPath string,
+	bootAllowlistDir string,
+	nvramRulesPath string,
+	diagnosisRulesPath string,
+) (*analyzers.ReferenceData, error) {
+	result := analyzers.NewReferenceData()
+
+	if advisoriesPath != "" {
+		advisoryDB, err := vulnerablemodules.LoadAdvisoryDB(advisoriesPath)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := result.AdvisoryDBs.Put(advisoryDB); err != nil {
+			return nil, err
+		}
+	}
+	if txtErrorCodesPath != "" {
+		errorCodes, err := txterrors.LoadErrorCodes(txtErrorCodesPath)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := result.TXTErrorCodes.Put(errorCodes); err != nil {
+			return nil, err
+		}
+	}
+	if bootAllowlistDir != "" {
+		allowlist, err := bootchain.LoadAllowlistDir(bootAllowlistDir)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := result.BootAllowlists.Put(allowlist); err != nil {
+			return nil, err
+		}
+	}
+	if nvramRulesPath != "" {
+		nvramRules, err := diffmeasuredboot.LoadNVRAMRules(nvramRulesPath)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := result.NVRAMRules.Put(nvramRules); err != nil {
+			return nil, err
+		}
+	}
+	if diagnosisRulesPath != "" {
+		diagnosisRules, err := diffmeasuredboot.LoadDiagnosisRules(diagnosisRulesPath)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := result.DiagnosisRules.Put(diagnosisRules); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
@@ -17,12 +17,25 @@ import (
 
 	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/acpitables"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/apcbsectokens"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/biosrtmvolume"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/psbfuses"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspsignature"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/amd/pspspl"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/bootchain"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/diffmeasuredboot"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/firmwareprovenance"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/flashdegradation"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/imagediff"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelacm"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelifd"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/intelme"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/optionroms"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin"
 	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/reproducepcr"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/txterrors"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/vulnerablemodules"
 	"github.com/immune-gmbh/attestation-sdk/pkg/blobstorage"
 	controllertypes "github.com/immune-gmbh/attestation-sdk/pkg/server/controller/types"
 	"github.com/immune-gmbh/attestation-sdk/pkg/storage"
@@ -33,37 +46,74 @@ import (
 
 // AnalyzerReport executes an analyzer using inputs of an analyzer report with given ID.
 // Returns a regenerated report.
+//
+// plugins are the out-of-process analyzer plugins which may be used to regenerate the report.
+// referenceData provides the reference data (databases, catalogs, etc) referenced by
+// the inputs by their content hashes, see analyzers.ReferenceData.
 func AnalyzerReport(
 	ctx context.Context,
 	blobstoreURL string,
 	rdbmsDriver string,
 	rdbmsURL string,
 	analyzerReportID int64,
+	plugins []*plugin.Plugin,
+	referenceData *analyzers.ReferenceData,
 ) (*models.AnalyzerReport, error) {
+	stor, closeFn, err := openStorage(ctx, blobstoreURL, rdbmsDriver, rdbmsURL)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	report, err := stor.FindAnalyzerReport(nil, analyzerReportID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find analyzer report (ID: %d): %w", analyzerReportID, err)
+	}
+
+	if err := replayAnalyzerReport(ctx, stor, report, plugins, referenceData); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func openStorage(
+	ctx context.Context,
+	blobstoreURL string,
+	rdbmsDriver string,
+	rdbmsURL string,
+) (*storage.Storage, func(), error) {
 	blobStorage, err := blobstorage.New(blobstoreURL)
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the blob storage using URL '%s': %w", blobstoreURL, err)
+		return nil, nil, fmt.Errorf("unable to initialize the blob storage using URL '%s': %w", blobstoreURL, err)
 	}
-	defer func() {
-		if err := blobStorage.Close(); err != nil {
-			logger.FromCtx(ctx).Error(err)
-		}
-	}()
 
 	stor, err := storage.New(rdbmsDriver, rdbmsURL, blobStorage, nil, logger.FromCtx(ctx).WithField("module", "storage"))
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize Storage client: %w", err)
+		if err := blobStorage.Close(); err != nil {
+			logger.FromCtx(ctx).Error(err)
+		}
+		return nil, nil, fmt.Errorf("unable to initialize Storage client: %w", err)
 	}
-	defer func() {
+
+	return stor, func() {
 		if err := stor.Close(); err != nil {
 			logger.FromCtx(ctx).Error(err)
 		}
-	}()
+		if err := blobStorage.Close(); err != nil {
+			logger.FromCtx(ctx).Error(err)
+		}
+	}, nil
+}
 
-	report, err := stor.FindAnalyzerReport(nil, analyzerReportID)
-	if err != nil {
-		return nil, fmt.Errorf("unable to find analyzer report (ID: %d): %w", analyzerReportID, err)
-	}
+// replayAnalyzerReport re-executes the analyzer using the inputs of the report
+// and overwrites the outcome (and the analyzer version) of the report.
+func replayAnalyzerReport(
+	ctx context.Context,
+	stor *storage.Storage,
+	report *models.AnalyzerReport,
+	plugins []*plugin.Plugin,
+	referenceData *analyzers.ReferenceData,
+) error {
 
 	// prepareImage initializes an analysis.Blob:
 	//
@@ -93,48 +143,93 @@ func AnalyzerReport(
 			err = prepareImage(v.Blob)
 		}
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// TODO: infer analyzer from input type, instead of this switch with analyzer ID constants
 	switch report.AnalyzerID {
 	case apcbsectokens.ID:
-		report.Report, report.ExecError.Err = executeAnalyzer[apcbsectokens.Input](ctx, report)
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[apcbsectokens.Input](ctx, report, referenceData)
 	case biosrtmvolume.ID:
-		report.Report, report.ExecError.Err = executeAnalyzer[biosrtmvolume.Input](ctx, report)
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[biosrtmvolume.Input](ctx, report, referenceData)
 	case pspsignature.ID:
-		report.Report, report.ExecError.Err = executeAnalyzer[pspsignature.Input](ctx, report)
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[pspsignature.Input](ctx, report, referenceData)
 	case diffmeasuredboot.ID:
-		report.Report, report.ExecError.Err = executeAnalyzer[diffmeasuredboot.Input](ctx, report)
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[diffmeasuredboot.Input](ctx, report, referenceData)
 	case intelacm.ID:
-		report.Report, report.ExecError.Err = executeAnalyzer[intelacm.Input](ctx, report)
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[intelacm.Input](ctx, report, referenceData)
 	case reproducepcr.ID:
-		report.Report, report.ExecError.Err = executeAnalyzer[reproducepcr.Input](ctx, report)
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[reproducepcr.Input](ctx, report, referenceData)
+	case intelifd.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[intelifd.Input](ctx, report, referenceData)
+	case intelme.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[intelme.Input](ctx, report, referenceData)
+	case pspspl.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[pspspl.Input](ctx, report, referenceData)
+	case psbfuses.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[psbfuses.Input](ctx, report, referenceData)
+	case vulnerablemodules.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[vulnerablemodules.Input](ctx, report, referenceData)
+	case flashdegradation.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[flashdegradation.Input](ctx, report, referenceData)
+	case firmwareprovenance.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[firmwareprovenance.Input](ctx, report, referenceData)
+	case optionroms.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[optionroms.Input](ctx, report, referenceData)
+	case bootchain.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[bootchain.Input](ctx, report, referenceData)
+	case acpitables.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[acpitables.Input](ctx, report, referenceData)
+	case txterrors.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[txterrors.Input](ctx, report, referenceData)
+	case imagediff.ID:
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[imagediff.Input](ctx, report, referenceData)
 	default:
-		return nil, fmt.Errorf("unknown analyzer (ID '%s')", report.AnalyzerID)
+		if !hasPlugin(plugins, report.AnalyzerID) {
+			return fmt.Errorf("unknown analyzer (ID '%s')", report.AnalyzerID)
+		}
+		report.AnalyzerVersion, report.Report, report.ExecError.Err = executeAnalyzer[plugin.Input](ctx, report, referenceData, plugins...)
 	}
-	return report, nil
+	return nil
+}
+
+func hasPlugin(plugins []*plugin.Plugin, analyzerID analysis.AnalyzerID) bool {
+	for _, p := range plugins {
+		if p.ID() == analyzerID {
+			return true
+		}
+	}
+	return false
 }
 
 func executeAnalyzer[analyzerInputType any](
 	ctx context.Context,
 	report *models.AnalyzerReport,
-) (*analysis.Report, error) {
-	analyzersRegistry, err := analyzers.NewRegistryWithKnownAnalyzers()
+	referenceData *analyzers.ReferenceData,
+	plugins ...*plugin.Plugin,
+) (analysis.AnalyzerVersion, *analysis.Report, error) {
+	analyzersRegistry, err := analyzers.NewRegistryWithKnownAnalyzers(referenceData)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get analyzers registry: %w", err)
+		return "", nil, fmt.Errorf("unable to get analyzers registry: %w", err)
+	}
+	if err := analyzers.AddPlugins(analyzersRegistry, plugins); err != nil {
+		return "", nil, fmt.Errorf("unable to register analyzer plugins: %w", err)
 	}
 
 	analyzer := analyzers.Get[analyzerInputType](analyzersRegistry, report.AnalyzerID)
 	if analyzer == nil {
-		return nil, fmt.Errorf("analyzer with ID '%s' is not found", report.AnalyzerID)
+		return "", nil, fmt.Errorf("analyzer with ID '%s' is not found", report.AnalyzerID)
 	}
 
 	dataCalculator, err := analysis.NewDataCalculator(100)
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize data calculator: %w", err)
+		return "", nil, fmt.Errorf("unable to initialize data calculator: %w", err)
+	}
+	if err := analyzersRegistry.RegisterAnalyzerReports(dataCalculator); err != nil {
+		return "", nil, fmt.Errorf("unable to provide analyzer reports to dependent analyzers: %w", err)
 	}
 
-	return analysis.ExecuteAnalyzer(ctx, dataCalculator, analyzer, report.Input, nil)
+	analysisReport, err := analysis.ExecuteAnalyzer(ctx, dataCalculator, analyzer, report.Input, nil)
+	return analyzer.Version(), analysisReport, err
 }
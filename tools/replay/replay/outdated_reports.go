@@ -0,0 +1,167 @@
+// Copyright 2023 Meta Platforms, Inc. and affiliates.
+//
+// Redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice, this list of conditions and the following disclaimer in the documentation and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its contributors may be used to endorse or promote products derived from this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+
+	"github.com/immune-gmbh/attestation-sdk/pkg/analysis"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers"
+	"github.com/immune-gmbh/attestation-sdk/pkg/analyzers/plugin"
+	"github.com/immune-gmbh/attestation-sdk/pkg/storage"
+	"github.com/immune-gmbh/attestation-sdk/pkg/storage/models"
+)
+
+// Verdict is the part of an analyzer report, which is compared
+// to find if the outcome of analysis has changed.
+type Verdict struct {
+	// Outcome is "ok", "not applicable" or "error".
+	Outcome string
+
+	// Issues are the sorted "<severity>: <description>" lines of the report issues.
+	Issues []string
+}
+
+// NewVerdict extracts the Verdict from an analyzer report.
+func NewVerdict(report *models.AnalyzerReport) Verdict {
+	var verdict Verdict
+	switch err := report.ExecError.Err; {
+	case err == nil:
+		verdict.Outcome = "ok"
+	case errors.As(err, &analysis.ErrNotApplicable{}):
+		verdict.Outcome = "not applicable"
+	default:
+		verdict.Outcome = "error"
+	}
+	if report.Report != nil {
+		for _, issue := range report.Report.Issues {
+			verdict.Issues = append(verdict.Issues, fmt.Sprintf("%s: %s", issue.Severity, issue.Description))
+		}
+		sort.Strings(verdict.Issues)
+	}
+	return verdict
+}
+
+// Equal returns true if the verdicts are the same.
+func (v Verdict) Equal(other Verdict) bool {
+	if v.Outcome != other.Outcome || len(v.Issues) != len(other.Issues) {
+		return false
+	}
+	for idx := range v.Issues {
+		if v.Issues[idx] != other.Issues[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements fmt.Stringer.
+func (v Verdict) String() string {
+	if len(v.Issues) == 0 {
+		return v.Outcome
+	}
+	return fmt.Sprintf("%s, issues: [%s]", v.Outcome, strings.Join(v.Issues, "; "))
+}
+
+// VerdictChange is a report which verdict has changed after replaying it with the current analyzer.
+type VerdictChange struct {
+	AnalyzerReportID uint64
+	AnalyzeReportID  uint64
+	OldVersion       analysis.AnalyzerVersion
+	NewVersion       analysis.AnalyzerVersion
+	OldVerdict       Verdict
+	NewVerdict       Verdict
+}
+
+// ReplaySummary is the outcome of OutdatedAnalyzerReports.
+type ReplaySummary struct {
+	// Replayed is the amount of reports, which were re-executed.
+	Replayed uint
+
+	// Unchanged is the amount of replayed reports with the same verdict.
+	Unchanged uint
+
+	// Changed are replayed reports with a different verdict.
+	Changed []VerdictChange
+
+	// Failed are the reports which could not be replayed (for example
+	// the firmware image is not found anymore) by the analyzer report ID.
+	Failed map[uint64]error
+}
+
+// OutdatedAnalyzerReports re-executes the analyzer on inputs of all reports of it,
+// produced by analyzer versions lower than belowVersion, and summarizes which verdicts
+// have changed.
+//
+// limit is the maximal amount of reports to replay (the newest reports go first), 0 -- no limit.
+func OutdatedAnalyzerReports(
+	ctx context.Context,
+	blobstoreURL string,
+	rdbmsDriver string,
+	rdbmsURL string,
+	analyzerID analysis.AnalyzerID,
+	belowVersion analysis.AnalyzerVersion,
+	limit uint,
+	plugins []*plugin.Plugin,
+	referenceData *analyzers.ReferenceData,
+) (*ReplaySummary, error) {
+	stor, closeFn, err := openStorage(ctx, blobstoreURL, rdbmsDriver, rdbmsURL)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	reports, err := stor.FindAnalyzerReports(ctx, storage.AnalyzerReportFindFilter{
+		AnalyzerID:         &analyzerID,
+		MaxAnalyzerVersion: &belowVersion,
+	}, nil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find reports of analyzer '%s' below version %s: %w", analyzerID, belowVersion, err)
+	}
+	log := logger.FromCtx(ctx)
+	log.Infof("found %d reports of analyzer '%s' below version %s", len(reports), analyzerID, belowVersion)
+
+	summary := &ReplaySummary{
+		Failed: map[uint64]error{},
+	}
+	for _, report := range reports {
+		oldVersion, oldVerdict := report.AnalyzerVersion, NewVerdict(report)
+		if err := replayAnalyzerReport(ctx, stor, report, plugins, referenceData); err != nil {
+			log.Warnf("unable to replay analyzer report %d: %v", report.ID, err)
+			summary.Failed[report.ID] = err
+			continue
+		}
+		summary.Replayed++
+
+		newVerdict := NewVerdict(report)
+		if oldVerdict.Equal(newVerdict) {
+			summary.Unchanged++
+			continue
+		}
+		summary.Changed = append(summary.Changed, VerdictChange{
+			AnalyzerReportID: report.ID,
+			AnalyzeReportID:  report.AnalyzeReportID,
+			OldVersion:       oldVersion,
+			NewVersion:       report.AnalyzerVersion,
+			OldVerdict:       oldVerdict,
+			NewVerdict:       newVerdict,
+		})
+	}
+	return summary, nil
+}